@@ -14,6 +14,9 @@ import (
 type Account struct {
 	privateKey  *ecdsa.PrivateKey
 	extendedKey *extkeys.ExtendedKey
+	// seed is the seed of the master key, kept for accounts imported from a
+	// mnemonic so that it can be split in SLIP-39 mnemonics
+	seed []byte
 }
 
 func NewAccount(privateKey *ecdsa.PrivateKey, extKey *extkeys.ExtendedKey) Account {
@@ -22,8 +22,14 @@ var (
 	ErrAccountCannotDeriveChildKeys = errors.New("selected account cannot derive child keys")
 	// ErrAccountManagerNotSet is returned when the account mananger instance is not set.
 	ErrAccountManagerNotSet = errors.New("account manager not set")
+	// ErrAccountHasNoSeed is returned when creating SLIP-39 mnemonics for an account not imported from a mnemonic.
+	ErrAccountHasNoSeed = errors.New("selected account has no seed")
 )
 
+// slip39IterationExponent is the iteration exponent of the encryption of
+// the master secret of SLIP-39 mnemonics, the default of the reference implementation.
+const slip39IterationExponent = 1
+
 type AccountManager interface {
 	AddressToDecryptedAccount(address, password string) (types.Account, *types.Key, error)
 	ImportSingleExtendedKey(extKey *extkeys.ExtendedKey, password string) (address, pubKey string, err error)
@@ -102,7 +108,8 @@ func (g *Generator) ImportJSONKey(json string, password string) (IdentifiedAccou
 
 func (g *Generator) ImportMnemonic(mnemonicPhrase string, bip39Passphrase string) (GeneratedAccountInfo, error) {
 	mnemonic := extkeys.NewMnemonic()
-	masterExtendedKey, err := extkeys.NewMaster(mnemonic.MnemonicSeed(mnemonicPhrase, bip39Passphrase))
+	seed := mnemonic.MnemonicSeed(mnemonicPhrase, bip39Passphrase)
+	masterExtendedKey, err := extkeys.NewMaster(seed)
 	if err != nil {
 		return GeneratedAccountInfo{}, fmt.Errorf("can not create master extended key: %v", err)
 	}
@@ -110,6 +117,7 @@ func (g *Generator) ImportMnemonic(mnemonicPhrase string, bip39Passphrase string
 	acc := &Account{
 		privateKey:  masterExtendedKey.ToECDSA(),
 		extendedKey: masterExtendedKey,
+		seed:        seed,
 	}
 
 	id := g.addAccount(acc)
@@ -117,6 +125,46 @@ func (g *Generator) ImportMnemonic(mnemonicPhrase string, bip39Passphrase string
 	return acc.ToGeneratedAccountInfo(id, mnemonicPhrase), nil
 }
 
+// ImportSLIP39Mnemonics imports the account of the master secret recovered
+// from the SLIP-39 mnemonics and the passphrase.
+func (g *Generator) ImportSLIP39Mnemonics(mnemonics []string, passphrase string) (IdentifiedAccountInfo, error) {
+	masterSecret, err := extkeys.CombineSLIP39Mnemonics(mnemonics, passphrase)
+	if err != nil {
+		return IdentifiedAccountInfo{}, err
+	}
+
+	masterExtendedKey, err := extkeys.NewMaster(masterSecret)
+	if err != nil {
+		return IdentifiedAccountInfo{}, fmt.Errorf("can not create master extended key: %v", err)
+	}
+
+	acc := &Account{
+		privateKey:  masterExtendedKey.ToECDSA(),
+		extendedKey: masterExtendedKey,
+		seed:        masterSecret,
+	}
+
+	id := g.addAccount(acc)
+
+	return acc.ToIdentifiedAccountInfo(id), nil
+}
+
+// CreateSLIP39Mnemonics splits the seed of the account in groups of SLIP-39
+// mnemonics, encrypted with the passphrase. Importing groupThreshold groups,
+// each with its member threshold of mnemonics, gives back the same account.
+func (g *Generator) CreateSLIP39Mnemonics(accountID string, groupThreshold int, groups []extkeys.SLIP39Group, passphrase string) ([][]string, error) {
+	acc, err := g.findAccount(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(acc.seed) == 0 {
+		return nil, ErrAccountHasNoSeed
+	}
+
+	return extkeys.GenerateSLIP39Mnemonics(groupThreshold, groups, acc.seed, passphrase, slip39IterationExponent)
+}
+
 func (g *Generator) GenerateAndDeriveAddresses(mnemonicPhraseLength int, n int, bip39Passphrase string, pathStrings []string) ([]GeneratedAndDerivedAccountInfo, error) {
 	masterAccounts, err := g.Generate(mnemonicPhraseLength, n, bip39Passphrase)
 	if err != nil {
@@ -1,6 +1,7 @@
 package generator
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strings"
 	"testing"
@@ -8,6 +9,8 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/status-im/status-go/extkeys"
 )
 
 var testAccount = struct {
@@ -145,3 +148,49 @@ func TestGenerator_DeriveAddresses_FromImportedPrivateKey(t *testing.T) {
 	_, err = g.DeriveAddresses(info.ID, []string{"m/0/1/2"})
 	assert.Equal(t, ErrAccountCannotDeriveChildKeys, err)
 }
+
+func TestGenerator_SLIP39Mnemonics(t *testing.T) {
+	g := New(nil)
+
+	info, err := g.ImportMnemonic(testAccount.mnemonic, testAccount.bip39Passphrase)
+	assert.NoError(t, err)
+
+	groups := []extkeys.SLIP39Group{{MemberThreshold: 2, MemberCount: 3}, {MemberThreshold: 1, MemberCount: 1}}
+	mnemonics, err := g.CreateSLIP39Mnemonics(info.ID, 1, groups, "passphrase")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(mnemonics))
+	assert.Equal(t, 3, len(mnemonics[0]))
+
+	// The recovered master secret is the seed of the mnemonic, so it derives the same accounts
+	recovered, err := g.ImportSLIP39Mnemonics([]string{mnemonics[0][2], mnemonics[0][0]}, "passphrase")
+	assert.NoError(t, err)
+	assert.Equal(t, info.KeyUID, recovered.KeyUID)
+
+	key := g.accounts[recovered.ID]
+	assert.Equal(t, testAccount.extendedMasterKey, key.extendedKey.String())
+
+	recovered, err = g.ImportSLIP39Mnemonics(mnemonics[1], "passphrase")
+	assert.NoError(t, err)
+	assert.Equal(t, info.KeyUID, recovered.KeyUID)
+
+	imported, err := g.ImportPrivateKey(testAccount.bip44Key0)
+	assert.NoError(t, err)
+	_, err = g.CreateSLIP39Mnemonics(imported.ID, 1, groups, "")
+	assert.Equal(t, ErrAccountHasNoSeed, err)
+}
+
+func TestGenerator_ImportSLIP39Mnemonics(t *testing.T) {
+	g := New(nil)
+
+	// SLIP-39 test vector, 2-of-3 shares of the 128 bits master secret b43ceb7e57a0ea8766221624d01b0864
+	info, err := g.ImportSLIP39Mnemonics([]string{
+		"shadow pistol academic always adequate wildlife fancy gross oasis cylinder mustang wrist rescue view short owner flip making coding armed",
+		"shadow pistol academic acid actress prayer class unknown daughter sweater depict flip twice unkind craft early superior advocate guest smoking",
+	}, "TREZOR")
+	assert.NoError(t, err)
+
+	masterSecret, _ := hex.DecodeString("b43ceb7e57a0ea8766221624d01b0864")
+	expected, err := extkeys.NewMaster(masterSecret)
+	assert.NoError(t, err)
+	assert.Equal(t, expected.String(), g.accounts[info.ID].extendedKey.String())
+}
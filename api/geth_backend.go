@@ -1076,6 +1076,7 @@ func (b *GethStatusBackend) injectAccountsIntoServices() error {
 			if err := st.InitProtocol(identity, b.appDB, b.multiaccountsDB, acc, logutils.ZapLogger()); err != nil {
 				return err
 			}
+			st.SetAccountManager(b.accountManager)
 			// Set initial connection state
 			st.ConnectionChanged(b.connectionState)
 		}
@@ -1097,6 +1098,7 @@ func (b *GethStatusBackend) injectAccountsIntoServices() error {
 		if err := st.InitProtocol(identity, b.appDB, b.multiaccountsDB, acc, logutils.ZapLogger()); err != nil {
 			return err
 		}
+		st.SetAccountManager(b.accountManager)
 	}
 
 	return nil
@@ -40,6 +40,12 @@
 // 1618395756_contacts_only.up.sql (136B)
 // 1622184614_add_default_sync_period.up.sql (125B)
 // 1625872445_user_status.up.sql (351B)
+// 1628265400_unfurl_links_setting.up.sql (76B)
+// 1628265407_app_metrics_sent.up.sql (72B)
+// 1628265409_ens_transactions.up.sql (299B)
+// 1628265410_dapp_account_permissions.up.sql (537B)
+// 1628265413_wallet_contract_abis.up.sql (369B)
+// 1628265414_wallet_allowances.up.sql (257B)
 // doc.go (74B)
 
 package migrations
@@ -909,6 +915,126 @@ func _1625872445_user_statusUpSql() (*asset, error) {
 	return a, nil
 }
 
+var __1628265400_unfurl_links_settingUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x05\xc1\x31\x0e\x80\x20\x10\x04\xc0\xde\x57\xec\x3f\xac\x0e\x39\xaa\x13\x12\x85\x9a\x68\x44\x43\x24\x14\x02\xff\x77\x86\xc4\xf3\x06\x4f\x4a\x18\x2d\xf5\x9e\xeb\xd3\x40\x5a\x63\x71\x12\x56\x8b\x51\xef\xf1\x95\x58\x72\x7d\x5b\x4c\xf5\x38\x4b\xba\xa0\x9c\x13\x26\x0b\xcd\x86\x82\x78\x18\x92\x9d\xe7\xe9\x07\xe5\x33\x75\x60\x4c\x00\x00\x00")
+
+func _1628265400_unfurl_links_settingUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1628265400_unfurl_links_settingUpSql,
+		"1628265400_unfurl_links_setting.up.sql",
+	)
+}
+
+func _1628265400_unfurl_links_settingUpSql() (*asset, error) {
+	bytes, err := _1628265400_unfurl_links_settingUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1628265400_unfurl_links_setting.up.sql", size: 76, mode: os.FileMode(0644), modTime: time.Unix(1792393160, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x3c, 0x89, 0x58, 0x4f, 0xe8, 0x53, 0xbe, 0xb4, 0x23, 0x4f, 0xc6, 0xf8, 0xf0, 0x62, 0x70, 0xf2, 0x54, 0x8f, 0x4a, 0xfb, 0x5b, 0x66, 0x1, 0xc3, 0x4d, 0xe3, 0xfc, 0x7b, 0x9, 0x16, 0xa8, 0x2f}}
+	return a, nil
+}
+
+var __1628265407_app_metrics_sentUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x48\x00\xb7\xff\x41\x4c\x54\x45\x52\x20\x54\x41\x42\x4c\x45\x20\x61\x70\x70\x5f\x6d\x65\x74\x72\x69\x63\x73\x20\x41\x44\x44\x20\x43\x4f\x4c\x55\x4d\x4e\x20\x73\x65\x6e\x74\x20\x42\x4f\x4f\x4c\x45\x41\x4e\x20\x4e\x4f\x54\x20\x4e\x55\x4c\x4c\x20\x44\x45\x46\x41\x55\x4c\x54\x20\x46\x41\x4c\x53\x45\x3b\x0a\x03\x00\xd3\x42\xbb\x0d\x48\x00\x00\x00")
+
+func _1628265407_app_metrics_sentUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1628265407_app_metrics_sentUpSql,
+		"1628265407_app_metrics_sent.up.sql",
+	)
+}
+
+func _1628265407_app_metrics_sentUpSql() (*asset, error) {
+	bytes, err := _1628265407_app_metrics_sentUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1628265407_app_metrics_sent.up.sql", size: 72, mode: os.FileMode(0644), modTime: time.Unix(1792395578, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xa6, 0xe6, 0xfd, 0x99, 0xad, 0xd2, 0x4a, 0xb8, 0x78, 0xd8, 0x2e, 0x24, 0x2a, 0xe8, 0x9e, 0xd8, 0x51, 0x61, 0x98, 0xec, 0x4a, 0xbe, 0x28, 0xb9, 0xd2, 0xd2, 0x69, 0x12, 0xf0, 0x8f, 0xda, 0xae}}
+	return a, nil
+}
+
+var __1628265409_ens_transactionsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\xcf\xb1\x6a\xc3\x30\x14\x85\xe1\x5d\x4f\x71\xc6\x04\xfa\x06\x99\x54\xf5\x86\x8a\xaa\x52\x50\xd4\x92\x4c\xe6\x12\x0b\xd2\xa1\x6a\xd1\xbd\x1e\xfa\xf6\x25\x60\xbc\xd8\xf3\x77\x38\xf0\xbb\x4c\xb6\x10\x8a\x7d\x0e\x04\x7f\x44\x4c\x05\x74\xf1\xe7\x72\x46\x6d\x32\x68\xe7\x26\x7c\xd3\xaf\x9f\x26\xd8\x19\xe0\xce\x72\xc7\xa7\xcd\xee\xd5\x66\x9c\xb2\x7f\xb7\xf9\x8a\x37\xba\x22\x45\xb8\x14\x8f\xc1\xbb\x82\x4c\xa7\x60\x1d\x3d\x19\x40\xff\x7e\xeb\xb2\x7f\x9c\xc7\x8f\x10\x1e\x30\x49\xed\x8d\xbf\xb7\x91\xc7\xb1\x57\x91\x4d\x13\x65\x9d\xb6\xe9\xd6\x2b\x6b\x1d\x07\x56\xf8\x58\x16\x32\xfb\x83\x31\x73\xa8\x8f\x2f\x74\x59\xa5\x0d\xf3\x69\x8a\x2b\xda\x89\xb2\x4e\xb2\x3f\x98\xff\x01\x00\x43\x3a\xc5\x90\x2b\x01\x00\x00")
+
+func _1628265409_ens_transactionsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1628265409_ens_transactionsUpSql,
+		"1628265409_ens_transactions.up.sql",
+	)
+}
+
+func _1628265409_ens_transactionsUpSql() (*asset, error) {
+	bytes, err := _1628265409_ens_transactionsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1628265409_ens_transactions.up.sql", size: 299, mode: os.FileMode(0644), modTime: time.Unix(1792396335, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x33, 0xff, 0x4f, 0x19, 0xec, 0xce, 0x60, 0xe8, 0x53, 0xe4, 0x15, 0x1, 0xdc, 0xd5, 0x51, 0xcb, 0x68, 0xd7, 0x87, 0x1, 0x92, 0x9e, 0xce, 0x25, 0x53, 0xbe, 0x2a, 0xd2, 0xa2, 0x35, 0x0, 0xc2}}
+	return a, nil
+}
+
+var __1628265410_dapp_account_permissionsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x91\xb1\x6e\xeb\x20\x18\x85\x77\x9e\xe2\x8c\x89\xe4\xe1\xee\x77\xc2\x04\xa7\xa8\x14\x47\x18\x57\xcd\x64\x21\x83\x5a\x86\xd8\x08\x5c\xa9\x8f\x5f\x39\x69\x53\x2b\x72\xd5\x6c\x48\x07\xfe\xef\xfb\x0f\x4c\x73\x6a\x38\x0c\x2d\x25\x87\xa8\xa0\x6a\x03\xfe\x22\x1a\xd3\xc0\xd9\x18\x3b\xdb\xf7\xe3\xfb\x30\x75\xd1\xa7\x53\xc8\x39\x8c\x43\xc6\x86\x00\x63\x0a\xaf\x61\xc0\x33\xd5\xec\x81\xea\xf3\x33\xd5\x4a\x59\x10\xc0\x3a\x97\x7c\xce\xab\xd9\xcf\x98\xd5\xd8\x7f\xc4\x90\x7c\xee\xec\x04\xa1\xcc\x35\xc2\x8e\x57\xb4\x95\x06\xff\xe6\xf9\x7d\xf2\x76\xf2\xee\xf6\xd2\x1c\x1d\xb4\x78\xa2\xfa\x88\x47\x7e\xc4\xe6\xa2\x58\x7c\xfb\x14\x0b\xf8\x16\xb5\x02\xab\x55\x25\x05\x33\xd0\xfc\x20\x29\xe3\x64\xfb\x9f\x90\xbf\xfa\xe8\xdf\x6c\xf8\xaa\xe0\x7c\xec\x82\x43\xab\x1a\xb1\x57\x7c\x87\x52\xec\x67\xa3\xa5\xc5\x0a\x67\x16\x8d\x36\xd9\x53\x46\x29\xeb\xf2\xba\xc0\x5d\xfc\xcb\x52\x4b\x8d\x9b\x9f\xb8\x03\xfe\xab\xf8\xd2\xe4\x73\x00\x2b\xcb\x49\x9d\x19\x02\x00\x00")
+
+func _1628265410_dapp_account_permissionsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1628265410_dapp_account_permissionsUpSql,
+		"1628265410_dapp_account_permissions.up.sql",
+	)
+}
+
+func _1628265410_dapp_account_permissionsUpSql() (*asset, error) {
+	bytes, err := _1628265410_dapp_account_permissionsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1628265410_dapp_account_permissions.up.sql", size: 537, mode: os.FileMode(0644), modTime: time.Unix(1792396597, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x1b, 0x1, 0x40, 0x83, 0x7e, 0xc1, 0xb1, 0xe9, 0x73, 0xad, 0xb8, 0x96, 0xf5, 0xdb, 0xfd, 0x96, 0x96, 0x66, 0x0, 0xc6, 0x85, 0xf7, 0x6a, 0x96, 0xb7, 0x68, 0x7d, 0xc9, 0x94, 0x8, 0xdd, 0x5f}}
+	return a, nil
+}
+
+var __1628265413_wallet_contract_abisUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x8f\xc1\x6a\xc3\x30\x10\x44\xef\xfa\x8a\x39\xc6\xe0\x3f\xc8\x49\x51\x37\xae\xa8\xba\x0e\xb2\x52\x92\x93\x51\x6c\xd1\x9a\xba\x36\x48\x2a\xf9\xfd\x42\x4b\x1a\x0c\x86\x9c\x67\xdf\xec\x1b\x65\x49\x3a\x82\x93\x3b\x43\xd0\x7b\x70\xed\x40\x27\xdd\xb8\x06\x57\x3f\x8e\x21\xb7\xdd\x3c\xe5\xe8\xbb\xdc\xfa\xcb\x90\xb0\x11\xc0\x14\xf2\x75\x8e\x9f\xed\xd0\xe3\xc8\x8d\xae\x98\x9e\xb0\xd3\x95\x66\xf7\x8b\xf3\xd1\x98\x52\x00\xbe\xef\x63\x48\x09\x6f\xd2\xaa\x67\x69\x97\xd9\x65\x80\xa3\xd3\x12\x38\x58\xfd\x2a\xed\x19\x2f\x74\xc6\xe6\xfe\xa4\xbc\x35\x15\xa8\x19\xaa\xe6\xbd\xd1\xca\xc1\xd2\xc1\x48\x45\xa2\xd8\x0a\xf1\x78\xc5\x57\xc8\x1f\x73\xdf\xa6\xe1\x7d\xf2\xf9\x3b\x86\xbf\x25\x29\x8c\xa1\xcb\x73\x5c\x75\xfc\xbf\x5d\x4d\x17\xb2\xb7\x9e\xf2\x0e\x2d\x65\x75\xc5\xb5\x25\x51\x6c\xc5\xcf\x00\xca\x36\x28\x02\x71\x01\x00\x00")
+
+func _1628265413_wallet_contract_abisUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1628265413_wallet_contract_abisUpSql,
+		"1628265413_wallet_contract_abis.up.sql",
+	)
+}
+
+func _1628265413_wallet_contract_abisUpSql() (*asset, error) {
+	bytes, err := _1628265413_wallet_contract_abisUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1628265413_wallet_contract_abis.up.sql", size: 369, mode: os.FileMode(0644), modTime: time.Unix(1792399232, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x28, 0xad, 0xd0, 0xd4, 0x6, 0xf1, 0x28, 0xc4, 0xda, 0x1b, 0x84, 0xb9, 0x6e, 0xbd, 0xed, 0xfd, 0x88, 0xd, 0x8e, 0x37, 0xd8, 0xe0, 0xca, 0x62, 0x52, 0xa1, 0x7, 0x7, 0x52, 0x36, 0x7c, 0xb6}}
+	return a, nil
+}
+
+var __1628265414_wallet_allowancesUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x8e\xcb\x8a\x83\x30\x00\x45\xf7\xf9\x8a\xbb\x54\xc8\x1f\xcc\x2a\x71\x32\x4e\x98\x4c\x2c\x31\x96\xba\x12\xad\x59\x14\x35\x29\x3e\xf0\xf7\x4b\x2b\xa5\x14\xec\xfa\x72\xee\x39\x89\x11\xcc\x0a\x58\xc6\x95\x80\xfc\x81\xce\x2c\xc4\x49\xe6\x36\x47\xdd\xf7\x61\xad\xfd\xd9\x4d\x88\x08\xe0\xdd\xbc\x86\xb1\xab\x2e\x2d\x0a\x9d\xcb\x54\x8b\x6f\x70\x99\x4a\x6d\x1f\x90\x2e\x94\xa2\x04\x08\xab\x77\x23\x8e\xcc\x24\xbf\xcc\xbc\x2d\x73\xe8\x9c\xdf\x5d\xa6\xab\xf3\xed\x07\xaa\x1e\xc2\xe2\x67\x70\x95\xf1\xfb\x7d\xd3\x77\x95\x5f\x86\xc6\x8d\x7b\xf2\x83\x91\xff\xcc\x94\xf8\x13\x25\xa2\x57\x30\xdd\xaa\xe8\x96\x40\x9f\xbe\x98\xc4\x5f\xe4\x36\x00\xf6\xb7\x6e\x7c\x01\x01\x00\x00")
+
+func _1628265414_wallet_allowancesUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1628265414_wallet_allowancesUpSql,
+		"1628265414_wallet_allowances.up.sql",
+	)
+}
+
+func _1628265414_wallet_allowancesUpSql() (*asset, error) {
+	bytes, err := _1628265414_wallet_allowancesUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1628265414_wallet_allowances.up.sql", size: 257, mode: os.FileMode(0644), modTime: time.Unix(1792399439, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe0, 0xaf, 0x8c, 0xe1, 0x25, 0xbb, 0x92, 0xc2, 0xa9, 0xe3, 0x88, 0x4, 0xb9, 0xf1, 0xad, 0x1c, 0xa, 0x86, 0x67, 0x1a, 0x79, 0x62, 0xe0, 0x76, 0x95, 0xde, 0xa2, 0x55, 0xe7, 0x5c, 0x8b, 0xe3}}
+	return a, nil
+}
+
 var _docGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\xc9\xb1\x0d\xc4\x20\x0c\x05\xd0\x9e\x29\xfe\x02\xd8\xfd\x6d\xe3\x4b\xac\x2f\x44\x82\x09\x78\x7f\xa5\x49\xfd\xa6\x1d\xdd\xe8\xd8\xcf\x55\x8a\x2a\xe3\x47\x1f\xbe\x2c\x1d\x8c\xfa\x6f\xe3\xb4\x34\xd4\xd9\x89\xbb\x71\x59\xb6\x18\x1b\x35\x20\xa2\x9f\x0a\x03\xa2\xe5\x0d\x00\x00\xff\xff\x60\xcd\x06\xbe\x4a\x00\x00\x00")
 
 func docGoBytes() ([]byte, error) {
@@ -1100,6 +1226,18 @@ var _bindata = map[string]func() (*asset, error){
 
 	"1625872445_user_status.up.sql": _1625872445_user_statusUpSql,
 
+	"1628265400_unfurl_links_setting.up.sql": _1628265400_unfurl_links_settingUpSql,
+
+	"1628265407_app_metrics_sent.up.sql": _1628265407_app_metrics_sentUpSql,
+
+	"1628265409_ens_transactions.up.sql": _1628265409_ens_transactionsUpSql,
+
+	"1628265410_dapp_account_permissions.up.sql": _1628265410_dapp_account_permissionsUpSql,
+
+	"1628265413_wallet_contract_abis.up.sql": _1628265413_wallet_contract_abisUpSql,
+
+	"1628265414_wallet_allowances.up.sql": _1628265414_wallet_allowancesUpSql,
+
 	"doc.go": docGo,
 }
 
@@ -1184,6 +1322,12 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"1618395756_contacts_only.up.sql":                     &bintree{_1618395756_contacts_onlyUpSql, map[string]*bintree{}},
 	"1622184614_add_default_sync_period.up.sql":           &bintree{_1622184614_add_default_sync_periodUpSql, map[string]*bintree{}},
 	"1625872445_user_status.up.sql":                       &bintree{_1625872445_user_statusUpSql, map[string]*bintree{}},
+	"1628265400_unfurl_links_setting.up.sql":              &bintree{_1628265400_unfurl_links_settingUpSql, map[string]*bintree{}},
+	"1628265407_app_metrics_sent.up.sql":                  &bintree{_1628265407_app_metrics_sentUpSql, map[string]*bintree{}},
+	"1628265409_ens_transactions.up.sql":                  &bintree{_1628265409_ens_transactionsUpSql, map[string]*bintree{}},
+	"1628265410_dapp_account_permissions.up.sql":          &bintree{_1628265410_dapp_account_permissionsUpSql, map[string]*bintree{}},
+	"1628265413_wallet_contract_abis.up.sql":              &bintree{_1628265413_wallet_contract_abisUpSql, map[string]*bintree{}},
+	"1628265414_wallet_allowances.up.sql":                 &bintree{_1628265414_wallet_allowancesUpSql, map[string]*bintree{}},
 	"doc.go":                                              &bintree{docGo, map[string]*bintree{}},
 }}
 
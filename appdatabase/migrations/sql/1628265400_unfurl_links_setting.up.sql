@@ -0,0 +1 @@
+ALTER TABLE settings ADD COLUMN unfurl_links_enabled BOOLEAN DEFAULT FALSE;
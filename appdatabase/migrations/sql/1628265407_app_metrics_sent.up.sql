@@ -0,0 +1 @@
+ALTER TABLE app_metrics ADD COLUMN sent BOOLEAN NOT NULL DEFAULT FALSE;
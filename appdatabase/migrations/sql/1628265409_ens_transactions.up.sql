@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS ens_transactions (
+  hash VARCHAR PRIMARY KEY ON CONFLICT REPLACE,
+  type VARCHAR NOT NULL,
+  username VARCHAR NOT NULL,
+  address VARCHAR NOT NULL,
+  status VARCHAR NOT NULL,
+  created_at INT NOT NULL
+);
+
+CREATE INDEX ens_transactions_status ON ens_transactions(status);
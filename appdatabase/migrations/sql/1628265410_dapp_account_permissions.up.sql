@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS dapp_account_permissions (
+  origin VARCHAR NOT NULL,
+  address VARCHAR NOT NULL,
+  permission VARCHAR NOT NULL,
+  expires_at INT NOT NULL DEFAULT 0,
+  created_at INT NOT NULL,
+  PRIMARY KEY (origin, address, permission) ON CONFLICT REPLACE
+);
+
+CREATE TABLE IF NOT EXISTS dapp_chains (
+  chain_id UNSIGNED BIGINT PRIMARY KEY ON CONFLICT REPLACE,
+  params BLOB NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS dapp_origin_chains (
+  origin VARCHAR PRIMARY KEY ON CONFLICT REPLACE,
+  chain_id UNSIGNED BIGINT NOT NULL
+);
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS wallet_contract_abis (
+  network_id UNSIGNED BIGINT NOT NULL,
+  address VARCHAR NOT NULL,
+  abi TEXT NOT NULL,
+  PRIMARY KEY (network_id, address) ON CONFLICT REPLACE
+);
+
+CREATE TABLE IF NOT EXISTS wallet_method_signatures (
+  selector VARCHAR NOT NULL,
+  signature VARCHAR NOT NULL,
+  PRIMARY KEY (selector, signature) ON CONFLICT IGNORE
+);
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS allowances (
+  network_id UNSIGNED BIGINT NOT NULL,
+  owner VARCHAR NOT NULL,
+  token VARCHAR NOT NULL,
+  spender VARCHAR NOT NULL,
+  amount BLOB,
+  blk_number BIGINT NOT NULL,
+  PRIMARY KEY (network_id, owner, token, spender)
+);
@@ -15,6 +15,7 @@ type AppMetricEventType string
 // Value is `json.RawMessage` so we can send any json shape, including strings
 // Validation is handled using JSON schemas defined in validators.go, instead of Golang structs
 type AppMetric struct {
+	ID         int64              `json:"-"`
 	Event      AppMetricEventType `json:"event"`
 	Value      json.RawMessage    `json:"value"`
 	AppVersion string             `json:"app_version"`
@@ -76,7 +77,12 @@ func jsonschemaErrorsToError(validationErrors []AppMetricValidationError) error
 	return errors.New(strings.Join(fieldErrors[:], "/ "))
 }
 
-func (db *Database) ValidateAppMetrics(appMetrics []AppMetric) (err error) {
+func (db *Database) ValidateAppMetrics(appMetrics []AppMetric) error {
+	return ValidateAppMetrics(appMetrics)
+}
+
+// ValidateAppMetrics checks the metrics against the schema of their event
+func ValidateAppMetrics(appMetrics []AppMetric) (err error) {
 	var calculatedErrors []AppMetricValidationError
 	for _, metric := range appMetrics {
 		schema := EventSchemaMap[metric.Event]
@@ -171,3 +177,60 @@ func (db *Database) GetAppMetrics(limit int, offset int) (page Page, err error)
 	}
 	return page, nil
 }
+
+// GetUnsentAppMetrics returns the oldest metrics which haven't been sent
+// to the collector yet
+func (db *Database) GetUnsentAppMetrics(limit int) ([]AppMetric, error) {
+	rows, err := db.db.Query("SELECT rowid, event, value, app_version, operating_system, session_id, created_at FROM app_metrics WHERE NOT sent ORDER BY rowid LIMIT ?", limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var appMetrics []AppMetric
+	for rows.Next() {
+		metric := AppMetric{}
+		var sessionID sql.NullString
+		err := rows.Scan(
+			&metric.ID, &metric.Event, &metric.Value,
+			&metric.AppVersion, &metric.OS,
+			&sessionID, &metric.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		metric.SessionID = sessionID.String
+		appMetrics = append(appMetrics, metric)
+	}
+	return appMetrics, nil
+}
+
+// SetAppMetricsSent marks the metrics with the given ids as sent
+func (db *Database) SetAppMetricsSent(ids []int64) (err error) {
+	tx, err := db.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		_ = tx.Rollback()
+	}()
+
+	update, err := tx.Prepare("UPDATE app_metrics SET sent = 1 WHERE rowid = ?")
+	if err != nil {
+		return err
+	}
+	defer update.Close()
+
+	for _, id := range ids {
+		_, err = update.Exec(id)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
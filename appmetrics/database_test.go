@@ -48,3 +48,26 @@ func TestSaveAppMetrics(t *testing.T) {
 	require.NotNil(t, res[0].CreatedAt)
 	require.Equal(t, count, 1)
 }
+
+func TestUnsentAppMetrics(t *testing.T) {
+	db, stop := setupTestDB(t)
+	defer stop()
+
+	appMetrics := []AppMetric{
+		{Event: NavigateTo, Value: json.RawMessage(`{"view_id": "view-1", "params": {"screen": "login"}}`), OS: "android", AppVersion: "1.11"},
+		{Event: NavigateTo, Value: json.RawMessage(`{"view_id": "view-2", "params": {"screen": "chat"}}`), OS: "android", AppVersion: "1.11"},
+	}
+	require.NoError(t, db.SaveAppMetrics(appMetrics, "rand-omse-ssid"))
+
+	unsent, err := db.GetUnsentAppMetrics(10)
+	require.NoError(t, err)
+	require.Len(t, unsent, 2)
+	require.Equal(t, "rand-omse-ssid", unsent[0].SessionID)
+
+	require.NoError(t, db.SetAppMetricsSent([]int64{unsent[0].ID}))
+
+	unsent, err = db.GetUnsentAppMetrics(10)
+	require.NoError(t, err)
+	require.Len(t, unsent, 1)
+	require.Equal(t, appMetrics[1].Value, unsent[0].Value)
+}
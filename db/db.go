@@ -27,6 +27,8 @@ const (
 	TopicHistoryBucket
 	// HistoryRequestBucket isolated bucket for storing list of pending requests.
 	HistoryRequestBucket
+	// PeersReputation is used for the db entries with peers stats and scores.
+	PeersReputation
 )
 
 // NewMemoryDB returns leveldb with memory backend prefixed with a bucket.
@@ -0,0 +1,399 @@
+package discovery
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/p2p/discv5"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+)
+
+const (
+	dnsLookupTimeout = 10 * time.Second
+	// dnsMaxLinkDepth limits how deep links between trees are followed.
+	dnsMaxLinkDepth = 4
+)
+
+var errDNSRootNotFound = errors.New("dns tree root not found")
+
+// Resolver looks up TXT records. *net.Resolver satisfies this interface,
+// tests can provide an in-memory implementation.
+type Resolver interface {
+	LookupTXT(ctx context.Context, domain string) ([]string, error)
+}
+
+// dnsTree is the cached state of a single ENR tree.
+type dnsTree struct {
+	Seq  uint   `json:"seq"`
+	Root string `json:"root"`
+	// Entries maps the subdomain hash to the TXT record published there.
+	Entries map[string]string `json:"entries"`
+}
+
+// NewDNS creates DNS discovery that follows EIP-1459 trees at the given enrtree:// urls.
+// If resolver is nil the default net.Resolver is used. If cachePath is not empty
+// the synced trees are persisted to that file and used when DNS is unreachable.
+func NewDNS(urls []string, resolver Resolver, cachePath string) (*DNS, error) {
+	links := make([]*dnsLinkEntry, len(urls))
+	for i, url := range urls {
+		link, err := parseDNSLink(url)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse dns discovery url %s: %v", url, err)
+		}
+		links[i] = link
+	}
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+	return &DNS{
+		links:         links,
+		resolver:      resolver,
+		cachePath:     cachePath,
+		lookupTimeout: dnsLookupTimeout,
+		trees:         map[string]*dnsTree{},
+	}, nil
+}
+
+// DNS is an implementation of discovery interface that resolves nodes
+// from ENR trees published in DNS.
+type DNS struct {
+	mu      sync.RWMutex
+	running bool
+
+	rootCtx       context.Context
+	cancelRootCtx context.CancelFunc
+
+	links         []*dnsLinkEntry
+	resolver      Resolver
+	cachePath     string
+	lookupTimeout time.Duration
+
+	treesMu sync.Mutex
+	trees   map[string]*dnsTree // keyed by tree domain
+}
+
+// Running returns true if DNS discovery was started.
+func (d *DNS) Running() bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.running
+}
+
+// Start loads cached trees from disk.
+func (d *DNS) Start() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.loadCache(); err != nil {
+		log.Warn("failed to load dns discovery cache", "path", d.cachePath, "err", err)
+	}
+	d.rootCtx, d.cancelRootCtx = context.WithCancel(context.Background())
+	d.running = true
+	return nil
+}
+
+// Stop cancels running lookups.
+func (d *DNS) Stop() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !d.running {
+		return nil
+	}
+	d.cancelRootCtx()
+	d.running = false
+	return nil
+}
+
+// Register is a noop for DNS discovery, trees are published by their operators.
+// It will block until stop is closed.
+func (d *DNS) Register(topic string, stop chan struct{}) error {
+	<-stop
+	return nil
+}
+
+// Discover syncs trees every time period fetched from period channel and publishes
+// all found nodes to found channel. Trees are not split by topic, so every topic
+// receives the same nodes.
+func (d *DNS) Discover(
+	topic string, period <-chan time.Duration, found chan<- *discv5.Node, lookup chan<- bool,
+) error {
+	ticker := time.NewTicker(<-period)
+	for {
+		select {
+		case newPeriod, ok := <-period:
+			ticker.Stop()
+			if !ok {
+				return nil
+			}
+			ticker = time.NewTicker(newPeriod)
+		case <-ticker.C:
+			nodes, err := d.Nodes()
+			if err == context.Canceled {
+				return err
+			} else if err == errDiscoveryIsStopped {
+				return nil
+			} else if err != nil {
+				log.Debug("error syncing dns trees", "topic", topic, "err", err)
+			}
+			for _, n := range nodes {
+				select {
+				case found <- n:
+				case newPeriod, ok := <-period:
+					// closing a period channel is a signal to producer that consumer exited
+					ticker.Stop()
+					if !ok {
+						return nil
+					}
+					ticker = time.NewTicker(newPeriod)
+				}
+			}
+		}
+	}
+}
+
+// Nodes syncs every configured tree and returns nodes found in them.
+// Trees that can't be synced are served from the cache.
+func (d *DNS) Nodes() ([]*discv5.Node, error) {
+	d.mu.RLock()
+	if !d.running {
+		d.mu.RUnlock()
+		return nil, errDiscoveryIsStopped
+	}
+	ctx := d.rootCtx
+	d.mu.RUnlock()
+
+	var (
+		rst      []*discv5.Node
+		seen     = map[discv5.NodeID]struct{}{}
+		visited  = map[string]struct{}{}
+		messages []string
+	)
+	var walk func(link *dnsLinkEntry, depth int) error
+	walk = func(link *dnsLinkEntry, depth int) error {
+		if _, ok := visited[link.domain]; ok || depth > dnsMaxLinkDepth {
+			return nil
+		}
+		visited[link.domain] = struct{}{}
+		nodes, links, err := d.syncTree(ctx, link)
+		if err == context.Canceled {
+			return err
+		} else if err != nil {
+			messages = append(messages, fmt.Sprintf("%s: %v", link.domain, err))
+		}
+		for _, n := range nodes {
+			dn, err := enrToNode(*n.Record())
+			if err != nil {
+				log.Debug("error converting enr record to node", "err", err)
+				continue
+			}
+			if _, ok := seen[dn.ID]; ok {
+				continue
+			}
+			seen[dn.ID] = struct{}{}
+			rst = append(rst, dn)
+		}
+		for _, l := range links {
+			if err := walk(l, depth+1); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, link := range d.links {
+		if err := walk(link, 0); err != nil {
+			return rst, err
+		}
+	}
+	if err := d.saveCache(); err != nil {
+		log.Warn("failed to save dns discovery cache", "path", d.cachePath, "err", err)
+	}
+	if len(messages) != 0 {
+		return rst, fmt.Errorf("failed to sync dns trees: %s", strings.Join(messages, "; "))
+	}
+	return rst, nil
+}
+
+// syncTree fetches the root of the tree and resolves entries that are missing in the cache.
+// If the root can't be fetched, nodes and links are taken from the cached tree.
+func (d *DNS) syncTree(ctx context.Context, link *dnsLinkEntry) ([]*enode.Node, []*dnsLinkEntry, error) {
+	d.treesMu.Lock()
+	cached := d.trees[link.domain]
+	d.treesMu.Unlock()
+
+	root, err := d.resolveRoot(ctx, link)
+	if err != nil {
+		if cached == nil || err == context.Canceled {
+			return nil, nil, err
+		}
+		log.Debug("using cached dns tree", "domain", link.domain, "err", err)
+		root, rerr := parseDNSRoot(cached.Root)
+		if rerr != nil {
+			return nil, nil, err
+		}
+		nodes, links, _, werr := d.walkTree(ctx, link, root, cached, true)
+		if werr != nil {
+			return nil, nil, werr
+		}
+		return nodes, links, err
+	}
+
+	nodes, links, tree, err := d.walkTree(ctx, link, root, cached, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	d.treesMu.Lock()
+	d.trees[link.domain] = tree
+	d.treesMu.Unlock()
+	return nodes, links, nil
+}
+
+func (d *DNS) resolveRoot(ctx context.Context, link *dnsLinkEntry) (*dnsRootEntry, error) {
+	txts, err := d.lookupTXT(ctx, link.domain)
+	if err != nil {
+		return nil, err
+	}
+	for _, txt := range txts {
+		if !strings.HasPrefix(txt, dnsRootPrefix) {
+			continue
+		}
+		root, err := parseDNSRoot(txt)
+		if err != nil {
+			return nil, err
+		}
+		if !root.verifySignature(link.pubkey) {
+			return nil, errDNSInvalidSig
+		}
+		return root, nil
+	}
+	return nil, errDNSRootNotFound
+}
+
+// walkTree resolves both subtrees of the root. Entries are content addressed,
+// therefore entries present in the cached tree are never requested again.
+func (d *DNS) walkTree(
+	ctx context.Context, link *dnsLinkEntry, root *dnsRootEntry, cached *dnsTree, offline bool,
+) ([]*enode.Node, []*dnsLinkEntry, *dnsTree, error) {
+	tree := &dnsTree{Seq: root.seq, Root: root.String(), Entries: map[string]string{}}
+	var (
+		nodes []*enode.Node
+		links []*dnsLinkEntry
+	)
+	var walk func(hash string, linkTree bool) error
+	walk = func(hash string, linkTree bool) error {
+		if _, ok := tree.Entries[hash]; ok {
+			return nil
+		}
+		entry, txt, err := d.resolveEntry(ctx, link.domain, hash, cached, offline)
+		if err != nil {
+			return err
+		}
+		tree.Entries[hash] = txt
+		switch e := entry.(type) {
+		case *dnsBranchEntry:
+			for _, child := range e.children {
+				if err := walk(child, linkTree); err != nil {
+					return err
+				}
+			}
+		case *dnsENREntry:
+			if linkTree {
+				return errDNSUnexpectedENR
+			}
+			nodes = append(nodes, e.node)
+		case *dnsLinkEntry:
+			if !linkTree {
+				return errDNSUnexpectedLnk
+			}
+			links = append(links, e)
+		}
+		return nil
+	}
+	if err := walk(root.eroot, false); err != nil {
+		return nil, nil, nil, err
+	}
+	if err := walk(root.lroot, true); err != nil {
+		return nil, nil, nil, err
+	}
+	return nodes, links, tree, nil
+}
+
+func (d *DNS) resolveEntry(
+	ctx context.Context, domain, hash string, cached *dnsTree, offline bool,
+) (dnsEntry, string, error) {
+	if cached != nil {
+		if txt, ok := cached.Entries[hash]; ok {
+			entry, err := parseDNSEntry(txt, enode.ValidSchemes)
+			return entry, txt, err
+		}
+	}
+	if offline {
+		return nil, "", fmt.Errorf("entry %s is not cached", hash)
+	}
+	txts, err := d.lookupTXT(ctx, hash+"."+domain)
+	if err != nil {
+		return nil, "", err
+	}
+	for _, txt := range txts {
+		// DNS may return multiple TXT records at the same name,
+		// only the one that matches the hash is considered.
+		h := crypto.Keccak256([]byte(txt))
+		if !strings.EqualFold(b32.EncodeToString(h[:16]), hash) {
+			continue
+		}
+		entry, err := parseDNSEntry(txt, enode.ValidSchemes)
+		return entry, txt, err
+	}
+	return nil, "", errDNSHashMismatch
+}
+
+func (d *DNS) lookupTXT(ctx context.Context, name string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.lookupTimeout)
+	defer cancel()
+	txts, err := d.resolver.LookupTXT(ctx, name)
+	if ctx.Err() == context.Canceled {
+		return nil, context.Canceled
+	}
+	return txts, err
+}
+
+func (d *DNS) loadCache() error {
+	if d.cachePath == "" {
+		return nil
+	}
+	data, err := ioutil.ReadFile(d.cachePath)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	trees := map[string]*dnsTree{}
+	if err := json.Unmarshal(data, &trees); err != nil {
+		return err
+	}
+	d.treesMu.Lock()
+	d.trees = trees
+	d.treesMu.Unlock()
+	return nil
+}
+
+func (d *DNS) saveCache() error {
+	if d.cachePath == "" {
+		return nil
+	}
+	d.treesMu.Lock()
+	data, err := json.Marshal(d.trees)
+	d.treesMu.Unlock()
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(d.cachePath, data, 0600)
+}
@@ -0,0 +1,218 @@
+package discovery
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/p2p/discv5"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+)
+
+type mapResolver map[string][]string
+
+func (r mapResolver) LookupTXT(ctx context.Context, domain string) ([]string, error) {
+	if txts, ok := r[domain]; ok {
+		return txts, nil
+	}
+	return nil, fmt.Errorf("no such host %s", domain)
+}
+
+func makeTestNode(t *testing.T, port int) *enode.Node {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	var r enr.Record
+	r.Set(enr.IP(net.IPv4(127, 0, 0, 1)))
+	r.Set(enr.TCP(port))
+	r.Set(enr.UDP(port))
+	require.NoError(t, enode.SignV4(&r, key))
+	n, err := enode.New(enode.ValidSchemes, &r)
+	require.NoError(t, err)
+	return n
+}
+
+// publishTree adds records of a tree with given nodes and links to the resolver.
+func publishTree(t *testing.T, r mapResolver, key *ecdsa.PrivateKey, domain string, seq uint, nodes []*enode.Node, links []string) string {
+	publish := func(e dnsEntry) string {
+		h := dnsSubdomain(e)
+		r[h+"."+domain] = []string{e.String()}
+		return h
+	}
+	enrBranch := &dnsBranchEntry{}
+	for _, n := range nodes {
+		enrBranch.children = append(enrBranch.children, publish(&dnsENREntry{node: n}))
+	}
+	linkBranch := &dnsBranchEntry{}
+	for _, l := range links {
+		link, err := parseDNSLink(l)
+		require.NoError(t, err)
+		linkBranch.children = append(linkBranch.children, publish(link))
+	}
+	root := &dnsRootEntry{eroot: publish(enrBranch), lroot: publish(linkBranch), seq: seq}
+	sig, err := crypto.Sign(root.sigHash(), key)
+	require.NoError(t, err)
+	root.sig = sig
+	r[domain] = []string{"v=spf1 -all", root.String()}
+	return DNSTreeURL(&key.PublicKey, domain)
+}
+
+func nodeIDs(nodes []*discv5.Node) []discv5.NodeID {
+	ids := make([]discv5.NodeID, len(nodes))
+	for i := range nodes {
+		ids[i] = nodes[i].ID
+	}
+	return ids
+}
+
+func expectedIDs(nodes ...*enode.Node) []discv5.NodeID {
+	ids := make([]discv5.NodeID, len(nodes))
+	for i := range nodes {
+		ids[i] = discv5.PubkeyID(nodes[i].Pubkey())
+	}
+	return ids
+}
+
+func TestDNSParseRoot(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	r := mapResolver{}
+	publishTree(t, r, key, "nodes.example.org", 3, nil, nil)
+
+	root, err := parseDNSRoot(r["nodes.example.org"][1])
+	require.NoError(t, err)
+	require.Equal(t, uint(3), root.seq)
+	require.True(t, root.verifySignature(&key.PublicKey))
+
+	other, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	require.False(t, root.verifySignature(&other.PublicKey))
+
+	_, err = parseDNSRoot("enrtree-root:v1 e=A l=B seq=1 sig=" + base64.RawURLEncoding.EncodeToString(make([]byte, 65)))
+	require.Equal(t, errDNSInvalidChild, err)
+}
+
+func TestDNSNodes(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	r := mapResolver{}
+	n1, n2 := makeTestNode(t, 30303), makeTestNode(t, 30304)
+	url := publishTree(t, r, key, "nodes.example.org", 1, []*enode.Node{n1, n2}, nil)
+
+	d, err := NewDNS([]string{url}, r, "")
+	require.NoError(t, err)
+	require.NoError(t, d.Start())
+	defer func() { require.NoError(t, d.Stop()) }()
+
+	nodes, err := d.Nodes()
+	require.NoError(t, err)
+	require.ElementsMatch(t, expectedIDs(n1, n2), nodeIDs(nodes))
+}
+
+func TestDNSFollowsLinks(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	linkedKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	r := mapResolver{}
+	n1, n2 := makeTestNode(t, 30303), makeTestNode(t, 30304)
+	linked := publishTree(t, r, linkedKey, "linked.example.org", 1, []*enode.Node{n2}, nil)
+	// loops between trees must not be followed forever
+	publishTree(t, r, linkedKey, "linked.example.org", 1, []*enode.Node{n2}, []string{DNSTreeURL(&key.PublicKey, "nodes.example.org")})
+	url := publishTree(t, r, key, "nodes.example.org", 1, []*enode.Node{n1}, []string{linked})
+
+	d, err := NewDNS([]string{url}, r, "")
+	require.NoError(t, err)
+	require.NoError(t, d.Start())
+	defer func() { require.NoError(t, d.Stop()) }()
+
+	nodes, err := d.Nodes()
+	require.NoError(t, err)
+	require.ElementsMatch(t, expectedIDs(n1, n2), nodeIDs(nodes))
+}
+
+func TestDNSInvalidSignature(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	other, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	r := mapResolver{}
+	publishTree(t, r, other, "nodes.example.org", 1, []*enode.Node{makeTestNode(t, 30303)}, nil)
+
+	d, err := NewDNS([]string{DNSTreeURL(&key.PublicKey, "nodes.example.org")}, r, "")
+	require.NoError(t, err)
+	require.NoError(t, d.Start())
+	defer func() { require.NoError(t, d.Stop()) }()
+
+	nodes, err := d.Nodes()
+	require.Error(t, err)
+	require.Empty(t, nodes)
+}
+
+func TestDNSCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dns-discovery")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	cachePath := filepath.Join(dir, "dnsdisc.json")
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	r := mapResolver{}
+	n1 := makeTestNode(t, 30303)
+	url := publishTree(t, r, key, "nodes.example.org", 1, []*enode.Node{n1}, nil)
+
+	d, err := NewDNS([]string{url}, r, cachePath)
+	require.NoError(t, err)
+	require.NoError(t, d.Start())
+	_, err = d.Nodes()
+	require.NoError(t, err)
+	require.NoError(t, d.Stop())
+
+	// DNS is not reachable, nodes are served from the cache
+	offline, err := NewDNS([]string{url}, mapResolver{}, cachePath)
+	require.NoError(t, err)
+	require.NoError(t, offline.Start())
+	defer func() { require.NoError(t, offline.Stop()) }()
+	nodes, err := offline.Nodes()
+	require.Error(t, err)
+	require.Equal(t, expectedIDs(n1), nodeIDs(nodes))
+}
+
+func TestDNSDiscover(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	r := mapResolver{}
+	n1 := makeTestNode(t, 30303)
+	url := publishTree(t, r, key, "nodes.example.org", 1, []*enode.Node{n1}, nil)
+
+	d, err := NewDNS([]string{url}, r, "")
+	require.NoError(t, err)
+	require.NoError(t, d.Start())
+	defer func() { require.NoError(t, d.Stop()) }()
+
+	period := make(chan time.Duration, 1)
+	period <- 10 * time.Millisecond
+	found := make(chan *discv5.Node, 1)
+	errs := make(chan error, 1)
+	go func() {
+		errs <- d.Discover("test", period, found, nil)
+	}()
+	select {
+	case n := <-found:
+		require.Equal(t, discv5.PubkeyID(n1.Pubkey()), n.ID)
+	case <-time.After(time.Second):
+		require.FailNow(t, "node wasn't discovered")
+	}
+	close(period)
+	require.NoError(t, <-errs)
+}
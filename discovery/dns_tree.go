@@ -0,0 +1,180 @@
+package discovery
+
+import (
+	"crypto/ecdsa"
+	"encoding/base32"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+)
+
+// Record prefixes defined by EIP-1459.
+const (
+	dnsRootPrefix   = "enrtree-root:v1"
+	dnsLinkPrefix   = "enrtree://"
+	dnsBranchPrefix = "enrtree-branch:"
+	dnsENRPrefix    = "enr:"
+)
+
+var (
+	errDNSUnknownEntry  = errors.New("unknown dns tree entry")
+	errDNSInvalidRoot   = errors.New("invalid dns tree root")
+	errDNSInvalidSig    = errors.New("invalid dns tree root signature")
+	errDNSInvalidLink   = errors.New("invalid dns tree link")
+	errDNSInvalidChild  = errors.New("invalid dns tree child hash")
+	errDNSHashMismatch  = errors.New("dns tree entry does not match its hash")
+	errDNSUnexpectedENR = errors.New("unexpected enr in link subtree")
+	errDNSUnexpectedLnk = errors.New("unexpected link in enr subtree")
+)
+
+// b32 is the unpadded base32 encoding used for hashes and public keys in the tree.
+var b32 = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// dnsEntry is a parsed TXT record of the ENR tree.
+type dnsEntry interface {
+	fmt.Stringer
+}
+
+type (
+	dnsRootEntry struct {
+		eroot string
+		lroot string
+		seq   uint
+		sig   []byte
+	}
+	dnsBranchEntry struct {
+		children []string
+	}
+	dnsENREntry struct {
+		node *enode.Node
+	}
+	dnsLinkEntry struct {
+		str    string
+		domain string
+		pubkey *ecdsa.PublicKey
+	}
+)
+
+func (e *dnsRootEntry) sigHash() []byte {
+	return crypto.Keccak256([]byte(fmt.Sprintf("%s e=%s l=%s seq=%d", dnsRootPrefix, e.eroot, e.lroot, e.seq)))
+}
+
+func (e *dnsRootEntry) String() string {
+	return fmt.Sprintf("%s e=%s l=%s seq=%d sig=%s", dnsRootPrefix, e.eroot, e.lroot, e.seq, base64.RawURLEncoding.EncodeToString(e.sig))
+}
+
+// verifySignature checks that the root was signed by the given key.
+func (e *dnsRootEntry) verifySignature(pubkey *ecdsa.PublicKey) bool {
+	if len(e.sig) != crypto.SignatureLength {
+		return false
+	}
+	return crypto.VerifySignature(crypto.FromECDSAPub(pubkey), e.sigHash(), e.sig[:crypto.RecoveryIDOffset])
+}
+
+func (e *dnsBranchEntry) String() string {
+	return dnsBranchPrefix + strings.Join(e.children, ",")
+}
+
+func (e *dnsENREntry) String() string {
+	return e.node.String()
+}
+
+func (e *dnsLinkEntry) String() string {
+	return e.str
+}
+
+// dnsSubdomain returns the subdomain name under which the entry is published.
+func dnsSubdomain(e dnsEntry) string {
+	h := crypto.Keccak256([]byte(e.String()))
+	return b32.EncodeToString(h[:16])
+}
+
+// parseDNSRoot parses the TXT record found at the tree domain.
+func parseDNSRoot(txt string) (*dnsRootEntry, error) {
+	var (
+		e   dnsRootEntry
+		sig string
+	)
+	_, err := fmt.Sscanf(txt, dnsRootPrefix+" e=%s l=%s seq=%d sig=%s", &e.eroot, &e.lroot, &e.seq, &sig)
+	if err != nil {
+		return nil, errDNSInvalidRoot
+	}
+	if !isValidDNSHash(e.eroot) || !isValidDNSHash(e.lroot) {
+		return nil, errDNSInvalidChild
+	}
+	e.sig, err = base64.RawURLEncoding.DecodeString(sig)
+	if err != nil || len(e.sig) != crypto.SignatureLength {
+		return nil, errDNSInvalidSig
+	}
+	return &e, nil
+}
+
+// parseDNSEntry parses a non-root TXT record of the tree.
+func parseDNSEntry(txt string, validSchemes enr.IdentityScheme) (dnsEntry, error) {
+	switch {
+	case strings.HasPrefix(txt, dnsLinkPrefix):
+		return parseDNSLink(txt)
+	case strings.HasPrefix(txt, dnsBranchPrefix):
+		return parseDNSBranch(txt[len(dnsBranchPrefix):])
+	case strings.HasPrefix(txt, dnsENRPrefix):
+		n, err := enode.Parse(validSchemes, txt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid enr in dns tree: %v", err)
+		}
+		return &dnsENREntry{node: n}, nil
+	default:
+		return nil, errDNSUnknownEntry
+	}
+}
+
+func parseDNSBranch(txt string) (dnsEntry, error) {
+	if txt == "" {
+		return &dnsBranchEntry{}, nil
+	}
+	hashes := strings.Split(txt, ",")
+	for _, h := range hashes {
+		if !isValidDNSHash(h) {
+			return nil, errDNSInvalidChild
+		}
+	}
+	return &dnsBranchEntry{children: hashes}, nil
+}
+
+// parseDNSLink parses an enrtree://<key>@<domain> URL.
+func parseDNSLink(url string) (*dnsLinkEntry, error) {
+	if !strings.HasPrefix(url, dnsLinkPrefix) {
+		return nil, errDNSInvalidLink
+	}
+	parts := strings.SplitN(url[len(dnsLinkPrefix):], "@", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return nil, errDNSInvalidLink
+	}
+	keybytes, err := b32.DecodeString(parts[0])
+	if err != nil {
+		return nil, errDNSInvalidLink
+	}
+	key, err := crypto.DecompressPubkey(keybytes)
+	if err != nil {
+		return nil, errDNSInvalidLink
+	}
+	return &dnsLinkEntry{str: url, domain: parts[1], pubkey: key}, nil
+}
+
+// DNSTreeURL formats a link to the tree published at domain and signed with pubkey.
+func DNSTreeURL(pubkey *ecdsa.PublicKey, domain string) string {
+	return dnsLinkPrefix + b32.EncodeToString(crypto.CompressPubkey(pubkey)) + "@" + domain
+}
+
+func isValidDNSHash(s string) bool {
+	dlen := b32.DecodedLen(len(s))
+	if dlen < 12 || dlen > 32 {
+		return false
+	}
+	_, err := b32.DecodeString(s)
+	return err == nil
+}
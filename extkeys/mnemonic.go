@@ -146,11 +146,6 @@ func (m *Mnemonic) MnemonicSeed(mnemonic string, password string) []byte {
 
 // MnemonicPhrase returns a human readable seed for BIP32 Hierarchical Deterministic Wallets
 func (m *Mnemonic) MnemonicPhrase(strength EntropyStrength, language Language) (string, error) {
-	wordList, err := m.WordList(language)
-	if err != nil {
-		return "", err
-	}
-
 	// The mnemonic must encode entropy in a multiple of 32 bits.
 	// With more entropy security is improved but the sentence length increases.
 	// We refer to the initial entropy length as ENT. The recommended size of ENT is 128-256 bits.
@@ -161,12 +156,28 @@ func (m *Mnemonic) MnemonicPhrase(strength EntropyStrength, language Language) (
 
 	// First, an initial entropy of ENT bits is generated
 	entropy := make([]byte, strength/8)
-	_, err = rand.Read(entropy)
+	_, err := rand.Read(entropy)
+
+	if err != nil {
+		return "", err
+	}
 
+	return m.EntropyToMnemonic(entropy, language)
+}
+
+// EntropyToMnemonic returns the mnemonic encoding the entropy, which must be
+// 128 to 256 bits long, in a multiple of 32 bits
+func (m *Mnemonic) EntropyToMnemonic(entropy []byte, language Language) (string, error) {
+	wordList, err := m.WordList(language)
 	if err != nil {
 		return "", err
 	}
 
+	strength := len(entropy) * 8
+	if strength%32 > 0 || strength < 128 || strength > 256 {
+		return "", ErrInvalidEntropyStrength
+	}
+
 	entropyBigInt := new(big.Int).SetBytes(entropy)
 
 	// A checksum is generated by taking the first bits of its SHA256 hash ( ENT / 32 )
@@ -219,9 +230,15 @@ func (m *Mnemonic) MnemonicPhrase(strength EntropyStrength, language Language) (
 
 // ValidateMnemonic validates that all words from a mnemonic string are in wordlist and that checksum is valid
 func (m *Mnemonic) ValidateMnemonic(mnemonic string, language Language) error {
+	_, err := m.MnemonicToEntropy(mnemonic, language)
+	return err
+}
+
+// MnemonicToEntropy validates the mnemonic and returns the entropy it encodes
+func (m *Mnemonic) MnemonicToEntropy(mnemonic string, language Language) ([]byte, error) {
 	wordList, err := m.WordList(language)
 	if err != nil {
-		return errors.New("invalid language specified")
+		return nil, errors.New("invalid language specified")
 	}
 
 	// Create a list of all the words in the mnemonic sentence
@@ -232,7 +249,7 @@ func (m *Mnemonic) ValidateMnemonic(mnemonic string, language Language) error {
 
 	// The number of words should be 12, 15, 18, 21 or 24
 	if numOfWords%3 != 0 || numOfWords < 12 || numOfWords > 24 {
-		return errors.New("mnemonic contains an invalid number of words")
+		return nil, errors.New("mnemonic contains an invalid number of words")
 	}
 
 	// Create reverse lookup map for dictionary
@@ -248,7 +265,7 @@ func (m *Mnemonic) ValidateMnemonic(mnemonic string, language Language) error {
 
 		wordIndex, ok := wordMap[words[i]]
 		if !ok {
-			return fmt.Errorf("word %s not found in the dictionary", words[i])
+			return nil, fmt.Errorf("word %s not found in the dictionary", words[i])
 		}
 		var wordBytes [2]byte
 		binary.BigEndian.PutUint16(wordBytes[:], uint16(wordIndex))
@@ -269,7 +286,7 @@ func (m *Mnemonic) ValidateMnemonic(mnemonic string, language Language) error {
 	// Calculate checksum from entropy derived above
 	hasher := sha256.New()
 	if _, err := hasher.Write(entropy); err != nil {
-		return err
+		return nil, err
 	}
 
 	computedChecksumBytes := hasher.Sum(nil)
@@ -281,10 +298,10 @@ func (m *Mnemonic) ValidateMnemonic(mnemonic string, language Language) error {
 	}
 
 	if checksum.Cmp(computedChecksum) != 0 {
-		return errors.New("checksum for mnemonic seed is invalid")
+		return nil, errors.New("checksum for mnemonic seed is invalid")
 	}
 
-	return nil
+	return entropy, nil
 }
 
 // ValidMnemonic validates mnemonic string
@@ -125,3 +125,32 @@ func (v *Vector) String() string {
 	return fmt.Sprintf("{password: %s, input: %s, mnemonic: %s, seed: %s, xprv: %s}",
 		v.password, v.input, v.mnemonic, v.seed, v.xprv)
 }
+
+func TestMnemonicEntropy(t *testing.T) {
+	m := NewMnemonic()
+
+	entropy := make([]byte, 16)
+	phrase, err := m.EntropyToMnemonic(entropy, EnglishLanguage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	if phrase != expected {
+		t.Errorf("unexpected mnemonic: %s", phrase)
+	}
+
+	decoded, err := m.MnemonicToEntropy(phrase, EnglishLanguage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(decoded) != string(entropy) {
+		t.Errorf("unexpected entropy: %x", decoded)
+	}
+
+	if _, err := m.EntropyToMnemonic(make([]byte, 15), EnglishLanguage); err != ErrInvalidEntropyStrength {
+		t.Errorf("expected entropy strength to be invalid, got: %v", err)
+	}
+	if _, err := m.MnemonicToEntropy(expected[:len(expected)-5]+"abandon", EnglishLanguage); err == nil {
+		t.Error("expected checksum to be invalid")
+	}
+}
@@ -0,0 +1,136 @@
+package extkeys
+
+import (
+	"crypto/rand"
+	"errors"
+)
+
+// Shamir's secret sharing over GF(256), with the field used by AES and SLIP-0039:
+// x^8 + x^4 + x^3 + x + 1. Each byte of the secret is shared with its own
+// random polynomial whose constant term is the byte.
+
+var (
+	// ErrInvalidThreshold is returned when the threshold is not between 1 and the number of shares
+	ErrInvalidThreshold = errors.New("threshold must be between 1 and the number of shares, at most 255")
+	// ErrInvalidShares is returned when the shares can't be combined
+	ErrInvalidShares = errors.New("shares are invalid, duplicated or of different lengths")
+)
+
+// Share is a share of a secret, the value of the polynomials at Index.
+type Share struct {
+	Index byte   `json:"index"`
+	Value []byte `json:"value"`
+}
+
+var gfExp, gfLog [256]byte
+
+func init() {
+	// 3 is a generator of the multiplicative group of the field
+	x := byte(1)
+	for i := 0; i < 255; i++ {
+		gfExp[i] = x
+		gfLog[x] = byte(i)
+		// x * 3 = x * 2 + x
+		x2 := x << 1
+		if x&0x80 != 0 {
+			x2 ^= 0x1b
+		}
+		x = x2 ^ x
+	}
+	gfExp[255] = gfExp[0]
+}
+
+func gfMul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return gfExp[(int(gfLog[a])+int(gfLog[b]))%255]
+}
+
+func gfDiv(a, b byte) byte {
+	if a == 0 {
+		return 0
+	}
+	return gfExp[(int(gfLog[a])-int(gfLog[b])+255)%255]
+}
+
+// SplitSecret splits the secret in shares, any threshold of which recover it.
+// Shares are indexed from 1.
+func SplitSecret(secret []byte, threshold, shares int) ([]Share, error) {
+	if threshold < 1 || shares < threshold || shares > 255 {
+		return nil, ErrInvalidThreshold
+	}
+
+	// The coefficients of the polynomials, the constant terms being the secret
+	coefficients := make([][]byte, threshold)
+	coefficients[0] = secret
+	for i := 1; i < threshold; i++ {
+		coefficients[i] = make([]byte, len(secret))
+		if _, err := rand.Read(coefficients[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	result := make([]Share, shares)
+	for i := range result {
+		x := byte(i + 1)
+		value := make([]byte, len(secret))
+		for j := range value {
+			// Horner's method
+			var y byte
+			for k := threshold - 1; k >= 0; k-- {
+				y = gfMul(y, x) ^ coefficients[k][j]
+			}
+			value[j] = y
+		}
+		result[i] = Share{Index: x, Value: value}
+	}
+	return result, nil
+}
+
+// Interpolate returns the value at x of the polynomials going through the shares.
+func Interpolate(shares []Share, x byte) ([]byte, error) {
+	if len(shares) == 0 {
+		return nil, ErrInvalidShares
+	}
+	length := len(shares[0].Value)
+	seen := make(map[byte]bool)
+	for _, share := range shares {
+		if len(share.Value) != length || seen[share.Index] {
+			return nil, ErrInvalidShares
+		}
+		seen[share.Index] = true
+	}
+
+	result := make([]byte, length)
+	for i, share := range shares {
+		if share.Index == x {
+			copy(result, share.Value)
+			return result, nil
+		}
+
+		// Lagrange basis polynomial of the share at x
+		basis := byte(1)
+		for j, other := range shares {
+			if i == j {
+				continue
+			}
+			basis = gfMul(basis, gfDiv(x^other.Index, share.Index^other.Index))
+		}
+		for k := range result {
+			result[k] ^= gfMul(basis, share.Value[k])
+		}
+	}
+	return result, nil
+}
+
+// CombineShares recovers the secret from at least threshold of its shares. Combining
+// fewer shares returns a wrong secret, the caller has to check it.
+func CombineShares(shares []Share) ([]byte, error) {
+	for _, share := range shares {
+		if share.Index == 0 {
+			return nil, ErrInvalidShares
+		}
+	}
+	return Interpolate(shares, 0)
+}
@@ -0,0 +1,61 @@
+package extkeys
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestShamir(t *testing.T) {
+	secret := []byte("0123456789abcdef")
+
+	shares, err := SplitSecret(secret, 3, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(shares) != 5 {
+		t.Fatalf("expected 5 shares, got %d", len(shares))
+	}
+
+	for _, subset := range [][]int{{0, 1, 2}, {4, 2, 0}, {1, 3, 4}, {0, 1, 2, 3, 4}} {
+		var selected []Share
+		for _, i := range subset {
+			selected = append(selected, shares[i])
+		}
+		recovered, err := CombineShares(selected)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(secret, recovered) {
+			t.Errorf("shares %v recovered %x", subset, recovered)
+		}
+	}
+
+	recovered, err := CombineShares(shares[:2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(secret, recovered) {
+		t.Error("less than threshold shares shouldn't recover the secret")
+	}
+
+	if _, err := CombineShares([]Share{shares[0], shares[0]}); err != ErrInvalidShares {
+		t.Errorf("expected duplicated shares to be invalid, got: %v", err)
+	}
+	if _, err := SplitSecret(secret, 3, 2); err != ErrInvalidThreshold {
+		t.Errorf("expected threshold to be invalid, got: %v", err)
+	}
+}
+
+func TestGFArithmetic(t *testing.T) {
+	// Known products in the AES field
+	if gfMul(0x57, 0x83) != 0xc1 {
+		t.Errorf("unexpected product %x", gfMul(0x57, 0x83))
+	}
+	for a := 1; a < 256; a++ {
+		for _, b := range []byte{1, 2, 0x53, 0xff} {
+			if gfDiv(gfMul(byte(a), b), b) != byte(a) {
+				t.Fatalf("division doesn't invert multiplication for %x, %x", a, b)
+			}
+		}
+	}
+}
@@ -0,0 +1,454 @@
+package extkeys
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// Implementation of SLIP-0039 https://github.com/satoshilabs/slips/blob/master/slip-0039.md
+// The master secret is encrypted with the passphrase, then split in groups
+// of shares with two levels of Shamir's secret sharing. Each share is encoded
+// as a mnemonic of 20 words or more.
+
+const (
+	slip39RadixBits          = 10
+	slip39IDBits             = 15
+	slip39IterationExpBits   = 5
+	slip39ChecksumWords      = 3
+	slip39MetadataWords      = 7
+	slip39MinMnemonicWords   = 20
+	slip39MaxShareCount      = 16
+	slip39DigestLength       = 4
+	slip39DigestIndex        = 254
+	slip39SecretIndex        = 255
+	slip39BaseIterationCount = 10000
+	slip39RoundCount         = 4
+	slip39MinSecretBytes     = 16
+	slip39Customization      = "shamir"
+)
+
+var (
+	// ErrInvalidSLIP39Mnemonic is returned when a mnemonic can't be decoded
+	ErrInvalidSLIP39Mnemonic = errors.New("invalid slip-39 mnemonic")
+	// ErrInvalidSLIP39Checksum is returned when the checksum of a mnemonic doesn't match its words
+	ErrInvalidSLIP39Checksum = errors.New("invalid slip-39 mnemonic checksum")
+	// ErrSLIP39MnemonicsMismatch is returned when combining mnemonics of different sets
+	ErrSLIP39MnemonicsMismatch = errors.New("slip-39 mnemonics don't belong to the same set")
+	// ErrInsufficientSLIP39Mnemonics is returned when there are not enough mnemonics to recover the secret
+	ErrInsufficientSLIP39Mnemonics = errors.New("insufficient number of slip-39 mnemonics")
+	// ErrInvalidSLIP39Digest is returned when the recovered secret doesn't match its digest
+	ErrInvalidSLIP39Digest = errors.New("invalid slip-39 digest, the mnemonics are incorrect")
+	// ErrInvalidSLIP39Passphrase is returned when the passphrase contains non-printable ASCII characters
+	ErrInvalidSLIP39Passphrase = errors.New("slip-39 passphrase must contain only printable ASCII characters")
+	// ErrInvalidSLIP39MasterSecret is returned when the master secret is too short or of odd length
+	ErrInvalidSLIP39MasterSecret = errors.New("slip-39 master secret must be at least 128 bits long, in a multiple of 16 bits")
+	// ErrInvalidSLIP39Groups is returned when the group parameters are out of range
+	ErrInvalidSLIP39Groups = errors.New("invalid slip-39 group parameters")
+)
+
+// SLIP39Group is the number of shares of a group and how many of them are
+// needed to recover the group secret
+type SLIP39Group struct {
+	MemberThreshold int `json:"memberThreshold"`
+	MemberCount     int `json:"memberCount"`
+}
+
+// slip39Share is a decoded SLIP-39 mnemonic.
+type slip39Share struct {
+	identifier        uint16
+	iterationExponent byte
+	groupIndex        byte
+	groupThreshold    byte
+	groupCount        byte
+	memberIndex       byte
+	memberThreshold   byte
+	value             []byte
+}
+
+var slip39WordIndexes = func() map[string]int {
+	indexes := make(map[string]int, len(slip39WordList))
+	for i, word := range slip39WordList {
+		indexes[word] = i
+	}
+	return indexes
+}()
+
+var slip39Generator = [10]uint32{0xe0e040, 0x1c1c080, 0x3838100, 0x7070200, 0xe0e0009, 0x1c0c2412, 0x38086c24, 0x3090fc48, 0x21b1f890, 0x3f3f120}
+
+// rs1024Polymod computes the Reed-Solomon code over GF(1024) used as checksum.
+func rs1024Polymod(values []int) uint32 {
+	checksum := uint32(1)
+	for _, value := range values {
+		b := checksum >> 20
+		checksum = (checksum&0xfffff)<<10 ^ uint32(value)
+		for i := 0; i < 10; i++ {
+			if (b>>uint(i))&1 != 0 {
+				checksum ^= slip39Generator[i]
+			}
+		}
+	}
+	return checksum
+}
+
+func rs1024Values(indexes []int) []int {
+	values := make([]int, 0, len(slip39Customization)+len(indexes))
+	for _, c := range []byte(slip39Customization) {
+		values = append(values, int(c))
+	}
+	return append(values, indexes...)
+}
+
+func rs1024CreateChecksum(indexes []int) []int {
+	values := append(rs1024Values(indexes), 0, 0, 0)
+	polymod := rs1024Polymod(values) ^ 1
+	checksum := make([]int, slip39ChecksumWords)
+	for i := range checksum {
+		checksum[i] = int(polymod>>(slip39RadixBits*uint(slip39ChecksumWords-1-i))) & 1023
+	}
+	return checksum
+}
+
+func rs1024VerifyChecksum(indexes []int) bool {
+	return rs1024Polymod(rs1024Values(indexes)) == 1
+}
+
+// mnemonic encodes the share as a SLIP-39 mnemonic.
+func (s *slip39Share) mnemonic() string {
+	valueWords := (len(s.value)*8 + slip39RadixBits - 1) / slip39RadixBits
+
+	header := uint64(s.identifier)<<25 |
+		uint64(s.iterationExponent)<<20 |
+		uint64(s.groupIndex)<<16 |
+		uint64(s.groupThreshold-1)<<12 |
+		uint64(s.groupCount-1)<<8 |
+		uint64(s.memberIndex)<<4 |
+		uint64(s.memberThreshold-1)
+
+	data := new(big.Int).SetUint64(header)
+	data.Lsh(data, uint(valueWords*slip39RadixBits))
+	data.Or(data, new(big.Int).SetBytes(s.value))
+
+	dataWords := valueWords + slip39MetadataWords - slip39ChecksumWords
+	indexes := make([]int, dataWords)
+	mask := big.NewInt(1023)
+	for i := dataWords - 1; i >= 0; i-- {
+		indexes[i] = int(new(big.Int).And(data, mask).Int64())
+		data.Rsh(data, slip39RadixBits)
+	}
+	indexes = append(indexes, rs1024CreateChecksum(indexes)...)
+
+	words := make([]string, len(indexes))
+	for i, index := range indexes {
+		words[i] = slip39WordList[index]
+	}
+	return strings.Join(words, " ")
+}
+
+// parseSLIP39Share decodes a SLIP-39 mnemonic and checks its checksum.
+func parseSLIP39Share(mnemonic string) (*slip39Share, error) {
+	words := strings.Fields(strings.ToLower(mnemonic))
+	if len(words) < slip39MinMnemonicWords {
+		return nil, fmt.Errorf("%w: it must contain at least %d words", ErrInvalidSLIP39Mnemonic, slip39MinMnemonicWords)
+	}
+
+	valueWords := len(words) - slip39MetadataWords
+	padding := (slip39RadixBits * valueWords) % 16
+	if padding > 8 {
+		return nil, fmt.Errorf("%w: invalid length", ErrInvalidSLIP39Mnemonic)
+	}
+
+	indexes := make([]int, len(words))
+	for i, word := range words {
+		index, ok := slip39WordIndexes[word]
+		if !ok {
+			return nil, fmt.Errorf("%w: word %s not found in the dictionary", ErrInvalidSLIP39Mnemonic, word)
+		}
+		indexes[i] = index
+	}
+
+	if !rs1024VerifyChecksum(indexes) {
+		return nil, ErrInvalidSLIP39Checksum
+	}
+
+	var header uint64
+	for _, index := range indexes[:4] {
+		header = header<<slip39RadixBits | uint64(index)
+	}
+
+	value := new(big.Int)
+	for _, index := range indexes[4 : len(indexes)-slip39ChecksumWords] {
+		value.Lsh(value, slip39RadixBits)
+		value.Or(value, big.NewInt(int64(index)))
+	}
+	valueBytes := (slip39RadixBits*valueWords - padding) / 8
+	// The padding bits must be zero
+	if value.BitLen() > valueBytes*8 {
+		return nil, fmt.Errorf("%w: invalid padding", ErrInvalidSLIP39Mnemonic)
+	}
+
+	share := &slip39Share{
+		identifier:        uint16(header >> 25),
+		iterationExponent: byte(header>>20) & 0x1f,
+		groupIndex:        byte(header>>16) & 0xf,
+		groupThreshold:    byte(header>>12)&0xf + 1,
+		groupCount:        byte(header>>8)&0xf + 1,
+		memberIndex:       byte(header>>4) & 0xf,
+		memberThreshold:   byte(header)&0xf + 1,
+		value:             padByteSlice(value.Bytes(), valueBytes),
+	}
+	if share.groupThreshold > share.groupCount {
+		return nil, fmt.Errorf("%w: group threshold greater than group count", ErrInvalidSLIP39Mnemonic)
+	}
+	return share, nil
+}
+
+// ValidateSLIP39Mnemonic checks that the mnemonic is a well formed SLIP-39 share.
+func ValidateSLIP39Mnemonic(mnemonic string) error {
+	_, err := parseSLIP39Share(mnemonic)
+	return err
+}
+
+func slip39Digest(randomPart []byte, secret []byte) []byte {
+	mac := hmac.New(sha256.New, randomPart)
+	mac.Write(secret) // nolint: errcheck
+	return mac.Sum(nil)[:slip39DigestLength]
+}
+
+// splitSLIP39Secret splits the secret in shares indexed from 0. Along with
+// the secret, a digest is shared to check the recovered secret.
+func splitSLIP39Secret(threshold, count int, secret []byte) ([]Share, error) {
+	if threshold < 1 || threshold > count || count > slip39MaxShareCount {
+		return nil, ErrInvalidSLIP39Groups
+	}
+
+	shares := make([]Share, 0, count)
+	if threshold == 1 {
+		for i := 0; i < count; i++ {
+			shares = append(shares, Share{Index: byte(i), Value: secret})
+		}
+		return shares, nil
+	}
+
+	randomShares := threshold - 2
+	for i := 0; i < randomShares; i++ {
+		value := make([]byte, len(secret))
+		if _, err := rand.Read(value); err != nil {
+			return nil, err
+		}
+		shares = append(shares, Share{Index: byte(i), Value: value})
+	}
+
+	randomPart := make([]byte, len(secret)-slip39DigestLength)
+	if _, err := rand.Read(randomPart); err != nil {
+		return nil, err
+	}
+	digest := append(slip39Digest(randomPart, secret), randomPart...)
+
+	baseShares := append(shares[:randomShares:randomShares],
+		Share{Index: slip39DigestIndex, Value: digest},
+		Share{Index: slip39SecretIndex, Value: secret},
+	)
+	for i := randomShares; i < count; i++ {
+		value, err := Interpolate(baseShares, byte(i))
+		if err != nil {
+			return nil, err
+		}
+		shares = append(shares, Share{Index: byte(i), Value: value})
+	}
+	return shares, nil
+}
+
+// recoverSLIP39Secret recovers the secret from threshold shares and checks its digest.
+func recoverSLIP39Secret(threshold int, shares []Share) ([]byte, error) {
+	if threshold == 1 {
+		return shares[0].Value, nil
+	}
+
+	secret, err := Interpolate(shares, slip39SecretIndex)
+	if err != nil {
+		return nil, err
+	}
+	digest, err := Interpolate(shares, slip39DigestIndex)
+	if err != nil {
+		return nil, err
+	}
+	if !hmac.Equal(digest[:slip39DigestLength], slip39Digest(digest[slip39DigestLength:], secret)) {
+		return nil, ErrInvalidSLIP39Digest
+	}
+	return secret, nil
+}
+
+func slip39RoundFunction(round int, passphrase []byte, iterationExponent byte, salt []byte, r []byte) []byte {
+	iterations := (slip39BaseIterationCount << iterationExponent) / slip39RoundCount
+	password := append([]byte{byte(round)}, passphrase...)
+	return pbkdf2.Key(password, append(salt, r...), iterations, len(r), sha256.New)
+}
+
+// slip39Feistel runs the Feistel network encrypting the master secret, in
+// reverse order of rounds to decrypt it.
+func slip39Feistel(input []byte, passphrase string, iterationExponent byte, identifier uint16, decrypt bool) []byte {
+	half := len(input) / 2
+	l := append([]byte{}, input[:half]...)
+	r := append([]byte{}, input[half:]...)
+
+	salt := make([]byte, len(slip39Customization)+2)
+	copy(salt, slip39Customization)
+	binary.BigEndian.PutUint16(salt[len(slip39Customization):], identifier)
+
+	for i := 0; i < slip39RoundCount; i++ {
+		round := i
+		if decrypt {
+			round = slip39RoundCount - 1 - i
+		}
+		f := slip39RoundFunction(round, []byte(passphrase), iterationExponent, salt, r)
+		for j := range l {
+			l[j] ^= f[j]
+		}
+		l, r = r, l
+	}
+	return append(r, l...)
+}
+
+func validSLIP39Passphrase(passphrase string) bool {
+	for _, c := range []byte(passphrase) {
+		if c < 32 || c > 126 {
+			return false
+		}
+	}
+	return true
+}
+
+// GenerateSLIP39Mnemonics splits the master secret, encrypted with the
+// passphrase, in groups of mnemonics. Any groupThreshold groups, each with
+// its member threshold of mnemonics, recover the master secret. Encryption
+// iterates 10000 * 2^iterationExponent times.
+func GenerateSLIP39Mnemonics(groupThreshold int, groups []SLIP39Group, masterSecret []byte, passphrase string, iterationExponent int) ([][]string, error) {
+	if len(masterSecret) < slip39MinSecretBytes || len(masterSecret)%2 != 0 {
+		return nil, ErrInvalidSLIP39MasterSecret
+	}
+	if !validSLIP39Passphrase(passphrase) {
+		return nil, ErrInvalidSLIP39Passphrase
+	}
+	if groupThreshold < 1 || groupThreshold > len(groups) || len(groups) > slip39MaxShareCount ||
+		iterationExponent < 0 || iterationExponent >= 1<<slip39IterationExpBits {
+		return nil, ErrInvalidSLIP39Groups
+	}
+	for _, group := range groups {
+		// A single share is enough to recover the group secret, more would be copies of it
+		if group.MemberThreshold == 1 && group.MemberCount > 1 {
+			return nil, fmt.Errorf("%w: use 1-of-1 member sharing instead of 1-of-n", ErrInvalidSLIP39Groups)
+		}
+	}
+
+	var id [2]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return nil, err
+	}
+	identifier := binary.BigEndian.Uint16(id[:]) & (1<<slip39IDBits - 1)
+
+	encryptedSecret := slip39Feistel(masterSecret, passphrase, byte(iterationExponent), identifier, false)
+
+	groupShares, err := splitSLIP39Secret(groupThreshold, len(groups), encryptedSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	mnemonics := make([][]string, len(groups))
+	for i, group := range groups {
+		memberShares, err := splitSLIP39Secret(group.MemberThreshold, group.MemberCount, groupShares[i].Value)
+		if err != nil {
+			return nil, err
+		}
+		for _, memberShare := range memberShares {
+			share := &slip39Share{
+				identifier:        identifier,
+				iterationExponent: byte(iterationExponent),
+				groupIndex:        groupShares[i].Index,
+				groupThreshold:    byte(groupThreshold),
+				groupCount:        byte(len(groups)),
+				memberIndex:       memberShare.Index,
+				memberThreshold:   byte(group.MemberThreshold),
+				value:             memberShare.Value,
+			}
+			mnemonics[i] = append(mnemonics[i], share.mnemonic())
+		}
+	}
+	return mnemonics, nil
+}
+
+// CombineSLIP39Mnemonics recovers the master secret from the mnemonics and
+// decrypts it with the passphrase. Any passphrase decrypts a master secret,
+// only the right one gives the original.
+func CombineSLIP39Mnemonics(mnemonics []string, passphrase string) ([]byte, error) {
+	if len(mnemonics) == 0 {
+		return nil, ErrInsufficientSLIP39Mnemonics
+	}
+	if !validSLIP39Passphrase(passphrase) {
+		return nil, ErrInvalidSLIP39Passphrase
+	}
+
+	var first *slip39Share
+	groups := make(map[byte][]*slip39Share)
+	var groupIndexes []byte
+	for _, mnemonic := range mnemonics {
+		share, err := parseSLIP39Share(mnemonic)
+		if err != nil {
+			return nil, err
+		}
+		if first == nil {
+			first = share
+		}
+		if share.identifier != first.identifier ||
+			share.iterationExponent != first.iterationExponent ||
+			share.groupThreshold != first.groupThreshold ||
+			share.groupCount != first.groupCount ||
+			len(share.value) != len(first.value) {
+			return nil, ErrSLIP39MnemonicsMismatch
+		}
+		if _, ok := groups[share.groupIndex]; !ok {
+			groupIndexes = append(groupIndexes, share.groupIndex)
+		}
+		groups[share.groupIndex] = append(groups[share.groupIndex], share)
+	}
+
+	if len(groups) != int(first.groupThreshold) {
+		return nil, fmt.Errorf("%w: %d groups are required, %d were provided", ErrInsufficientSLIP39Mnemonics, first.groupThreshold, len(groups))
+	}
+
+	groupShares := make([]Share, 0, len(groups))
+	for _, groupIndex := range groupIndexes {
+		members := groups[groupIndex]
+		memberThreshold := members[0].memberThreshold
+		memberShares := make([]Share, 0, len(members))
+		for _, member := range members {
+			if member.memberThreshold != memberThreshold {
+				return nil, ErrSLIP39MnemonicsMismatch
+			}
+			memberShares = append(memberShares, Share{Index: member.memberIndex, Value: member.value})
+		}
+		if len(members) != int(memberThreshold) {
+			return nil, fmt.Errorf("%w: group %d requires %d mnemonics, %d were provided", ErrInsufficientSLIP39Mnemonics, groupIndex, memberThreshold, len(members))
+		}
+
+		groupSecret, err := recoverSLIP39Secret(int(memberThreshold), memberShares)
+		if err != nil {
+			return nil, err
+		}
+		groupShares = append(groupShares, Share{Index: groupIndex, Value: groupSecret})
+	}
+
+	encryptedSecret, err := recoverSLIP39Secret(int(first.groupThreshold), groupShares)
+	if err != nil {
+		return nil, err
+	}
+
+	return slip39Feistel(encryptedSecret, passphrase, first.iterationExponent, first.identifier, true), nil
+}
@@ -0,0 +1,149 @@
+package extkeys
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"testing"
+)
+
+// TestSLIP39Vectors runs every entry of slip39_vectors.json, which has the
+// format of the vectors.json of the SLIP-39 reference implementation so that
+// it can be replaced by it unchanged: a description, the mnemonics, the master
+// secret recovered with the passphrase "TREZOR", empty when the mnemonics are
+// invalid, and optionally the BIP-32 master key of the master secret.
+func TestSLIP39Vectors(t *testing.T) {
+	fp, err := os.Open("slip39_vectors.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fp.Close()
+
+	var vectors [][]json.RawMessage
+	if err := json.NewDecoder(fp).Decode(&vectors); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, vector := range vectors {
+		if len(vector) < 3 {
+			t.Fatalf("vector %d: expected at least 3 fields, got %d", i, len(vector))
+		}
+		var description, masterSecretHex, xprv string
+		var mnemonics []string
+		if err := json.Unmarshal(vector[0], &description); err != nil {
+			t.Fatal(err)
+		}
+		if err := json.Unmarshal(vector[1], &mnemonics); err != nil {
+			t.Fatal(err)
+		}
+		if err := json.Unmarshal(vector[2], &masterSecretHex); err != nil {
+			t.Fatal(err)
+		}
+		if len(vector) > 3 {
+			if err := json.Unmarshal(vector[3], &xprv); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		t.Run(description, func(t *testing.T) {
+			masterSecret, err := CombineSLIP39Mnemonics(mnemonics, "TREZOR")
+			if masterSecretHex == "" {
+				if err == nil {
+					t.Error("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if hex.EncodeToString(masterSecret) != masterSecretHex {
+				t.Fatalf("expected %s, got %x", masterSecretHex, masterSecret)
+			}
+			if xprv == "" {
+				return
+			}
+			key, err := NewMaster(masterSecret)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if key.String() != xprv {
+				t.Errorf("expected %s, got %s", xprv, key.String())
+			}
+		})
+	}
+}
+
+func TestSLIP39GenerateAndCombine(t *testing.T) {
+	masterSecret := []byte("ABCDEFGHIJKLMNOP")
+	groups := []SLIP39Group{{1, 1}, {2, 3}, {3, 5}}
+
+	mnemonics, err := GenerateSLIP39Mnemonics(2, groups, masterSecret, "TREZOR", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mnemonics) != 3 || len(mnemonics[1]) != 3 || len(mnemonics[2]) != 5 {
+		t.Fatal("unexpected number of mnemonics")
+	}
+	for _, group := range mnemonics {
+		for _, mnemonic := range group {
+			if err := ValidateSLIP39Mnemonic(mnemonic); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	for _, selected := range [][]string{
+		{mnemonics[0][0], mnemonics[1][2], mnemonics[1][0]},
+		{mnemonics[2][4], mnemonics[1][1], mnemonics[2][0], mnemonics[1][0], mnemonics[2][2]},
+	} {
+		recovered, err := CombineSLIP39Mnemonics(selected, "TREZOR")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(masterSecret, recovered) {
+			t.Fatalf("expected %x, got %x", masterSecret, recovered)
+		}
+	}
+
+	// Another passphrase decrypts another secret
+	recovered, err := CombineSLIP39Mnemonics([]string{mnemonics[0][0], mnemonics[1][0], mnemonics[1][1]}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(masterSecret, recovered) {
+		t.Fatal("expected a different secret")
+	}
+
+	_, err = CombineSLIP39Mnemonics([]string{mnemonics[0][0], mnemonics[1][0]}, "TREZOR")
+	if !errors.Is(err, ErrInsufficientSLIP39Mnemonics) {
+		t.Fatalf("expected insufficient mnemonics, got %v", err)
+	}
+
+	other, err := GenerateSLIP39Mnemonics(1, []SLIP39Group{{2, 3}}, masterSecret, "TREZOR", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = CombineSLIP39Mnemonics([]string{other[0][0], mnemonics[0][0]}, "TREZOR")
+	if err != ErrSLIP39MnemonicsMismatch {
+		t.Fatalf("expected mismatch, got %v", err)
+	}
+}
+
+func TestSLIP39InvalidParameters(t *testing.T) {
+	masterSecret := []byte("ABCDEFGHIJKLMNOP")
+
+	if _, err := GenerateSLIP39Mnemonics(1, []SLIP39Group{{1, 1}}, masterSecret[:15], "", 0); err != ErrInvalidSLIP39MasterSecret {
+		t.Errorf("expected invalid master secret, got %v", err)
+	}
+	if _, err := GenerateSLIP39Mnemonics(2, []SLIP39Group{{1, 1}}, masterSecret, "", 0); !errors.Is(err, ErrInvalidSLIP39Groups) {
+		t.Errorf("expected invalid groups, got %v", err)
+	}
+	if _, err := GenerateSLIP39Mnemonics(1, []SLIP39Group{{1, 2}}, masterSecret, "", 0); !errors.Is(err, ErrInvalidSLIP39Groups) {
+		t.Errorf("expected invalid groups, got %v", err)
+	}
+	if _, err := GenerateSLIP39Mnemonics(1, []SLIP39Group{{1, 1}}, masterSecret, "café", 0); err != ErrInvalidSLIP39Passphrase {
+		t.Errorf("expected invalid passphrase, got %v", err)
+	}
+}
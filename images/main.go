@@ -6,41 +6,72 @@ import (
 )
 
 func GenerateIdentityImages(filepath string, aX, aY, bX, bY int) ([]*IdentityImage, error) {
-	img, err := Decode(filepath)
-	if err != nil {
-		return nil, err
-	}
-
-	cropRect := image.Rectangle{
-		Min: image.Point{X: aX, Y: aY},
-		Max: image.Point{X: bX, Y: bY},
-	}
-	cImg, err := Crop(img, cropRect)
+	cImg, err := decodeAndCrop(filepath, aX, aY, bX, bY)
 	if err != nil {
 		return nil, err
 	}
 
 	var iis []*IdentityImage
 	for _, s := range ResizeDimensions {
-		rImg := Resize(s, cImg)
-
-		bb := bytes.NewBuffer([]byte{})
-		err = EncodeToBestSize(bb, rImg, s)
+		ii, err := resizeAndEncode(cImg, s)
 		if err != nil {
 			return nil, err
 		}
 
-		ii := &IdentityImage{
-			Name:         ResizeDimensionToName[s],
-			Payload:      bb.Bytes(),
-			Width:        rImg.Bounds().Dx(),
-			Height:       rImg.Bounds().Dy(),
-			FileSize:     bb.Len(),
-			ResizeTarget: int(s),
-		}
-
 		iis = append(iis, ii)
 	}
 
 	return iis, nil
 }
+
+// GenerateEmojiImage generates the image of a custom emoji
+func GenerateEmojiImage(filepath string, aX, aY, bX, bY int) (*IdentityImage, error) {
+	cImg, err := decodeAndCrop(filepath, aX, aY, bX, bY)
+	if err != nil {
+		return nil, err
+	}
+
+	return resizeAndEncode(cImg, EmojiDim)
+}
+
+// GenerateThumbnail decodes an encoded image and resizes it to the given dimension
+func GenerateThumbnail(payload []byte, s ResizeDimension) (*IdentityImage, error) {
+	img, err := decodeImageData(payload, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+
+	return resizeAndEncode(img, s)
+}
+
+func decodeAndCrop(filepath string, aX, aY, bX, bY int) (image.Image, error) {
+	img, err := Decode(filepath)
+	if err != nil {
+		return nil, err
+	}
+
+	cropRect := image.Rectangle{
+		Min: image.Point{X: aX, Y: aY},
+		Max: image.Point{X: bX, Y: bY},
+	}
+	return Crop(img, cropRect)
+}
+
+func resizeAndEncode(img image.Image, s ResizeDimension) (*IdentityImage, error) {
+	rImg := Resize(s, img)
+
+	bb := bytes.NewBuffer([]byte{})
+	err := EncodeToBestSize(bb, rImg, s)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IdentityImage{
+		Name:         ResizeDimensionToName[s],
+		Payload:      bb.Bytes(),
+		Width:        rImg.Bounds().Dx(),
+		Height:       rImg.Bounds().Dy(),
+		FileSize:     bb.Len(),
+		ResizeTarget: int(s),
+	}, nil
+}
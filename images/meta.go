@@ -17,9 +17,11 @@ const (
 
 	SmallDim = ResizeDimension(80)
 	LargeDim = ResizeDimension(240)
+	EmojiDim = ResizeDimension(64)
 
 	SmallDimName = "thumbnail"
 	LargeDimName = "large"
+	EmojiDimName = "emoji"
 )
 
 var (
@@ -37,18 +39,24 @@ var (
 			Ideal: 16384, // Base on the largest sample image at quality 60% (16,143 bytes ∴ 1024 * 16)
 			Max:   38400, // Base on the largest sample image at quality 80% + 50% margin (24,290 bytes * 1.5 ≈ 37500 ∴ 1024 * 37.5)
 		},
+		EmojiDim: {
+			Ideal: 2048, // Custom emoji are sent with every community description, so they are kept smaller than thumbnails
+			Max:   4096,
+		},
 	}
 
 	// ResizeDimensionToName maps a ResizeDimension to its assigned string name
 	ResizeDimensionToName = map[ResizeDimension]string{
 		SmallDim: SmallDimName,
 		LargeDim: LargeDimName,
+		EmojiDim: EmojiDimName,
 	}
 
 	// NameToResizeDimension maps a string name to its assigned ResizeDimension
 	NameToResizeDimension = map[string]ResizeDimension{
 		SmallDimName: SmallDim,
 		LargeDimName: LargeDim,
+		EmojiDimName: EmojiDim,
 	}
 )
 
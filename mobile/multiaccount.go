@@ -2,6 +2,8 @@ package statusgo
 
 import (
 	"encoding/json"
+
+	"github.com/status-im/status-go/extkeys"
 )
 
 // MultiAccountGenerateParams are the params sent to MultiAccountGenerate.
@@ -52,6 +54,20 @@ type MultiAccountImportMnemonicParams struct {
 	Bip39Passphrase string `json:"Bip39Passphrase"`
 }
 
+// MultiAccountImportSLIP39MnemonicsParams are the params sent to MultiAccountImportSLIP39Mnemonics.
+type MultiAccountImportSLIP39MnemonicsParams struct {
+	Mnemonics  []string `json:"mnemonics"`
+	Passphrase string   `json:"passphrase"`
+}
+
+// MultiAccountCreateSLIP39MnemonicsParams are the params sent to MultiAccountCreateSLIP39Mnemonics.
+type MultiAccountCreateSLIP39MnemonicsParams struct {
+	AccountID      string                `json:"accountID"`
+	GroupThreshold int                   `json:"groupThreshold"`
+	Groups         []extkeys.SLIP39Group `json:"groups"`
+	Passphrase     string                `json:"passphrase"`
+}
+
 // MultiAccountGenerate generates account in memory without storing them.
 func MultiAccountGenerate(paramsJSON string) string {
 	var p MultiAccountGenerateParams
@@ -178,6 +194,48 @@ func MultiAccountImportMnemonic(paramsJSON string) string {
 	return string(out)
 }
 
+// MultiAccountImportSLIP39Mnemonics imports the account of the master secret recovered from SLIP-39 mnemonics.
+func MultiAccountImportSLIP39Mnemonics(paramsJSON string) string {
+	var p MultiAccountImportSLIP39MnemonicsParams
+
+	if err := json.Unmarshal([]byte(paramsJSON), &p); err != nil {
+		return makeJSONResponse(err)
+	}
+
+	resp, err := statusBackend.AccountManager().AccountsGenerator().ImportSLIP39Mnemonics(p.Mnemonics, p.Passphrase)
+	if err != nil {
+		return makeJSONResponse(err)
+	}
+
+	out, err := json.Marshal(resp)
+	if err != nil {
+		return makeJSONResponse(err)
+	}
+
+	return string(out)
+}
+
+// MultiAccountCreateSLIP39Mnemonics splits the seed of an account imported from a mnemonic in groups of SLIP-39 mnemonics.
+func MultiAccountCreateSLIP39Mnemonics(paramsJSON string) string {
+	var p MultiAccountCreateSLIP39MnemonicsParams
+
+	if err := json.Unmarshal([]byte(paramsJSON), &p); err != nil {
+		return makeJSONResponse(err)
+	}
+
+	resp, err := statusBackend.AccountManager().AccountsGenerator().CreateSLIP39Mnemonics(p.AccountID, p.GroupThreshold, p.Groups, p.Passphrase)
+	if err != nil {
+		return makeJSONResponse(err)
+	}
+
+	out, err := json.Marshal(resp)
+	if err != nil {
+		return makeJSONResponse(err)
+	}
+
+	return string(out)
+}
+
 // MultiAccountStoreAccount stores the select account.
 func MultiAccountStoreAccount(paramsJSON string) string {
 	var p MultiAccountStoreAccountParams
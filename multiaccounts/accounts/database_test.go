@@ -78,6 +78,22 @@ func TestSaveSetting(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestUnfurlLinksDisabledByDefault(t *testing.T) {
+	db, stop := setupTestDB(t)
+	defer stop()
+
+	require.NoError(t, db.CreateSettings(settings, config))
+
+	enabled, err := db.UnfurlLinksEnabled()
+	require.NoError(t, err)
+	require.False(t, enabled)
+
+	require.NoError(t, db.SaveSetting("unfurl-links-enabled?", true))
+	enabled, err = db.UnfurlLinksEnabled()
+	require.NoError(t, err)
+	require.True(t, enabled)
+}
+
 func TestGetNodeConfig(t *testing.T) {
 	db, stop := setupTestDB(t)
 	defer stop()
@@ -31,7 +31,9 @@ import (
 	"github.com/status-im/status-go/rpc"
 	accountssvc "github.com/status-im/status-go/services/accounts"
 	appmetricsservice "github.com/status-im/status-go/services/appmetrics"
+	"github.com/status-im/status-go/services/bridge"
 	"github.com/status-im/status-go/services/browsers"
+	"github.com/status-im/status-go/services/ens"
 	localnotifications "github.com/status-im/status-go/services/local-notifications"
 	"github.com/status-im/status-go/services/mailservers"
 	"github.com/status-im/status-go/services/peer"
@@ -39,6 +41,9 @@ import (
 	"github.com/status-im/status-go/services/personal"
 	"github.com/status-im/status-go/services/rpcfilters"
 	"github.com/status-im/status-go/services/rpcstats"
+	"github.com/status-im/status-go/services/safe"
+	"github.com/status-im/status-go/services/siwe"
+	"github.com/status-im/status-go/services/stickers"
 	"github.com/status-im/status-go/services/subscriptions"
 	"github.com/status-im/status-go/services/wakuext"
 	"github.com/status-im/status-go/services/wakuv2ext"
@@ -48,6 +53,9 @@ import (
 	"github.com/status-im/status-go/wakuv2"
 )
 
+// dnsDiscoveryCacheFile is the name of the file in DataDir where synced ENR trees are kept.
+const dnsDiscoveryCacheFile = "dnsdisc.json"
+
 // errors
 var (
 	ErrNodeRunning            = errors.New("node is already running")
@@ -75,6 +83,8 @@ type StatusNode struct {
 	register  *peers.Register
 	peerPool  *peers.PeerPool
 	db        *leveldb.DB // used as a cache for PeerPool
+	// reputation is shared by PeerPool and mail servers selection
+	reputation *peers.Reputation
 
 	log log.Logger
 
@@ -95,6 +105,10 @@ type StatusNode struct {
 	mailserversSrvc        *mailservers.Service
 	appMetricsSrvc         *appmetricsservice.Service
 	walletSrvc             *wallet.Service
+	stickersSrvc           *stickers.Service
+	ensSrvc                *ens.Service
+	siweSrvc               *siwe.Service
+	safeSrvc               *safe.Service
 	peerSrvc               *peer.Service
 	localNotificationsSrvc *localnotifications.Service
 	personalSrvc           *personal.Service
@@ -103,6 +117,7 @@ type StatusNode struct {
 	wakuExtSrvc            *wakuext.Service
 	wakuV2Srvc             *wakuv2.Waku
 	wakuV2ExtSrvc          *wakuv2ext.Service
+	bridgeSrvc             *bridge.Bridge
 }
 
 // New makes new instance of StatusNode.
@@ -178,6 +193,7 @@ func (n *StatusNode) StartWithOptions(config *params.NodeConfig, options StartOp
 	}
 
 	n.db = db
+	n.reputation = peers.NewReputation(db)
 
 	err = n.startWithDB(config, options.AccountsManager, db)
 
@@ -191,6 +207,7 @@ func (n *StatusNode) StartWithOptions(config *params.NodeConfig, options StartOp
 			n.log.Error("error while closing leveldb after node crash", "error", dberr)
 		}
 		n.db = nil
+		n.reputation = nil
 		return err
 	}
 
@@ -238,7 +255,7 @@ func (n *StatusNode) setupRPCClient() (err error) {
 }
 
 func (n *StatusNode) discoveryEnabled() bool {
-	return n.config != nil && (!n.config.NoDiscovery || n.config.Rendezvous) && n.config.ClusterConfig.Enabled
+	return n.config != nil && (!n.config.NoDiscovery || n.config.Rendezvous || n.config.DNSDiscovery) && n.config.ClusterConfig.Enabled
 }
 
 func (n *StatusNode) discoverNode() (*enode.Node, error) {
@@ -317,6 +334,16 @@ func (n *StatusNode) startDiscovery() error {
 		}
 		discoveries = append(discoveries, d)
 	}
+	if n.config.DNSDiscovery {
+		d, err := discovery.NewDNS(
+			n.config.ClusterConfig.DNSDiscoveryURLs,
+			nil,
+			filepath.Join(n.config.DataDir, dnsDiscoveryCacheFile))
+		if err != nil {
+			return err
+		}
+		discoveries = append(discoveries, d)
+	}
 	if len(discoveries) == 0 {
 		return errors.New("wasn't able to register any discovery")
 	} else if len(discoveries) > 1 {
@@ -335,6 +362,7 @@ func (n *StatusNode) startDiscovery() error {
 	// TODO(dshulyak) consider adding a flag to define this behaviour
 	options.AllowStop = len(n.config.RegisterTopics) == 0
 	options.TrustedMailServers = parseNodesToNodeID(n.config.ClusterConfig.TrustedMailServers)
+	options.Reputation = n.reputation
 
 	n.peerPool = peers.NewPeerPool(
 		n.discovery,
@@ -388,6 +416,7 @@ func (n *StatusNode) stop() error {
 		err := n.db.Close()
 
 		n.db = nil
+		n.reputation = nil
 
 		return err
 	}
@@ -401,6 +430,10 @@ func (n *StatusNode) stop() error {
 	n.mailserversSrvc = nil
 	n.appMetricsSrvc = nil
 	n.walletSrvc = nil
+	n.stickersSrvc = nil
+	n.ensSrvc = nil
+	n.siweSrvc = nil
+	n.safeSrvc = nil
 	n.peerSrvc = nil
 	n.localNotificationsSrvc = nil
 	n.personalSrvc = nil
@@ -409,6 +442,7 @@ func (n *StatusNode) stop() error {
 	n.wakuExtSrvc = nil
 	n.wakuV2Srvc = nil
 	n.wakuV2ExtSrvc = nil
+	n.bridgeSrvc = nil
 	n.publicMethods = make(map[string]bool)
 
 	return nil
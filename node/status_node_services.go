@@ -7,6 +7,9 @@ import (
 	"reflect"
 
 	logging "github.com/ipfs/go-log"
+	libp2ppeer "github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
 
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/p2p/enode"
@@ -23,7 +26,9 @@ import (
 	"github.com/status-im/status-go/rpc"
 	accountssvc "github.com/status-im/status-go/services/accounts"
 	appmetricsservice "github.com/status-im/status-go/services/appmetrics"
+	"github.com/status-im/status-go/services/bridge"
 	"github.com/status-im/status-go/services/browsers"
+	"github.com/status-im/status-go/services/ens"
 	"github.com/status-im/status-go/services/ext"
 	localnotifications "github.com/status-im/status-go/services/local-notifications"
 	"github.com/status-im/status-go/services/mailservers"
@@ -32,14 +37,19 @@ import (
 	"github.com/status-im/status-go/services/personal"
 	"github.com/status-im/status-go/services/rpcfilters"
 	"github.com/status-im/status-go/services/rpcstats"
+	"github.com/status-im/status-go/services/safe"
+	"github.com/status-im/status-go/services/siwe"
+	"github.com/status-im/status-go/services/stickers"
 	"github.com/status-im/status-go/services/subscriptions"
 	"github.com/status-im/status-go/services/wakuext"
 	"github.com/status-im/status-go/services/wakuv2ext"
 	"github.com/status-im/status-go/services/wallet"
 	"github.com/status-im/status-go/timesource"
+	"github.com/status-im/status-go/transactions"
 	"github.com/status-im/status-go/waku"
 	wakucommon "github.com/status-im/status-go/waku/common"
 	"github.com/status-im/status-go/wakuv2"
+	wakuv2common "github.com/status-im/status-go/wakuv2/common"
 )
 
 var (
@@ -63,7 +73,7 @@ func (b *StatusNode) initServices(config *params.NodeConfig) error {
 	services = appendIf(config.EnableNTPSync, services, b.timeSource())
 	services = appendIf(b.appDB != nil && b.multiaccountsDB != nil, services, b.accountsService(accountsFeed))
 	services = appendIf(config.BrowsersConfig.Enabled, services, b.browsersService())
-	services = appendIf(config.PermissionsConfig.Enabled, services, b.permissionsService())
+	services = appendIf(config.PermissionsConfig.Enabled, services, b.permissionsService(config.NetworkID))
 	services = appendIf(config.MailserversConfig.Enabled, services, b.mailserversService())
 	if config.WakuConfig.Enabled {
 		wakuService, err := b.wakuService(&config.WakuConfig, &config.ClusterConfig)
@@ -100,12 +110,41 @@ func (b *StatusNode) initServices(config *params.NodeConfig) error {
 		services = append(services, wakuext)
 	}
 
+	if config.BridgeConfig.Enabled && config.WakuConfig.Enabled && config.WakuV2Config.Enabled {
+		services = append(services, b.bridgeService(&config.BridgeConfig))
+	}
+
 	if config.WalletConfig.Enabled {
 		walletService := b.walletService(config.NetworkID, accountsFeed)
 		b.walletSrvc.SetClient(b.rpcClient.Ethclient())
+		b.walletSrvc.SetRPCClient(b.rpcClient)
 		services = append(services, walletService)
 	}
 
+	if config.StickersConfig.Enabled {
+		stickersService := b.stickersService(config.NetworkID)
+		b.stickersSrvc.SetClient(b.rpcClient.Ethclient())
+		services = append(services, stickersService)
+	}
+
+	if config.EnsConfig.Enabled {
+		ensService := b.ensService(config)
+		b.ensSrvc.SetClient(b.rpcClient.Ethclient())
+		services = append(services, ensService)
+	}
+
+	if config.SiweConfig.Enabled {
+		siweService := b.siweService(config.NetworkID)
+		b.siweSrvc.SetClient(b.rpcClient.Ethclient())
+		services = append(services, siweService)
+	}
+
+	if config.SafeConfig.Enabled {
+		safeService := b.safeService(config)
+		b.safeSrvc.SetClient(b.rpcClient.Ethclient())
+		services = append(services, safeService)
+	}
+
 	// We ignore for now local notifications flag as users who are upgrading have no mean to enable it
 	services = append(services, b.localNotificationsService(config.NetworkID))
 
@@ -147,7 +186,7 @@ func (b *StatusNode) wakuExtService(config *params.NodeConfig) (*wakuext.Service
 	}
 
 	if b.wakuExtSrvc == nil {
-		b.wakuExtSrvc = wakuext.New(config.ShhextConfig, b.nodeBridge(), ext.EnvelopeSignalHandler{}, b.db)
+		b.wakuExtSrvc = wakuext.New(config.ShhextConfig, b.nodeBridge(), ext.EnvelopeSignalHandler{}, b.db, b.reputation)
 	}
 
 	b.wakuExtSrvc.SetP2PServer(b.gethNode.Server())
@@ -159,7 +198,7 @@ func (b *StatusNode) wakuV2ExtService(config *params.NodeConfig) (*wakuv2ext.Ser
 		return nil, errors.New("geth node not initialized")
 	}
 	if b.wakuV2ExtSrvc == nil {
-		b.wakuV2ExtSrvc = wakuv2ext.New(config.ShhextConfig, b.nodeBridge(), ext.EnvelopeSignalHandler{}, b.db)
+		b.wakuV2ExtSrvc = wakuv2ext.New(config.ShhextConfig, b.nodeBridge(), ext.EnvelopeSignalHandler{}, b.db, b.reputation)
 	}
 
 	b.wakuV2ExtSrvc.SetP2PServer(b.gethNode.Server())
@@ -238,6 +277,10 @@ func (b *StatusNode) wakuV2Service(nodeKey string, wakuCfg *params.WakuV2Config,
 			Port:                   wakuCfg.Port,
 			BootNodes:              clusterCfg.WakuNodes,
 			StoreNodes:             clusterCfg.WakuStoreNodes,
+			LightpushNodes:         clusterCfg.WakuLightpushNodes,
+			LightClient:            wakuCfg.LightClient,
+			FullNode:               wakuCfg.FullNode,
+			EnableConfirmations:    wakuCfg.EnableConfirmations,
 		}
 
 		if wakuCfg.MaxMessageSize > 0 {
@@ -255,6 +298,16 @@ func (b *StatusNode) wakuV2Service(nodeKey string, wakuCfg *params.WakuV2Config,
 		if err != nil {
 			return nil, err
 		}
+
+		if wakuCfg.EnableRateLimiter {
+			r := wakuV2RateLimiter(wakuCfg, clusterCfg)
+			w.RegisterRateLimiter(r)
+		}
+
+		if timesource := b.timeSource(); timesource != nil {
+			w.SetTimeSource(timesource.Now)
+		}
+
 		b.wakuV2Srvc = w
 	}
 
@@ -290,6 +343,39 @@ func wakuRateLimiter(wakuCfg *params.WakuConfig, clusterCfg *params.ClusterConfi
 	)
 }
 
+func wakuV2RateLimiter(wakuCfg *params.WakuV2Config, clusterCfg *params.ClusterConfig) *wakuv2common.PeerRateLimiter {
+	var (
+		ips     []string
+		peerIDs []string
+	)
+	for _, address := range append(clusterCfg.WakuNodes, clusterCfg.WakuStoreNodes...) {
+		addr, err := ma.NewMultiaddr(address)
+		if err != nil {
+			continue
+		}
+		if info, err := libp2ppeer.AddrInfoFromP2pAddr(addr); err == nil {
+			peerIDs = append(peerIDs, info.ID.Pretty())
+		}
+		if ip, err := manet.ToIP(addr); err == nil {
+			ips = append(ips, ip.String())
+		}
+	}
+	return wakuv2common.NewPeerRateLimiter(
+		&wakuv2common.PeerRateLimiterConfig{
+			PacketLimitPerSecIP:     wakuCfg.PacketRateLimitIP,
+			PacketLimitPerSecPeerID: wakuCfg.PacketRateLimitPeerID,
+			BytesLimitPerSecIP:      wakuCfg.BytesRateLimitIP,
+			BytesLimitPerSecPeerID:  wakuCfg.BytesRateLimitPeerID,
+			WhitelistedIPs:          ips,
+			WhitelistedPeerIDs:      peerIDs,
+		},
+		&wakuv2common.MetricsRateLimiterHandler{},
+		&wakuv2common.DropPeerRateLimiterHandler{
+			Tolerance: wakuCfg.RateLimitTolerance,
+		},
+	)
+}
+
 func (b *StatusNode) rpcFiltersService() *rpcfilters.Service {
 	if b.rpcFiltersSrvc == nil {
 		b.rpcFiltersSrvc = rpcfilters.New(b)
@@ -328,9 +414,10 @@ func (b *StatusNode) browsersService() *browsers.Service {
 	return b.browsersSrvc
 }
 
-func (b *StatusNode) permissionsService() *permissions.Service {
+func (b *StatusNode) permissionsService(network uint64) *permissions.Service {
 	if b.permissionsSrvc == nil {
-		b.permissionsSrvc = permissions.NewService(permissions.NewDB(b.appDB))
+		b.permissionsSrvc = permissions.NewService(permissions.NewDB(b.appDB), network)
+		b.permissionsSrvc.SetRPCClient(b.rpcClient)
 	}
 	return b.permissionsSrvc
 }
@@ -357,6 +444,59 @@ func (b *StatusNode) walletService(network uint64, accountsFeed *event.Feed) com
 	return b.walletSrvc
 }
 
+func (b *StatusNode) bridgeService(config *params.BridgeConfig) *bridge.Bridge {
+	if b.bridgeSrvc == nil {
+		topics := make([]wakucommon.TopicType, len(config.Topics))
+		for i, topic := range config.Topics {
+			topics[i] = wakucommon.BytesToTopic(types.FromHex(topic))
+		}
+		b.bridgeSrvc = bridge.New(b.wakuSrvc, b.wakuV2Srvc, topics, logutils.ZapLogger())
+	}
+	return b.bridgeSrvc
+}
+
+func (b *StatusNode) stickersService(network uint64) *stickers.Service {
+	if b.stickersSrvc == nil {
+		b.stickersSrvc = stickers.NewService(accounts.NewDB(b.appDB), network)
+	}
+	return b.stickersSrvc
+}
+
+func (b *StatusNode) ensService(config *params.NodeConfig) *ens.Service {
+	if b.ensSrvc == nil {
+		transactor := transactions.NewTransactor()
+		transactor.SetNetworkID(config.NetworkID)
+		transactor.SetRPC(b.rpcClient, rpc.DefaultCallTimeout)
+		b.ensSrvc = ens.NewService(b.appDB, b.gethAccountManager, transactor, config.KeyStoreDir, config.NetworkID)
+	}
+	return b.ensSrvc
+}
+
+func (b *StatusNode) siweService(network uint64) *siwe.Service {
+	if b.siweSrvc == nil {
+		b.siweSrvc = siwe.NewService(network)
+	}
+	return b.siweSrvc
+}
+
+func (b *StatusNode) safeService(config *params.NodeConfig) *safe.Service {
+	if b.safeSrvc == nil {
+		transactor := transactions.NewTransactor()
+		transactor.SetNetworkID(config.NetworkID)
+		transactor.SetRPC(b.rpcClient, rpc.DefaultCallTimeout)
+
+		// Proposals are exchanged through the messenger of whichever waku extension is enabled
+		var messengerProvider safe.MessengerProvider
+		if b.wakuV2ExtSrvc != nil {
+			messengerProvider = b.wakuV2ExtSrvc
+		} else if b.wakuExtSrvc != nil {
+			messengerProvider = b.wakuExtSrvc
+		}
+		b.safeSrvc = safe.NewService(b.appDB, b.gethAccountManager, transactor, config.KeyStoreDir, config.NetworkID, messengerProvider)
+	}
+	return b.safeSrvc
+}
+
 func (b *StatusNode) localNotificationsService(network uint64) *localnotifications.Service {
 	if b.localNotificationsSrvc == nil {
 		b.localNotificationsSrvc = localnotifications.NewService(b.appDB, network)
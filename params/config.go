@@ -162,7 +162,7 @@ type WakuV2Config struct {
 	// Port number in which to start libp2p protocol (0 for random)
 	Port int
 
-	// LightClient should be true if the node should start with an empty bloom filter and not forward messages from other nodes
+	// LightClient should be true if the node should send its messages through a full node using lightpush
 	LightClient bool
 
 	// FullNode should be true if waku should always acta as a full node
@@ -219,7 +219,7 @@ type WakuV2Config struct {
 	// SoftBlacklistedPeerIDs is a list of peer ids that should be soft-blacklisted (messages should be dropped but connection kept)
 	SoftBlacklistedPeerIDs []string
 
-	// EnableConfirmations when true, instructs that confirmation should be sent for received messages
+	// EnableConfirmations when true, messages are considered sent only once they are relayed to a peer
 	EnableConfirmations bool
 }
 
@@ -270,11 +270,17 @@ type ClusterConfig struct {
 	// RendezvousNodes is a list rendezvous discovery nodes.
 	RendezvousNodes []string
 
+	// DNSDiscoveryURLs is a list of EIP-1459 ENR trees (enrtree://<key>@<domain>).
+	DNSDiscoveryURLs []string
+
 	// WakuNodes is a list of wakuv2 libp2p nodes
 	WakuNodes []string
 
 	// WakuStoreNodes is a list of wakuv2 store nodes
 	WakuStoreNodes []string
+
+	// WakuLightpushNodes is a list of wakuv2 full nodes light clients send their messages through
+	WakuLightpushNodes []string
 }
 
 // String dumps config object as nicely indented JSON
@@ -335,6 +341,9 @@ type NodeConfig struct {
 	// Rendezvous enables discovery protocol.
 	Rendezvous bool
 
+	// DNSDiscovery enables discovery of nodes from ENR trees published in DNS.
+	DNSDiscovery bool
+
 	// ListenAddr is an IP address and port of this node (e.g. 127.0.0.1:30303).
 	ListenAddr string
 
@@ -443,7 +452,7 @@ type NodeConfig struct {
 	// WakuV2Config provides a configuration for WakuV2 protocol.
 	WakuV2Config WakuV2Config `json:"WakuV2Config" validate:"structonly"`
 
-	// BridgeConfig provides a configuration for Whisper-Waku bridge.
+	// BridgeConfig provides a configuration for Waku-WakuV2 bridge.
 	BridgeConfig BridgeConfig `json:"BridgeConfig" validate:"structonly"`
 
 	// ShhextConfig extra configuration for service running under shhext namespace.
@@ -461,6 +470,18 @@ type NodeConfig struct {
 	// PermissionsConfig extra configuration for permissions.Service.
 	PermissionsConfig PermissionsConfig
 
+	// StickersConfig extra configuration for stickers.Service.
+	StickersConfig StickersConfig
+
+	// EnsConfig extra configuration for ens.Service.
+	EnsConfig EnsConfig
+
+	// SiweConfig extra configuration for siwe.Service.
+	SiweConfig SiweConfig
+
+	// SafeConfig extra configuration for safe.Service.
+	SafeConfig SafeConfig
+
 	// MailserversConfig extra configuration for mailservers.Service
 	// (persistent storage of user's mailserver records).
 	MailserversConfig MailserversConfig
@@ -503,14 +524,39 @@ type PermissionsConfig struct {
 	Enabled bool
 }
 
+// StickersConfig extra configuration for stickers.Service.
+type StickersConfig struct {
+	Enabled bool
+}
+
+// EnsConfig extra configuration for ens.Service.
+type EnsConfig struct {
+	Enabled bool
+}
+
+// SiweConfig extra configuration for siwe.Service.
+type SiweConfig struct {
+	Enabled bool
+}
+
+// SafeConfig extra configuration for safe.Service.
+type SafeConfig struct {
+	Enabled bool
+}
+
 // MailserversConfig extra configuration for mailservers.Service.
 type MailserversConfig struct {
 	Enabled bool
 }
 
-// BridgeConfig provides configuration for Whisper-Waku bridge.
+// BridgeConfig provides configuration for Waku-WakuV2 bridge.
+// The bridge is started only if both Waku and WakuV2 are enabled.
 type BridgeConfig struct {
 	Enabled bool
+
+	// Topics is the list of hex encoded topics to bridge, all topics
+	// are bridged if it's empty.
+	Topics []string
 }
 
 // ShhextConfig defines options used by shhext service.
@@ -566,6 +612,14 @@ type ShhextConfig struct {
 
 	// DefaultPushNotificationsServers is the default-status run push notification servers
 	DefaultPushNotificationsServers []*ecdsa.PublicKey
+
+	// AnonMetricsSendID is the public key of the collector anonymous metrics are sent to.
+	// If not set, metrics are never sent
+	AnonMetricsSendID string
+
+	// MaxFileSize is the size cap in bytes of the files attached to messages we send and accept.
+	// If not set, the default cap of the protocol is used
+	MaxFileSize uint64
 }
 
 // Validate validates the ShhextConfig struct and returns an error if inconsistent values are found
@@ -727,7 +781,7 @@ func NewNodeConfigWithDefaultsAndFiles(
 
 // updatePeerLimits will set default peer limits expectations based on enabled services.
 func (c *NodeConfig) updatePeerLimits() {
-	if c.NoDiscovery && !c.Rendezvous {
+	if c.NoDiscovery && !c.Rendezvous && !c.DNSDiscovery {
 		return
 	}
 	if c.LightEthConfig.Enabled {
@@ -913,6 +967,10 @@ func (c *NodeConfig) Validate() error {
 		return fmt.Errorf("Rendezvous is enabled, but ClusterConfig.RendezvousNodes is empty")
 	}
 
+	if len(c.ClusterConfig.DNSDiscoveryURLs) == 0 && c.DNSDiscovery {
+		return fmt.Errorf("DNSDiscovery is enabled, but ClusterConfig.DNSDiscoveryURLs is empty")
+	}
+
 	return nil
 }
 
@@ -210,6 +210,17 @@ func TestNodeConfigValidate(t *testing.T) {
 			}`,
 			Error: "Rendezvous is enabled, but ClusterConfig.RendezvousNodes is empty",
 		},
+		{
+			Name: "Validate that ClusterConfig.DNSDiscoveryURLs is verified to not be empty if DNSDiscovery is enabled",
+			Config: `{
+				"NetworkId": 1,
+				"DataDir": "/some/dir",
+				"KeyStoreDir": "/some/dir",
+				"NoDiscovery": true,
+				"DNSDiscovery": true
+			}`,
+			Error: "DNSDiscovery is enabled, but ClusterConfig.DNSDiscoveryURLs is empty",
+		},
 		{
 			Name: "Validate that PFSEnabled & InstallationID are checked for validity",
 			Config: `{
@@ -58,6 +58,8 @@ type Options struct {
 	TopicStopSearchDelay time.Duration
 	// TrustedMailServers is a list of trusted nodes.
 	TrustedMailServers []enode.ID
+	// Reputation is used to rank and evict peers. If nil, peers are ranked by discovery time.
+	Reputation *Reputation
 }
 
 // NewDefaultOptions returns a struct with default Options.
@@ -78,6 +80,8 @@ type peerInfo struct {
 	dismissed bool
 	// added is true when the node tries to add this peer to a server
 	added bool
+	// score is a reputation score of the peer when it was queued
+	score float64
 
 	node *discv5.Node
 	// store public key separately to make peerInfo more independent from discv5
@@ -155,6 +159,7 @@ func (p *PeerPool) Start(server *p2p.Server, rpcClient contracts.RPCClient) erro
 	for topic, limits := range p.config {
 		var topicPool TopicPoolInterface
 		t := newTopicPool(p.discovery, topic, limits, p.opts.SlowSync, p.opts.FastSync, p.cache)
+		t.reputation = p.opts.Reputation
 		if topic == MailServerDiscoveryTopic {
 			v, err := p.initVerifier(rpcClient)
 			if err != nil {
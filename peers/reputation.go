@@ -0,0 +1,285 @@
+package peers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/syndtr/goleveldb/leveldb"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+
+	"github.com/status-im/status-go/db"
+	"github.com/status-im/status-go/rtt"
+)
+
+const (
+	// DefaultPeerScore is a score of a peer without any recorded stats.
+	DefaultPeerScore = 0.5
+	// MinPeerScore is a score below which a peer with enough observations is avoided.
+	MinPeerScore = 0.2
+	// minObservations is a number of recorded samples required to ban a peer.
+	minObservations = 5
+	// banDuration is how long a peer stays banned after its stats were last updated.
+	banDuration = 30 * time.Minute
+	// statsHalfLife is a period after which recorded counters are halved, so that
+	// a peer that recovered from a transient fault can regain its score.
+	statsHalfLife = 24 * time.Hour
+
+	// rttReferenceMs is an RTT that yields a half score.
+	rttReferenceMs = 200
+	// requestLatencyReferenceMs is a mail server request latency that yields a half score.
+	requestLatencyReferenceMs = 2000
+	// ewmaWeight is a weight of the most recent sample in averages.
+	ewmaWeight = 0.3
+
+	rttWeight            = 1
+	envelopesWeight      = 2
+	requestsWeight       = 3
+	requestLatencyWeight = 1
+)
+
+// PeerStats are observations about a single peer that are used to compute its score.
+type PeerStats struct {
+	// RTTMs is an exponentially weighted average of TCP round trip time, 0 if unknown.
+	RTTMs float64 `json:"rttMs"`
+	// EnvelopesSent is a number of envelopes sent to a peer that expect a confirmation.
+	EnvelopesSent uint64 `json:"envelopesSent"`
+	// EnvelopesConfirmed is a number of envelopes confirmed by a peer.
+	EnvelopesConfirmed uint64 `json:"envelopesConfirmed"`
+	// RequestsCompleted is a number of successful mail server requests.
+	RequestsCompleted uint64 `json:"requestsCompleted"`
+	// RequestsFailed is a number of failed or expired mail server requests.
+	RequestsFailed uint64 `json:"requestsFailed"`
+	// RequestLatencyMs is an exponentially weighted average of mail server request latency.
+	RequestLatencyMs float64 `json:"requestLatencyMs"`
+	// UpdatedAt is the last time stats were changed.
+	UpdatedAt time.Time `json:"updatedAt"`
+	// DecayedAt is the last time counters were halved.
+	DecayedAt time.Time `json:"decayedAt"`
+}
+
+// Observations returns a number of recorded samples.
+func (s PeerStats) Observations() uint64 {
+	return s.EnvelopesSent + s.RequestsCompleted + s.RequestsFailed
+}
+
+// Score combines known stats into a value between 0 and 1. Higher is better.
+// Rates are smoothed so that a single sample doesn't decide the fate of a peer.
+func (s PeerStats) Score() float64 {
+	var sum, weights float64
+	if s.RTTMs > 0 {
+		sum += rttWeight * rttReferenceMs / (rttReferenceMs + s.RTTMs)
+		weights += rttWeight
+	}
+	if s.EnvelopesSent > 0 {
+		sum += envelopesWeight * float64(s.EnvelopesConfirmed+1) / float64(s.EnvelopesSent+2)
+		weights += envelopesWeight
+	}
+	if requests := s.RequestsCompleted + s.RequestsFailed; requests > 0 {
+		sum += requestsWeight * float64(s.RequestsCompleted+1) / float64(requests+2)
+		weights += requestsWeight
+	}
+	if s.RequestLatencyMs > 0 {
+		sum += requestLatencyWeight * requestLatencyReferenceMs / (requestLatencyReferenceMs + s.RequestLatencyMs)
+		weights += requestLatencyWeight
+	}
+	if weights == 0 {
+		return DefaultPeerScore
+	}
+	return sum / weights
+}
+
+// decay halves the counters once for every statsHalfLife elapsed since they were last halved.
+func (s *PeerStats) decay(now time.Time) {
+	if s.DecayedAt.IsZero() {
+		s.DecayedAt = s.UpdatedAt
+	}
+	if s.DecayedAt.IsZero() {
+		return
+	}
+	for now.Sub(s.DecayedAt) >= statsHalfLife {
+		s.EnvelopesSent /= 2
+		s.EnvelopesConfirmed /= 2
+		s.RequestsCompleted /= 2
+		s.RequestsFailed /= 2
+		s.DecayedAt = s.DecayedAt.Add(statsHalfLife)
+		if s.Observations() == 0 {
+			s.DecayedAt = now
+		}
+	}
+}
+
+func ewma(current, sample float64) float64 {
+	if current == 0 {
+		return sample
+	}
+	return (1-ewmaWeight)*current + ewmaWeight*sample
+}
+
+// NewReputation returns an instance of Reputation backed by leveldb.
+func NewReputation(db *leveldb.DB) *Reputation {
+	return &Reputation{
+		db:    db,
+		stats: map[enode.ID]*PeerStats{},
+		clock: realClock{},
+	}
+}
+
+// Reputation is a persistent store of peers stats.
+// A nil Reputation is valid and returns DefaultPeerScore for every peer.
+type Reputation struct {
+	mu    sync.Mutex
+	db    *leveldb.DB
+	stats map[enode.ID]*PeerStats
+	clock Clock
+}
+
+func makeReputationKey(id enode.ID) []byte {
+	return db.Key(db.PeersReputation, id.Bytes())
+}
+
+// get returns cached stats or loads them from the database, with counters decayed
+// to the current time. Must be called with lock held.
+func (r *Reputation) get(id enode.ID) *PeerStats {
+	if s, ok := r.stats[id]; ok {
+		s.decay(r.clock.Now())
+		return s
+	}
+	s := &PeerStats{}
+	data, err := r.db.Get(makeReputationKey(id), nil)
+	if err == nil {
+		if err := json.Unmarshal(data, s); err != nil {
+			log.Error("can't unmarshal peer stats", "peer", id, "error", err)
+		}
+	} else if err != leveldb.ErrNotFound {
+		log.Error("can't load peer stats", "peer", id, "error", err)
+	}
+	s.decay(r.clock.Now())
+	r.stats[id] = s
+	return s
+}
+
+// update applies fn to the stats of a peer and persists the result.
+func (r *Reputation) update(id enode.ID, fn func(*PeerStats)) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s := r.get(id)
+	fn(s)
+	s.UpdatedAt = r.clock.Now()
+	if s.DecayedAt.IsZero() {
+		s.DecayedAt = s.UpdatedAt
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		log.Error("can't marshal peer stats", "peer", id, "error", err)
+		return
+	}
+	if err := r.db.Put(makeReputationKey(id), data, nil); err != nil {
+		log.Error("failed to persist peer stats", "peer", id, "error", err)
+	}
+}
+
+// Stats returns a copy of the stats recorded for a peer.
+func (r *Reputation) Stats(id enode.ID) PeerStats {
+	if r == nil {
+		return PeerStats{}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return *r.get(id)
+}
+
+// Score returns a score of a peer.
+func (r *Reputation) Score(id enode.ID) float64 {
+	return r.Stats(id).Score()
+}
+
+// Banned returns true if a peer was observed long enough and its score is below MinPeerScore.
+// A ban expires after banDuration without new observations, so that the peer is tried again.
+func (r *Reputation) Banned(id enode.ID) bool {
+	if r == nil {
+		return false
+	}
+	s := r.Stats(id)
+	if s.Observations() < minObservations || s.Score() >= MinPeerScore {
+		return false
+	}
+	return r.clock.Now().Sub(s.UpdatedAt) < banDuration
+}
+
+// RecordRTT records a measured round trip time.
+func (r *Reputation) RecordRTT(id enode.ID, rtt time.Duration) {
+	r.update(id, func(s *PeerStats) {
+		s.RTTMs = ewma(s.RTTMs, float64(rtt)/float64(time.Millisecond))
+	})
+}
+
+// RecordEnvelopes records a number of envelopes sent to a peer and how many of them were confirmed.
+func (r *Reputation) RecordEnvelopes(id enode.ID, sent, confirmed int) {
+	r.update(id, func(s *PeerStats) {
+		s.EnvelopesSent += uint64(sent)
+		s.EnvelopesConfirmed += uint64(confirmed)
+	})
+}
+
+// RecordRequest records an outcome of a mail server request.
+func (r *Reputation) RecordRequest(id enode.ID, latency time.Duration, success bool) {
+	r.update(id, func(s *PeerStats) {
+		if success {
+			s.RequestsCompleted++
+		} else {
+			s.RequestsFailed++
+		}
+		s.RequestLatencyMs = ewma(s.RequestLatencyMs, float64(latency)/float64(time.Millisecond))
+	})
+}
+
+// SortByScore sorts nodes from the highest score to the lowest.
+// Nodes with equal scores keep their original order.
+func (r *Reputation) SortByScore(nodes []*enode.Node) {
+	if r == nil {
+		return
+	}
+	scores := make(map[enode.ID]float64, len(nodes))
+	for _, n := range nodes {
+		scores[n.ID()] = r.Score(n.ID())
+	}
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return scores[nodes[i].ID()] > scores[nodes[j].ID()]
+	})
+}
+
+// MeasureRTT checks TCP round trip time of nodes and records results.
+func (r *Reputation) MeasureRTT(nodes []*enode.Node, timeout time.Duration) error {
+	if r == nil || len(nodes) == 0 {
+		return nil
+	}
+	addrs := make([]string, 0, len(nodes))
+	byAddr := make(map[string]enode.ID, len(nodes))
+	for _, n := range nodes {
+		addr := net.JoinHostPort(n.IP().String(), strconv.Itoa(n.TCP()))
+		addrs = append(addrs, addr)
+		byAddr[addr] = n.ID()
+	}
+	results, err := rtt.CheckHosts(addrs, timeout)
+	if err != nil {
+		return fmt.Errorf("failed to measure rtt: %v", err)
+	}
+	for _, res := range results {
+		if res.Err != nil {
+			log.Debug("rtt check failed", "addr", res.Addr, "error", res.Err)
+			continue
+		}
+		r.RecordRTT(byAddr[res.Addr], time.Duration(res.RTTMs)*time.Millisecond)
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package peers
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+const (
+	envelopeEventsBuffer = 100
+	// batchConfirmationTimeout is a time after which an unacknowledged batch
+	// is recorded as not confirmed.
+	batchConfirmationTimeout = 30 * time.Second
+)
+
+// EnvelopeEventSubscriber interface to subscribe for types.EnvelopeEvent's.
+type EnvelopeEventSubscriber interface {
+	SubscribeEnvelopeEvents(chan<- types.EnvelopeEvent) types.Subscription
+}
+
+type pendingBatch struct {
+	peer   types.EnodeID
+	count  int
+	sentAt time.Time
+}
+
+// NewReputationMonitor returns pointer to the instance of ReputationMonitor.
+func NewReputationMonitor(reputation *Reputation, eventSub EnvelopeEventSubscriber) *ReputationMonitor {
+	return &ReputationMonitor{
+		reputation: reputation,
+		eventSub:   eventSub,
+		timeout:    batchConfirmationTimeout,
+		batches:    map[types.Hash]*pendingBatch{},
+		clock:      realClock{},
+	}
+}
+
+// ReputationMonitor watches envelope events and records how many envelopes
+// sent to every peer were confirmed.
+type ReputationMonitor struct {
+	reputation *Reputation
+	eventSub   EnvelopeEventSubscriber
+	timeout    time.Duration
+	clock      Clock
+
+	batches map[types.Hash]*pendingBatch
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// Start spins a separate goroutine to watch envelope events.
+func (mon *ReputationMonitor) Start() {
+	mon.quit = make(chan struct{})
+	mon.wg.Add(1)
+	go func() {
+		events := make(chan types.EnvelopeEvent, envelopeEventsBuffer)
+		sub := mon.eventSub.SubscribeEnvelopeEvents(events)
+		ticker := time.NewTicker(mon.timeout / 2)
+		defer ticker.Stop()
+		defer sub.Unsubscribe()
+		defer mon.wg.Done()
+		for {
+			select {
+			case <-mon.quit:
+				return
+			case err := <-sub.Err():
+				log.Error("retry after error suscribing to envelope events", "error", err)
+				return
+			case <-ticker.C:
+				mon.expireBatches()
+			case ev := <-events:
+				mon.handleEvent(ev)
+			}
+		}
+	}()
+}
+
+func (mon *ReputationMonitor) handleEvent(ev types.EnvelopeEvent) {
+	switch ev.Event {
+	case types.EventEnvelopeSent:
+		// only envelopes sent in a batch are confirmed by peers
+		if ev.Batch == (types.Hash{}) {
+			return
+		}
+		batch, ok := mon.batches[ev.Batch]
+		if !ok {
+			batch = &pendingBatch{peer: ev.Peer, sentAt: mon.clock.Now()}
+			mon.batches[ev.Batch] = batch
+		}
+		batch.count++
+	case types.EventBatchAcknowledged:
+		batch, ok := mon.batches[ev.Batch]
+		if !ok || batch.peer != ev.Peer {
+			return
+		}
+		delete(mon.batches, ev.Batch)
+		failed := 0
+		if envelopeErrors, ok := ev.Data.([]types.EnvelopeError); ok {
+			failed = len(envelopeErrors)
+		}
+		confirmed := batch.count - failed
+		if confirmed < 0 {
+			confirmed = 0
+		}
+		mon.reputation.RecordEnvelopes(enode.ID(ev.Peer), batch.count, confirmed)
+	}
+}
+
+func (mon *ReputationMonitor) expireBatches() {
+	now := mon.clock.Now()
+	for hash, batch := range mon.batches {
+		if now.Sub(batch.sentAt) < mon.timeout {
+			continue
+		}
+		delete(mon.batches, hash)
+		mon.reputation.RecordEnvelopes(enode.ID(batch.peer), batch.count, 0)
+	}
+}
+
+// Stop closes channel to signal a quit and waits until all goroutines are stoppped.
+func (mon *ReputationMonitor) Stop() {
+	if mon.quit == nil {
+		return
+	}
+	select {
+	case <-mon.quit:
+		return
+	default:
+	}
+	close(mon.quit)
+	mon.wg.Wait()
+	mon.quit = nil
+}
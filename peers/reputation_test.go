@@ -0,0 +1,176 @@
+package peers
+
+import (
+	"container/heap"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+func newInMemoryReputation(t *testing.T) (*Reputation, *leveldb.DB) {
+	memdb, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	return NewReputation(memdb), memdb
+}
+
+func TestPeerStatsScore(t *testing.T) {
+	require.Equal(t, DefaultPeerScore, PeerStats{}.Score())
+
+	fast := PeerStats{RTTMs: 20, EnvelopesSent: 10, EnvelopesConfirmed: 10}
+	slow := PeerStats{RTTMs: 2000, EnvelopesSent: 10, EnvelopesConfirmed: 10}
+	require.True(t, fast.Score() > slow.Score())
+
+	reliable := PeerStats{RequestsCompleted: 10, RequestLatencyMs: 500}
+	failing := PeerStats{RequestsCompleted: 1, RequestsFailed: 9, RequestLatencyMs: 500}
+	require.True(t, reliable.Score() > DefaultPeerScore)
+	require.True(t, failing.Score() < MinPeerScore*2)
+}
+
+func TestReputationPersisted(t *testing.T) {
+	reputation, memdb := newInMemoryReputation(t)
+	id := enode.ID{1}
+	reputation.RecordRTT(id, 100*time.Millisecond)
+	reputation.RecordEnvelopes(id, 4, 3)
+	reputation.RecordRequest(id, time.Second, true)
+
+	stats := NewReputation(memdb).Stats(id)
+	require.Equal(t, float64(100), stats.RTTMs)
+	require.Equal(t, uint64(4), stats.EnvelopesSent)
+	require.Equal(t, uint64(3), stats.EnvelopesConfirmed)
+	require.Equal(t, uint64(1), stats.RequestsCompleted)
+	require.Equal(t, float64(1000), stats.RequestLatencyMs)
+	require.Equal(t, reputation.Score(id), stats.Score())
+}
+
+func TestReputationBanned(t *testing.T) {
+	reputation, _ := newInMemoryReputation(t)
+	id := enode.ID{1}
+	for i := 0; i < minObservations-1; i++ {
+		reputation.RecordRequest(id, 10*time.Second, false)
+	}
+	// not enough observations yet
+	require.False(t, reputation.Banned(id))
+	reputation.RecordEnvelopes(id, 10, 0)
+	require.True(t, reputation.Banned(id))
+}
+
+func TestReputationBanExpires(t *testing.T) {
+	reputation, _ := newInMemoryReputation(t)
+	clock := &mockClock{now: time.Now()}
+	reputation.clock = clock
+	id := enode.ID{1}
+	reputation.RecordEnvelopes(id, 10, 0)
+	require.True(t, reputation.Banned(id))
+
+	clock.now = clock.now.Add(banDuration)
+	require.False(t, reputation.Banned(id))
+	// new failures ban the peer again
+	reputation.RecordEnvelopes(id, 5, 0)
+	require.True(t, reputation.Banned(id))
+}
+
+func TestReputationDecay(t *testing.T) {
+	reputation, memdb := newInMemoryReputation(t)
+	clock := &mockClock{now: time.Now()}
+	reputation.clock = clock
+	id := enode.ID{1}
+	reputation.RecordEnvelopes(id, 40, 0)
+	reputation.RecordRequest(id, time.Second, false)
+
+	clock.now = clock.now.Add(2*statsHalfLife + time.Hour)
+	stats := reputation.Stats(id)
+	require.Equal(t, uint64(10), stats.EnvelopesSent)
+	require.Equal(t, uint64(0), stats.RequestsFailed)
+
+	// peer recovered, successes outweigh the decayed failures
+	for i := 0; i < 10; i++ {
+		reputation.RecordEnvelopes(id, 1, 1)
+	}
+	require.True(t, reputation.Score(id) > MinPeerScore)
+
+	restored := NewReputation(memdb)
+	restored.clock = clock
+	require.Equal(t, uint64(20), restored.Stats(id).EnvelopesSent)
+}
+
+func TestNilReputation(t *testing.T) {
+	var reputation *Reputation
+	reputation.RecordRTT(enode.ID{1}, time.Second)
+	require.Equal(t, DefaultPeerScore, reputation.Score(enode.ID{1}))
+	require.False(t, reputation.Banned(enode.ID{1}))
+}
+
+func TestReputationSortByScore(t *testing.T) {
+	reputation, _ := newInMemoryReputation(t)
+	nodes := make([]*enode.Node, 3)
+	for i := range nodes {
+		nodes[i] = enode.SignNull(new(enr.Record), enode.ID{byte(i + 1)})
+	}
+	reputation.RecordRequest(nodes[0].ID(), time.Second, false)
+	reputation.RecordRequest(nodes[2].ID(), time.Second, true)
+
+	reputation.SortByScore(nodes)
+	require.Equal(t, enode.ID{3}, nodes[0].ID())
+	require.Equal(t, enode.ID{2}, nodes[1].ID())
+	require.Equal(t, enode.ID{1}, nodes[2].ID())
+}
+
+func TestPeerQueuePrefersScore(t *testing.T) {
+	q := make(peerPriorityQueue, 0)
+	heap.Init(&q)
+	now := time.Now()
+	heap.Push(&q, &peerInfoItem{peerInfo: &peerInfo{discoveredTime: now, score: 0.3}})
+	heap.Push(&q, &peerInfoItem{peerInfo: &peerInfo{discoveredTime: now.Add(-time.Minute), score: 0.9}})
+	heap.Push(&q, &peerInfoItem{peerInfo: &peerInfo{discoveredTime: now.Add(time.Minute), score: 0.3}})
+
+	require.Equal(t, 0.9, heap.Pop(&q).(*peerInfoItem).score)
+	require.Equal(t, now.Add(time.Minute), heap.Pop(&q).(*peerInfoItem).discoveredTime)
+	require.Equal(t, now, heap.Pop(&q).(*peerInfoItem).discoveredTime)
+}
+
+type mockClock struct {
+	now time.Time
+}
+
+func (c *mockClock) Now() time.Time { return c.now }
+
+func TestReputationMonitorRecordsBatches(t *testing.T) {
+	reputation, _ := newInMemoryReputation(t)
+	clock := &mockClock{now: time.Now()}
+	mon := NewReputationMonitor(reputation, nil)
+	mon.clock = clock
+
+	acked, lost := types.EnodeID{1}, types.EnodeID{2}
+	for i := 0; i < 3; i++ {
+		mon.handleEvent(types.EnvelopeEvent{Event: types.EventEnvelopeSent, Peer: acked, Batch: types.Hash{1}})
+		mon.handleEvent(types.EnvelopeEvent{Event: types.EventEnvelopeSent, Peer: lost, Batch: types.Hash{2}})
+	}
+	// envelopes without batch don't expect confirmations
+	mon.handleEvent(types.EnvelopeEvent{Event: types.EventEnvelopeSent, Peer: acked})
+
+	mon.handleEvent(types.EnvelopeEvent{
+		Event: types.EventBatchAcknowledged,
+		Peer:  acked,
+		Batch: types.Hash{1},
+		Data:  []types.EnvelopeError{{Hash: types.Hash{10}}},
+	})
+	stats := reputation.Stats(enode.ID(acked))
+	require.Equal(t, uint64(3), stats.EnvelopesSent)
+	require.Equal(t, uint64(2), stats.EnvelopesConfirmed)
+
+	mon.expireBatches()
+	require.Equal(t, uint64(0), reputation.Stats(enode.ID(lost)).EnvelopesSent)
+	clock.now = clock.now.Add(batchConfirmationTimeout)
+	mon.expireBatches()
+	stats = reputation.Stats(enode.ID(lost))
+	require.Equal(t, uint64(3), stats.EnvelopesSent)
+	require.Equal(t, uint64(0), stats.EnvelopesConfirmed)
+}
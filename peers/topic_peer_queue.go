@@ -15,7 +15,11 @@ var _ heap.Interface = (*peerPriorityQueue)(nil)
 
 func (q peerPriorityQueue) Len() int { return len(q) }
 
+// Less prefers peers with a higher score and then the most recently discovered.
 func (q peerPriorityQueue) Less(i, j int) bool {
+	if q[i].score != q[j].score {
+		return q[i].score > q[j].score
+	}
 	return q[i].discoveredTime.After(q[j].discoveredTime)
 }
 
@@ -18,6 +18,8 @@ import (
 const (
 	// notQueuedIndex used to define that item is not queued in the heap queue.
 	notQueuedIndex = -1
+	// rttCheckTimeout is a timeout for measuring round trip time of a connected peer.
+	rttCheckTimeout = 5 * time.Second
 )
 
 // maxCachedPeersMultiplier peers max limit will be multiplied by this number
@@ -108,6 +110,7 @@ type TopicPool struct {
 	maxPendingPeers int
 	maxCachedPeers  int
 	cache           *Cache
+	reputation      *Reputation
 
 	clock Clock
 }
@@ -145,6 +148,7 @@ func (t *TopicPool) addToQueue(peer *peerInfo) {
 			return
 		}
 
+		p.score = t.reputation.Score(peer.NodeID())
 		heap.Push(&t.discoveredPeersQueue, p)
 		t.discoveredPeers[peer.NodeID()] = true
 	}
@@ -302,9 +306,9 @@ func (t *TopicPool) limitFastMode(timeout time.Duration) chan struct{} {
 // ConfirmAdded called when peer was added by p2p Server.
 // 1. Skip a peer if it not in our peer table
 // 2. Add a peer to a cache.
-// 3. Disconnect a peer if it was connected after we reached max limit of peers.
-//    (we can't know in advance if peer will be connected, thats why we allow
-//     to overflow for short duration)
+// 3. Disconnect a peer with the lowest score if it was connected after we reached
+//    max limit of peers. (we can't know in advance if peer will be connected,
+//    thats why we allow to overflow for short duration)
 // 4. Switch search to slow mode if it is running.
 func (t *TopicPool) ConfirmAdded(server *p2p.Server, nodeID enode.ID) {
 	t.mu.Lock()
@@ -328,16 +332,20 @@ func (t *TopicPool) ConfirmAdded(server *p2p.Server, nodeID enode.ID) {
 	}
 
 	t.movePeerFromPoolToConnected(nodeID)
-	// if the upper limit is already reached, drop this peer
-	if len(t.connectedPeers) > t.limits.Max {
-		log.Debug("max limit is reached drop the peer", "ID", nodeID, "topic", t.topic)
-		peer.dismissed = true
-		t.removeServerPeer(server, peer)
-		return
-	}
-
 	// make sure `dismissed` is reset
 	peer.dismissed = false
+	t.measureRTT(peer)
+
+	// if the upper limit is already reached, drop the worst peer
+	if len(t.connectedPeers) > t.limits.Max {
+		worst := t.worstConnectedPeer(nodeID)
+		log.Debug("max limit is reached drop the peer", "ID", worst.NodeID(), "topic", t.topic)
+		worst.dismissed = true
+		t.removeServerPeer(server, worst)
+		if worst == peer {
+			return
+		}
+	}
 
 	// A peer was added so check if we can switch to slow mode.
 	if t.SearchRunning() {
@@ -345,6 +353,32 @@ func (t *TopicPool) ConfirmAdded(server *p2p.Server, nodeID enode.ID) {
 	}
 }
 
+// worstConnectedPeer returns a connected peer with the lowest score.
+// The most recently added peer is preferred among peers with equal scores.
+func (t *TopicPool) worstConnectedPeer(added enode.ID) *peerInfo {
+	worst := t.connectedPeers[added]
+	worstScore := t.reputation.Score(added)
+	for nodeID, peer := range t.connectedPeers {
+		if score := t.reputation.Score(nodeID); score < worstScore {
+			worst, worstScore = peer, score
+		}
+	}
+	return worst
+}
+
+// measureRTT records round trip time of a connected peer in background.
+func (t *TopicPool) measureRTT(peer *peerInfo) {
+	if t.reputation == nil {
+		return
+	}
+	n := enode.NewV4(peer.publicKey, peer.node.IP, int(peer.node.TCP), int(peer.node.UDP))
+	go func() {
+		if err := t.reputation.MeasureRTT([]*enode.Node{n}, rttCheckTimeout); err != nil {
+			log.Debug("failed to measure peer rtt", "ID", n.ID(), "error", err)
+		}
+	}()
+}
+
 // ConfirmDropped called when server receives drop event.
 // 1. Skip peer if it is not in our peer table.
 // 2. If disconnect request - we could drop that peer ourselves.
@@ -497,6 +531,11 @@ func (t *TopicPool) processFoundNode(server *p2p.Server, node *discv5.Node) erro
 		return nil
 	}
 
+	if t.reputation.Banned(nodeID) {
+		log.Debug("skipping peer with low score", "ID", nodeID, "topic", t.topic)
+		return nil
+	}
+
 	if _, ok := t.pendingPeers[nodeID]; ok {
 		t.updatePendingPeer(nodeID)
 	} else {
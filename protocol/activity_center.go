@@ -20,6 +20,8 @@ const (
 	ActivityCenterNotificationTypeNewPrivateGroupChat
 	ActivityCenterNotificationTypeMention
 	ActivityCenterNotificationTypeReply
+	ActivityCenterNotificationTypeFilteredMessage
+	ActivityCenterNotificationTypeContactTrustReset
 )
 
 var ErrInvalidActivityCenterNotification = errors.New("invalid activity center notification")
@@ -0,0 +1,51 @@
+package anonmetrics
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/status-im/status-go/appmetrics"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// createdAtLayouts are the formats app_metrics.created_at is read in
+var createdAtLayouts = []string{time.RFC3339Nano, "2006-01-02 15:04:05"}
+
+func parseCreatedAt(createdAt string) (time.Time, error) {
+	var err error
+	for _, layout := range createdAtLayouts {
+		var t time.Time
+		t, err = time.Parse(layout, createdAt)
+		if err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, err
+}
+
+// AppMetricToProtobuf strips the fields which could link the metric to a
+// session: the session id is dropped and the time is rounded down to the hour
+func AppMetricToProtobuf(metric appmetrics.AppMetric) (*protobuf.AnonymousMetric, error) {
+	createdAt, err := parseCreatedAt(metric.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protobuf.AnonymousMetric{
+		Event:      string(metric.Event),
+		Value:      metric.Value,
+		AppVersion: metric.AppVersion,
+		Os:         metric.OS,
+		CreatedAt:  uint64(createdAt.Truncate(time.Hour).Unix()),
+	}, nil
+}
+
+func ProtobufToAppMetric(metric *protobuf.AnonymousMetric) appmetrics.AppMetric {
+	return appmetrics.AppMetric{
+		Event:      appmetrics.AppMetricEventType(metric.Event),
+		Value:      json.RawMessage(metric.Value),
+		AppVersion: metric.AppVersion,
+		OS:         metric.Os,
+		CreatedAt:  time.Unix(int64(metric.CreatedAt), 0).UTC().Format(time.RFC3339),
+	}
+}
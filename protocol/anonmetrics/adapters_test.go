@@ -0,0 +1,66 @@
+package anonmetrics
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/appdatabase"
+	"github.com/status-im/status-go/appmetrics"
+)
+
+func TestAppMetricToProtobuf(t *testing.T) {
+	metric := appmetrics.AppMetric{
+		Event:      appmetrics.NavigateTo,
+		Value:      json.RawMessage(`{"view_id": "some-view-id", "params": {"screen": "login"}}`),
+		AppVersion: "1.11",
+		OS:         "android",
+		SessionID:  "rand-omse-ssid",
+		CreatedAt:  "2021-08-06T15:43:12Z",
+	}
+
+	anonymousMetric, err := AppMetricToProtobuf(metric)
+	require.NoError(t, err)
+	require.Equal(t, uint64(time.Date(2021, 8, 6, 15, 0, 0, 0, time.UTC).Unix()), anonymousMetric.CreatedAt)
+
+	received := ProtobufToAppMetric(anonymousMetric)
+	require.Equal(t, metric.Event, received.Event)
+	require.Equal(t, metric.Value, received.Value)
+	require.Equal(t, metric.OS, received.OS)
+	require.Equal(t, metric.AppVersion, received.AppVersion)
+	require.Empty(t, received.SessionID)
+	require.Equal(t, "2021-08-06T15:00:00Z", received.CreatedAt)
+	require.NoError(t, appmetrics.ValidateAppMetrics([]appmetrics.AppMetric{received}))
+
+	_, err = AppMetricToProtobuf(appmetrics.AppMetric{CreatedAt: "yesterday"})
+	require.Error(t, err)
+}
+
+func TestStoredAppMetricToProtobuf(t *testing.T) {
+	tmpfile, err := ioutil.TempFile("", "anonmetrics-tests-")
+	require.NoError(t, err)
+	defer os.Remove(tmpfile.Name())
+
+	sqlDB, err := appdatabase.InitializeDB(tmpfile.Name(), "anonmetrics-tests")
+	require.NoError(t, err)
+	defer sqlDB.Close()
+
+	db := appmetrics.NewDB(sqlDB)
+	require.NoError(t, db.SaveAppMetrics([]appmetrics.AppMetric{
+		{Event: appmetrics.NavigateTo, Value: json.RawMessage(`{"view_id": "some-view-id", "params": {"screen": "login"}}`), OS: "android", AppVersion: "1.11"},
+	}, "rand-omse-ssid"))
+
+	unsent, err := db.GetUnsentAppMetrics(10)
+	require.NoError(t, err)
+	require.Len(t, unsent, 1)
+
+	// The time format of the database is understood
+	anonymousMetric, err := AppMetricToProtobuf(unsent[0])
+	require.NoError(t, err)
+	require.NotZero(t, anonymousMetric.CreatedAt)
+	require.Zero(t, anonymousMetric.CreatedAt%3600)
+}
@@ -0,0 +1,148 @@
+package anonmetrics
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/appmetrics"
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/multiaccounts/accounts"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// How often the metrics recorded since the last time are sent
+const sendInterval = 10 * time.Minute
+
+// batchSize is the number of metrics sent in a single message
+const batchSize = 50
+
+type ClientConfig struct {
+	// SendAddress is the key of the collector metrics are sent to
+	SendAddress *ecdsa.PublicKey
+}
+
+// Client sends the app metrics to the collector when the user has opted in,
+// each batch is sent with a new ephemeral key
+type Client struct {
+	config        *ClientConfig
+	database      *appmetrics.Database
+	settings      *accounts.Database
+	messageSender *common.MessageSender
+	logger        *zap.Logger
+	quit          chan struct{}
+}
+
+func NewClient(config *ClientConfig, logger *zap.Logger, database *appmetrics.Database, settings *accounts.Database, messageSender *common.MessageSender) *Client {
+	return &Client{
+		config:        config,
+		logger:        logger.With(zap.Namespace("AnonMetricsClient")),
+		database:      database,
+		settings:      settings,
+		messageSender: messageSender,
+		quit:          make(chan struct{}),
+	}
+}
+
+func (c *Client) Start() error {
+	if c.messageSender == nil {
+		return errors.New("can't start, missing message sender")
+	}
+
+	go func() {
+		ticker := time.NewTicker(sendInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if err := c.Send(); err != nil {
+					c.logger.Warn("failed to send anonymous metrics", zap.Error(err))
+				}
+			case <-c.quit:
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (c *Client) Stop() error {
+	close(c.quit)
+	return nil
+}
+
+// Send sends the metrics which haven't been sent yet, if the user allows it
+func (c *Client) Send() error {
+	shouldSend, err := c.settings.ShouldSendAnonMetrics()
+	if err != nil {
+		return err
+	}
+	if !shouldSend {
+		return nil
+	}
+
+	for {
+		metrics, err := c.database.GetUnsentAppMetrics(batchSize)
+		if err != nil {
+			return err
+		}
+		if len(metrics) == 0 {
+			return nil
+		}
+
+		batch := &protobuf.AnonymousMetricBatch{}
+		var ids []int64
+		for _, metric := range metrics {
+			ids = append(ids, metric.ID)
+
+			anonymousMetric, err := AppMetricToProtobuf(metric)
+			if err != nil {
+				c.logger.Warn("skipping invalid app metric", zap.Int64("id", metric.ID), zap.Error(err))
+				continue
+			}
+			batch.Metrics = append(batch.Metrics, anonymousMetric)
+		}
+
+		if len(batch.Metrics) != 0 {
+			err = c.sendBatch(batch)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = c.database.SetAppMetricsSent(ids)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (c *Client) sendBatch(batch *protobuf.AnonymousMetricBatch) error {
+	encodedMessage, err := proto.Marshal(batch)
+	if err != nil {
+		return err
+	}
+
+	ephemeralKey, err := crypto.GenerateKey()
+	if err != nil {
+		return err
+	}
+
+	rawMessage := common.RawMessage{
+		Payload: encodedMessage,
+		Sender:  ephemeralKey,
+		// we skip encryption as sent from an ephemeral key
+		SkipEncryption: true,
+		MessageType:    protobuf.ApplicationMetadataMessage_ANONYMOUS_METRIC_BATCH,
+	}
+
+	_, err = c.messageSender.SendPrivate(context.Background(), c.config.SendAddress, &rawMessage)
+	return err
+}
@@ -0,0 +1 @@
+DROP TABLE anonymous_metrics;
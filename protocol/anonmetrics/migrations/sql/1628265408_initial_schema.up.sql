@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS anonymous_metrics (
+  message_id VARCHAR NOT NULL,
+  position INTEGER NOT NULL,
+  event VARCHAR NOT NULL,
+  value JSONB NOT NULL,
+  app_version VARCHAR NOT NULL,
+  os VARCHAR NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  received_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (message_id, position)
+);
+
+CREATE INDEX anonymous_metrics_event ON anonymous_metrics(event, created_at);
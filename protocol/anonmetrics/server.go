@@ -0,0 +1,144 @@
+package anonmetrics
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	// Import postgres driver
+	_ "github.com/lib/pq"
+	"go.uber.org/zap"
+
+	"github.com/status-im/migrate/v4"
+	"github.com/status-im/migrate/v4/database/postgres"
+	bindata "github.com/status-im/migrate/v4/source/go_bindata"
+
+	"github.com/status-im/status-go/appmetrics"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/anonmetrics/migrations"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// maxBatchSize is the number of metrics accepted in a single message
+const maxBatchSize = 2 * batchSize
+
+var ErrBatchTooLarge = errors.New("anonymous metric batch too large")
+
+type ServerConfig struct {
+	Enabled bool
+	// PostgresURI is the database metrics are stored in
+	PostgresURI string
+}
+
+// Server collects the metrics sent by the clients, and stores the valid ones
+type Server struct {
+	config *ServerConfig
+	logger *zap.Logger
+	db     *sql.DB
+}
+
+func NewServer(config *ServerConfig, logger *zap.Logger) *Server {
+	return &Server{
+		config: config,
+		logger: logger.With(zap.Namespace("AnonMetricsServer")),
+	}
+}
+
+func (s *Server) Start() error {
+	db, err := sql.Open("postgres", s.config.PostgresURI)
+	if err != nil {
+		return err
+	}
+	s.db = db
+
+	if err := s.setup(); err != nil {
+		return err
+	}
+
+	s.logger.Info("started anonymous metrics server")
+	return nil
+}
+
+func (s *Server) Stop() error {
+	if s.db == nil {
+		return nil
+	}
+	return s.db.Close()
+}
+
+func (s *Server) setup() error {
+	resources := bindata.Resource(
+		migrations.AssetNames(),
+		func(name string) ([]byte, error) {
+			return migrations.Asset(name)
+		},
+	)
+
+	source, err := bindata.WithInstance(resources)
+	if err != nil {
+		return err
+	}
+
+	driver, err := postgres.WithInstance(s.db, &postgres.Config{})
+	if err != nil {
+		return err
+	}
+
+	m, err := migrate.NewWithInstance(
+		"go-bindata",
+		source,
+		"postgres",
+		driver)
+	if err != nil {
+		return err
+	}
+
+	if err = m.Up(); err != migrate.ErrNoChange {
+		return err
+	}
+
+	return nil
+}
+
+// StoreMetrics validates the metrics received in the message with the given
+// id against the schema of their event, and stores the valid ones.
+// Receiving the same message again has no effect.
+func (s *Server) StoreMetrics(messageID types.HexBytes, batch protobuf.AnonymousMetricBatch) (err error) {
+	if len(batch.Metrics) > maxBatchSize {
+		return ErrBatchTooLarge
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		_ = tx.Rollback()
+	}()
+
+	insert, err := tx.Prepare(`INSERT INTO anonymous_metrics (message_id, position, event, value, app_version, os, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT DO NOTHING`)
+	if err != nil {
+		return err
+	}
+	defer insert.Close()
+
+	for i, anonymousMetric := range batch.Metrics {
+		metric := ProtobufToAppMetric(anonymousMetric)
+		if err := appmetrics.ValidateAppMetrics([]appmetrics.AppMetric{metric}); err != nil {
+			s.logger.Debug("dropping invalid anonymous metric", zap.String("event", anonymousMetric.Event), zap.Error(err))
+			continue
+		}
+
+		createdAt := time.Unix(int64(anonymousMetric.CreatedAt), 0).UTC()
+		_, err = insert.Exec(messageID.String(), i, metric.Event, string(metric.Value), metric.AppVersion, metric.OS, createdAt)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
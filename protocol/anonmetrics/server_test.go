@@ -0,0 +1,94 @@
+// In order to run the tests storing metrics, you must run a PostgreSQL database.
+//
+// Using Docker:
+//   docker run --name anon-metrics-db -e POSTGRES_HOST_AUTH_METHOD=trust -d -p 5432:5432 postgres:9.6-alpine
+//
+
+package anonmetrics
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/appmetrics"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+const testPostgresURI = "postgres://postgres@127.0.0.1:5432/postgres?sslmode=disable"
+
+// newTestServer starts a server on an empty database,
+// the test is skipped if no database is running
+func newTestServer(t *testing.T) (*Server, *sql.DB) {
+	db, err := sql.Open("postgres", testPostgresURI)
+	require.NoError(t, err)
+	if err := db.Ping(); err != nil {
+		t.Skipf("no postgres database running: %v", err)
+	}
+	_, err = db.Exec("DROP TABLE IF EXISTS anonymous_metrics, schema_migrations")
+	require.NoError(t, err)
+
+	server := NewServer(&ServerConfig{Enabled: true, PostgresURI: testPostgresURI}, zap.NewNop())
+	require.NoError(t, server.Start())
+	t.Cleanup(func() {
+		require.NoError(t, server.Stop())
+		require.NoError(t, db.Close())
+	})
+	return server, db
+}
+
+func testAnonymousMetric(t *testing.T, event appmetrics.AppMetricEventType, value string) *protobuf.AnonymousMetric {
+	anonymousMetric, err := AppMetricToProtobuf(appmetrics.AppMetric{
+		Event:      event,
+		Value:      json.RawMessage(value),
+		AppVersion: "1.11",
+		OS:         "android",
+		CreatedAt:  time.Now().UTC().Format(time.RFC3339),
+	})
+	require.NoError(t, err)
+	return anonymousMetric
+}
+
+func TestStoreMetrics(t *testing.T) {
+	server, db := newTestServer(t)
+
+	batch := protobuf.AnonymousMetricBatch{
+		Metrics: []*protobuf.AnonymousMetric{
+			testAnonymousMetric(t, appmetrics.NavigateTo, `{"view_id": "some-view-id", "params": {"screen": "login"}}`),
+			// Metrics not matching the schema of their event are dropped
+			testAnonymousMetric(t, appmetrics.NavigateTo, `{"view_id": 1}`),
+		},
+	}
+	messageID := types.HexBytes{0x01}
+	require.NoError(t, server.StoreMetrics(messageID, batch))
+
+	var count int
+	require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM anonymous_metrics").Scan(&count))
+	require.Equal(t, 1, count)
+
+	// Receiving the same message again has no effect
+	require.NoError(t, server.StoreMetrics(messageID, batch))
+	require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM anonymous_metrics").Scan(&count))
+	require.Equal(t, 1, count)
+
+	var event, value, os string
+	require.NoError(t, db.QueryRow("SELECT event, value, os FROM anonymous_metrics WHERE message_id = $1", messageID.String()).Scan(&event, &value, &os))
+	require.Equal(t, string(appmetrics.NavigateTo), event)
+	require.JSONEq(t, `{"view_id": "some-view-id", "params": {"screen": "login"}}`, value)
+	require.Equal(t, "android", os)
+}
+
+func TestStoreMetricsBatchTooLarge(t *testing.T) {
+	server := NewServer(&ServerConfig{Enabled: true}, zap.NewNop())
+
+	batch := protobuf.AnonymousMetricBatch{}
+	for i := 0; i <= maxBatchSize; i++ {
+		batch.Metrics = append(batch.Metrics, &protobuf.AnonymousMetric{})
+	}
+	require.Equal(t, ErrBatchTooLarge, server.StoreMetrics(types.HexBytes{0x01}, batch))
+}
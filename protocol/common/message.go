@@ -17,6 +17,7 @@ import (
 	"github.com/status-im/markdown/ast"
 
 	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
 	"github.com/status-im/status-go/images"
 	"github.com/status-im/status-go/protocol/protobuf"
 )
@@ -131,6 +132,8 @@ type Message struct {
 	Base64Audio string `json:"audio,omitempty"`
 	// AudioPath is the path of the audio to be sent
 	AudioPath string `json:"audioPath,omitempty"`
+	// FilePath is the path of the file to be sent
+	FilePath string `json:"filePath,omitempty"`
 
 	// CommunityID is the id of the community to advertise
 	CommunityID string `json:"communityId,omitempty"`
@@ -158,6 +161,49 @@ type Message struct {
 	Deleted bool `json:"deleted"`
 }
 
+// pollJSON is the representation of a poll exchanged with the client
+type pollJSON struct {
+	Question       string   `json:"question"`
+	Options        []string `json:"options"`
+	MultipleChoice bool     `json:"multipleChoice"`
+	EndTime        uint64   `json:"endTime,omitempty"`
+}
+
+// fileJSON is the representation of a file attachment exchanged with the client
+type fileJSON struct {
+	Name     string `json:"name"`
+	MimeType string `json:"mimeType"`
+	Size     uint64 `json:"size"`
+	Hash     string `json:"hash"`
+}
+
+// safeTransactionJSON is the representation of a Safe transaction proposal exchanged with the client
+type safeTransactionJSON struct {
+	ChainID        uint64 `json:"chainId"`
+	Safe           string `json:"safe"`
+	To             string `json:"to"`
+	Value          string `json:"value"`
+	Data           string `json:"data"`
+	Operation      uint32 `json:"operation"`
+	SafeTxGas      string `json:"safeTxGas"`
+	BaseGas        string `json:"baseGas"`
+	GasPrice       string `json:"gasPrice"`
+	GasToken       string `json:"gasToken"`
+	RefundReceiver string `json:"refundReceiver"`
+	Nonce          string `json:"nonce"`
+}
+
+// linkPreviewJSON is the representation of a link preview exchanged with the client
+type linkPreviewJSON struct {
+	URL             string `json:"url"`
+	Site            string `json:"site"`
+	Title           string `json:"title"`
+	Description     string `json:"description,omitempty"`
+	Thumbnail       string `json:"thumbnail,omitempty"`
+	ThumbnailWidth  uint32 `json:"thumbnailWidth,omitempty"`
+	ThumbnailHeight uint32 `json:"thumbnailHeight,omitempty"`
+}
+
 func (m *Message) MarshalJSON() ([]byte, error) {
 	type StickerAlias struct {
 		Hash string `json:"hash"`
@@ -188,6 +234,9 @@ func (m *Message) MarshalJSON() ([]byte, error) {
 		AudioDurationMs   uint64                           `json:"audioDurationMs,omitempty"`
 		CommunityID       string                           `json:"communityId,omitempty"`
 		Sticker           *StickerAlias                    `json:"sticker,omitempty"`
+		Poll              *pollJSON                        `json:"poll,omitempty"`
+		File              *fileJSON                        `json:"file,omitempty"`
+		SafeTransaction   *safeTransactionJSON             `json:"safeTransaction,omitempty"`
 		CommandParameters *CommandParameters               `json:"commandParameters,omitempty"`
 		GapParameters     *GapParameters                   `json:"gapParameters,omitempty"`
 		Timestamp         uint64                           `json:"timestamp"`
@@ -196,6 +245,7 @@ func (m *Message) MarshalJSON() ([]byte, error) {
 		Mentions          []string                         `json:"mentions,omitempty"`
 		Mentioned         bool                             `json:"mentioned,omitempty"`
 		Links             []string                         `json:"links,omitempty"`
+		LinkPreviews      []*linkPreviewJSON               `json:"linkPreviews,omitempty"`
 		EditedAt          uint64                           `json:"editedAt,omitempty"`
 		Deleted           bool                             `json:"deleted,omitempty"`
 	}{
@@ -243,6 +293,60 @@ func (m *Message) MarshalJSON() ([]byte, error) {
 		item.AudioDurationMs = audio.DurationMs
 	}
 
+	for _, preview := range m.LinkPreviews {
+		previewJSON := &linkPreviewJSON{
+			URL:         preview.Url,
+			Site:        preview.Site,
+			Title:       preview.Title,
+			Description: preview.Description,
+		}
+		if len(preview.ThumbnailPayload) != 0 {
+			thumbnail, err := images.GetPayloadDataURI(preview.ThumbnailPayload)
+			if err != nil {
+				return nil, err
+			}
+			previewJSON.Thumbnail = thumbnail
+			previewJSON.ThumbnailWidth = preview.ThumbnailWidth
+			previewJSON.ThumbnailHeight = preview.ThumbnailHeight
+		}
+		item.LinkPreviews = append(item.LinkPreviews, previewJSON)
+	}
+
+	if file := m.GetFile(); file != nil {
+		item.File = &fileJSON{
+			Name:     file.Name,
+			MimeType: file.MimeType,
+			Size:     file.Size,
+			Hash:     hex.EncodeToString(file.Hash),
+		}
+	}
+
+	if poll := m.GetPoll(); poll != nil {
+		item.Poll = &pollJSON{
+			Question:       poll.Question,
+			Options:        poll.Options,
+			MultipleChoice: poll.MultipleChoice,
+			EndTime:        poll.EndTime,
+		}
+	}
+
+	if proposal := m.GetSafeTransactionProposal(); proposal != nil {
+		item.SafeTransaction = &safeTransactionJSON{
+			ChainID:        proposal.ChainId,
+			Safe:           proposal.Safe,
+			To:             proposal.To,
+			Value:          proposal.Value,
+			Data:           types.EncodeHex(proposal.Data),
+			Operation:      proposal.Operation,
+			SafeTxGas:      proposal.SafeTxGas,
+			BaseGas:        proposal.BaseGas,
+			GasPrice:       proposal.GasPrice,
+			GasToken:       proposal.GasToken,
+			RefundReceiver: proposal.RefundReceiver,
+			Nonce:          proposal.Nonce,
+		}
+	}
+
 	return json.Marshal(item)
 }
 
@@ -254,6 +358,7 @@ func (m *Message) UnmarshalJSON(data []byte) error {
 		EnsName         string                           `json:"ensName"`
 		ChatID          string                           `json:"chatId"`
 		Sticker         *protobuf.StickerMessage         `json:"sticker"`
+		Poll            *pollJSON                        `json:"poll"`
 		AudioDurationMs uint64                           `json:"audioDurationMs"`
 		ParsedText      json.RawMessage                  `json:"parsedText"`
 		ContentType     protobuf.ChatMessage_ContentType `json:"contentType"`
@@ -271,6 +376,16 @@ func (m *Message) UnmarshalJSON(data []byte) error {
 			Audio: &protobuf.AudioMessage{DurationMs: aux.AudioDurationMs},
 		}
 	}
+	if aux.ContentType == protobuf.ChatMessage_POLL && aux.Poll != nil {
+		m.Payload = &protobuf.ChatMessage_Poll{
+			Poll: &protobuf.PollMessage{
+				Question:       aux.Poll.Question,
+				Options:        aux.Poll.Options,
+				MultipleChoice: aux.Poll.MultipleChoice,
+				EndTime:        aux.Poll.EndTime,
+			},
+		}
+	}
 	m.ResponseTo = aux.ResponseTo
 	m.EnsName = aux.EnsName
 	m.ChatId = aux.ChatID
@@ -465,6 +580,15 @@ func (m *Message) GetSimplifiedText(identity string, canonicalNames map[string]s
 	if m.ContentType == protobuf.ChatMessage_COMMUNITY {
 		return "Community", nil
 	}
+	if m.ContentType == protobuf.ChatMessage_POLL {
+		return "Poll", nil
+	}
+	if m.ContentType == protobuf.ChatMessage_FILE {
+		return "File", nil
+	}
+	if m.ContentType == protobuf.ChatMessage_SAFE_TRANSACTION_PROPOSAL {
+		return "Safe transaction", nil
+	}
 
 	if m.ParsedTextAst == nil {
 		err := m.PrepareContent(identity)
@@ -24,3 +24,19 @@ type RawMessage struct {
 	SkipGroupMessageWrap bool
 	SendOnPersonalTopic  bool
 }
+
+// QueuedRawMessage is a reference to a RawMessage that hasn't reached
+// any peer yet and has to be sent again once we are online
+type QueuedRawMessage struct {
+	// ID is the id of the RawMessage that was queued first
+	ID string
+	// MessageID is the id of the last RawMessage sent
+	MessageID   string
+	LocalChatID string
+	// Clock is the clock value of the message, used to preserve the order of messages
+	Clock uint64
+	// Attempts is the number of times the message has been re-sent from the queue
+	Attempts int
+	// NextAttempt is the time in ms after which the message can be re-sent
+	NextAttempt uint64
+}
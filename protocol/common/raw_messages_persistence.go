@@ -179,6 +179,12 @@ func (db RawMessagesPersistence) RawMessagesIDsByType(t protobuf.ApplicationMeta
 	return ids, nil
 }
 
+// DeleteRawMessage removes the copy of a message kept to resend it
+func (db RawMessagesPersistence) DeleteRawMessage(id string) error {
+	_, err := db.db.Exec(`DELETE FROM raw_messages WHERE id = ?`, id)
+	return err
+}
+
 // MarkAsConfirmed marks all the messages with dataSyncID as confirmed and returns
 // the messageIDs that can be considered confirmed.
 // If atLeastOne is set it will return messageid if at least once of the messages
@@ -248,3 +254,102 @@ func (db RawMessagesPersistence) InsertPendingConfirmation(confirmation *RawMess
 	)
 	return err
 }
+
+func (db RawMessagesPersistence) SaveQueuedRawMessage(message *QueuedRawMessage) error {
+	_, err := db.db.Exec(`
+		INSERT INTO
+		raw_messages_queue
+		(
+		  id,
+		  message_id,
+		  local_chat_id,
+		  clock,
+		  attempts,
+		  next_attempt
+		)
+		VALUES (?, ?, ?, ?, ?, ?)`,
+		message.ID,
+		message.MessageID,
+		message.LocalChatID,
+		message.Clock,
+		message.Attempts,
+		message.NextAttempt)
+	return err
+}
+
+func scanQueuedRawMessage(row interface{ Scan(...interface{}) error }) (*QueuedRawMessage, error) {
+	message := &QueuedRawMessage{}
+	err := row.Scan(
+		&message.ID,
+		&message.MessageID,
+		&message.LocalChatID,
+		&message.Clock,
+		&message.Attempts,
+		&message.NextAttempt)
+	return message, err
+}
+
+// QueuedRawMessageByMessageID returns the queued message whose first or last attempt
+// was sent with the given id, or nil if there's none
+func (db RawMessagesPersistence) QueuedRawMessageByMessageID(messageID string) (*QueuedRawMessage, error) {
+	message, err := scanQueuedRawMessage(db.db.QueryRow(`
+		SELECT
+		  id,
+		  message_id,
+		  local_chat_id,
+		  clock,
+		  attempts,
+		  next_attempt
+		FROM
+		  raw_messages_queue
+		WHERE
+		  id = ? OR message_id = ?`,
+		messageID,
+		messageID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return message, nil
+}
+
+// QueuedRawMessages returns the queued messages that can be re-sent at the given time,
+// ordered by the clock of their first attempt
+func (db RawMessagesPersistence) QueuedRawMessages(now uint64) ([]*QueuedRawMessage, error) {
+	rows, err := db.db.Query(`
+		SELECT
+		  id,
+		  message_id,
+		  local_chat_id,
+		  clock,
+		  attempts,
+		  next_attempt
+		FROM
+		  raw_messages_queue
+		WHERE
+		  next_attempt <= ?
+		ORDER BY clock ASC`,
+		now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var messages []*QueuedRawMessage
+	for rows.Next() {
+		message, err := scanQueuedRawMessage(rows)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, message)
+	}
+	return messages, nil
+}
+
+// DeleteQueuedRawMessage removes a message from the queue
+func (db RawMessagesPersistence) DeleteQueuedRawMessage(id string) error {
+	_, err := db.db.Exec(`DELETE FROM raw_messages_queue WHERE id = ?`, id)
+	return err
+}
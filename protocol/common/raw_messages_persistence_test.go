@@ -0,0 +1,49 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/protocol/sqlite"
+)
+
+func TestQueuedRawMessages(t *testing.T) {
+	db, err := sqlite.OpenInMemory()
+	require.NoError(t, err)
+	p := NewRawMessagesPersistence(db)
+
+	require.NoError(t, p.SaveQueuedRawMessage(&QueuedRawMessage{ID: "0x2", MessageID: "0x2", LocalChatID: "chat", Clock: 2}))
+	require.NoError(t, p.SaveQueuedRawMessage(&QueuedRawMessage{ID: "0x1", MessageID: "0x1", LocalChatID: "chat", Clock: 1}))
+	require.NoError(t, p.SaveQueuedRawMessage(&QueuedRawMessage{ID: "0x3", MessageID: "0x3", LocalChatID: "chat", Clock: 3, NextAttempt: 100}))
+
+	queue, err := p.QueuedRawMessages(10)
+	require.NoError(t, err)
+	require.Len(t, queue, 2)
+	require.Equal(t, "0x1", queue[0].ID)
+	require.Equal(t, "0x2", queue[1].ID)
+
+	queue, err = p.QueuedRawMessages(100)
+	require.NoError(t, err)
+	require.Len(t, queue, 3)
+	require.Equal(t, "0x3", queue[2].ID)
+
+	// a re-sent message keeps its place in the queue
+	require.NoError(t, p.SaveQueuedRawMessage(&QueuedRawMessage{ID: "0x1", MessageID: "0x4", LocalChatID: "chat", Clock: 1, Attempts: 1, NextAttempt: 50}))
+	queued, err := p.QueuedRawMessageByMessageID("0x4")
+	require.NoError(t, err)
+	require.Equal(t, &QueuedRawMessage{ID: "0x1", MessageID: "0x4", LocalChatID: "chat", Clock: 1, Attempts: 1, NextAttempt: 50}, queued)
+
+	queued, err = p.QueuedRawMessageByMessageID("0x1")
+	require.NoError(t, err)
+	require.Equal(t, "0x4", queued.MessageID)
+
+	require.NoError(t, p.DeleteQueuedRawMessage("0x1"))
+	queued, err = p.QueuedRawMessageByMessageID("0x4")
+	require.NoError(t, err)
+	require.Nil(t, queued)
+
+	queue, err = p.QueuedRawMessages(100)
+	require.NoError(t, err)
+	require.Len(t, queue, 2)
+}
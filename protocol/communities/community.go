@@ -88,6 +88,7 @@ func (o *Community) MarshalJSON() ([]byte, error) {
 		Description       string                               `json:"description"`
 		Chats             map[string]CommunityChat             `json:"chats"`
 		Categories        map[string]CommunityCategory         `json:"categories"`
+		Emojis            map[string]CommunityEmoji            `json:"emojis"`
 		Images            map[string]images.IdentityImage      `json:"images"`
 		Permissions       *protobuf.CommunityPermissions       `json:"permissions"`
 		Members           map[string]*protobuf.CommunityMember `json:"members"`
@@ -98,12 +99,15 @@ func (o *Community) MarshalJSON() ([]byte, error) {
 		RequestedToJoinAt uint64                               `json:"requestedToJoinAt,omitempty"`
 		IsMember          bool                                 `json:"isMember"`
 		Muted             bool                                 `json:"muted"`
+		Owner             types.HexBytes                       `json:"owner"`
+		Control           *protobuf.CommunityControl           `json:"control,omitempty"`
 	}{
 		ID:                o.ID(),
 		Admin:             o.IsAdmin(),
 		Verified:          o.config.Verified,
 		Chats:             make(map[string]CommunityChat),
 		Categories:        make(map[string]CommunityCategory),
+		Emojis:            make(map[string]CommunityEmoji),
 		Joined:            o.config.Joined,
 		CanRequestAccess:  o.CanRequestAccess(o.config.MemberIdentity),
 		CanJoin:           o.canJoin(),
@@ -121,6 +125,16 @@ func (o *Community) MarshalJSON() ([]byte, error) {
 			}
 			communityItem.Categories[id] = category
 		}
+		for id, e := range o.config.CommunityDescription.Emojis {
+			emoji := CommunityEmoji{
+				ID:   id,
+				Name: e.Name,
+			}
+			if e.Image != nil {
+				emoji.Image = images.IdentityImage{Name: images.EmojiDimName, Payload: e.Image.Payload}
+			}
+			communityItem.Emojis[id] = emoji
+		}
 		for id, c := range o.config.CommunityDescription.Chats {
 			canPost, err := o.CanPost(o.config.MemberIdentity, id, nil)
 			if err != nil {
@@ -140,6 +154,8 @@ func (o *Community) MarshalJSON() ([]byte, error) {
 		}
 		communityItem.Members = o.config.CommunityDescription.Members
 		communityItem.Permissions = o.config.CommunityDescription.Permissions
+		communityItem.Owner = crypto.CompressPubkey(o.ownerPublicKey())
+		communityItem.Control = o.config.CommunityDescription.Control
 		if o.config.CommunityDescription.Identity != nil {
 			communityItem.Name = o.Name()
 			communityItem.Color = o.config.CommunityDescription.Identity.Color
@@ -338,6 +354,7 @@ func (o *Community) DeleteChat(chatID string) (*protobuf.CommunityDescription, e
 		tmpCatID := chat.CategoryId
 		chat.CategoryId = ""
 		o.SortCategoryChats(changes, tmpCatID)
+		o.config.CommunityDescription.RemovedChats = append(o.config.CommunityDescription.RemovedChats, chatID)
 	}
 
 	delete(o.config.CommunityDescription.Chats, chatID)
@@ -602,11 +619,12 @@ func (o *Community) UpdateCommunityDescription(signer *ecdsa.PublicKey, descript
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
 
-	if !common.IsPubKeyEqual(o.config.ID, signer) {
-		return nil, ErrNotAuthorized
+	err := o.verifyOwner(signer, description)
+	if err != nil {
+		return nil, err
 	}
 
-	err := ValidateCommunityDescription(description)
+	err = ValidateCommunityDescription(description)
 	if err != nil {
 		return nil, err
 	}
@@ -617,6 +635,11 @@ func (o *Community) UpdateCommunityDescription(signer *ecdsa.PublicKey, descript
 		return response, nil
 	}
 
+	err = o.verifyControl(description)
+	if err != nil {
+		return nil, err
+	}
+
 	// We only calculate changes if we joined the community or we requested access, otherwise not interested
 	if o.config.Joined || o.config.RequestedToJoinAt > 0 {
 		// Check for new members at the org level
@@ -959,7 +982,7 @@ func (o *Community) VerifyGrantSignature(data []byte) (*protobuf.Grant, error) {
 		return nil, err
 	}
 
-	if !common.IsPubKeyEqual(o.config.ID, extractedPublicKey) {
+	if !common.IsPubKeyEqual(o.ownerPublicKey(), extractedPublicKey) {
 		return nil, ErrInvalidGrant
 	}
 
@@ -978,8 +1001,8 @@ func (o *Community) CanPost(pk *ecdsa.PublicKey, chatID string, grantBytes []byt
 		return false, nil
 	}
 
-	// creator can always post
-	if common.IsPubKeyEqual(pk, o.config.ID) {
+	// creator and owner can always post
+	if common.IsPubKeyEqual(pk, o.config.ID) || common.IsPubKeyEqual(pk, o.ownerPublicKey()) {
 		return true, nil
 	}
 
@@ -0,0 +1,98 @@
+package communities
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"sort"
+
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// AddMessageArchives publishes the archives in the index of the community
+// description, so that members can request them
+func (o *Community) AddMessageArchives(archives []*MessageArchive) (*protobuf.CommunityDescription, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if o.config.PrivateKey == nil {
+		return nil, ErrNotAdmin
+	}
+
+	index := o.config.CommunityDescription.ArchiveIndex
+	for _, archive := range archives {
+		index = append(index, archive.IndexEntry())
+	}
+
+	// The oldest archives are unlisted once the index is full
+	sort.SliceStable(index, func(i, j int) bool {
+		return index[i].To < index[j].To
+	})
+	if len(index) > maxMessageArchiveIndexEntries {
+		index = index[len(index)-maxMessageArchiveIndexEntries:]
+	}
+	o.config.CommunityDescription.ArchiveIndex = index
+
+	o.increaseClock()
+
+	return o.config.CommunityDescription, nil
+}
+
+// MessageArchiveIndex returns the archives published by the community
+func (o *Community) MessageArchiveIndex() []*protobuf.CommunityMessageArchiveIndexEntry {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if o.config.CommunityDescription == nil {
+		return nil
+	}
+
+	response := make([]*protobuf.CommunityMessageArchiveIndexEntry, len(o.config.CommunityDescription.ArchiveIndex))
+	copy(response, o.config.CommunityDescription.ArchiveIndex)
+	return response
+}
+
+// MessageArchiveIndexEntry returns the index entry of the archive with the given hash,
+// or nil if the community has not published it
+func (o *Community) MessageArchiveIndexEntry(hash []byte) *protobuf.CommunityMessageArchiveIndexEntry {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if o.config.CommunityDescription == nil {
+		return nil
+	}
+
+	for _, entry := range o.config.CommunityDescription.ArchiveIndex {
+		if bytes.Equal(entry.Hash, hash) {
+			return entry
+		}
+	}
+	return nil
+}
+
+// CanReadMessageArchive returns whether pk is allowed to fetch the message
+// archives of a chat of the community, that is whether it can read the chat
+func (o *Community) CanReadMessageArchive(pk *ecdsa.PublicKey, chatID string) bool {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if o.config.CommunityDescription == nil || o.isBanned(pk) {
+		return false
+	}
+
+	chat, ok := o.config.CommunityDescription.Chats[chatID]
+	if !ok {
+		return false
+	}
+
+	if chat.Permissions.Access != protobuf.CommunityPermissions_NO_MEMBERSHIP {
+		_, ok := chat.Members[common.PubkeyToHex(pk)]
+		return ok
+	}
+
+	if o.config.CommunityDescription.Permissions.Access == protobuf.CommunityPermissions_NO_MEMBERSHIP {
+		return true
+	}
+
+	return o.hasMember(pk)
+}
@@ -0,0 +1,410 @@
+package communities
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/binary"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func ownershipTransferSignatureMaterial(communityID []byte, newOwner []byte, clock uint64) []byte {
+	clockBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(clockBytes, clock)
+
+	var material []byte
+	material = append(material, communityID...)
+	material = append(material, newOwner...)
+	material = append(material, clockBytes...)
+	return crypto.Keccak256(material)
+}
+
+// ownerFromTransfers walks the chain of ownership transfers, starting from the
+// community key, and returns the current owner
+func ownerFromTransfers(communityKey *ecdsa.PublicKey, transfers []*protobuf.CommunityOwnershipTransfer) (*ecdsa.PublicKey, error) {
+	communityID := crypto.CompressPubkey(communityKey)
+	owner := communityKey
+	var clock uint64
+
+	for _, transfer := range transfers {
+		if transfer.Clock <= clock {
+			return nil, ErrInvalidOwnershipTransfer
+		}
+
+		signer, err := crypto.SigToPub(ownershipTransferSignatureMaterial(communityID, transfer.NewOwner, transfer.Clock), transfer.Signature)
+		if err != nil {
+			return nil, ErrInvalidOwnershipTransfer
+		}
+		if !common.IsPubKeyEqual(signer, owner) {
+			return nil, ErrInvalidOwnershipTransfer
+		}
+
+		owner, err = crypto.DecompressPubkey(transfer.NewOwner)
+		if err != nil {
+			return nil, ErrInvalidOwnershipTransfer
+		}
+		clock = transfer.Clock
+	}
+
+	return owner, nil
+}
+
+func (o *Community) ownerPublicKey() *ecdsa.PublicKey {
+	owner, err := ownerFromTransfers(o.config.ID, o.config.CommunityDescription.OwnershipTransfers)
+	if err != nil {
+		// Transfers are verified when the description is received
+		return o.config.ID
+	}
+	return owner
+}
+
+// OwnerPublicKey returns the key currently controlling the community,
+// which is the community key unless ownership has been transferred
+func (o *Community) OwnerPublicKey() *ecdsa.PublicKey {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	return o.ownerPublicKey()
+}
+
+// IsOwner returns whether pk controls the community
+func (o *Community) IsOwner(pk *ecdsa.PublicKey) bool {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	return common.IsPubKeyEqual(o.ownerPublicKey(), pk)
+}
+
+// OwnershipTransferred returns whether the community is controlled by a key
+// other than the community key
+func (o *Community) OwnershipTransferred() bool {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	return len(o.config.CommunityDescription.OwnershipTransfers) != 0
+}
+
+// TransferOwnership hands control of the community to newOwner. Once the
+// description is published, only descriptions signed by newOwner are accepted
+func (o *Community) TransferOwnership(newOwner *ecdsa.PublicKey) (*protobuf.CommunityDescription, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if o.config.PrivateKey == nil {
+		return nil, ErrNotAdmin
+	}
+
+	if common.IsPubKeyEqual(o.ownerPublicKey(), newOwner) {
+		return nil, ErrAlreadyOwner
+	}
+
+	clock := o.nextClock()
+	newOwnerBytes := crypto.CompressPubkey(newOwner)
+	signature, err := crypto.Sign(ownershipTransferSignatureMaterial(o.ID(), newOwnerBytes, clock), o.config.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	o.config.CommunityDescription.Id = o.ID()
+	o.config.CommunityDescription.OwnershipTransfers = append(o.config.CommunityDescription.OwnershipTransfers, &protobuf.CommunityOwnershipTransfer{
+		NewOwner:  newOwnerBytes,
+		Clock:     clock,
+		Signature: signature,
+	})
+	o.config.CommunityDescription.Clock = clock
+
+	return o.config.CommunityDescription, nil
+}
+
+// SetControl requires threshold signatures out of signers for critical
+// changes to the community. A threshold of 0 disables control signing.
+func (o *Community) SetControl(signers []*ecdsa.PublicKey, threshold uint32) (*protobuf.CommunityDescription, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if o.config.PrivateKey == nil {
+		return nil, ErrNotAdmin
+	}
+
+	if int(threshold) > len(signers) {
+		return nil, ErrInvalidCommunityControl
+	}
+
+	control := &protobuf.CommunityControl{Threshold: threshold}
+	seen := make(map[string]bool)
+	for _, signer := range signers {
+		if !o.hasMember(signer) && !common.IsPubKeyEqual(signer, o.ownerPublicKey()) {
+			return nil, ErrInvalidCommunityControl
+		}
+		key := common.PubkeyToHex(signer)
+		if seen[key] {
+			return nil, ErrInvalidCommunityControl
+		}
+		seen[key] = true
+		control.Signers = append(control.Signers, crypto.CompressPubkey(signer))
+	}
+
+	o.config.CommunityDescription.Control = control
+	o.increaseClock()
+
+	return o.config.CommunityDescription, nil
+}
+
+func (o *Community) Control() *protobuf.CommunityControl {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	return o.config.CommunityDescription.Control
+}
+
+func controlEnabled(control *protobuf.CommunityControl) bool {
+	return control != nil && control.Threshold > 0
+}
+
+// ControlStateHash returns the hash control signers sign to approve the
+// critical settings of a community description
+func ControlStateHash(communityID []byte, description *protobuf.CommunityDescription) ([]byte, error) {
+	banList := append([]string{}, description.BanList...)
+	sort.Strings(banList)
+	removedChats := append([]string{}, description.RemovedChats...)
+	sort.Strings(removedChats)
+
+	state := &protobuf.CommunityControlState{
+		CommunityId:        communityID,
+		OwnershipTransfers: description.OwnershipTransfers,
+		Control:            description.Control,
+		Permissions:        description.Permissions,
+		BanList:            banList,
+		RemovedChats:       removedChats,
+	}
+
+	payload, err := proto.Marshal(state)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.Keccak256(payload), nil
+}
+
+func (o *Community) ControlStateHash() ([]byte, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	return ControlStateHash(o.ID(), o.config.CommunityDescription)
+}
+
+// countControlSignatures returns how many distinct signers of control signed hash
+func countControlSignatures(control *protobuf.CommunityControl, hash []byte, signatures [][]byte) int {
+	if control == nil {
+		return 0
+	}
+
+	signed := make(map[int]bool)
+	for _, signature := range signatures {
+		signer, err := crypto.SigToPub(hash, signature)
+		if err != nil {
+			continue
+		}
+		signerBytes := crypto.CompressPubkey(signer)
+		for i, s := range control.Signers {
+			if bytes.Equal(s, signerBytes) {
+				signed[i] = true
+			}
+		}
+	}
+	return len(signed)
+}
+
+// NeedsControlSignatures returns whether the critical settings of the
+// community still need to be approved by the control signers
+func (o *Community) NeedsControlSignatures() bool {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	control := o.config.CommunityDescription.Control
+	if !controlEnabled(control) {
+		return false
+	}
+
+	hash, err := ControlStateHash(o.ID(), o.config.CommunityDescription)
+	if err != nil {
+		return true
+	}
+	return countControlSignatures(control, hash, o.config.CommunityDescription.ControlSignatures) < int(control.Threshold)
+}
+
+// ControlSigners returns the keys which are asked to approve critical changes
+func (o *Community) ControlSigners() ([]*ecdsa.PublicKey, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if o.config.CommunityDescription.Control == nil {
+		return nil, nil
+	}
+
+	var signers []*ecdsa.PublicKey
+	for _, s := range o.config.CommunityDescription.Control.Signers {
+		signer, err := crypto.DecompressPubkey(s)
+		if err != nil {
+			return nil, err
+		}
+		signers = append(signers, signer)
+	}
+	return signers, nil
+}
+
+// AddControlSignature adds a signature of the current control state. Signatures
+// of a previous state are dropped, as they don't approve it.
+func (o *Community) AddControlSignature(hash []byte, signature []byte) (*protobuf.CommunityDescription, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if o.config.PrivateKey == nil {
+		return nil, ErrNotAdmin
+	}
+
+	currentHash, err := ControlStateHash(o.ID(), o.config.CommunityDescription)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(currentHash, hash) {
+		return nil, ErrInvalidControlSignature
+	}
+
+	signer, err := crypto.SigToPub(hash, signature)
+	if err != nil {
+		return nil, ErrInvalidControlSignature
+	}
+
+	// Signers of the previous control settings might be approving their
+	// change, so we accept any member and let receivers count the ones
+	// that matter to them
+	if !o.hasMember(signer) && !o.isControlSigner(signer) {
+		return nil, ErrInvalidControlSignature
+	}
+
+	var signatures [][]byte
+	for _, s := range o.config.CommunityDescription.ControlSignatures {
+		existingSigner, err := crypto.SigToPub(currentHash, s)
+		if err != nil {
+			continue
+		}
+		if common.IsPubKeyEqual(existingSigner, signer) {
+			return o.config.CommunityDescription, nil
+		}
+		signatures = append(signatures, s)
+	}
+
+	// The clock is not increased: members who rejected the description for
+	// lack of signatures accept it once they are added, while the others
+	// already share its control state
+	o.config.CommunityDescription.ControlSignatures = append(signatures, signature)
+
+	return o.config.CommunityDescription, nil
+}
+
+func (o *Community) isControlSigner(pk *ecdsa.PublicKey) bool {
+	if o.config.CommunityDescription.Control == nil {
+		return false
+	}
+	key := crypto.CompressPubkey(pk)
+	for _, s := range o.config.CommunityDescription.Control.Signers {
+		if bytes.Equal(s, key) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsControlSigner returns whether pk is asked to approve critical changes
+func (o *Community) IsControlSigner(pk *ecdsa.PublicKey) bool {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	return o.isControlSigner(pk)
+}
+
+// verifyOwner checks that description is signed by the owner of the
+// community, as resulting from its ownership transfers. Those must extend
+// the ones we know of, so they can't be rolled back.
+func (o *Community) verifyOwner(signer *ecdsa.PublicKey, description *protobuf.CommunityDescription) error {
+	current := o.config.CommunityDescription.OwnershipTransfers
+	transfers := description.OwnershipTransfers
+	if len(transfers) < len(current) {
+		return ErrNotAuthorized
+	}
+	for i := range current {
+		if !proto.Equal(current[i], transfers[i]) {
+			return ErrNotAuthorized
+		}
+	}
+
+	owner, err := ownerFromTransfers(o.config.ID, transfers)
+	if err != nil {
+		return err
+	}
+	if common.IsPubKeyEqual(owner, signer) {
+		return nil
+	}
+
+	// The description handing over ownership is signed by the previous owner,
+	// once we have accepted it only the new owner can update the community
+	if n := len(transfers); n > len(current) {
+		previousOwner, err := ownerFromTransfers(o.config.ID, transfers[:n-1])
+		if err != nil {
+			return err
+		}
+		if common.IsPubKeyEqual(previousOwner, signer) {
+			return nil
+		}
+	}
+
+	return ErrNotAuthorized
+}
+
+// verifyControl checks that critical changes brought by description have been
+// approved by the control signers. The signers are the ones set in the current
+// description, or in description if the community is new to us.
+func (o *Community) verifyControl(description *protobuf.CommunityDescription) error {
+	current := o.config.CommunityDescription
+
+	control := current.Control
+	if current.Clock == 0 {
+		control = description.Control
+	}
+
+	if !controlEnabled(control) {
+		return nil
+	}
+
+	// Chats can only be deleted through the removed chats, which are approved
+	if current.Clock != 0 {
+		removed := make(map[string]bool)
+		for _, chatID := range description.RemovedChats {
+			removed[chatID] = true
+		}
+		for chatID := range current.Chats {
+			if _, ok := description.Chats[chatID]; !ok && !removed[chatID] {
+				return ErrControlSignaturesRequired
+			}
+		}
+	}
+
+	hash, err := ControlStateHash(o.ID(), description)
+	if err != nil {
+		return err
+	}
+
+	if current.Clock != 0 {
+		currentHash, err := ControlStateHash(o.ID(), current)
+		if err != nil {
+			return err
+		}
+		if bytes.Equal(currentHash, hash) {
+			return nil
+		}
+	}
+
+	if countControlSignatures(control, hash, description.ControlSignatures) < int(control.Threshold) {
+		return ErrControlSignaturesRequired
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+package communities
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// receiverOf builds the community as seen by a member, who holds the
+// current description of org but not its key
+func (s *CommunitySuite) receiverOf(org *Community) *Community {
+	config := s.config()
+	config.ID = org.config.ID
+	config.PrivateKey = nil
+	config.MemberIdentity = &s.member1.PublicKey
+	config.CommunityDescription = proto.Clone(org.config.CommunityDescription).(*protobuf.CommunityDescription)
+
+	receiver, err := New(config)
+	s.Require().NoError(err)
+	return receiver
+}
+
+func (s *CommunitySuite) cloneDescription(org *Community) *protobuf.CommunityDescription {
+	return proto.Clone(org.config.CommunityDescription).(*protobuf.CommunityDescription)
+}
+
+func (s *CommunitySuite) TestTransferOwnership() {
+	org := s.buildCommunity(&s.identity.PublicKey)
+	receiver := s.receiverOf(org)
+
+	_, err := org.TransferOwnership(&s.identity.PublicKey)
+	s.Require().Equal(ErrAlreadyOwner, err)
+
+	_, err = org.TransferOwnership(&s.member2.PublicKey)
+	s.Require().NoError(err)
+	s.Require().True(org.IsOwner(&s.member2.PublicKey))
+	s.Require().False(org.IsOwner(&s.identity.PublicKey))
+
+	// The transfer is signed by the previous owner
+	transfer := s.cloneDescription(org)
+	_, err = receiver.UpdateCommunityDescription(&s.identity.PublicKey, transfer, []byte{0x01})
+	s.Require().NoError(err)
+	s.Require().True(receiver.IsOwner(&s.member2.PublicKey))
+
+	// Once accepted, the previous owner can't update the community
+	description := proto.Clone(transfer).(*protobuf.CommunityDescription)
+	description.Clock++
+	_, err = receiver.UpdateCommunityDescription(&s.identity.PublicKey, description, []byte{0x01})
+	s.Require().Equal(ErrNotAuthorized, err)
+
+	// The transfers can't be rolled back
+	description.OwnershipTransfers = nil
+	_, err = receiver.UpdateCommunityDescription(&s.identity.PublicKey, description, []byte{0x01})
+	s.Require().Equal(ErrNotAuthorized, err)
+
+	// The new owner can
+	description = proto.Clone(transfer).(*protobuf.CommunityDescription)
+	description.Clock++
+	_, err = receiver.UpdateCommunityDescription(&s.member2.PublicKey, description, []byte{0x01})
+	s.Require().NoError(err)
+	s.Require().Equal(description.Clock, receiver.config.CommunityDescription.Clock)
+}
+
+func (s *CommunitySuite) TestTransferOwnershipForgedTransfer() {
+	org := s.buildCommunity(&s.identity.PublicKey)
+	receiver := s.receiverOf(org)
+
+	// A transfer not signed by the current owner is rejected
+	org.config.PrivateKey = s.member3
+	_, err := org.TransferOwnership(&s.member3.PublicKey)
+	s.Require().NoError(err)
+
+	_, err = receiver.UpdateCommunityDescription(&s.member3.PublicKey, s.cloneDescription(org), []byte{0x01})
+	s.Require().Equal(ErrInvalidOwnershipTransfer, err)
+}
+
+func (s *CommunitySuite) TestControlSignatures() {
+	org := s.buildCommunity(&s.identity.PublicKey)
+	receiver := s.receiverOf(org)
+
+	_, err := org.SetControl([]*ecdsa.PublicKey{&s.member1.PublicKey, &s.member3.PublicKey}, 1)
+	s.Require().Equal(ErrInvalidCommunityControl, err)
+
+	_, err = org.SetControl([]*ecdsa.PublicKey{&s.member1.PublicKey, &s.member2.PublicKey}, 3)
+	s.Require().Equal(ErrInvalidCommunityControl, err)
+
+	// Signers are asked to approve it, but enabling control on a community
+	// without it doesn't require their signatures
+	_, err = org.SetControl([]*ecdsa.PublicKey{&s.member1.PublicKey, &s.member2.PublicKey}, 2)
+	s.Require().NoError(err)
+	s.Require().True(org.NeedsControlSignatures())
+	_, err = receiver.UpdateCommunityDescription(&s.identity.PublicKey, s.cloneDescription(org), []byte{0x01})
+	s.Require().NoError(err)
+
+	// Non critical changes don't either
+	_, err = org.InviteUserToOrg(&s.member3.PublicKey)
+	s.Require().NoError(err)
+	_, err = receiver.UpdateCommunityDescription(&s.identity.PublicKey, s.cloneDescription(org), []byte{0x01})
+	s.Require().NoError(err)
+
+	_, err = org.BanUserFromCommunity(&s.member3.PublicKey)
+	s.Require().NoError(err)
+	s.Require().True(org.NeedsControlSignatures())
+
+	_, err = receiver.UpdateCommunityDescription(&s.identity.PublicKey, s.cloneDescription(org), []byte{0x01})
+	s.Require().Equal(ErrControlSignaturesRequired, err)
+
+	hash, err := org.ControlStateHash()
+	s.Require().NoError(err)
+
+	// Signatures of a different state are rejected
+	signature, err := crypto.Sign(crypto.Keccak256([]byte("other")), s.member1)
+	s.Require().NoError(err)
+	_, err = org.AddControlSignature(crypto.Keccak256([]byte("other")), signature)
+	s.Require().Equal(ErrInvalidControlSignature, err)
+
+	signature, err = crypto.Sign(hash, s.member1)
+	s.Require().NoError(err)
+	_, err = org.AddControlSignature(hash, signature)
+	s.Require().NoError(err)
+
+	// Signing twice doesn't count
+	_, err = org.AddControlSignature(hash, signature)
+	s.Require().NoError(err)
+	s.Require().True(org.NeedsControlSignatures())
+
+	_, err = receiver.UpdateCommunityDescription(&s.identity.PublicKey, s.cloneDescription(org), []byte{0x01})
+	s.Require().Equal(ErrControlSignaturesRequired, err)
+
+	signature, err = crypto.Sign(hash, s.member2)
+	s.Require().NoError(err)
+	_, err = org.AddControlSignature(hash, signature)
+	s.Require().NoError(err)
+	s.Require().False(org.NeedsControlSignatures())
+
+	_, err = receiver.UpdateCommunityDescription(&s.identity.PublicKey, s.cloneDescription(org), []byte{0x01})
+	s.Require().NoError(err)
+	s.Require().Contains(receiver.config.CommunityDescription.BanList, s.member3Key)
+}
+
+func (s *CommunitySuite) TestControlRemovedChats() {
+	org := s.buildCommunity(&s.identity.PublicKey)
+
+	_, err := org.SetControl([]*ecdsa.PublicKey{&s.member1.PublicKey}, 1)
+	s.Require().NoError(err)
+	receiver := s.receiverOf(org)
+
+	// A chat dropped from the description without being removed is rejected
+	description := s.cloneDescription(org)
+	description.Clock++
+	delete(description.Chats, testChatID1)
+	_, err = receiver.UpdateCommunityDescription(&s.identity.PublicKey, description, []byte{0x01})
+	s.Require().Equal(ErrControlSignaturesRequired, err)
+
+	_, err = org.DeleteChat(testChatID1)
+	s.Require().NoError(err)
+	s.Require().True(org.NeedsControlSignatures())
+
+	hash, err := org.ControlStateHash()
+	s.Require().NoError(err)
+	signature, err := crypto.Sign(hash, s.member1)
+	s.Require().NoError(err)
+	_, err = org.AddControlSignature(hash, signature)
+	s.Require().NoError(err)
+
+	_, err = receiver.UpdateCommunityDescription(&s.identity.PublicKey, s.cloneDescription(org), []byte{0x01})
+	s.Require().NoError(err)
+	s.Require().NotContains(receiver.config.CommunityDescription.Chats, testChatID1)
+}
@@ -0,0 +1,89 @@
+package communities
+
+import (
+	"github.com/status-im/status-go/images"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// CommunityEmoji is a custom emoji defined by the admin of a community,
+// which members can react with
+type CommunityEmoji struct {
+	ID    string               `json:"id"`
+	Name  string               `json:"name"`
+	Image images.IdentityImage `json:"image"`
+}
+
+func (o *Community) AddEmoji(emojiID string, emoji *protobuf.CommunityEmoji) (*protobuf.CommunityDescription, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if o.config.PrivateKey == nil {
+		return nil, ErrNotAdmin
+	}
+
+	if o.config.CommunityDescription.Emojis == nil {
+		o.config.CommunityDescription.Emojis = make(map[string]*protobuf.CommunityEmoji)
+	}
+	if _, ok := o.config.CommunityDescription.Emojis[emojiID]; ok {
+		return nil, ErrEmojiAlreadyExists
+	}
+
+	for _, e := range o.config.CommunityDescription.Emojis {
+		if e.Name == emoji.Name {
+			return nil, ErrEmojiNameAlreadyExists
+		}
+	}
+
+	emoji.EmojiId = emojiID
+	if err := validateCommunityEmoji(emoji); err != nil {
+		return nil, err
+	}
+
+	o.config.CommunityDescription.Emojis[emojiID] = emoji
+
+	o.increaseClock()
+
+	return o.config.CommunityDescription, nil
+}
+
+func (o *Community) DeleteEmoji(emojiID string) (*protobuf.CommunityDescription, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if o.config.PrivateKey == nil {
+		return nil, ErrNotAdmin
+	}
+
+	if _, ok := o.config.CommunityDescription.Emojis[emojiID]; !ok {
+		return nil, ErrEmojiNotFound
+	}
+
+	delete(o.config.CommunityDescription.Emojis, emojiID)
+
+	o.increaseClock()
+
+	return o.config.CommunityDescription, nil
+}
+
+// HasEmoji returns whether the community defines a custom emoji
+func (o *Community) HasEmoji(emojiID string) bool {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if o.config.CommunityDescription == nil {
+		return false
+	}
+	_, ok := o.config.CommunityDescription.Emojis[emojiID]
+	return ok
+}
+
+func (o *Community) Emojis() map[string]*protobuf.CommunityEmoji {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	response := make(map[string]*protobuf.CommunityEmoji)
+	for k, v := range o.config.CommunityDescription.Emojis {
+		response[k] = v
+	}
+	return response
+}
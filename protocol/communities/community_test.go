@@ -602,6 +602,105 @@ func (s *CommunitySuite) TestCanPost() {
 	}
 }
 
+func (s *CommunitySuite) TestCanReadMessageArchive() {
+	member := &s.member1.PublicKey
+	notMember := &s.member3.PublicKey
+
+	testCases := []struct {
+		name    string
+		config  Config
+		member  *ecdsa.PublicKey
+		chatID  string
+		canRead bool
+	}{
+		{
+			name:    "no-membership org with no-membership chat",
+			config:  s.configNoMembershipOrgNoMembershipChat(),
+			member:  notMember,
+			chatID:  testChatID1,
+			canRead: true,
+		},
+		{
+			name:    "unknown chat",
+			config:  s.configNoMembershipOrgNoMembershipChat(),
+			member:  member,
+			chatID:  testChatID2,
+			canRead: false,
+		},
+		{
+			name:    "no-membership org with invitation-only chat, member of the chat",
+			config:  s.configNoMembershipOrgInvitationOnlyChat(),
+			member:  member,
+			chatID:  testChatID1,
+			canRead: true,
+		},
+		{
+			name:    "no-membership org with invitation-only chat, not a member of the chat",
+			config:  s.configNoMembershipOrgInvitationOnlyChat(),
+			member:  notMember,
+			chatID:  testChatID1,
+			canRead: false,
+		},
+		{
+			name:    "on-request org with no-membership chat, member",
+			config:  s.configOnRequestOrgNoMembershipChat(),
+			member:  member,
+			chatID:  testChatID1,
+			canRead: true,
+		},
+		{
+			name:    "on-request org with no-membership chat, not a member",
+			config:  s.configOnRequestOrgNoMembershipChat(),
+			member:  notMember,
+			chatID:  testChatID1,
+			canRead: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			org, err := New(tc.config)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.canRead, org.CanReadMessageArchive(tc.member, tc.chatID))
+		})
+	}
+
+	// Banned members can't read any chat
+	org, err := New(s.configNoMembershipOrgNoMembershipChat())
+	s.Require().NoError(err)
+	_, err = org.BanUserFromCommunity(member)
+	s.Require().NoError(err)
+	s.Require().False(org.CanReadMessageArchive(member, testChatID1))
+}
+
+func (s *CommunitySuite) TestMessageArchiveIndexBounded() {
+	org := s.buildCommunity(&s.identity.PublicKey)
+	org.config.PrivateKey = s.identity
+
+	var archives []*MessageArchive
+	for i := uint64(0); i < maxMessageArchiveIndexEntries+10; i++ {
+		archives = append(archives, &MessageArchive{
+			ChatID: testChatID1,
+			From:   i * MessageArchiveWindow,
+			To:     (i + 1) * MessageArchiveWindow,
+			Hash:   make([]byte, 32),
+		})
+	}
+
+	// Archives are added in any order, the most recent ones are kept
+	description, err := org.AddMessageArchives(archives[5:])
+	s.Require().NoError(err)
+	s.Require().Len(description.ArchiveIndex, maxMessageArchiveIndexEntries)
+	description, err = org.AddMessageArchives(archives[:5])
+	s.Require().NoError(err)
+
+	index := org.MessageArchiveIndex()
+	s.Require().Len(index, maxMessageArchiveIndexEntries)
+	s.Require().Equal(uint64(10*MessageArchiveWindow), index[0].From)
+	s.Require().Equal(archives[len(archives)-1].To, index[len(index)-1].To)
+	s.Require().NoError(ValidateCommunityDescription(description))
+}
+
 func (s *CommunitySuite) TestHandleCommunityDescription() {
 	key, err := crypto.GenerateKey()
 	s.Require().NoError(err)
@@ -751,6 +850,11 @@ func (s *CommunitySuite) TestValidateCommunityDescription() {
 			description: s.memberInChatNotInOrgCommunityDescription(),
 			err:         ErrInvalidCommunityDescriptionMemberInChatButNotInOrg,
 		},
+		{
+			name:        "too many archives",
+			description: s.tooManyArchivesCommunityDescription(),
+			err:         ErrInvalidCommunityDescriptionArchiveIndex,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -922,6 +1026,19 @@ func (s *CommunitySuite) memberInChatNotInOrgCommunityDescription() *protobuf.Co
 	return desc
 }
 
+func (s *CommunitySuite) tooManyArchivesCommunityDescription() *protobuf.CommunityDescription {
+	desc := s.buildCommunityDescription()
+	for i := uint64(0); i <= maxMessageArchiveIndexEntries; i++ {
+		desc.ArchiveIndex = append(desc.ArchiveIndex, &protobuf.CommunityMessageArchiveIndexEntry{
+			Hash:   make([]byte, 32),
+			ChatId: testChatID1,
+			From:   i * MessageArchiveWindow,
+			To:     (i + 1) * MessageArchiveWindow,
+		})
+	}
+	return desc
+}
+
 func (s *CommunitySuite) buildCommunity(owner *ecdsa.PublicKey) *Community {
 
 	config := s.config()
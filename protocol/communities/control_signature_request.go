@@ -0,0 +1,15 @@
+package communities
+
+import (
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// ControlSignatureRequest is a request from the owner of a community to
+// approve critical changes to it, pending until we sign or decline it
+type ControlSignatureRequest struct {
+	CommunityID types.HexBytes                 `json:"communityId"`
+	Clock       uint64                         `json:"clock"`
+	StateHash   types.HexBytes                 `json:"stateHash"`
+	Description *protobuf.CommunityDescription `json:"description"`
+}
@@ -0,0 +1,181 @@
+package communities
+
+import (
+	"crypto/ecdsa"
+	"strings"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/images"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// DirectoryChatID is the public chat community listings are published on
+const DirectoryChatID = "status-community-directory"
+
+// DirectoryListingTTL is how long, in milliseconds, a listing is shown after
+// it has been published. Owners publish their listings again well before.
+const DirectoryListingTTL = 7 * 24 * 60 * 60 * 1000
+
+const maxDirectoryListingNameLength = 64
+const maxDirectoryListingDescriptionLength = 512
+const maxDirectoryListingTags = 10
+const maxDirectoryListingTagLength = 32
+const maxDirectoryListingImageSize = 64 * 1024
+
+// DirectoryListing is a community as advertised in the directory
+type DirectoryListing struct {
+	CommunityID  types.HexBytes                       `json:"communityId"`
+	Clock        uint64                               `json:"clock"`
+	Name         string                               `json:"name"`
+	Description  string                               `json:"description"`
+	Tags         []string                             `json:"tags"`
+	MembersCount uint32                               `json:"membersCount"`
+	Image        types.HexBytes                       `json:"image,omitempty"`
+	Access       protobuf.CommunityPermissions_Access `json:"access"`
+	Flagged      bool                                 `json:"flagged"`
+}
+
+// OwnDirectoryListing is the listing of a community we own, published with its key
+type OwnDirectoryListing struct {
+	Community *Community
+	Listing   *protobuf.CommunityDirectoryListing
+}
+
+// DirectoryQuery filters the listings of the directory
+type DirectoryQuery struct {
+	// Name matches listings whose name contains it, case insensitive
+	Name string
+	// Tags matches listings having all of them
+	Tags []string
+	// IncludeFlagged includes listings of communities flagged as spam
+	IncludeFlagged bool
+}
+
+// NormalizeDirectoryTag returns the canonical form of a tag, tags are
+// compared in this form
+func NormalizeDirectoryTag(tag string) string {
+	return strings.ToLower(strings.TrimSpace(tag))
+}
+
+func ValidateDirectoryListing(listing *protobuf.CommunityDirectoryListing) error {
+	if listing.Clock == 0 {
+		return ErrInvalidDirectoryListing
+	}
+
+	if _, err := crypto.DecompressPubkey(listing.CommunityId); err != nil {
+		return ErrInvalidDirectoryListing
+	}
+
+	name := strings.TrimSpace(listing.Name)
+	if len(name) == 0 || len(name) > maxDirectoryListingNameLength {
+		return ErrInvalidDirectoryListing
+	}
+
+	if len(listing.Description) > maxDirectoryListingDescriptionLength {
+		return ErrInvalidDirectoryListing
+	}
+
+	if len(listing.Tags) > maxDirectoryListingTags {
+		return ErrInvalidDirectoryListing
+	}
+	for _, tag := range listing.Tags {
+		tag = NormalizeDirectoryTag(tag)
+		if len(tag) == 0 || len(tag) > maxDirectoryListingTagLength {
+			return ErrInvalidDirectoryListing
+		}
+	}
+
+	if len(listing.Image) > maxDirectoryListingImageSize {
+		return ErrInvalidDirectoryListing
+	}
+
+	if listing.Access == protobuf.CommunityPermissions_UNKNOWN_ACCESS {
+		return ErrInvalidDirectoryListing
+	}
+
+	return nil
+}
+
+// DirectoryListing builds the listing advertising the community
+func (o *Community) DirectoryListing(tags []string, clock uint64) *protobuf.CommunityDirectoryListing {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	description := o.config.CommunityDescription
+
+	listing := &protobuf.CommunityDirectoryListing{
+		Clock:        clock,
+		CommunityId:  o.ID(),
+		MembersCount: uint32(len(description.Members)),
+	}
+
+	for _, tag := range tags {
+		listing.Tags = append(listing.Tags, NormalizeDirectoryTag(tag))
+	}
+
+	if description.Permissions != nil {
+		listing.Access = description.Permissions.Access
+	}
+
+	if description.Identity != nil {
+		listing.Name = description.Identity.DisplayName
+		listing.Description = description.Identity.Description
+		if image, ok := description.Identity.Images[images.SmallDimName]; ok && len(image.Payload) <= maxDirectoryListingImageSize {
+			listing.Image = image.Payload
+		}
+	}
+
+	return listing
+}
+
+// verifyDirectoryListingSigner checks that the listing is signed by the owner
+// of the community, which is the community key unless we know it has
+// been transferred
+func verifyDirectoryListingSigner(community *Community, signer *ecdsa.PublicKey, listing *protobuf.CommunityDirectoryListing) error {
+	if community != nil {
+		if !community.IsOwner(signer) {
+			return ErrNotAuthorized
+		}
+		return nil
+	}
+
+	communityKey, err := crypto.DecompressPubkey(listing.CommunityId)
+	if err != nil {
+		return err
+	}
+	if !common.IsPubKeyEqual(communityKey, signer) {
+		return ErrNotAuthorized
+	}
+	return nil
+}
+
+func (l *DirectoryListing) hasTags(tags []string) bool {
+	for _, tag := range tags {
+		found := false
+		for _, t := range l.Tags {
+			if t == NormalizeDirectoryTag(tag) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func (l *DirectoryListing) matches(query *DirectoryQuery) bool {
+	if l.Flagged && !query.IncludeFlagged {
+		return false
+	}
+
+	name := strings.ToLower(strings.TrimSpace(query.Name))
+	if len(name) != 0 && !strings.Contains(strings.ToLower(l.Name), name) {
+		return false
+	}
+
+	return l.hasTags(query.Tags)
+}
@@ -8,6 +8,9 @@ var ErrChatAlreadyAssigned = errors.New("chat already assigned to a category")
 var ErrOrgNotFound = errors.New("community not found")
 var ErrChatAlreadyExists = errors.New("chat already exists")
 var ErrCategoryAlreadyExists = errors.New("category already exists")
+var ErrEmojiNotFound = errors.New("emoji not found")
+var ErrEmojiAlreadyExists = errors.New("emoji already exists")
+var ErrEmojiNameAlreadyExists = errors.New("an emoji with the same name already exists")
 var ErrCantRequestAccess = errors.New("can't request access")
 var ErrInvalidCommunityDescription = errors.New("invalid community description")
 var ErrInvalidCommunityDescriptionNoOrgPermissions = errors.New("invalid community description no org permissions")
@@ -20,8 +23,22 @@ var ErrInvalidCommunityDescriptionCategoryNoName = errors.New("invalid community
 var ErrInvalidCommunityDescriptionChatIdentity = errors.New("invalid community chat name, missing")
 var ErrInvalidCommunityDescriptionDuplicatedName = errors.New("invalid community chat name, duplicated")
 var ErrInvalidCommunityDescriptionUnknownChatCategory = errors.New("invalid community category in chat")
+var ErrInvalidCommunityDescriptionEmojiNoID = errors.New("invalid community emoji id")
+var ErrInvalidCommunityDescriptionEmojiNoName = errors.New("invalid community emoji name")
+var ErrInvalidCommunityDescriptionEmojiNoImage = errors.New("invalid community emoji image")
+var ErrInvalidCommunityDescriptionArchiveIndex = errors.New("invalid community message archive index")
 var ErrNotAdmin = errors.New("no admin privileges for this community")
 var ErrInvalidGrant = errors.New("invalid grant")
 var ErrNotAuthorized = errors.New("not authorized")
 var ErrAlreadyMember = errors.New("already a member")
 var ErrInvalidMessage = errors.New("invalid community description message")
+var ErrInvalidMessageArchive = errors.New("invalid community message archive")
+var ErrMessageArchiveNotFound = errors.New("community message archive not found")
+var ErrInvalidOwnershipTransfer = errors.New("invalid community ownership transfer")
+var ErrAlreadyOwner = errors.New("already the owner of the community")
+var ErrOwnershipTransferred = errors.New("community ownership has been transferred")
+var ErrInvalidCommunityControl = errors.New("invalid community control signers")
+var ErrInvalidControlSignature = errors.New("invalid community control signature")
+var ErrControlSignaturesRequired = errors.New("not enough community control signatures")
+var ErrControlSignatureRequestNotFound = errors.New("community control signature request not found")
+var ErrInvalidDirectoryListing = errors.New("invalid community directory listing")
@@ -1,6 +1,7 @@
 package communities
 
 import (
+	"bytes"
 	"crypto/ecdsa"
 	"database/sql"
 	"fmt"
@@ -246,6 +247,11 @@ func (m *Manager) ExportCommunity(id types.HexBytes) (*ecdsa.PrivateKey, error)
 		return nil, errors.New("not an admin")
 	}
 
+	// After a transfer we hold the owner key, not the community one
+	if community.OwnershipTransferred() {
+		return nil, ErrOwnershipTransferred
+	}
+
 	return community.config.PrivateKey, nil
 }
 
@@ -515,20 +521,88 @@ func (m *Manager) DeleteCategory(request *requests.DeleteCommunityCategory) (*Co
 	return community, changes, nil
 }
 
+func (m *Manager) AddEmoji(request *requests.AddCommunityEmoji) (*Community, error) {
+	community, err := m.GetByID(request.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil {
+		return nil, ErrOrgNotFound
+	}
+
+	emoji, err := request.ToCommunityEmoji()
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = community.AddEmoji(uuid.New().String(), emoji)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.persistence.SaveCommunity(community)
+	if err != nil {
+		return nil, err
+	}
+
+	// Advertise changes
+	m.publish(&Subscription{Community: community})
+
+	return community, nil
+}
+
+func (m *Manager) DeleteEmoji(request *requests.DeleteCommunityEmoji) (*Community, error) {
+	community, err := m.GetByID(request.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil {
+		return nil, ErrOrgNotFound
+	}
+
+	_, err = community.DeleteEmoji(request.EmojiID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.persistence.SaveCommunity(community)
+	if err != nil {
+		return nil, err
+	}
+
+	// Advertise changes
+	m.publish(&Subscription{Community: community})
+
+	return community, nil
+}
+
 func (m *Manager) HandleCommunityDescriptionMessage(signer *ecdsa.PublicKey, description *protobuf.CommunityDescription, payload []byte) (*CommunityResponse, error) {
 	id := crypto.CompressPubkey(signer)
+	communityKey := signer
+	// Once ownership is transferred the description is not signed by the community key
+	if len(description.Id) != 0 && !bytes.Equal(description.Id, id) {
+		var err error
+		id = description.Id
+		communityKey, err = crypto.DecompressPubkey(id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	community, err := m.persistence.GetByID(m.identity, id)
 	if err != nil {
 		return nil, err
 	}
 
 	if community == nil {
+		// We start from an empty description, so that the received one
+		// is verified as any update
 		config := Config{
-			CommunityDescription:          description,
+			CommunityDescription:          &protobuf.CommunityDescription{},
 			Logger:                        m.logger,
 			MarshaledCommunityDescription: payload,
 			MemberIdentity:                m.identity,
-			ID:                            signer,
+			ID:                            communityKey,
 		}
 
 		community, err = New(config)
@@ -884,3 +958,611 @@ func (m *Manager) CanPost(pk *ecdsa.PublicKey, communityID string, chatID string
 	}
 	return community.CanPost(pk, chatID, grant)
 }
+
+// StoreMessageArchiveEnvelopes keeps the envelopes received in a chat of
+// a community we own, so that they can later be archived
+func (m *Manager) StoreMessageArchiveEnvelopes(communityID string, chatID string, messages []*types.Message) error {
+	community, err := m.GetByIDString(communityID)
+	if err != nil {
+		return err
+	}
+	if community == nil || !community.IsAdmin() {
+		return nil
+	}
+
+	if _, ok := community.Chats()[chatID]; !ok {
+		return nil
+	}
+
+	for _, message := range messages {
+		err := m.persistence.SaveMessageArchiveEnvelope(community.ID(), chatID, ToWakuMessage(message))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CreateMessageArchives archives the envelopes received in the chats of the
+// community in every window which has ended by now, and publishes them in
+// the community description
+func (m *Manager) CreateMessageArchives(community *Community, now uint64) (*Community, error) {
+	if !community.IsAdmin() {
+		return nil, ErrNotAdmin
+	}
+
+	oldest, err := m.persistence.OldestMessageArchiveEnvelopes(community.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	var created []*MessageArchive
+	for chatID, timestamp := range oldest {
+		for from := WindowStart(timestamp); from+MessageArchiveWindow <= now; from += MessageArchiveWindow {
+			to := from + MessageArchiveWindow
+			envelopes, err := m.persistence.MessageArchiveEnvelopes(community.ID(), chatID, from, to)
+			if err != nil {
+				return nil, err
+			}
+			if len(envelopes) == 0 {
+				continue
+			}
+
+			archives, err := BuildMessageArchives(community.PrivateKey(), community.ID(), chatID, from, to, envelopes)
+			if err != nil {
+				return nil, err
+			}
+
+			// We never import our own archives
+			for _, archive := range archives {
+				archive.Imported = true
+			}
+
+			err = m.persistence.SaveMessageArchives(community.ID(), chatID, from, to, archives)
+			if err != nil {
+				return nil, err
+			}
+			created = append(created, archives...)
+		}
+	}
+
+	if len(created) == 0 {
+		return community, nil
+	}
+
+	_, err = community.AddMessageArchives(created)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.persistence.SaveCommunity(community)
+	if err != nil {
+		return nil, err
+	}
+
+	// Advertise changes
+	m.publish(&Subscription{Community: community})
+
+	return community, nil
+}
+
+// MessageArchivesForRequest returns the archives requested by signer, among
+// the ones of the chats it can read
+func (m *Manager) MessageArchivesForRequest(signer *ecdsa.PublicKey, request *protobuf.CommunityMessageArchiveRequest) (*Community, []*MessageArchive, error) {
+	community, err := m.GetByID(request.CommunityId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if community == nil {
+		return nil, nil, ErrOrgNotFound
+	}
+	if !community.IsAdmin() {
+		return nil, nil, ErrNotAdmin
+	}
+
+	hashes := request.Hashes
+	if len(hashes) > maxMessageArchivesPerRequest {
+		hashes = hashes[:maxMessageArchivesPerRequest]
+	}
+
+	var archives []*MessageArchive
+	for _, hash := range hashes {
+		archive, err := m.persistence.GetMessageArchive(hash)
+		if err != nil {
+			return nil, nil, err
+		}
+		if archive == nil || !bytes.Equal(archive.CommunityID, community.ID()) {
+			continue
+		}
+		// Archives are only served to the members of their chat
+		if !community.CanReadMessageArchive(signer, archive.ChatID) {
+			continue
+		}
+		archives = append(archives, archive)
+	}
+
+	return community, archives, nil
+}
+
+// HandleMessageArchiveResponse verifies an archive sent by the community and
+// stores it so that it can be imported
+func (m *Manager) HandleMessageArchiveResponse(response *protobuf.CommunityMessageArchiveResponse) (*MessageArchive, error) {
+	community, err := m.GetByID(response.CommunityId)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil {
+		return nil, ErrOrgNotFound
+	}
+	if !community.Joined() {
+		return nil, ErrNotAuthorized
+	}
+
+	entry := community.MessageArchiveIndexEntry(response.Hash)
+	if entry == nil {
+		return nil, ErrMessageArchiveNotFound
+	}
+
+	// Archives built before an ownership transfer are signed with the community key
+	err = VerifyMessageArchive(community.OwnerPublicKey(), response.Hash, response.Data, response.Signature)
+	if err != nil && community.OwnershipTransferred() {
+		err = VerifyMessageArchive(community.PublicKey(), response.Hash, response.Data, response.Signature)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := m.persistence.GetMessageArchive(response.Hash)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return existing, nil
+	}
+
+	archive := &MessageArchive{
+		CommunityID: community.ID(),
+		ChatID:      entry.ChatId,
+		From:        entry.From,
+		To:          entry.To,
+		Count:       entry.MessagesCount,
+		Data:        response.Data,
+		Hash:        response.Hash,
+		Signature:   response.Signature,
+	}
+
+	err = m.persistence.SaveReceivedMessageArchive(archive)
+	if err != nil {
+		return nil, err
+	}
+	return archive, nil
+}
+
+// MessageArchivesToRequest returns the hashes of the archives published by the
+// community that we don't have and haven't recently requested, and marks them
+// as requested
+func (m *Manager) MessageArchivesToRequest(community *Community, now uint64) ([][]byte, error) {
+	if community.IsAdmin() {
+		return nil, nil
+	}
+
+	requests, err := m.persistence.MessageArchiveRequests(community.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	var hashes [][]byte
+	for _, entry := range community.MessageArchiveIndex() {
+		if requestedAt, ok := requests[types.EncodeHex(entry.Hash)]; ok && requestedAt+messageArchiveRequestInterval > now {
+			continue
+		}
+
+		archive, err := m.persistence.GetMessageArchive(entry.Hash)
+		if err != nil {
+			return nil, err
+		}
+		if archive != nil {
+			continue
+		}
+
+		hashes = append(hashes, entry.Hash)
+		if len(hashes) == maxMessageArchivesPerRequest {
+			break
+		}
+	}
+
+	if len(hashes) == 0 {
+		return nil, nil
+	}
+
+	err = m.persistence.SetMessageArchivesRequested(community.ID(), hashes, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return hashes, nil
+}
+
+func (m *Manager) UnimportedMessageArchives(limit int) ([]*MessageArchive, error) {
+	return m.persistence.UnimportedMessageArchives(limit)
+}
+
+func (m *Manager) SetMessageArchiveImported(hash []byte) error {
+	return m.persistence.SetMessageArchiveImported(hash)
+}
+
+// TransferOwnership hands control of the community to a new owner. We keep
+// our key until the transfer is published, see ReleaseControl.
+func (m *Manager) TransferOwnership(request *requests.TransferCommunityOwnership) (*Community, error) {
+	newOwner, err := common.HexToPubkey(request.NewOwner.String())
+	if err != nil {
+		return nil, err
+	}
+
+	community, err := m.GetByID(request.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil {
+		return nil, ErrOrgNotFound
+	}
+
+	_, err = community.TransferOwnership(newOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.persistence.SaveCommunity(community)
+	if err != nil {
+		return nil, err
+	}
+
+	// Advertise changes
+	m.publish(&Subscription{Community: community})
+
+	return community, nil
+}
+
+// ReleaseControl drops our key once we have published the transfer of the
+// community to another owner, from then on we only hold the signed description
+func (m *Manager) ReleaseControl(community *Community) (*Community, error) {
+	if !community.IsAdmin() || community.IsOwner(&community.PrivateKey().PublicKey) {
+		return community, nil
+	}
+
+	payload, err := community.ToBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	community.config.MarshaledCommunityDescription = payload
+	community.config.PrivateKey = nil
+
+	err = m.persistence.SaveCommunity(community)
+	if err != nil {
+		return nil, err
+	}
+	return community, nil
+}
+
+// TakeControl sets the key we control the community with, once its
+// ownership has been transferred to us
+func (m *Manager) TakeControl(id types.HexBytes, key *ecdsa.PrivateKey) (*Community, error) {
+	community, err := m.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil {
+		return nil, ErrOrgNotFound
+	}
+	if !community.IsOwner(&key.PublicKey) {
+		return nil, ErrNotAuthorized
+	}
+
+	community.config.PrivateKey = key
+
+	err = m.persistence.SaveCommunity(community)
+	if err != nil {
+		return nil, err
+	}
+	return community, nil
+}
+
+func (m *Manager) SetControl(request *requests.SetCommunityControl) (*Community, error) {
+	var signers []*ecdsa.PublicKey
+	for _, s := range request.Signers {
+		signer, err := common.HexToPubkey(s.String())
+		if err != nil {
+			return nil, err
+		}
+		signers = append(signers, signer)
+	}
+
+	community, err := m.GetByID(request.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil {
+		return nil, ErrOrgNotFound
+	}
+
+	_, err = community.SetControl(signers, request.Threshold)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.persistence.SaveCommunity(community)
+	if err != nil {
+		return nil, err
+	}
+
+	// Advertise changes
+	m.publish(&Subscription{Community: community})
+
+	return community, nil
+}
+
+// HandleControlSignatureRequest stores a request from the owner of a community
+// to approve its critical settings, until we sign or decline it
+func (m *Manager) HandleControlSignatureRequest(signer *ecdsa.PublicKey, request *protobuf.CommunityControlSignatureRequest) (*ControlSignatureRequest, error) {
+	community, err := m.GetByID(request.CommunityId)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil {
+		return nil, ErrOrgNotFound
+	}
+	if !community.IsOwner(signer) {
+		return nil, ErrNotAuthorized
+	}
+	if !community.IsControlSigner(m.identity) {
+		return nil, ErrNotAuthorized
+	}
+
+	description := &protobuf.CommunityDescription{}
+	err = proto.Unmarshal(request.Description, description)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ValidateCommunityDescription(description)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := m.persistence.GetControlSignatureRequest(request.CommunityId)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil && existing.Clock >= request.Clock {
+		return existing, nil
+	}
+
+	err = m.persistence.SaveControlSignatureRequest(request.CommunityId, request.Clock, request.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.persistence.GetControlSignatureRequest(request.CommunityId)
+}
+
+func (m *Manager) ControlSignatureRequests() ([]*ControlSignatureRequest, error) {
+	return m.persistence.ControlSignatureRequests()
+}
+
+// SignControlSignatureRequest approves the pending request for the community
+// with key, and returns the signature to be sent to its owner
+func (m *Manager) SignControlSignatureRequest(id types.HexBytes, key *ecdsa.PrivateKey) (*Community, *protobuf.CommunityControlSignature, error) {
+	community, err := m.GetByID(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if community == nil {
+		return nil, nil, ErrOrgNotFound
+	}
+
+	request, err := m.persistence.GetControlSignatureRequest(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if request == nil {
+		return nil, nil, ErrControlSignatureRequestNotFound
+	}
+
+	signature, err := crypto.Sign(request.StateHash, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = m.persistence.DeleteControlSignatureRequest(id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return community, &protobuf.CommunityControlSignature{
+		Clock:       request.Clock,
+		CommunityId: id,
+		StateHash:   request.StateHash,
+		Signature:   signature,
+	}, nil
+}
+
+func (m *Manager) DeclineControlSignatureRequest(id types.HexBytes) error {
+	return m.persistence.DeleteControlSignatureRequest(id)
+}
+
+// HandleControlSignature adds a control signature to a community we own
+func (m *Manager) HandleControlSignature(signature *protobuf.CommunityControlSignature) (*Community, error) {
+	community, err := m.GetByID(signature.CommunityId)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil {
+		return nil, ErrOrgNotFound
+	}
+
+	_, err = community.AddControlSignature(signature.StateHash, signature.Signature)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.persistence.SaveCommunity(community)
+	if err != nil {
+		return nil, err
+	}
+
+	// Advertise changes
+	m.publish(&Subscription{Community: community})
+
+	return community, nil
+}
+
+// ListInDirectory lists a community we own in the directory, it returns the
+// listing to be published
+func (m *Manager) ListInDirectory(request *requests.ListCommunityInDirectory, clock uint64) (*OwnDirectoryListing, error) {
+	community, err := m.GetByID(request.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	if community == nil {
+		return nil, ErrOrgNotFound
+	}
+	if !community.IsAdmin() {
+		return nil, ErrNotAdmin
+	}
+
+	listing := community.DirectoryListing(request.Tags, clock)
+	err = ValidateDirectoryListing(listing)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.persistence.SaveOwnDirectoryListing(community.ID(), listing.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OwnDirectoryListing{Community: community, Listing: listing}, nil
+}
+
+// UnlistFromDirectory stops publishing the listing of a community, which
+// expires after DirectoryListingTTL
+func (m *Manager) UnlistFromDirectory(id types.HexBytes) error {
+	return m.persistence.DeleteOwnDirectoryListing(id)
+}
+
+// OwnDirectoryListings returns the up to date listings of the communities we list
+func (m *Manager) OwnDirectoryListings(clock uint64) ([]*OwnDirectoryListing, error) {
+	ownListings, err := m.persistence.OwnDirectoryListings()
+	if err != nil {
+		return nil, err
+	}
+
+	var listings []*OwnDirectoryListing
+	for id, tags := range ownListings {
+		communityID, err := types.DecodeHex(id)
+		if err != nil {
+			return nil, err
+		}
+
+		community, err := m.GetByID(communityID)
+		if err != nil {
+			return nil, err
+		}
+		// We might have handed the community over
+		if community == nil || !community.IsAdmin() {
+			continue
+		}
+
+		listing := community.DirectoryListing(tags, clock)
+		if ValidateDirectoryListing(listing) != nil {
+			continue
+		}
+		listings = append(listings, &OwnDirectoryListing{Community: community, Listing: listing})
+	}
+	return listings, nil
+}
+
+// HandleDirectoryListing stores a listing received on the directory, it
+// returns nil if we already have a more recent one
+func (m *Manager) HandleDirectoryListing(signer *ecdsa.PublicKey, listing *protobuf.CommunityDirectoryListing) (*DirectoryListing, error) {
+	err := ValidateDirectoryListing(listing)
+	if err != nil {
+		return nil, err
+	}
+
+	community, err := m.GetByID(listing.CommunityId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = verifyDirectoryListingSigner(community, signer, listing)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := m.persistence.GetDirectoryListing(listing.CommunityId)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil && existing.Clock >= listing.Clock {
+		return nil, nil
+	}
+
+	for i, tag := range listing.Tags {
+		listing.Tags[i] = NormalizeDirectoryTag(tag)
+	}
+
+	err = m.persistence.SaveDirectoryListing(listing)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.persistence.GetDirectoryListing(listing.CommunityId)
+}
+
+// DirectoryListings returns the listings matching query which haven't
+// expired. Communities which banned us are left out.
+func (m *Manager) DirectoryListings(query *DirectoryQuery, now uint64) ([]*DirectoryListing, error) {
+	var since uint64
+	if now > DirectoryListingTTL {
+		since = now - DirectoryListingTTL
+	}
+
+	listings, err := m.persistence.DirectoryListings(since)
+	if err != nil {
+		return nil, err
+	}
+
+	var response []*DirectoryListing
+	for _, listing := range listings {
+		if !listing.matches(query) {
+			continue
+		}
+
+		community, err := m.GetByID(listing.CommunityID)
+		if err != nil {
+			return nil, err
+		}
+		if community != nil && community.IsBanned(m.identity) {
+			continue
+		}
+
+		response = append(response, listing)
+	}
+	return response, nil
+}
+
+// SetDirectoryListingFlagged flags a community as spam, its listing is then
+// left out of the directory unless asked for
+func (m *Manager) SetDirectoryListingFlagged(id types.HexBytes, flagged bool) error {
+	return m.persistence.SetDirectoryListingFlagged(id, flagged)
+}
+
+// PruneDirectoryListings removes the listings which have expired
+func (m *Manager) PruneDirectoryListings(now uint64) error {
+	if now <= DirectoryListingTTL {
+		return nil
+	}
+	return m.persistence.DeleteDirectoryListingsBefore(now - DirectoryListingTTL)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
 	"github.com/status-im/status-go/protocol/protobuf"
 	"github.com/status-im/status-go/protocol/sqlite"
 )
@@ -104,3 +105,155 @@ func (s *ManagerSuite) TestEditCommunity() {
 	s.Require().Equal(storedCommunity.config.CommunityDescription.Identity.DisplayName, update.CreateCommunity.Name)
 	s.Require().Equal(storedCommunity.config.CommunityDescription.Identity.Description, update.CreateCommunity.Description)
 }
+
+func (s *ManagerSuite) TestCreateMessageArchives() {
+	request := &requests.CreateCommunity{
+		Name:        "status",
+		Description: "status community description",
+		Membership:  protobuf.CommunityPermissions_NO_MEMBERSHIP,
+	}
+
+	community, err := s.manager.CreateCommunity(request)
+	s.Require().NoError(err)
+
+	community, changes, err := s.manager.CreateChat(community.ID(), &protobuf.CommunityChat{
+		Identity:    &protobuf.ChatIdentity{DisplayName: "general"},
+		Permissions: &protobuf.CommunityPermissions{Access: protobuf.CommunityPermissions_NO_MEMBERSHIP},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(changes.ChatsAdded, 1)
+
+	var chatID string
+	for id := range changes.ChatsAdded {
+		chatID = id
+	}
+
+	from := WindowStart(1628000000)
+	messages := []*types.Message{
+		{Timestamp: uint32(from + 10), Payload: []byte("first"), Hash: []byte{0x01}},
+		{Timestamp: uint32(from + 20), Payload: []byte("second"), Hash: []byte{0x02}},
+		// Not in a complete window yet
+		{Timestamp: uint32(from + MessageArchiveWindow + 10), Payload: []byte("third"), Hash: []byte{0x03}},
+	}
+	s.Require().NoError(s.manager.StoreMessageArchiveEnvelopes(community.IDString(), chatID, messages))
+
+	community, err = s.manager.CreateMessageArchives(community, from+MessageArchiveWindow+20)
+	s.Require().NoError(err)
+
+	index := community.MessageArchiveIndex()
+	s.Require().Len(index, 1)
+	s.Require().Equal(chatID, index[0].ChatId)
+	s.Require().Equal(from, index[0].From)
+	s.Require().Equal(from+MessageArchiveWindow, index[0].To)
+	s.Require().Equal(uint32(2), index[0].MessagesCount)
+
+	archive, err := s.manager.persistence.GetMessageArchive(index[0].Hash)
+	s.Require().NoError(err)
+	s.Require().NotNil(archive)
+	s.Require().True(archive.Imported)
+	s.Require().NoError(VerifyMessageArchive(community.PublicKey(), archive.Hash, archive.Data, archive.Signature))
+
+	content, err := OpenMessageArchive(archive.Data)
+	s.Require().NoError(err)
+	s.Require().Len(content.Messages, 2)
+	s.Require().Equal([]byte("first"), content.Messages[0].Payload)
+
+	// Archived envelopes are removed, the others are kept for the next window
+	oldest, err := s.manager.persistence.OldestMessageArchiveEnvelopes(community.ID())
+	s.Require().NoError(err)
+	s.Require().Equal(map[string]uint64{chatID: from + MessageArchiveWindow + 10}, oldest)
+
+	// Running again doesn't archive anything new
+	community, err = s.manager.CreateMessageArchives(community, from+MessageArchiveWindow+20)
+	s.Require().NoError(err)
+	s.Require().Len(community.MessageArchiveIndex(), 1)
+
+	// Archives are served to the users who can read their chat
+	key, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	archiveRequest := &protobuf.CommunityMessageArchiveRequest{CommunityId: community.ID(), Hashes: [][]byte{index[0].Hash}}
+	_, archives, err := s.manager.MessageArchivesForRequest(&key.PublicKey, archiveRequest)
+	s.Require().NoError(err)
+	s.Require().Len(archives, 1)
+
+	_, _, err = s.manager.EditChat(community.ID(), chatID, &protobuf.CommunityChat{
+		Identity:    &protobuf.ChatIdentity{DisplayName: "general"},
+		Permissions: &protobuf.CommunityPermissions{Access: protobuf.CommunityPermissions_INVITATION_ONLY},
+	})
+	s.Require().NoError(err)
+	_, archives, err = s.manager.MessageArchivesForRequest(&key.PublicKey, archiveRequest)
+	s.Require().NoError(err)
+	s.Require().Empty(archives)
+}
+
+func (s *ManagerSuite) TestDirectoryListings() {
+	request := &requests.CreateCommunity{
+		Name:        "status",
+		Description: "status community description",
+		Membership:  protobuf.CommunityPermissions_NO_MEMBERSHIP,
+	}
+
+	community, err := s.manager.CreateCommunity(request)
+	s.Require().NoError(err)
+
+	now := uint64(1628000000000)
+	ownListing, err := s.manager.ListInDirectory(&requests.ListCommunityInDirectory{
+		CommunityID: community.ID(),
+		Tags:        []string{" Crypto", "privacy"},
+	}, now)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"crypto", "privacy"}, ownListing.Listing.Tags)
+
+	ownListings, err := s.manager.OwnDirectoryListings(now + 1)
+	s.Require().NoError(err)
+	s.Require().Len(ownListings, 1)
+	s.Require().Equal(now+1, ownListings[0].Listing.Clock)
+
+	// Listings must be signed by the community
+	key, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	_, err = s.manager.HandleDirectoryListing(&key.PublicKey, ownListing.Listing)
+	s.Require().Equal(ErrNotAuthorized, err)
+
+	listing, err := s.manager.HandleDirectoryListing(&community.PrivateKey().PublicKey, ownListing.Listing)
+	s.Require().NoError(err)
+	s.Require().NotNil(listing)
+	s.Require().Equal("status", listing.Name)
+	s.Require().Equal(uint32(1), listing.MembersCount)
+
+	// Older listings are ignored
+	older := proto.Clone(ownListing.Listing).(*protobuf.CommunityDirectoryListing)
+	older.Clock--
+	listing, err = s.manager.HandleDirectoryListing(&community.PrivateKey().PublicKey, older)
+	s.Require().NoError(err)
+	s.Require().Nil(listing)
+
+	listings, err := s.manager.DirectoryListings(&DirectoryQuery{Name: "STAT", Tags: []string{"crypto"}}, now)
+	s.Require().NoError(err)
+	s.Require().Len(listings, 1)
+
+	listings, err = s.manager.DirectoryListings(&DirectoryQuery{Tags: []string{"crypto", "games"}}, now)
+	s.Require().NoError(err)
+	s.Require().Len(listings, 0)
+
+	// Flagged listings are left out unless asked for
+	s.Require().NoError(s.manager.SetDirectoryListingFlagged(community.ID(), true))
+	listings, err = s.manager.DirectoryListings(&DirectoryQuery{}, now)
+	s.Require().NoError(err)
+	s.Require().Len(listings, 0)
+
+	listings, err = s.manager.DirectoryListings(&DirectoryQuery{IncludeFlagged: true}, now)
+	s.Require().NoError(err)
+	s.Require().Len(listings, 1)
+	s.Require().True(listings[0].Flagged)
+
+	// Expired listings are left out
+	listings, err = s.manager.DirectoryListings(&DirectoryQuery{IncludeFlagged: true}, now+DirectoryListingTTL+1)
+	s.Require().NoError(err)
+	s.Require().Len(listings, 0)
+
+	s.Require().NoError(s.manager.UnlistFromDirectory(community.ID()))
+	ownListings, err = s.manager.OwnDirectoryListings(now)
+	s.Require().NoError(err)
+	s.Require().Len(ownListings, 0)
+}
@@ -0,0 +1,226 @@
+package communities
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/ecdsa"
+	"io"
+	"io/ioutil"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// MessageArchiveWindow is the time window, in seconds, covered by the
+// message archives of a community chat
+const MessageArchiveWindow = 7 * 24 * 60 * 60
+
+// maxMessageArchiveSize is the maximum size of the envelopes payloads
+// bundled in a single archive. A window holding more is split in
+// several archives, so that each one can be sent in a single message
+const maxMessageArchiveSize = 512 * 1024
+
+// maxMessageArchiveDecompressedSize bounds how much we read when opening
+// an archive, as it's received from the network
+const maxMessageArchiveDecompressedSize = 16 * 1024 * 1024
+
+// maxMessageArchivesPerRequest is the maximum number of archives requested,
+// or served, at once
+const maxMessageArchivesPerRequest = 20
+
+// maxMessageArchiveIndexEntries is the maximum number of archives listed in
+// the community description, so that it still fits in a single message.
+// Only the most recent archives are listed.
+const maxMessageArchiveIndexEntries = 100
+
+// messageArchiveRequestInterval is how long we wait, in seconds, before
+// requesting again an archive we haven't received
+const messageArchiveRequestInterval = 60 * 60
+
+// MessageArchive is a signed, compressed bundle of the raw envelopes
+// received in a community chat during a time window
+type MessageArchive struct {
+	CommunityID types.HexBytes
+	ChatID      string
+	From        uint64
+	To          uint64
+	Count       uint32
+	Data        []byte
+	Hash        []byte
+	Signature   []byte
+	Imported    bool
+}
+
+func (a *MessageArchive) IndexEntry() *protobuf.CommunityMessageArchiveIndexEntry {
+	return &protobuf.CommunityMessageArchiveIndexEntry{
+		Hash:          a.Hash,
+		ChatId:        a.ChatID,
+		From:          a.From,
+		To:            a.To,
+		MessagesCount: a.Count,
+		Size:          uint64(len(a.Data)),
+	}
+}
+
+func (a *MessageArchive) ToResponse(clock uint64) *protobuf.CommunityMessageArchiveResponse {
+	return &protobuf.CommunityMessageArchiveResponse{
+		Clock:       clock,
+		CommunityId: a.CommunityID,
+		Hash:        a.Hash,
+		Data:        a.Data,
+		Signature:   a.Signature,
+	}
+}
+
+// WindowStart returns the start of the archive window timestamp falls in
+func WindowStart(timestamp uint64) uint64 {
+	return timestamp - timestamp%MessageArchiveWindow
+}
+
+func ToWakuMessage(message *types.Message) *protobuf.WakuMessage {
+	return &protobuf.WakuMessage{
+		Sig:       message.Sig,
+		Timestamp: message.Timestamp,
+		Topic:     message.Topic[:],
+		Payload:   message.Payload,
+		Padding:   message.Padding,
+		Hash:      message.Hash,
+	}
+}
+
+func FromWakuMessage(message *protobuf.WakuMessage) *types.Message {
+	return &types.Message{
+		Sig:       message.Sig,
+		Timestamp: message.Timestamp,
+		Topic:     types.BytesToTopic(message.Topic),
+		Payload:   message.Payload,
+		Padding:   message.Padding,
+		Hash:      message.Hash,
+	}
+}
+
+// BuildMessageArchives bundles the envelopes of a chat, received between
+// from and to, in one or more archives signed with the community key.
+// Envelopes must be sorted by timestamp.
+func BuildMessageArchives(key *ecdsa.PrivateKey, communityID types.HexBytes, chatID string, from, to uint64, envelopes []*protobuf.WakuMessage) ([]*MessageArchive, error) {
+	var archives []*MessageArchive
+
+	var current []*protobuf.WakuMessage
+	var currentSize int
+	currentFrom := from
+
+	flush := func(partTo uint64) error {
+		archive, err := buildMessageArchive(key, communityID, chatID, currentFrom, partTo, current)
+		if err != nil {
+			return err
+		}
+		archives = append(archives, archive)
+		current = nil
+		currentSize = 0
+		currentFrom = partTo
+		return nil
+	}
+
+	for _, envelope := range envelopes {
+		size := len(envelope.Payload)
+		if len(current) != 0 && currentSize+size > maxMessageArchiveSize {
+			if err := flush(uint64(envelope.Timestamp)); err != nil {
+				return nil, err
+			}
+		}
+		current = append(current, envelope)
+		currentSize += size
+	}
+
+	if len(current) != 0 {
+		if err := flush(to); err != nil {
+			return nil, err
+		}
+	}
+
+	return archives, nil
+}
+
+func buildMessageArchive(key *ecdsa.PrivateKey, communityID types.HexBytes, chatID string, from, to uint64, envelopes []*protobuf.WakuMessage) (*MessageArchive, error) {
+	payload, err := proto.Marshal(&protobuf.CommunityMessageArchive{
+		ChatId:   chatID,
+		From:     from,
+		To:       to,
+		Messages: envelopes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write(payload); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	data := buf.Bytes()
+	hash := crypto.Keccak256(data)
+	signature, err := crypto.Sign(hash, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MessageArchive{
+		CommunityID: communityID,
+		ChatID:      chatID,
+		From:        from,
+		To:          to,
+		Count:       uint32(len(envelopes)),
+		Data:        data,
+		Hash:        hash,
+		Signature:   signature,
+	}, nil
+}
+
+// VerifyMessageArchive checks that data hashes to hash and that it
+// has been signed by the community
+func VerifyMessageArchive(communityPublicKey *ecdsa.PublicKey, hash, data, signature []byte) error {
+	if !bytes.Equal(crypto.Keccak256(data), hash) {
+		return ErrInvalidMessageArchive
+	}
+
+	signer, err := crypto.SigToPub(hash, signature)
+	if err != nil {
+		return ErrInvalidMessageArchive
+	}
+
+	if !common.IsPubKeyEqual(signer, communityPublicKey) {
+		return ErrInvalidMessageArchive
+	}
+	return nil
+}
+
+// OpenMessageArchive decompresses and decodes an archive
+func OpenMessageArchive(data []byte) (*protobuf.CommunityMessageArchive, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	payload, err := ioutil.ReadAll(io.LimitReader(reader, maxMessageArchiveDecompressedSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(payload) > maxMessageArchiveDecompressedSize {
+		return nil, ErrInvalidMessageArchive
+	}
+
+	archive := &protobuf.CommunityMessageArchive{}
+	if err := proto.Unmarshal(payload, archive); err != nil {
+		return nil, err
+	}
+	return archive, nil
+}
@@ -0,0 +1,63 @@
+package communities
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func TestBuildMessageArchivesSplitsLargeWindows(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	payload := bytes.Repeat([]byte{0x01}, maxMessageArchiveSize/2+1)
+	envelopes := []*protobuf.WakuMessage{
+		{Timestamp: 10, Payload: payload, Hash: []byte{0x01}},
+		{Timestamp: 20, Payload: payload, Hash: []byte{0x02}},
+		{Timestamp: 30, Payload: []byte{0x03}, Hash: []byte{0x03}},
+	}
+
+	archives, err := BuildMessageArchives(key, []byte{0x01}, "chat-id", 0, MessageArchiveWindow, envelopes)
+	require.NoError(t, err)
+	require.Len(t, archives, 2)
+
+	require.Equal(t, uint64(0), archives[0].From)
+	require.Equal(t, uint64(20), archives[0].To)
+	require.Equal(t, uint32(1), archives[0].Count)
+	require.Equal(t, uint64(20), archives[1].From)
+	require.Equal(t, uint64(MessageArchiveWindow), archives[1].To)
+	require.Equal(t, uint32(2), archives[1].Count)
+
+	for _, archive := range archives {
+		require.NoError(t, VerifyMessageArchive(&key.PublicKey, archive.Hash, archive.Data, archive.Signature))
+	}
+
+	content, err := OpenMessageArchive(archives[1].Data)
+	require.NoError(t, err)
+	require.Equal(t, "chat-id", content.ChatId)
+	require.Len(t, content.Messages, 2)
+	require.Equal(t, uint32(30), content.Messages[1].Timestamp)
+}
+
+func TestVerifyMessageArchive(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	archives, err := BuildMessageArchives(key, []byte{0x01}, "chat-id", 0, MessageArchiveWindow, []*protobuf.WakuMessage{{Timestamp: 10, Payload: []byte{0x01}}})
+	require.NoError(t, err)
+	require.Len(t, archives, 1)
+	archive := archives[0]
+
+	require.NoError(t, VerifyMessageArchive(&key.PublicKey, archive.Hash, archive.Data, archive.Signature))
+	require.Equal(t, ErrInvalidMessageArchive, VerifyMessageArchive(&otherKey.PublicKey, archive.Hash, archive.Data, archive.Signature))
+
+	tampered := append([]byte{}, archive.Data...)
+	tampered[len(tampered)-1] ^= 0xff
+	require.Equal(t, ErrInvalidMessageArchive, VerifyMessageArchive(&key.PublicKey, archive.Hash, tampered, archive.Signature))
+}
@@ -4,12 +4,14 @@ import (
 	"context"
 	"crypto/ecdsa"
 	"database/sql"
+	"encoding/json"
 	"errors"
 
 	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
 
 	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
 	"github.com/status-im/status-go/protocol/common"
 	"github.com/status-im/status-go/protocol/protobuf"
 )
@@ -246,3 +248,361 @@ func (p *Persistence) GetRequestToJoin(id []byte) (*RequestToJoin, error) {
 
 	return request, nil
 }
+
+func (p *Persistence) SaveMessageArchiveEnvelope(communityID []byte, chatID string, envelope *protobuf.WakuMessage) error {
+	payload, err := proto.Marshal(envelope)
+	if err != nil {
+		return err
+	}
+	_, err = p.db.Exec(`INSERT INTO community_message_archive_envelopes (hash, community_id, chat_id, timestamp, envelope) VALUES (?, ?, ?, ?, ?)`, envelope.Hash, communityID, chatID, envelope.Timestamp, payload)
+	return err
+}
+
+// OldestMessageArchiveEnvelopes returns, for each chat of the community,
+// the timestamp of the oldest envelope which has not been archived yet
+func (p *Persistence) OldestMessageArchiveEnvelopes(communityID []byte) (map[string]uint64, error) {
+	rows, err := p.db.Query(`SELECT chat_id, MIN(timestamp) FROM community_message_archive_envelopes WHERE community_id = ? GROUP BY chat_id`, communityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	response := make(map[string]uint64)
+	for rows.Next() {
+		var chatID string
+		var timestamp uint64
+		if err := rows.Scan(&chatID, &timestamp); err != nil {
+			return nil, err
+		}
+		response[chatID] = timestamp
+	}
+	return response, nil
+}
+
+func (p *Persistence) MessageArchiveEnvelopes(communityID []byte, chatID string, from, to uint64) ([]*protobuf.WakuMessage, error) {
+	rows, err := p.db.Query(`SELECT envelope FROM community_message_archive_envelopes WHERE community_id = ? AND chat_id = ? AND timestamp >= ? AND timestamp < ? ORDER BY timestamp`, communityID, chatID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var envelopes []*protobuf.WakuMessage
+	for rows.Next() {
+		var payload []byte
+		if err := rows.Scan(&payload); err != nil {
+			return nil, err
+		}
+		envelope := &protobuf.WakuMessage{}
+		if err := proto.Unmarshal(payload, envelope); err != nil {
+			return nil, err
+		}
+		envelopes = append(envelopes, envelope)
+	}
+	return envelopes, nil
+}
+
+// SaveMessageArchives stores the archives built for a chat and deletes the
+// envelopes they bundle, which were received between from and to
+func (p *Persistence) SaveMessageArchives(communityID []byte, chatID string, from, to uint64, archives []*MessageArchive) (err error) {
+	tx, err := p.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	for _, archive := range archives {
+		err = saveMessageArchive(tx, archive)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.Exec(`DELETE FROM community_message_archive_envelopes WHERE community_id = ? AND chat_id = ? AND timestamp >= ? AND timestamp < ?`, communityID, chatID, from, to)
+	return err
+}
+
+// SaveReceivedMessageArchive stores an archive received from the community,
+// to be imported
+func (p *Persistence) SaveReceivedMessageArchive(archive *MessageArchive) error {
+	tx, err := p.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+
+	err = saveMessageArchive(tx, archive)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func saveMessageArchive(tx *sql.Tx, archive *MessageArchive) error {
+	_, err := tx.Exec(`INSERT INTO community_message_archives (hash, community_id, chat_id, from_timestamp, to_timestamp, messages_count, data, signature, imported) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`, archive.Hash, archive.CommunityID, archive.ChatID, archive.From, archive.To, archive.Count, archive.Data, archive.Signature, archive.Imported)
+	return err
+}
+
+const messageArchivesBaseQuery = `SELECT hash, community_id, chat_id, from_timestamp, to_timestamp, messages_count, data, signature, imported FROM community_message_archives`
+
+func (p *Persistence) queryMessageArchives(query string, args ...interface{}) ([]*MessageArchive, error) {
+	rows, err := p.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var archives []*MessageArchive
+	for rows.Next() {
+		archive := &MessageArchive{}
+		err := rows.Scan(&archive.Hash, &archive.CommunityID, &archive.ChatID, &archive.From, &archive.To, &archive.Count, &archive.Data, &archive.Signature, &archive.Imported)
+		if err != nil {
+			return nil, err
+		}
+		archives = append(archives, archive)
+	}
+	return archives, nil
+}
+
+// GetMessageArchive returns the archive with the given hash, or nil if we don't have it
+func (p *Persistence) GetMessageArchive(hash []byte) (*MessageArchive, error) {
+	archives, err := p.queryMessageArchives(messageArchivesBaseQuery+` WHERE hash = ?`, hash)
+	if err != nil {
+		return nil, err
+	}
+	if len(archives) == 0 {
+		return nil, nil
+	}
+	return archives[0], nil
+}
+
+func (p *Persistence) UnimportedMessageArchives(limit int) ([]*MessageArchive, error) {
+	return p.queryMessageArchives(messageArchivesBaseQuery+` WHERE NOT imported ORDER BY from_timestamp LIMIT ?`, limit)
+}
+
+func (p *Persistence) SetMessageArchiveImported(hash []byte) error {
+	_, err := p.db.Exec(`UPDATE community_message_archives SET imported = 1 WHERE hash = ?`, hash)
+	return err
+}
+
+// MessageArchiveRequests returns when each archive of the community was last requested
+func (p *Persistence) MessageArchiveRequests(communityID []byte) (map[string]uint64, error) {
+	rows, err := p.db.Query(`SELECT hash, requested_at FROM community_message_archive_requests WHERE community_id = ?`, communityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	response := make(map[string]uint64)
+	for rows.Next() {
+		var hash []byte
+		var requestedAt uint64
+		if err := rows.Scan(&hash, &requestedAt); err != nil {
+			return nil, err
+		}
+		response[types.EncodeHex(hash)] = requestedAt
+	}
+	return response, nil
+}
+
+func (p *Persistence) SetMessageArchivesRequested(communityID []byte, hashes [][]byte, requestedAt uint64) (err error) {
+	tx, err := p.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	for _, hash := range hashes {
+		_, err = tx.Exec(`INSERT INTO community_message_archive_requests (hash, community_id, requested_at) VALUES (?, ?, ?)`, hash, communityID, requestedAt)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *Persistence) SaveControlSignatureRequest(communityID []byte, clock uint64, description []byte) error {
+	_, err := p.db.Exec(`INSERT INTO communities_control_signature_requests (community_id, clock, description) VALUES (?, ?, ?)`, communityID, clock, description)
+	return err
+}
+
+func (p *Persistence) queryControlSignatureRequests(query string, args ...interface{}) ([]*ControlSignatureRequest, error) {
+	rows, err := p.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var requests []*ControlSignatureRequest
+	for rows.Next() {
+		request := &ControlSignatureRequest{}
+		var descriptionBytes []byte
+		err := rows.Scan(&request.CommunityID, &request.Clock, &descriptionBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		request.Description = &protobuf.CommunityDescription{}
+		err = proto.Unmarshal(descriptionBytes, request.Description)
+		if err != nil {
+			return nil, err
+		}
+
+		request.StateHash, err = ControlStateHash(request.CommunityID, request.Description)
+		if err != nil {
+			return nil, err
+		}
+
+		requests = append(requests, request)
+	}
+	return requests, nil
+}
+
+func (p *Persistence) ControlSignatureRequests() ([]*ControlSignatureRequest, error) {
+	return p.queryControlSignatureRequests(`SELECT community_id, clock, description FROM communities_control_signature_requests`)
+}
+
+// GetControlSignatureRequest returns the pending request for the community, or nil if there's none
+func (p *Persistence) GetControlSignatureRequest(communityID []byte) (*ControlSignatureRequest, error) {
+	requests, err := p.queryControlSignatureRequests(`SELECT community_id, clock, description FROM communities_control_signature_requests WHERE community_id = ?`, communityID)
+	if err != nil {
+		return nil, err
+	}
+	if len(requests) == 0 {
+		return nil, nil
+	}
+	return requests[0], nil
+}
+
+func (p *Persistence) DeleteControlSignatureRequest(communityID []byte) error {
+	_, err := p.db.Exec(`DELETE FROM communities_control_signature_requests WHERE community_id = ?`, communityID)
+	return err
+}
+
+func (p *Persistence) SaveDirectoryListing(listing *protobuf.CommunityDirectoryListing) error {
+	tags, err := json.Marshal(listing.Tags)
+	if err != nil {
+		return err
+	}
+
+	_, err = p.db.Exec(`INSERT INTO communities_directory_listings (community_id, clock, name, description, tags, members_count, image, access) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`, listing.CommunityId, listing.Clock, listing.Name, listing.Description, string(tags), listing.MembersCount, listing.Image, listing.Access)
+	return err
+}
+
+func (p *Persistence) queryDirectoryListings(query string, args ...interface{}) ([]*DirectoryListing, error) {
+	rows, err := p.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var listings []*DirectoryListing
+	for rows.Next() {
+		listing := &DirectoryListing{}
+		var tags string
+		var image []byte
+		err := rows.Scan(&listing.CommunityID, &listing.Clock, &listing.Name, &listing.Description, &tags, &listing.MembersCount, &image, &listing.Access, &listing.Flagged)
+		if err != nil {
+			return nil, err
+		}
+		listing.Image = image
+
+		err = json.Unmarshal([]byte(tags), &listing.Tags)
+		if err != nil {
+			return nil, err
+		}
+
+		listings = append(listings, listing)
+	}
+	return listings, nil
+}
+
+const directoryListingsBaseQuery = `SELECT l.community_id, l.clock, l.name, l.description, l.tags, l.members_count, l.image, l.access, f.community_id IS NOT NULL FROM communities_directory_listings l LEFT JOIN communities_directory_flags f ON l.community_id = f.community_id`
+
+// DirectoryListings returns the listings published after since, most recent first
+func (p *Persistence) DirectoryListings(since uint64) ([]*DirectoryListing, error) {
+	return p.queryDirectoryListings(directoryListingsBaseQuery+` WHERE l.clock >= ? ORDER BY l.clock DESC`, since)
+}
+
+// GetDirectoryListing returns the listing of the community, or nil if there's none
+func (p *Persistence) GetDirectoryListing(communityID []byte) (*DirectoryListing, error) {
+	listings, err := p.queryDirectoryListings(directoryListingsBaseQuery+` WHERE l.community_id = ?`, communityID)
+	if err != nil {
+		return nil, err
+	}
+	if len(listings) == 0 {
+		return nil, nil
+	}
+	return listings[0], nil
+}
+
+func (p *Persistence) DeleteDirectoryListingsBefore(clock uint64) error {
+	_, err := p.db.Exec(`DELETE FROM communities_directory_listings WHERE clock < ?`, clock)
+	return err
+}
+
+func (p *Persistence) SetDirectoryListingFlagged(communityID []byte, flagged bool) error {
+	if flagged {
+		_, err := p.db.Exec(`INSERT INTO communities_directory_flags (community_id) VALUES (?)`, communityID)
+		return err
+	}
+	_, err := p.db.Exec(`DELETE FROM communities_directory_flags WHERE community_id = ?`, communityID)
+	return err
+}
+
+func (p *Persistence) SaveOwnDirectoryListing(communityID []byte, tags []string) error {
+	encodedTags, err := json.Marshal(tags)
+	if err != nil {
+		return err
+	}
+
+	_, err = p.db.Exec(`INSERT INTO communities_directory_own_listings (community_id, tags) VALUES (?, ?)`, communityID, string(encodedTags))
+	return err
+}
+
+// OwnDirectoryListings returns the tags of the communities we list in the directory, by community ID
+func (p *Persistence) OwnDirectoryListings() (map[string][]string, error) {
+	rows, err := p.db.Query(`SELECT community_id, tags FROM communities_directory_own_listings`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	listings := make(map[string][]string)
+	for rows.Next() {
+		var communityID types.HexBytes
+		var encodedTags string
+		err := rows.Scan(&communityID, &encodedTags)
+		if err != nil {
+			return nil, err
+		}
+
+		var tags []string
+		err = json.Unmarshal([]byte(encodedTags), &tags)
+		if err != nil {
+			return nil, err
+		}
+		listings[communityID.String()] = tags
+	}
+	return listings, nil
+}
+
+func (p *Persistence) DeleteOwnDirectoryListing(communityID []byte) error {
+	_, err := p.db.Exec(`DELETE FROM communities_directory_own_listings WHERE community_id = ?`, communityID)
+	return err
+}
@@ -50,6 +50,34 @@ func validateCommunityCategory(category *protobuf.CommunityCategory) error {
 	return nil
 }
 
+func validateCommunityEmoji(emoji *protobuf.CommunityEmoji) error {
+	if len(emoji.EmojiId) == 0 {
+		return ErrInvalidCommunityDescriptionEmojiNoID
+	}
+
+	if len(emoji.Name) == 0 {
+		return ErrInvalidCommunityDescriptionEmojiNoName
+	}
+
+	if emoji.Image == nil || len(emoji.Image.Payload) == 0 {
+		return ErrInvalidCommunityDescriptionEmojiNoImage
+	}
+
+	return nil
+}
+
+func validateCommunityMessageArchiveIndexEntry(entry *protobuf.CommunityMessageArchiveIndexEntry) error {
+	if len(entry.Hash) != 32 {
+		return ErrInvalidCommunityDescriptionArchiveIndex
+	}
+
+	if len(entry.ChatId) == 0 || entry.To <= entry.From {
+		return ErrInvalidCommunityDescriptionArchiveIndex
+	}
+
+	return nil
+}
+
 func ValidateCommunityDescription(desc *protobuf.CommunityDescription) error {
 	if desc == nil {
 		return ErrInvalidCommunityDescription
@@ -73,5 +101,20 @@ func ValidateCommunityDescription(desc *protobuf.CommunityDescription) error {
 		}
 	}
 
+	for _, emoji := range desc.Emojis {
+		if err := validateCommunityEmoji(emoji); err != nil {
+			return err
+		}
+	}
+
+	if len(desc.ArchiveIndex) > maxMessageArchiveIndexEntries {
+		return ErrInvalidCommunityDescriptionArchiveIndex
+	}
+	for _, entry := range desc.ArchiveIndex {
+		if err := validateCommunityMessageArchiveIndexEntry(entry); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
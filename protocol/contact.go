@@ -16,6 +16,23 @@ const (
 	contactRequestReceived = ":contact/request-received"
 )
 
+// TrustStatus is the outcome of an out-of-band verification of a contact
+type TrustStatus int
+
+const (
+	// TrustStatusUnknown is the status of contacts that haven't been verified
+	TrustStatusUnknown TrustStatus = iota
+	// TrustStatusTrusted is set when the identity of the contact has been confirmed
+	TrustStatusTrusted
+	// TrustStatusUntrustworthy is set when the verification of the contact failed
+	TrustStatusUntrustworthy
+)
+
+// Valid returns whether the value is one of the known trust statuses
+func (s TrustStatus) Valid() bool {
+	return s >= TrustStatusUnknown && s <= TrustStatusUntrustworthy
+}
+
 // ContactDeviceInfo is a struct containing information about a particular device owned by a contact
 type ContactDeviceInfo struct {
 	// The installation id of the device
@@ -68,6 +85,9 @@ type Contact struct {
 	LocalNickname string              `json:"localNickname,omitempty"`
 
 	Images map[string]images.IdentityImage `json:"images"`
+
+	// TrustStatus is the result of the last verification of the contact
+	TrustStatus TrustStatus `json:"trustStatus"`
 }
 
 func (c Contact) PublicKey() (*ecdsa.PublicKey, error) {
@@ -149,7 +169,7 @@ func buildContact(publicKeyString string, publicKey *ecdsa.PublicKey) (*Contact,
 // HasCustomFields returns whether the the contact has any field that is valuable
 // to the client other than the computed name/image
 func (c Contact) HasCustomFields() bool {
-	return c.IsAdded() || c.HasBeenAdded() || c.IsBlocked() || c.ENSVerified || c.LocalNickname != "" || len(c.Images) != 0
+	return c.IsAdded() || c.HasBeenAdded() || c.IsBlocked() || c.ENSVerified || c.LocalNickname != "" || len(c.Images) != 0 || c.TrustStatus != TrustStatusUnknown
 }
 
 func contactIDFromPublicKey(key *ecdsa.PublicKey) string {
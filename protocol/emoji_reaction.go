@@ -9,9 +9,12 @@ import (
 
 	"github.com/status-im/status-go/eth-node/crypto"
 	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/emojis"
 	"github.com/status-im/status-go/protocol/protobuf"
 )
 
+const communityEmojiKeyPrefix = "community:"
+
 // EmojiReaction represents an emoji reaction from a user in the application layer, used for persistence, querying and
 // signaling
 type EmojiReaction struct {
@@ -27,9 +30,38 @@ type EmojiReaction struct {
 	LocalChatID string `json:"localChatId"`
 }
 
-// ID is the Keccak256() contatenation of From-MessageID-EmojiType
+// ID is the Keccak256() contatenation of From-MessageID-EmojiType.
+// Reactions which don't map to a legacy type use the emoji sequence,
+// or the community emoji id, in place of the type
 func (e EmojiReaction) ID() string {
-	return types.EncodeHex(crypto.Keccak256([]byte(fmt.Sprintf("%s%s%d", e.From, e.MessageId, e.Type))))
+	if e.Type != protobuf.EmojiReaction_UNKNOWN_EMOJI_REACTION_TYPE {
+		return types.EncodeHex(crypto.Keccak256([]byte(fmt.Sprintf("%s%s%d", e.From, e.MessageId, e.Type))))
+	}
+	return types.EncodeHex(crypto.Keccak256([]byte(fmt.Sprintf("%s%s%s", e.From, e.MessageId, e.Key()))))
+}
+
+// Key identifies the emoji the user reacted with
+func (e EmojiReaction) Key() string {
+	if e.CommunityEmojiId != "" {
+		return communityEmojiKeyPrefix + e.CommunityEmojiId
+	}
+	return e.Emoji
+}
+
+// normalize makes sure that both the legacy type and the emoji are set,
+// so that the reaction can be displayed by old and new clients
+func (e *EmojiReaction) normalize() {
+	if e.CommunityEmojiId != "" {
+		return
+	}
+	if e.Emoji != "" && e.Type == protobuf.EmojiReaction_UNKNOWN_EMOJI_REACTION_TYPE {
+		e.Type = emojis.ToReactionType(e.Emoji)
+	}
+	// Legacy emoji are always stored in the same form, so that
+	// they are counted together regardless of the variation selector
+	if legacyEmoji := emojis.FromReactionType(e.Type); legacyEmoji != "" {
+		e.Emoji = legacyEmoji
+	}
 }
 
 // GetSigPubKey returns an ecdsa encoded public key
@@ -52,26 +84,30 @@ func (e *EmojiReaction) SetMessageType(messageType protobuf.MessageType) {
 
 func (e EmojiReaction) MarshalJSON() ([]byte, error) {
 	item := struct {
-		ID          string                      `json:"id"`
-		Clock       uint64                      `json:"clock,omitempty"`
-		ChatID      string                      `json:"chatId,omitempty"`
-		LocalChatID string                      `json:"localChatId,omitempty"`
-		From        string                      `json:"from"`
-		MessageID   string                      `json:"messageId,omitempty"`
-		MessageType protobuf.MessageType        `json:"messageType,omitempty"`
-		Retracted   bool                        `json:"retracted,omitempty"`
-		EmojiID     protobuf.EmojiReaction_Type `json:"emojiId,omitempty"`
+		ID               string                      `json:"id"`
+		Clock            uint64                      `json:"clock,omitempty"`
+		ChatID           string                      `json:"chatId,omitempty"`
+		LocalChatID      string                      `json:"localChatId,omitempty"`
+		From             string                      `json:"from"`
+		MessageID        string                      `json:"messageId,omitempty"`
+		MessageType      protobuf.MessageType        `json:"messageType,omitempty"`
+		Retracted        bool                        `json:"retracted,omitempty"`
+		EmojiID          protobuf.EmojiReaction_Type `json:"emojiId,omitempty"`
+		Emoji            string                      `json:"emoji,omitempty"`
+		CommunityEmojiID string                      `json:"communityEmojiId,omitempty"`
 	}{
 
-		ID:          e.ID(),
-		Clock:       e.Clock,
-		ChatID:      e.ChatId,
-		LocalChatID: e.LocalChatID,
-		From:        e.From,
-		MessageID:   e.MessageId,
-		MessageType: e.MessageType,
-		Retracted:   e.Retracted,
-		EmojiID:     e.Type,
+		ID:               e.ID(),
+		Clock:            e.Clock,
+		ChatID:           e.ChatId,
+		LocalChatID:      e.LocalChatID,
+		From:             e.From,
+		MessageID:        e.MessageId,
+		MessageType:      e.MessageType,
+		Retracted:        e.Retracted,
+		EmojiID:          e.Type,
+		Emoji:            e.Emoji,
+		CommunityEmojiID: e.CommunityEmojiId,
 	}
 
 	return json.Marshal(item)
@@ -81,3 +117,13 @@ func (e EmojiReaction) MarshalJSON() ([]byte, error) {
 func (e EmojiReaction) WrapGroupMessage() bool {
 	return false
 }
+
+// EmojiReactionCount is the number of users who reacted to a message with an emoji
+type EmojiReactionCount struct {
+	Emoji            string                      `json:"emoji,omitempty"`
+	CommunityEmojiID string                      `json:"communityEmojiId,omitempty"`
+	EmojiID          protobuf.EmojiReaction_Type `json:"emojiId,omitempty"`
+	Count            int                         `json:"count"`
+	// Reacted is true if the current user is among the ones who reacted
+	Reacted bool `json:"reacted"`
+}
@@ -0,0 +1,135 @@
+package emojis
+
+import (
+	"errors"
+	"unicode/utf8"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// maxRunes is the maximum number of code points of an emoji sequence,
+// long enough for the longest ZWJ and tag sequences
+const maxRunes = 16
+
+var (
+	ErrEmptyEmoji   = errors.New("emoji can't be empty")
+	ErrInvalidEmoji = errors.New("invalid emoji sequence")
+)
+
+const (
+	zeroWidthJoiner   = 0x200D
+	variationSelector = 0xFE0F
+	textPresentation  = 0xFE0E
+	combiningKeycap   = 0x20E3
+)
+
+// legacyTypes maps the reaction types supported by older clients to their emoji
+var legacyTypes = map[protobuf.EmojiReaction_Type]string{
+	protobuf.EmojiReaction_LOVE:        "❤️",
+	protobuf.EmojiReaction_THUMBS_UP:   "👍",
+	protobuf.EmojiReaction_THUMBS_DOWN: "👎",
+	protobuf.EmojiReaction_LAUGH:       "😂",
+	protobuf.EmojiReaction_SAD:         "😢",
+	protobuf.EmojiReaction_ANGRY:       "😡",
+}
+
+// FromReactionType returns the emoji of a legacy reaction type,
+// or an empty string if the type is unknown
+func FromReactionType(t protobuf.EmojiReaction_Type) string {
+	return legacyTypes[t]
+}
+
+// ToReactionType returns the legacy reaction type of an emoji,
+// so that older clients can display it
+func ToReactionType(emoji string) protobuf.EmojiReaction_Type {
+	for t, e := range legacyTypes {
+		if e == emoji || e == trimVariationSelector(emoji) || trimVariationSelector(e) == emoji {
+			return t
+		}
+	}
+	return protobuf.EmojiReaction_UNKNOWN_EMOJI_REACTION_TYPE
+}
+
+// Validate checks that the string is a single emoji sequence
+func Validate(emoji string) error {
+	if len(emoji) == 0 {
+		return ErrEmptyEmoji
+	}
+
+	if !utf8.ValidString(emoji) || utf8.RuneCountInString(emoji) > maxRunes {
+		return ErrInvalidEmoji
+	}
+
+	hasPictograph := false
+	hasKeycap := false
+	hasKeycapBase := false
+	for _, r := range emoji {
+		switch {
+		case isPictograph(r):
+			hasPictograph = true
+		case r == combiningKeycap:
+			hasKeycap = true
+		case isKeycapBase(r):
+			hasKeycapBase = true
+		case isComponent(r):
+		default:
+			return ErrInvalidEmoji
+		}
+	}
+
+	// Digits, # and * are only emoji when followed by a keycap
+	if hasKeycapBase && !hasKeycap {
+		return ErrInvalidEmoji
+	}
+
+	if !hasPictograph && !hasKeycap {
+		return ErrInvalidEmoji
+	}
+
+	return nil
+}
+
+func trimVariationSelector(emoji string) string {
+	r, size := utf8.DecodeLastRuneInString(emoji)
+	if r == variationSelector {
+		return emoji[:len(emoji)-size]
+	}
+	return emoji
+}
+
+func isPictograph(r rune) bool {
+	switch {
+	case r >= 0x1F000 && r <= 0x1FAFF: // Emoticons, pictographs, transport, flags, skin tones...
+		return true
+	case r >= 0x2600 && r <= 0x27BF: // Miscellaneous symbols and dingbats
+		return true
+	case r >= 0x2300 && r <= 0x23FF: // Miscellaneous technical
+		return true
+	case r >= 0x2190 && r <= 0x21FF, r >= 0x2900 && r <= 0x297F: // Arrows
+		return true
+	case r >= 0x2B00 && r <= 0x2BFF: // Miscellaneous symbols and arrows
+		return true
+	case r >= 0x25A0 && r <= 0x25FF: // Geometric shapes
+		return true
+	}
+
+	switch r {
+	case 0x00A9, 0x00AE, 0x203C, 0x2049, 0x2122, 0x2139, 0x24C2, 0x3030, 0x303D, 0x3297, 0x3299:
+		return true
+	}
+	return false
+}
+
+func isKeycapBase(r rune) bool {
+	return (r >= '0' && r <= '9') || r == '#' || r == '*'
+}
+
+func isComponent(r rune) bool {
+	switch {
+	case r == zeroWidthJoiner, r == variationSelector, r == textPresentation:
+		return true
+	case r >= 0xE0020 && r <= 0xE007F: // Tags, used by subdivision flags
+		return true
+	}
+	return false
+}
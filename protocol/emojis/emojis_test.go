@@ -0,0 +1,54 @@
+package emojis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func TestValidate(t *testing.T) {
+	valid := []string{
+		"👍",
+		"❤️",
+		"❤",
+		"👍🏽",
+		"👨‍👩‍👧‍👦",
+		"🏳️‍🌈",
+		"🇨🇭",
+		"🏴󠁧󠁢󠁳󠁣󠁴󠁿",
+		"1️⃣",
+		"#️⃣",
+		"©️",
+	}
+	for _, e := range valid {
+		require.NoError(t, Validate(e), e)
+	}
+
+	invalid := []string{
+		"",
+		"a",
+		"1",
+		"👍 ",
+		"👍a",
+		"‍",
+		"\xff",
+		"👍👍👍👍👍👍👍👍👍👍👍👍👍👍👍👍👍",
+	}
+	for _, e := range invalid {
+		require.Error(t, Validate(e), e)
+	}
+}
+
+func TestLegacyTypes(t *testing.T) {
+	for i := protobuf.EmojiReaction_LOVE; i <= protobuf.EmojiReaction_ANGRY; i++ {
+		emoji := FromReactionType(i)
+		require.NoError(t, Validate(emoji))
+		require.Equal(t, i, ToReactionType(emoji))
+	}
+
+	require.Equal(t, protobuf.EmojiReaction_LOVE, ToReactionType("❤"))
+	require.Equal(t, protobuf.EmojiReaction_UNKNOWN_EMOJI_REACTION_TYPE, ToReactionType("🦄"))
+	require.Equal(t, "", FromReactionType(protobuf.EmojiReaction_UNKNOWN_EMOJI_REACTION_TYPE))
+}
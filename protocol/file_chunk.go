@@ -0,0 +1,43 @@
+package protocol
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// FileChunk represents a chunk of a file attached to a message in the application layer
+type FileChunk struct {
+	protobuf.FileChunk
+
+	// From is a public key of the sender of the file
+	From string `json:"from,omitempty"`
+
+	// SigPubKey is the ecdsa encoded public key of the sender of the file
+	SigPubKey *ecdsa.PublicKey `json:"-"`
+}
+
+// GetSigPubKey returns an ecdsa encoded public key
+// this function is required to implement the ChatEntity interface
+func (c FileChunk) GetSigPubKey() *ecdsa.PublicKey {
+	return c.SigPubKey
+}
+
+// GetProtoBuf returns the struct's embedded protobuf struct
+// this function is required to implement the ChatEntity interface
+func (c *FileChunk) GetProtobuf() proto.Message {
+	return &c.FileChunk
+}
+
+// SetMessageType a setter for the MessageType field
+// this function is required to implement the ChatEntity interface
+func (c *FileChunk) SetMessageType(messageType protobuf.MessageType) {
+	c.MessageType = messageType
+}
+
+// WrapGroupMessage indicates whether we should wrap this in membership information
+func (c FileChunk) WrapGroupMessage() bool {
+	return false
+}
@@ -0,0 +1,134 @@
+package files
+
+import (
+	"sync"
+	"time"
+)
+
+// BufferedChunk is a chunk received before the message describing its file
+type BufferedChunk struct {
+	ChatID      string
+	MessageID   string
+	Index       uint32
+	ChunksCount uint32
+	Payload     []byte
+	receivedAt  time.Time
+}
+
+// ChunksBuffer holds in memory the chunks received before the message
+// describing their file. Each sender can only have a few chunks buffered,
+// and they are dropped if the message isn't received in time.
+type ChunksBuffer struct {
+	mu           sync.Mutex
+	ttl          time.Duration
+	maxPerSender int
+	maxChunks    int
+	count        int
+	chunks       map[string][]*BufferedChunk // Chunks by sender, oldest first
+}
+
+func NewChunksBuffer(ttl time.Duration, maxPerSender int, maxChunks int) *ChunksBuffer {
+	return &ChunksBuffer{
+		ttl:          ttl,
+		maxPerSender: maxPerSender,
+		maxChunks:    maxChunks,
+		chunks:       make(map[string][]*BufferedChunk),
+	}
+}
+
+// Add buffers a chunk of the sender, evicting the oldest chunk
+// of the sender, or of the buffer, if they are full
+func (b *ChunksBuffer) Add(sender string, chunk *BufferedChunk, now time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.expire(now)
+
+	if len(b.chunks[sender]) >= b.maxPerSender {
+		b.removeFirst(sender)
+	}
+	if b.count >= b.maxChunks {
+		b.removeOldest()
+	}
+
+	chunk.receivedAt = now
+	b.chunks[sender] = append(b.chunks[sender], chunk)
+	b.count++
+}
+
+// Take removes and returns the chunks of the file attached to the message of the sender
+func (b *ChunksBuffer) Take(sender string, messageID string) []*BufferedChunk {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var taken []*BufferedChunk
+	var kept []*BufferedChunk
+	for _, chunk := range b.chunks[sender] {
+		if chunk.MessageID == messageID {
+			taken = append(taken, chunk)
+		} else {
+			kept = append(kept, chunk)
+		}
+	}
+
+	b.count -= len(taken)
+	if len(kept) == 0 {
+		delete(b.chunks, sender)
+	} else {
+		b.chunks[sender] = kept
+	}
+
+	return taken
+}
+
+// Expire drops the chunks which have been buffered for too long
+func (b *ChunksBuffer) Expire(now time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.expire(now)
+}
+
+// Len returns the number of buffered chunks
+func (b *ChunksBuffer) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.count
+}
+
+func (b *ChunksBuffer) expire(now time.Time) {
+	for sender, chunks := range b.chunks {
+		for len(chunks) != 0 && now.Sub(chunks[0].receivedAt) > b.ttl {
+			chunks = chunks[1:]
+			b.count--
+		}
+		if len(chunks) == 0 {
+			delete(b.chunks, sender)
+		} else {
+			b.chunks[sender] = chunks
+		}
+	}
+}
+
+func (b *ChunksBuffer) removeFirst(sender string) {
+	chunks := b.chunks[sender][1:]
+	b.count--
+	if len(chunks) == 0 {
+		delete(b.chunks, sender)
+	} else {
+		b.chunks[sender] = chunks
+	}
+}
+
+func (b *ChunksBuffer) removeOldest() {
+	var oldest string
+	var oldestAt time.Time
+	for sender, chunks := range b.chunks {
+		if oldest == "" || chunks[0].receivedAt.Before(oldestAt) {
+			oldest = sender
+			oldestAt = chunks[0].receivedAt
+		}
+	}
+	if oldest != "" {
+		b.removeFirst(oldest)
+	}
+}
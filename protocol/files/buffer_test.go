@@ -0,0 +1,61 @@
+package files
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChunksBuffer(t *testing.T) {
+	buffer := NewChunksBuffer(time.Minute, 2, 3)
+	now := time.Now()
+
+	buffer.Add("alice", &BufferedChunk{MessageID: "0x01", Index: 0}, now)
+	buffer.Add("alice", &BufferedChunk{MessageID: "0x01", Index: 1}, now)
+	buffer.Add("bob", &BufferedChunk{MessageID: "0x02", Index: 0}, now)
+
+	// Chunks are only taken for their sender
+	require.Empty(t, buffer.Take("bob", "0x01"))
+	chunks := buffer.Take("alice", "0x01")
+	require.Len(t, chunks, 2)
+	require.Equal(t, uint32(0), chunks[0].Index)
+	require.Equal(t, uint32(1), chunks[1].Index)
+	require.Equal(t, 1, buffer.Len())
+	require.Empty(t, buffer.Take("alice", "0x01"))
+}
+
+func TestChunksBufferBounded(t *testing.T) {
+	buffer := NewChunksBuffer(time.Minute, 2, 3)
+	now := time.Now()
+
+	// The oldest chunk of a sender is evicted once it has too many
+	for i := uint32(0); i < 3; i++ {
+		buffer.Add("alice", &BufferedChunk{MessageID: "0x01", Index: i}, now.Add(time.Duration(i)*time.Second))
+	}
+	require.Equal(t, 2, buffer.Len())
+
+	// The oldest chunk of the buffer is evicted once it's full
+	buffer.Add("bob", &BufferedChunk{MessageID: "0x02", Index: 0}, now.Add(3*time.Second))
+	buffer.Add("carol", &BufferedChunk{MessageID: "0x03", Index: 0}, now.Add(4*time.Second))
+	require.Equal(t, 3, buffer.Len())
+
+	chunks := buffer.Take("alice", "0x01")
+	require.Len(t, chunks, 1)
+	require.Equal(t, uint32(2), chunks[0].Index)
+	require.Len(t, buffer.Take("bob", "0x02"), 1)
+	require.Len(t, buffer.Take("carol", "0x03"), 1)
+}
+
+func TestChunksBufferExpire(t *testing.T) {
+	buffer := NewChunksBuffer(time.Minute, 2, 3)
+	now := time.Now()
+
+	buffer.Add("alice", &BufferedChunk{MessageID: "0x01", Index: 0}, now)
+	buffer.Add("bob", &BufferedChunk{MessageID: "0x02", Index: 0}, now.Add(30*time.Second))
+
+	buffer.Expire(now.Add(time.Minute + time.Second))
+	require.Equal(t, 1, buffer.Len())
+	require.Empty(t, buffer.Take("alice", "0x01"))
+	require.Len(t, buffer.Take("bob", "0x02"), 1)
+}
@@ -0,0 +1,255 @@
+package files
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// DefaultMaxFileSize is the size cap of the files we send and accept,
+// unless configured otherwise
+const DefaultMaxFileSize = 50 * 1024 * 1024
+
+// ChunkSize is the size of the chunks files are split into, small
+// enough for a chunk to fit in a single envelope once encrypted
+const ChunkSize = 256 * 1024
+
+var ErrFileTooLarge = errors.New("file is too large")
+var ErrInvalidMessageID = errors.New("invalid message id")
+var ErrIncompleteFile = errors.New("not all the chunks of the file have been received")
+var ErrHashMismatch = errors.New("hash of the file doesn't match")
+
+var messageIDRegexp = regexp.MustCompile("^0x[0-9a-f]+$")
+
+// Hash returns the hash used to verify the content of a file
+func Hash(data []byte) []byte {
+	hash := sha256.Sum256(data)
+	return hash[:]
+}
+
+// MimeType returns the MIME type of a file from its content
+func MimeType(data []byte) string {
+	return http.DetectContentType(data)
+}
+
+// Split divides the content of a file in chunks of ChunkSize
+func Split(data []byte) [][]byte {
+	var chunks [][]byte
+	for len(data) > ChunkSize {
+		chunks = append(chunks, data[:ChunkSize])
+		data = data[ChunkSize:]
+	}
+	return append(chunks, data)
+}
+
+// ChunksCount returns the number of chunks a file of the given size is split into
+func ChunksCount(size uint64) uint32 {
+	if size == 0 {
+		return 1
+	}
+	return uint32((size + ChunkSize - 1) / ChunkSize)
+}
+
+// Store keeps the files attached to messages on disk, outside of the database.
+// Files are named after the id of the message they are attached to.
+type Store struct {
+	dir     string
+	maxSize uint64
+}
+
+func NewStore(dir string, maxSize uint64) (*Store, error) {
+	if maxSize == 0 {
+		maxSize = DefaultMaxFileSize
+	}
+
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Store{dir: dir, maxSize: maxSize}, nil
+}
+
+// MaxSize is the size cap of the files we send and accept
+func (s *Store) MaxSize() uint64 {
+	return s.maxSize
+}
+
+// Path returns where the file attached to the message is stored
+func (s *Store) Path(messageID string) (string, error) {
+	if !messageIDRegexp.MatchString(messageID) {
+		return "", ErrInvalidMessageID
+	}
+	return filepath.Join(s.dir, messageID), nil
+}
+
+func (s *Store) chunksDir(messageID string) (string, error) {
+	path, err := s.Path(messageID)
+	if err != nil {
+		return "", err
+	}
+	return path + ".chunks", nil
+}
+
+// Exists returns whether the complete file attached to the message is stored
+func (s *Store) Exists(messageID string) (bool, error) {
+	path, err := s.Path(messageID)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = os.Stat(path)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// Save stores the complete file attached to the message
+func (s *Store) Save(messageID string, data []byte) error {
+	if uint64(len(data)) > s.maxSize {
+		return ErrFileTooLarge
+	}
+
+	path, err := s.Path(messageID)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, data, 0600)
+}
+
+// SaveChunk stores a chunk of the file attached to the message until
+// all of them are received
+func (s *Store) SaveChunk(messageID string, index uint32, data []byte) error {
+	if len(data) > ChunkSize || uint64(index)*ChunkSize >= s.maxSize {
+		return ErrFileTooLarge
+	}
+
+	dir, err := s.chunksDir(messageID)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(dir, 0700)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filepath.Join(dir, strconv.FormatUint(uint64(index), 10)), data, 0600)
+}
+
+// ReceivedChunks returns how many chunks of the file attached to the message are stored
+func (s *Store) ReceivedChunks(messageID string) (uint32, error) {
+	dir, err := s.chunksDir(messageID)
+	if err != nil {
+		return 0, err
+	}
+
+	entries, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return uint32(len(entries)), nil
+}
+
+// Assemble joins the chunks of the file attached to the message and verifies
+// them against the file description, the chunks are removed once the file is stored
+func (s *Store) Assemble(messageID string, file *protobuf.FileMessage) (string, error) {
+	if file.Size > s.maxSize {
+		return "", ErrFileTooLarge
+	}
+
+	dir, err := s.chunksDir(messageID)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	for i := uint32(0); i < file.ChunksCount; i++ {
+		chunk, err := ioutil.ReadFile(filepath.Join(dir, strconv.FormatUint(uint64(i), 10)))
+		if os.IsNotExist(err) {
+			return "", ErrIncompleteFile
+		}
+		if err != nil {
+			return "", err
+		}
+		buf.Write(chunk)
+	}
+
+	data := buf.Bytes()
+	if uint64(len(data)) != file.Size || !bytes.Equal(Hash(data), file.Hash) {
+		return "", ErrHashMismatch
+	}
+
+	path, err := s.Path(messageID)
+	if err != nil {
+		return "", err
+	}
+
+	err = ioutil.WriteFile(path, data, 0600)
+	if err != nil {
+		return "", err
+	}
+
+	return path, os.RemoveAll(dir)
+}
+
+// Delete removes the files attached to the messages, along with any chunk received
+func (s *Store) Delete(messageIDs ...string) error {
+	for _, messageID := range messageIDs {
+		path, err := s.Path(messageID)
+		if err == ErrInvalidMessageID {
+			// No file can be stored for this message
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		err = os.Remove(path)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+
+		err = os.RemoveAll(path + ".chunks")
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteStaleChunks removes the chunks of the files which haven't
+// been completed, if none of them was received since the given time
+func (s *Store) DeleteStaleChunks(before time.Time) error {
+	entries, err := ioutil.ReadDir(s.dir)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() || !strings.HasSuffix(entry.Name(), ".chunks") || !entry.ModTime().Before(before) {
+			continue
+		}
+		err := os.RemoveAll(filepath.Join(s.dir, entry.Name()))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
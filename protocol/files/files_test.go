@@ -0,0 +1,144 @@
+package files
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+const testMessageID = "0xabcdef"
+
+func newTestStore(t *testing.T) (*Store, func()) {
+	dir, err := ioutil.TempDir("", "files")
+	require.NoError(t, err)
+
+	store, err := NewStore(dir, 0)
+	require.NoError(t, err)
+
+	return store, func() { os.RemoveAll(dir) }
+}
+
+func TestSplit(t *testing.T) {
+	data := bytes.Repeat([]byte{1}, 2*ChunkSize+10)
+	chunks := Split(data)
+	require.Len(t, chunks, 3)
+	require.Len(t, chunks[2], 10)
+	require.Equal(t, ChunksCount(uint64(len(data))), uint32(len(chunks)))
+
+	require.Len(t, Split([]byte{1}), 1)
+	require.Equal(t, uint32(1), ChunksCount(ChunkSize))
+}
+
+func TestAssemble(t *testing.T) {
+	store, cleanup := newTestStore(t)
+	defer cleanup()
+
+	data := bytes.Repeat([]byte("status"), ChunkSize/2)
+	file := &protobuf.FileMessage{
+		Name:        "status.txt",
+		Size:        uint64(len(data)),
+		Hash:        Hash(data),
+		ChunksCount: ChunksCount(uint64(len(data))),
+	}
+
+	chunks := Split(data)
+	// Chunks can be received in any order
+	for i := len(chunks) - 1; i > 0; i-- {
+		require.NoError(t, store.SaveChunk(testMessageID, uint32(i), chunks[i]))
+	}
+
+	received, err := store.ReceivedChunks(testMessageID)
+	require.NoError(t, err)
+	require.Equal(t, file.ChunksCount-1, received)
+
+	_, err = store.Assemble(testMessageID, file)
+	require.Equal(t, ErrIncompleteFile, err)
+
+	require.NoError(t, store.SaveChunk(testMessageID, 0, chunks[0]))
+
+	path, err := store.Assemble(testMessageID, file)
+	require.NoError(t, err)
+
+	stored, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, data, stored)
+
+	// Chunks are removed once the file is assembled
+	received, err = store.ReceivedChunks(testMessageID)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), received)
+
+	require.NoError(t, store.Delete(testMessageID))
+	exists, err := store.Exists(testMessageID)
+	require.NoError(t, err)
+	require.False(t, exists)
+}
+
+func TestAssembleHashMismatch(t *testing.T) {
+	store, cleanup := newTestStore(t)
+	defer cleanup()
+
+	file := &protobuf.FileMessage{
+		Size:        6,
+		Hash:        Hash([]byte("status")),
+		ChunksCount: 1,
+	}
+
+	require.NoError(t, store.SaveChunk(testMessageID, 0, []byte("statu5")))
+	_, err := store.Assemble(testMessageID, file)
+	require.Equal(t, ErrHashMismatch, err)
+}
+
+func TestInvalidMessageID(t *testing.T) {
+	store, cleanup := newTestStore(t)
+	defer cleanup()
+
+	require.Equal(t, ErrInvalidMessageID, store.SaveChunk("../../etc", 0, []byte("data")))
+	require.Equal(t, ErrInvalidMessageID, store.Save("0x12/../..", []byte("data")))
+	// Nothing can be stored for an invalid id
+	require.NoError(t, store.Delete("../../etc"))
+}
+
+func TestSizeCap(t *testing.T) {
+	dir, err := ioutil.TempDir("", "files")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	store, err := NewStore(dir, 10)
+	require.NoError(t, err)
+
+	require.Equal(t, ErrFileTooLarge, store.Save(testMessageID, bytes.Repeat([]byte{1}, 11)))
+	require.Equal(t, ErrFileTooLarge, store.SaveChunk(testMessageID, 1, []byte{1}))
+	require.NoError(t, store.SaveChunk(testMessageID, 0, []byte{1}))
+}
+
+func TestDeleteStaleChunks(t *testing.T) {
+	store, cleanup := newTestStore(t)
+	defer cleanup()
+
+	require.NoError(t, store.Save("0x01", []byte("complete")))
+	require.NoError(t, store.SaveChunk("0x02", 0, []byte("stale")))
+	path, err := store.Path("0x02")
+	require.NoError(t, err)
+	staleAt := time.Now().Add(-2 * time.Hour)
+	require.NoError(t, os.Chtimes(path+".chunks", staleAt, staleAt))
+	require.NoError(t, store.SaveChunk("0x03", 0, []byte("recent")))
+
+	require.NoError(t, store.DeleteStaleChunks(time.Now().Add(-time.Hour)))
+
+	received, err := store.ReceivedChunks("0x02")
+	require.NoError(t, err)
+	require.Zero(t, received)
+	received, err = store.ReceivedChunks("0x03")
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), received)
+	exists, err := store.Exists("0x01")
+	require.NoError(t, err)
+	require.True(t, exists)
+}
@@ -0,0 +1,66 @@
+package safetynumber
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+)
+
+const (
+	// iterations of the hash function, makes it expensive to find a key
+	// with a colliding fingerprint
+	iterations = 5200
+	// chunks of 5 digits in a fingerprint
+	chunks  = 6
+	version = 0
+)
+
+// fingerprint returns 30 digits derived from a public key
+func fingerprint(publicKey *ecdsa.PublicKey) string {
+	key := crypto.CompressPubkey(publicKey)
+
+	hash := make([]byte, 2, 2+2*len(key))
+	binary.BigEndian.PutUint16(hash, version)
+	hash = append(hash, key...)
+	for i := 0; i < iterations; i++ {
+		digest := sha512.Sum512(append(hash, key...))
+		hash = digest[:]
+	}
+
+	var b strings.Builder
+	for i := 0; i < chunks; i++ {
+		chunk := hash[i*5 : i*5+5]
+		value := uint64(chunk[0])<<32 | uint64(chunk[1])<<24 | uint64(chunk[2])<<16 | uint64(chunk[3])<<8 | uint64(chunk[4])
+		fmt.Fprintf(&b, "%05d", value%100000)
+	}
+	return b.String()
+}
+
+// Generate returns the safety number of a conversation between two identities.
+// Both parties compute the same number, which they can compare out-of-band to
+// make sure that nobody is impersonating any of them.
+func Generate(ours, theirs *ecdsa.PublicKey) string {
+	first, second := fingerprint(ours), fingerprint(theirs)
+	if bytes.Compare(crypto.CompressPubkey(ours), crypto.CompressPubkey(theirs)) > 0 {
+		first, second = second, first
+	}
+	return first + second
+}
+
+// Format splits a safety number in groups of 5 digits for display
+func Format(safetyNumber string) string {
+	var groups []string
+	for i := 0; i < len(safetyNumber); i += 5 {
+		end := i + 5
+		if end > len(safetyNumber) {
+			end = len(safetyNumber)
+		}
+		groups = append(groups, safetyNumber[i:end])
+	}
+	return strings.Join(groups, " ")
+}
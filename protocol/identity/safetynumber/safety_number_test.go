@@ -0,0 +1,30 @@
+package safetynumber
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+)
+
+func TestGenerate(t *testing.T) {
+	alice, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	bob, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	mallory, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	number := Generate(&alice.PublicKey, &bob.PublicKey)
+	require.Len(t, number, 60)
+	require.Regexp(t, "^[0-9]+$", number)
+
+	// both parties compute the same number
+	require.Equal(t, number, Generate(&bob.PublicKey, &alice.PublicKey))
+	require.NotEqual(t, number, Generate(&alice.PublicKey, &mallory.PublicKey))
+}
+
+func TestFormat(t *testing.T) {
+	require.Equal(t, "12345 67890 12", Format("123456789012"))
+}
@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/golang/protobuf/proto"
+
 	"github.com/status-im/status-go/protocol/common"
 	"github.com/status-im/status-go/protocol/protobuf"
 )
@@ -36,6 +38,10 @@ func (db sqlitePersistence) tableUserMessagesAllFields() string {
 		audio_duration_ms,
 		audio_base64,
 		community_id,
+		poll_payload,
+		link_previews,
+		file_payload,
+		safe_transaction_payload,
 		mentions,
 		links,
 		command_id,
@@ -78,6 +84,10 @@ func (db sqlitePersistence) tableUserMessagesAllFieldsJoin() string {
 		COALESCE(m1.audio_duration_ms,0),
 		m1.audio_base64,
 		m1.community_id,
+		m1.poll_payload,
+		m1.link_previews,
+		m1.file_payload,
+		m1.safe_transaction_payload,
 		m1.mentions,
 		m1.links,
 		m1.command_id,
@@ -129,6 +139,10 @@ func (db sqlitePersistence) tableUserMessagesScanAllFields(row scanner, message
 	var alias sql.NullString
 	var identicon sql.NullString
 	var communityID sql.NullString
+	var pollPayload []byte
+	var serializedLinkPreviews []byte
+	var filePayload []byte
+	var safeTransactionPayload []byte
 	var gapFrom sql.NullInt64
 	var gapTo sql.NullInt64
 	var editedAt sql.NullInt64
@@ -159,6 +173,10 @@ func (db sqlitePersistence) tableUserMessagesScanAllFields(row scanner, message
 		&audio.DurationMs,
 		&message.Base64Audio,
 		&communityID,
+		&pollPayload,
+		&serializedLinkPreviews,
+		&filePayload,
+		&safeTransactionPayload,
 		&serializedMentions,
 		&serializedLinks,
 		&command.ID,
@@ -239,6 +257,13 @@ func (db sqlitePersistence) tableUserMessagesScanAllFields(row scanner, message
 		}
 	}
 
+	if serializedLinkPreviews != nil {
+		err := json.Unmarshal(serializedLinkPreviews, &message.LinkPreviews)
+		if err != nil {
+			return err
+		}
+	}
+
 	switch message.ContentType {
 	case protobuf.ChatMessage_STICKER:
 		message.Payload = &protobuf.ChatMessage_Sticker{Sticker: sticker}
@@ -248,6 +273,27 @@ func (db sqlitePersistence) tableUserMessagesScanAllFields(row scanner, message
 
 	case protobuf.ChatMessage_TRANSACTION_COMMAND:
 		message.CommandParameters = command
+
+	case protobuf.ChatMessage_POLL:
+		poll := &protobuf.PollMessage{}
+		if err := proto.Unmarshal(pollPayload, poll); err != nil {
+			return err
+		}
+		message.Payload = &protobuf.ChatMessage_Poll{Poll: poll}
+
+	case protobuf.ChatMessage_FILE:
+		file := &protobuf.FileMessage{}
+		if err := proto.Unmarshal(filePayload, file); err != nil {
+			return err
+		}
+		message.Payload = &protobuf.ChatMessage_File{File: file}
+
+	case protobuf.ChatMessage_SAFE_TRANSACTION_PROPOSAL:
+		proposal := &protobuf.SafeTransactionProposal{}
+		if err := proto.Unmarshal(safeTransactionPayload, proposal); err != nil {
+			return err
+		}
+		message.Payload = &protobuf.ChatMessage_SafeTransactionProposal{SafeTransactionProposal: proposal}
 	}
 
 	return nil
@@ -276,6 +322,33 @@ func (db sqlitePersistence) tableUserMessagesAllValues(message *common.Message)
 		command = &common.CommandParameters{}
 	}
 
+	var pollPayload []byte
+	if poll := message.GetPoll(); poll != nil {
+		var err error
+		pollPayload, err = proto.Marshal(poll)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var filePayload []byte
+	if file := message.GetFile(); file != nil {
+		var err error
+		filePayload, err = proto.Marshal(file)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var safeTransactionPayload []byte
+	if proposal := message.GetSafeTransactionProposal(); proposal != nil {
+		var err error
+		safeTransactionPayload, err = proto.Marshal(proposal)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if message.GapParameters != nil {
 		gapFrom = message.GapParameters.From
 		gapTo = message.GapParameters.To
@@ -298,6 +371,14 @@ func (db sqlitePersistence) tableUserMessagesAllValues(message *common.Message)
 		}
 	}
 
+	var serializedLinkPreviews []byte
+	if len(message.LinkPreviews) != 0 {
+		serializedLinkPreviews, err = json.Marshal(message.LinkPreviews)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return []interface{}{
 		message.ID,
 		message.WhisperTimestamp,
@@ -323,6 +404,10 @@ func (db sqlitePersistence) tableUserMessagesAllValues(message *common.Message)
 		audio.DurationMs,
 		message.Base64Audio,
 		message.CommunityID,
+		pollPayload,
+		serializedLinkPreviews,
+		filePayload,
+		safeTransactionPayload,
 		serializedMentions,
 		serializedLinks,
 		command.ID,
@@ -780,7 +865,9 @@ func (db sqlitePersistence) EmojiReactionsByChatID(chatID string, currCursor str
 			    e.message_id,
 			    e.chat_id,
 			    e.local_chat_id,
-			    e.retracted
+			    e.retracted,
+			    e.emoji,
+			    e.community_emoji_id
 			FROM
 				emoji_reactions e
 			WHERE NOT(e.retracted)
@@ -811,7 +898,9 @@ func (db sqlitePersistence) EmojiReactionsByChatID(chatID string, currCursor str
 			&emojiReaction.MessageId,
 			&emojiReaction.ChatId,
 			&emojiReaction.LocalChatID,
-			&emojiReaction.Retracted)
+			&emojiReaction.Retracted,
+			&emojiReaction.Emoji,
+			&emojiReaction.CommunityEmojiId)
 		if err != nil {
 			return nil, err
 		}
@@ -861,7 +950,9 @@ func (db sqlitePersistence) EmojiReactionsByChatIDs(chatIDs []string, currCursor
 			    e.message_id,
 			    e.chat_id,
 			    e.local_chat_id,
-			    e.retracted
+			    e.retracted,
+			    e.emoji,
+			    e.community_emoji_id
 			FROM
 				emoji_reactions e
 			WHERE NOT(e.retracted)
@@ -892,7 +983,9 @@ func (db sqlitePersistence) EmojiReactionsByChatIDs(chatIDs []string, currCursor
 			&emojiReaction.MessageId,
 			&emojiReaction.ChatId,
 			&emojiReaction.LocalChatID,
-			&emojiReaction.Retracted)
+			&emojiReaction.Retracted,
+			&emojiReaction.Emoji,
+			&emojiReaction.CommunityEmojiId)
 		if err != nil {
 			return nil, err
 		}
@@ -1032,6 +1125,26 @@ func (db sqlitePersistence) HideMessage(id string) error {
 	return err
 }
 
+// FileMessageIDsByChatID returns the ids of the messages of the chat with a file attached
+func (db sqlitePersistence) FileMessageIDsByChatID(chatID string) ([]string, error) {
+	rows, err := db.db.Query(`SELECT id FROM user_messages WHERE local_chat_id = ? AND content_type = ?`, chatID, protobuf.ChatMessage_FILE)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
 func (db sqlitePersistence) DeleteMessagesByChatID(id string) error {
 	return db.deleteMessagesByChatID(id, nil)
 }
@@ -1233,7 +1346,7 @@ func (db sqlitePersistence) BlockContact(contact *Contact) ([]*Chat, error) {
 }
 
 func (db sqlitePersistence) SaveEmojiReaction(emojiReaction *EmojiReaction) (err error) {
-	query := "INSERT INTO emoji_reactions(id,clock_value,source,emoji_id,message_id,chat_id,local_chat_id,retracted) VALUES (?,?,?,?,?,?,?,?)"
+	query := "INSERT INTO emoji_reactions(id,clock_value,source,emoji_id,message_id,chat_id,local_chat_id,retracted,emoji,community_emoji_id) VALUES (?,?,?,?,?,?,?,?,?,?)"
 	stmt, err := db.db.Prepare(query)
 	if err != nil {
 		return
@@ -1248,6 +1361,8 @@ func (db sqlitePersistence) SaveEmojiReaction(emojiReaction *EmojiReaction) (err
 		emojiReaction.ChatId,
 		emojiReaction.LocalChatID,
 		emojiReaction.Retracted,
+		emojiReaction.Emoji,
+		emojiReaction.CommunityEmojiId,
 	)
 
 	return
@@ -1262,7 +1377,9 @@ func (db sqlitePersistence) EmojiReactionByID(id string) (*EmojiReaction, error)
 			    message_id,
 			    chat_id,
 			    local_chat_id,
-			    retracted
+			    retracted,
+			    emoji,
+			    community_emoji_id
 			FROM
 				emoji_reactions
 			WHERE
@@ -1277,6 +1394,8 @@ func (db sqlitePersistence) EmojiReactionByID(id string) (*EmojiReaction, error)
 		&emojiReaction.ChatId,
 		&emojiReaction.LocalChatID,
 		&emojiReaction.Retracted,
+		&emojiReaction.Emoji,
+		&emojiReaction.CommunityEmojiId,
 	)
 
 	switch err {
@@ -1289,6 +1408,53 @@ func (db sqlitePersistence) EmojiReactionByID(id string) (*EmojiReaction, error)
 	}
 }
 
+// EmojiReactionsCountByMessageID returns the number of users who reacted
+// to a message, for each emoji
+func (db sqlitePersistence) EmojiReactionsCountByMessageID(localChatID string, messageID string, myPublicKey string) ([]*EmojiReactionCount, error) {
+	rows, err := db.db.Query(`
+			SELECT
+			    emoji,
+			    community_emoji_id,
+			    MAX(emoji_id),
+			    COUNT(*),
+			    SUM(source = ?)
+			FROM
+				emoji_reactions
+			WHERE NOT(retracted)
+			AND
+			local_chat_id = ?
+			AND
+			message_id = ?
+			GROUP BY emoji, community_emoji_id
+			ORDER BY COUNT(*) DESC, MIN(clock_value) ASC
+		`, myPublicKey, localChatID, messageID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*EmojiReactionCount
+	for rows.Next() {
+		var count EmojiReactionCount
+		var reacted int
+		err := rows.Scan(
+			&count.Emoji,
+			&count.CommunityEmojiID,
+			&count.EmojiID,
+			&count.Count,
+			&reacted,
+		)
+		if err != nil {
+			return nil, err
+		}
+		count.Reacted = reacted > 0
+
+		result = append(result, &count)
+	}
+
+	return result, nil
+}
+
 func (db sqlitePersistence) SaveInvitation(invitation *GroupChatInvitation) (err error) {
 	query := "INSERT INTO group_chat_invitations(id,source,chat_id,message,state,clock) VALUES (?,?,?,?,?,?)"
 	stmt, err := db.db.Prepare(query)
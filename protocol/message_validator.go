@@ -1,17 +1,27 @@
 package protocol
 
 import (
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"strconv"
 	"strings"
 
+	"github.com/status-im/status-go/images"
+	"github.com/status-im/status-go/protocol/emojis"
+	"github.com/status-im/status-go/protocol/files"
 	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/safe"
 	"github.com/status-im/status-go/protocol/v1"
 )
 
 const maxChatMessageTextLength = 4096
 const maxStatusMessageText = 128
+const maxCommunityEmojiIDLength = 64
+const maxPollOptions = 10
+const maxPollQuestionLength = 512
+const maxPollOptionLength = 128
+const maxLinkPreviews = 5
 
 // maxWhisperDrift is how many milliseconds we allow the clock value to differ
 // from whisperTimestamp
@@ -75,6 +85,96 @@ func ValidateEditMessage(message protobuf.EditMessage) error {
 	return ValidateText(message.Text)
 }
 
+func ValidatePoll(poll *protobuf.PollMessage) error {
+	if poll == nil {
+		return errors.New("no poll content")
+	}
+
+	question := strings.TrimSpace(poll.Question)
+	if len(question) == 0 {
+		return errors.New("poll question can't be empty")
+	}
+	if len(question) > maxPollQuestionLength {
+		return fmt.Errorf("poll question shouldn't be longer than %d", maxPollQuestionLength)
+	}
+
+	if len(poll.Options) < 2 {
+		return errors.New("poll needs at least two options")
+	}
+	if len(poll.Options) > maxPollOptions {
+		return fmt.Errorf("poll can't have more than %d options", maxPollOptions)
+	}
+
+	for _, option := range poll.Options {
+		option = strings.TrimSpace(option)
+		if len(option) == 0 {
+			return errors.New("poll option can't be empty")
+		}
+		if len(option) > maxPollOptionLength {
+			return fmt.Errorf("poll option shouldn't be longer than %d", maxPollOptionLength)
+		}
+	}
+
+	return nil
+}
+
+// ValidateSafeTransactionProposal checks that the proposal is a valid Safe
+// transaction signed by the proposer
+func ValidateSafeTransactionProposal(proposal *protobuf.SafeTransactionProposal) error {
+	transaction, err := safe.FromProtobuf(proposal)
+	if err != nil {
+		return err
+	}
+	hash, err := transaction.Hash()
+	if err != nil {
+		return err
+	}
+	_, err = safe.RecoverSigner(hash, proposal.Signature)
+	return err
+}
+
+func ValidateReceivedSafeTransactionSignature(signature *protobuf.SafeTransactionSignature, whisperTimestamp uint64) error {
+	if err := validateClockValue(signature.Clock, whisperTimestamp); err != nil {
+		return err
+	}
+	if len(signature.ChatId) == 0 {
+		return errors.New("chat-id can't be empty")
+	}
+	if len(signature.MessageId) == 0 {
+		return errors.New("message-id can't be empty")
+	}
+	if len(signature.Signature) == 0 {
+		return errors.New("signature can't be empty")
+	}
+
+	if signature.MessageType == protobuf.MessageType_UNKNOWN_MESSAGE_TYPE || signature.MessageType == protobuf.MessageType_SYSTEM_MESSAGE_PRIVATE_GROUP {
+		return errors.New("unknown message type")
+	}
+
+	return nil
+}
+
+func ValidateReceivedPollVote(vote *protobuf.PollVote, whisperTimestamp uint64) error {
+	if err := validateClockValue(vote.Clock, whisperTimestamp); err != nil {
+		return err
+	}
+	if len(vote.ChatId) == 0 {
+		return errors.New("chat-id can't be empty")
+	}
+	if len(vote.MessageId) == 0 {
+		return errors.New("message-id can't be empty")
+	}
+	if len(vote.Options) > maxPollOptions {
+		return errors.New("too many options")
+	}
+
+	if vote.MessageType == protobuf.MessageType_UNKNOWN_MESSAGE_TYPE || vote.MessageType == protobuf.MessageType_SYSTEM_MESSAGE_PRIVATE_GROUP {
+		return errors.New("unknown message type")
+	}
+
+	return nil
+}
+
 func ValidateDeleteMessage(message protobuf.DeleteMessage) error {
 	if len(message.ChatId) == 0 {
 		return errors.New("chat-id can't be empty")
@@ -237,6 +337,10 @@ func ValidateReceivedChatMessage(message *protobuf.ChatMessage, whisperTimestamp
 		return errors.New("unknown message type")
 	}
 
+	if err := validateLinkPreviews(message.LinkPreviews); err != nil {
+		return err
+	}
+
 	switch message.ContentType {
 	case protobuf.ChatMessage_UNKNOWN_CONTENT_TYPE:
 		return errors.New("unknown content type")
@@ -270,6 +374,21 @@ func ValidateReceivedChatMessage(message *protobuf.ChatMessage, whisperTimestamp
 		if image.Type == protobuf.ImageType_UNKNOWN_IMAGE_TYPE {
 			return errors.New("image type unknown")
 		}
+
+	case protobuf.ChatMessage_POLL:
+		if err := ValidatePoll(message.GetPoll()); err != nil {
+			return err
+		}
+
+	case protobuf.ChatMessage_FILE:
+		if err := validateFile(message.GetFile()); err != nil {
+			return err
+		}
+
+	case protobuf.ChatMessage_SAFE_TRANSACTION_PROPOSAL:
+		if err := ValidateSafeTransactionProposal(message.GetSafeTransactionProposal()); err != nil {
+			return err
+		}
 	}
 
 	if message.ContentType == protobuf.ChatMessage_AUDIO {
@@ -292,6 +411,67 @@ func ValidateReceivedChatMessage(message *protobuf.ChatMessage, whisperTimestamp
 	return nil
 }
 
+func validateFile(file *protobuf.FileMessage) error {
+	if file == nil {
+		return errors.New("no file content")
+	}
+	if len(file.Name) == 0 {
+		return errors.New("file name can't be empty")
+	}
+	if file.Size == 0 {
+		return errors.New("file can't be empty")
+	}
+	if len(file.Hash) != sha256.Size {
+		return errors.New("invalid file hash")
+	}
+	if file.ChunksCount != files.ChunksCount(file.Size) {
+		return errors.New("invalid file chunks count")
+	}
+
+	return nil
+}
+
+func ValidateReceivedFileChunk(chunk *protobuf.FileChunk, whisperTimestamp uint64) error {
+	if err := validateClockValue(chunk.Clock, whisperTimestamp); err != nil {
+		return err
+	}
+	if len(chunk.ChatId) == 0 {
+		return errors.New("chat-id can't be empty")
+	}
+	if len(chunk.MessageId) == 0 {
+		return errors.New("message-id can't be empty")
+	}
+	if chunk.Index >= chunk.ChunksCount {
+		return errors.New("invalid chunk index")
+	}
+	if len(chunk.Payload) == 0 || len(chunk.Payload) > files.ChunkSize {
+		return errors.New("invalid chunk size")
+	}
+
+	if chunk.MessageType == protobuf.MessageType_UNKNOWN_MESSAGE_TYPE || chunk.MessageType == protobuf.MessageType_SYSTEM_MESSAGE_PRIVATE_GROUP {
+		return errors.New("unknown message type")
+	}
+
+	return nil
+}
+
+func validateLinkPreviews(previews []*protobuf.LinkPreview) error {
+	if len(previews) > maxLinkPreviews {
+		return fmt.Errorf("message can't have more than %d link previews", maxLinkPreviews)
+	}
+
+	for _, preview := range previews {
+		if len(preview.Url) == 0 {
+			return errors.New("link preview url can't be empty")
+		}
+		if len(preview.ThumbnailPayload) > images.DimensionSizeLimit[images.LargeDim].Max {
+			return errors.New("link preview thumbnail is too large")
+		}
+	}
+
+	return nil
+}
+
 func ValidateReceivedEmojiReaction(emoji *protobuf.EmojiReaction, whisperTimestamp uint64) error {
 	if err := validateClockValue(emoji.Clock, whisperTimestamp); err != nil {
 		return err
@@ -305,7 +485,18 @@ func ValidateReceivedEmojiReaction(emoji *protobuf.EmojiReaction, whisperTimesta
 		return errors.New("chat-id can't be empty")
 	}
 
-	if emoji.Type == protobuf.EmojiReaction_UNKNOWN_EMOJI_REACTION_TYPE {
+	if emoji.CommunityEmojiId != "" {
+		if emoji.Emoji != "" || emoji.Type != protobuf.EmojiReaction_UNKNOWN_EMOJI_REACTION_TYPE {
+			return errors.New("community emoji reactions can't have a type")
+		}
+		if len(emoji.CommunityEmojiId) > maxCommunityEmojiIDLength {
+			return errors.New("invalid community emoji id")
+		}
+	} else if emoji.Emoji != "" {
+		if err := emojis.Validate(emoji.Emoji); err != nil {
+			return err
+		}
+	} else if emoji.Type == protobuf.EmojiReaction_UNKNOWN_EMOJI_REACTION_TYPE {
 		return errors.New("unknown emoji reaction type")
 	}
 
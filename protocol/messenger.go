@@ -22,12 +22,14 @@ import (
 	"github.com/golang/protobuf/proto"
 
 	"github.com/status-im/status-go/appdatabase"
+	"github.com/status-im/status-go/appmetrics"
 	"github.com/status-im/status-go/connection"
 	"github.com/status-im/status-go/eth-node/crypto"
 	"github.com/status-im/status-go/eth-node/types"
 	userimage "github.com/status-im/status-go/images"
 	"github.com/status-im/status-go/multiaccounts"
 	"github.com/status-im/status-go/multiaccounts/accounts"
+	"github.com/status-im/status-go/protocol/anonmetrics"
 	"github.com/status-im/status-go/protocol/audio"
 	"github.com/status-im/status-go/protocol/common"
 	"github.com/status-im/status-go/protocol/communities"
@@ -35,12 +37,14 @@ import (
 	"github.com/status-im/status-go/protocol/encryption/multidevice"
 	"github.com/status-im/status-go/protocol/encryption/sharedsecret"
 	"github.com/status-im/status-go/protocol/ens"
+	"github.com/status-im/status-go/protocol/files"
 	"github.com/status-im/status-go/protocol/identity/alias"
 	"github.com/status-im/status-go/protocol/identity/identicon"
 	"github.com/status-im/status-go/protocol/images"
 	"github.com/status-im/status-go/protocol/protobuf"
 	"github.com/status-im/status-go/protocol/pushnotificationclient"
 	"github.com/status-im/status-go/protocol/pushnotificationserver"
+	"github.com/status-im/status-go/protocol/spam"
 	"github.com/status-im/status-go/protocol/sqlite"
 	"github.com/status-im/status-go/protocol/transport"
 	v1protocol "github.com/status-im/status-go/protocol/v1"
@@ -85,6 +89,8 @@ type Messenger struct {
 	ensVerifier                *ens.Verifier
 	pushNotificationClient     *pushnotificationclient.Client
 	pushNotificationServer     *pushnotificationserver.Server
+	anonMetricsClient          *anonmetrics.Client
+	anonMetricsServer          *anonmetrics.Server
 	communitiesManager         *communities.Manager
 	logger                     *zap.Logger
 	verifyTransactionClient    EthClient
@@ -96,6 +102,7 @@ type Messenger struct {
 	allContacts                *contactMap
 	allInstallations           *installationMap
 	modifiedInstallations      *stringBoolMap
+	communityControlRequests   *stringBoolMap
 	installationID             string
 	mailserver                 []byte
 	database                   *sql.DB
@@ -106,6 +113,10 @@ type Messenger struct {
 	quit                       chan struct{}
 	requestedCommunities       map[string]*transport.Filter
 	connectionState            connection.State
+	outboundQueueFlush         chan struct{}
+	files                      *files.Store
+	fileChunks                 *files.ChunksBuffer // Chunks received before the message describing their file
+	spamFilter                 *spam.Filter
 
 	// TODO(samyoul) Determine if/how the remaining usage of this mutex can be removed
 	mutex sync.Mutex
@@ -139,7 +150,17 @@ func (interceptor EnvelopeEventsInterceptor) EnvelopeSent(identifiers [][]byte)
 
 // EnvelopeExpired triggered when envelope is expired but wasn't delivered to any peer.
 func (interceptor EnvelopeEventsInterceptor) EnvelopeExpired(identifiers [][]byte, err error) {
-	//we don't track expired events in Messenger, so just redirect to handler
+	if interceptor.Messenger != nil {
+		var ids []string
+		for _, identifierBytes := range identifiers {
+			ids = append(ids, types.EncodeHex(identifierBytes))
+		}
+
+		err := interceptor.Messenger.processExpiredMessages(ids)
+		if err != nil {
+			interceptor.Messenger.logger.Info("Messenger failed to process expired messages", zap.Error(err))
+		}
+	}
 	interceptor.EnvelopeEventsHandler.EnvelopeExpired(identifiers, err)
 }
 
@@ -281,6 +302,19 @@ func NewMessenger(
 
 	pushNotificationClient := pushnotificationclient.New(pushNotificationClientPersistence, pushNotificationClientConfig, sender, sqlitePersistence)
 
+	// Initialize anonymous metrics client, metrics are sent only if
+	// the user allows it
+	var anonMetricsClient *anonmetrics.Client
+	if c.anonMetricsClientConfig != nil && c.anonMetricsClientConfig.SendAddress != nil {
+		anonMetricsClient = anonmetrics.NewClient(c.anonMetricsClientConfig, logger, appmetrics.NewDB(database), accounts.NewDB(database), sender)
+	}
+
+	// Initialize anonymous metrics server
+	var anonMetricsServer *anonmetrics.Server
+	if c.anonMetricsServerConfig != nil && c.anonMetricsServerConfig.Enabled {
+		anonMetricsServer = anonmetrics.NewServer(c.anonMetricsServerConfig, logger)
+	}
+
 	ensVerifier := ens.New(node, logger, transp, database, c.verifyENSURL, c.verifyENSContractAddress)
 
 	communitiesManager, err := communities.NewManager(&identity.PublicKey, database, logger, ensVerifier)
@@ -288,6 +322,15 @@ func NewMessenger(
 		return nil, err
 	}
 	settings := accounts.NewDB(database)
+
+	var filesStore *files.Store
+	if c.filesDir != "" {
+		filesStore, err = files.NewStore(c.filesDir, c.maxFileSize)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	messenger = &Messenger{
 		config:                     &c,
 		node:                       node,
@@ -298,6 +341,8 @@ func NewMessenger(
 		sender:                     sender,
 		pushNotificationClient:     pushNotificationClient,
 		pushNotificationServer:     pushNotificationServer,
+		anonMetricsClient:          anonMetricsClient,
+		anonMetricsServer:          anonMetricsServer,
 		communitiesManager:         communitiesManager,
 		ensVerifier:                ensVerifier,
 		featureFlags:               c.featureFlags,
@@ -307,6 +352,7 @@ func NewMessenger(
 		allInstallations:           new(installationMap),
 		installationID:             installationID,
 		modifiedInstallations:      new(stringBoolMap),
+		communityControlRequests:   new(stringBoolMap),
 		verifyTransactionClient:    c.verifyTransactionClient,
 		database:                   database,
 		multiAccounts:              c.multiAccount,
@@ -315,6 +361,9 @@ func NewMessenger(
 		account:                    c.account,
 		quit:                       make(chan struct{}),
 		requestedCommunities:       make(map[string]*transport.Filter),
+		outboundQueueFlush:         make(chan struct{}, 1),
+		files:                      filesStore,
+		fileChunks:                 files.NewChunksBuffer(fileChunksBufferTTL, maxBufferedFileChunksPerSender, maxBufferedFileChunks),
 		shutdownTasks: []func() error{
 			ensVerifier.Stop,
 			pushNotificationClient.Stop,
@@ -331,6 +380,14 @@ func NewMessenger(
 		logger: logger,
 	}
 
+	// Stopped before the database is closed
+	if anonMetricsClient != nil {
+		messenger.shutdownTasks = append([]func() error{anonMetricsClient.Stop}, messenger.shutdownTasks...)
+	}
+	if anonMetricsServer != nil {
+		messenger.shutdownTasks = append(messenger.shutdownTasks, anonMetricsServer.Stop)
+	}
+
 	if c.envelopesMonitorConfig != nil {
 		interceptor := EnvelopeEventsInterceptor{c.envelopesMonitorConfig.EnvelopeEventsHandler, messenger}
 		err := messenger.transport.SetEnvelopeEventsHandler(interceptor)
@@ -355,6 +412,11 @@ func (m *Messenger) processSentMessages(ids []string) error {
 		if err != nil {
 			return errors.Wrapf(err, "Can't save raw message marked as sent")
 		}
+
+		err = m.processSentQueuedMessage(id)
+		if err != nil {
+			return errors.Wrapf(err, "Can't remove sent message from the queue")
+		}
 	}
 
 	return nil
@@ -397,7 +459,7 @@ func (m *Messenger) resendExpiredEmojiReactions() error {
 				return errors.Wrapf(err, "Can't save raw message marked as non-expired")
 			}
 
-			err = m.reSendRawMessage(context.Background(), rawMessage.ID)
+			_, err = m.reSendRawMessage(context.Background(), rawMessage.ID)
 			if err != nil {
 				return errors.Wrapf(err, "Can't resend expired message with id %v", rawMessage.ID)
 			}
@@ -426,6 +488,20 @@ func (m *Messenger) Start() (*MessengerResponse, error) {
 		}
 	}
 
+	// Start anonymous metrics client
+	if m.anonMetricsClient != nil {
+		if err := m.anonMetricsClient.Start(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Start anonymous metrics server
+	if m.anonMetricsServer != nil {
+		if err := m.anonMetricsServer.Start(); err != nil {
+			return nil, err
+		}
+	}
+
 	ensSubscription := m.ensVerifier.Subscribe()
 
 	// Subscrbe
@@ -437,6 +513,10 @@ func (m *Messenger) Start() (*MessengerResponse, error) {
 		return nil, err
 	}
 
+	if err := m.loadSpamFilter(); err != nil {
+		return nil, err
+	}
+
 	// set shared secret handles
 	m.sender.SetHandleSharedSecrets(m.handleSharedSecrets)
 
@@ -453,10 +533,14 @@ func (m *Messenger) Start() (*MessengerResponse, error) {
 
 	m.handleEncryptionLayerSubscriptions(subscriptions)
 	m.handleCommunitiesSubscription(m.communitiesManager.Subscribe())
+	m.watchCommunityMessageArchives()
+	m.watchSpamBlocklists()
+	m.watchStaleFileChunks()
 	m.handleConnectionChange(m.online())
 	m.handleENSVerificationSubscription(ensSubscription)
 	m.watchConnectionChange()
 	m.watchExpiredEmojis()
+	m.watchOutboundQueue()
 	m.watchIdentityImageChanges()
 	m.broadcastLatestUserStatus()
 
@@ -508,6 +592,12 @@ func (m *Messenger) handleConnectionChange(online bool) {
 			m.shouldPublishContactCode = false
 		}
 
+		// Flush the outbound queue, ignoring the backoff
+		select {
+		case m.outboundQueueFlush <- struct{}{}:
+		default:
+		}
+
 	} else {
 		if m.pushNotificationClient != nil {
 			m.pushNotificationClient.Offline()
@@ -1763,18 +1853,18 @@ func (m *Messenger) reregisterForPushNotifications() error {
 }
 
 // pull a message from the database and send it again
-func (m *Messenger) reSendRawMessage(ctx context.Context, messageID string) error {
+func (m *Messenger) reSendRawMessage(ctx context.Context, messageID string) (common.RawMessage, error) {
 	message, err := m.persistence.RawMessageByID(messageID)
 	if err != nil {
-		return err
+		return common.RawMessage{}, err
 	}
 
 	chat, ok := m.allChats.Load(message.LocalChatID)
 	if !ok {
-		return errors.New("chat not found")
+		return common.RawMessage{}, errors.New("chat not found")
 	}
 
-	_, err = m.dispatchMessage(ctx, common.RawMessage{
+	return m.dispatchMessage(ctx, common.RawMessage{
 		LocalChatID:         chat.ID,
 		Payload:             message.Payload,
 		MessageType:         message.MessageType,
@@ -1782,12 +1872,12 @@ func (m *Messenger) reSendRawMessage(ctx context.Context, messageID string) erro
 		ResendAutomatically: message.ResendAutomatically,
 		SendCount:           message.SendCount,
 	})
-	return err
 }
 
 // ReSendChatMessage pulls a message from the database and sends it again
 func (m *Messenger) ReSendChatMessage(ctx context.Context, messageID string) error {
-	return m.reSendRawMessage(ctx, messageID)
+	_, err := m.reSendRawMessage(ctx, messageID)
+	return err
 }
 
 func (m *Messenger) hasPairedDevices() bool {
@@ -1952,6 +2042,14 @@ func (m *Messenger) dispatchMessage(ctx context.Context, spec common.RawMessage)
 		return spec, err
 	}
 
+	// Messages created while offline are re-sent as soon as we are back online
+	if spec.SendCount == 1 && !m.online() {
+		err = m.queueRawMessage(&spec, 0)
+		if err != nil {
+			return spec, err
+		}
+	}
+
 	return spec, nil
 }
 
@@ -1980,6 +2078,8 @@ func (m *Messenger) SendChatMessages(ctx context.Context, messages []*common.Mes
 
 // SendChatMessage takes a minimal message and sends it based on the corresponding chat
 func (m *Messenger) sendChatMessage(ctx context.Context, message *common.Message) (*MessengerResponse, error) {
+	var fileData []byte
+
 	if len(message.ImagePath) != 0 {
 		file, err := os.Open(message.ImagePath)
 		if err != nil {
@@ -2016,6 +2116,13 @@ func (m *Messenger) sendChatMessage(ctx context.Context, message *common.Message
 
 		message.ContentType = protobuf.ChatMessage_COMMUNITY
 
+	} else if len(message.FilePath) != 0 {
+		var err error
+		fileData, err = m.prepareFileMessage(message)
+		if err != nil {
+			return nil, err
+		}
+
 	} else if len(message.AudioPath) != 0 {
 		file, err := os.Open(message.AudioPath)
 		if err != nil {
@@ -2059,6 +2166,12 @@ func (m *Messenger) sendChatMessage(ctx context.Context, message *common.Message
 		return nil, err
 	}
 
+	// Link previews are unfurled by the client beforehand, see UnfurlLinks
+	err = validateLinkPreviews(message.LinkPreviews)
+	if err != nil {
+		return nil, err
+	}
+
 	encodedMessage, err := m.encodeChatEntity(chat, message)
 	if err != nil {
 		return nil, err
@@ -2095,6 +2208,13 @@ func (m *Messenger) sendChatMessage(ctx context.Context, message *common.Message
 		return nil, err
 	}
 
+	if fileData != nil {
+		err = m.sendFileChunks(ctx, chat, message, fileData)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	msg, err := m.pullMessagesAndResponsesFromDB([]*common.Message{message})
 	if err != nil {
 		return nil, err
@@ -2260,6 +2380,7 @@ func (m *Messenger) syncContact(ctx context.Context, contact *Contact) error {
 		Id:            contact.ID,
 		EnsName:       contact.Name,
 		LocalNickname: contact.LocalNickname,
+		TrustStatus:   protobuf.TrustStatus(contact.TrustStatus),
 	}
 	encodedMessage, err := proto.Marshal(syncMessage)
 	if err != nil {
@@ -2288,7 +2409,17 @@ func (m *Messenger) RetrieveAll() (*MessengerResponse, error) {
 		return nil, err
 	}
 
-	return m.handleRetrievedMessages(chatWithMessages)
+	m.storeCommunityMessageArchiveEnvelopes(chatWithMessages)
+	importedArchives := m.addCommunityMessageArchives(chatWithMessages)
+
+	response, err := m.handleRetrievedMessages(chatWithMessages)
+	if err != nil {
+		return nil, err
+	}
+
+	m.markCommunityMessageArchivesImported(importedArchives)
+
+	return response, nil
 }
 
 type CurrentMessageState struct {
@@ -2347,6 +2478,11 @@ func (m *Messenger) markDeliveredMessages(acks [][]byte) {
 			m.logger.Debug("Can't set message status as delivered", zap.Error(err))
 		}
 
+		err = m.deleteConfirmedSocialRecoveryShare(messageID)
+		if err != nil {
+			m.logger.Warn("Can't delete confirmed social recovery share", zap.Error(err))
+		}
+
 		//send signal to client that message status updated
 		if m.config.messengerSignalsHandler != nil {
 			message, err := m.persistence.MessageByID(messageID)
@@ -2464,6 +2600,7 @@ func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filte
 				publicKey := msg.SigPubKey()
 
 				m.handleInstallations(msg.Installations)
+				m.handleContactInstallations(messageState, msg.Installations)
 				err := m.handleSharedSecrets(msg.SharedSecrets)
 				if err != nil {
 					// log and continue, non-critical error
@@ -2600,6 +2737,91 @@ func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filte
 							continue
 						}
 
+					case protobuf.RequestContactVerification:
+						p := msg.ParsedMessage.Interface().(protobuf.RequestContactVerification)
+						logger.Debug("Handling RequestContactVerification")
+						err = m.HandleRequestContactVerification(messageState, p)
+						if err != nil {
+							logger.Warn("failed to handle RequestContactVerification", zap.Error(err))
+							allMessagesProcessed = false
+							continue
+						}
+
+					case protobuf.AcceptContactVerification:
+						p := msg.ParsedMessage.Interface().(protobuf.AcceptContactVerification)
+						logger.Debug("Handling AcceptContactVerification")
+						err = m.HandleAcceptContactVerification(messageState, p)
+						if err != nil {
+							logger.Warn("failed to handle AcceptContactVerification", zap.Error(err))
+							allMessagesProcessed = false
+							continue
+						}
+
+					case protobuf.SyncVerificationRequest:
+						if !common.IsPubKeyEqual(messageState.CurrentMessageState.PublicKey, &m.identity.PublicKey) {
+							logger.Warn("not coming from us, ignoring")
+							continue
+						}
+
+						p := msg.ParsedMessage.Interface().(protobuf.SyncVerificationRequest)
+						logger.Debug("Handling SyncVerificationRequest")
+						err = m.HandleSyncVerificationRequest(messageState, p)
+						if err != nil {
+							logger.Warn("failed to handle SyncVerificationRequest", zap.Error(err))
+							allMessagesProcessed = false
+							continue
+						}
+
+					case protobuf.SocialRecoveryShare:
+						p := msg.ParsedMessage.Interface().(protobuf.SocialRecoveryShare)
+						logger.Debug("Handling SocialRecoveryShare")
+						err = m.HandleSocialRecoveryShare(messageState, p)
+						if err != nil {
+							logger.Warn("failed to handle SocialRecoveryShare", zap.Error(err))
+							allMessagesProcessed = false
+							continue
+						}
+
+					case protobuf.SocialRecoveryRequest:
+						p := msg.ParsedMessage.Interface().(protobuf.SocialRecoveryRequest)
+						logger.Debug("Handling SocialRecoveryRequest")
+						err = m.HandleSocialRecoveryRequest(messageState, p)
+						if err != nil {
+							logger.Warn("failed to handle SocialRecoveryRequest", zap.Error(err))
+							allMessagesProcessed = false
+							continue
+						}
+
+					case protobuf.SafeTransactionSignature:
+						p := msg.ParsedMessage.Interface().(protobuf.SafeTransactionSignature)
+						logger.Debug("Handling SafeTransactionSignature")
+						err = m.HandleSafeTransactionSignature(messageState, p)
+						if err != nil {
+							logger.Warn("failed to handle SafeTransactionSignature", zap.Error(err))
+							allMessagesProcessed = false
+							continue
+						}
+
+					case protobuf.FileChunk:
+						p := msg.ParsedMessage.Interface().(protobuf.FileChunk)
+						logger.Debug("Handling FileChunk")
+						err = m.HandleFileChunk(messageState, p)
+						if err != nil {
+							logger.Warn("failed to handle FileChunk", zap.Error(err))
+							allMessagesProcessed = false
+							continue
+						}
+
+					case protobuf.PollVote:
+						p := msg.ParsedMessage.Interface().(protobuf.PollVote)
+						logger.Debug("Handling PollVote")
+						err = m.HandlePollVote(messageState, p)
+						if err != nil {
+							logger.Warn("failed to handle PollVote", zap.Error(err))
+							allMessagesProcessed = false
+							continue
+						}
+
 					case protobuf.SyncInstallationContact:
 						if !common.IsPubKeyEqual(messageState.CurrentMessageState.PublicKey, &m.identity.PublicKey) {
 							logger.Warn("not coming from us, ignoring")
@@ -2860,6 +3082,72 @@ func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filte
 							continue
 						}
 
+					case protobuf.CommunityMessageArchiveRequest:
+						logger.Debug("Handling CommunityMessageArchiveRequest")
+						request := msg.ParsedMessage.Interface().(protobuf.CommunityMessageArchiveRequest)
+						err = m.HandleCommunityMessageArchiveRequest(messageState, publicKey, request)
+						if err != nil {
+							logger.Warn("failed to handle CommunityMessageArchiveRequest", zap.Error(err))
+							continue
+						}
+
+					case protobuf.CommunityMessageArchiveResponse:
+						logger.Debug("Handling CommunityMessageArchiveResponse")
+						response := msg.ParsedMessage.Interface().(protobuf.CommunityMessageArchiveResponse)
+						err = m.HandleCommunityMessageArchiveResponse(messageState, response)
+						if err != nil {
+							logger.Warn("failed to handle CommunityMessageArchiveResponse", zap.Error(err))
+							continue
+						}
+
+					case protobuf.CommunityControlSignatureRequest:
+						logger.Debug("Handling CommunityControlSignatureRequest")
+						request := msg.ParsedMessage.Interface().(protobuf.CommunityControlSignatureRequest)
+						err = m.HandleCommunityControlSignatureRequest(messageState, publicKey, request)
+						if err != nil {
+							logger.Warn("failed to handle CommunityControlSignatureRequest", zap.Error(err))
+							continue
+						}
+
+					case protobuf.CommunityControlSignature:
+						logger.Debug("Handling CommunityControlSignature")
+						signature := msg.ParsedMessage.Interface().(protobuf.CommunityControlSignature)
+						err = m.HandleCommunityControlSignature(messageState, signature)
+						if err != nil {
+							logger.Warn("failed to handle CommunityControlSignature", zap.Error(err))
+							continue
+						}
+
+					case protobuf.CommunityDirectoryListing:
+						logger.Debug("Handling CommunityDirectoryListing")
+						listing := msg.ParsedMessage.Interface().(protobuf.CommunityDirectoryListing)
+						err = m.HandleCommunityDirectoryListing(messageState, publicKey, listing)
+						if err != nil {
+							logger.Warn("failed to handle CommunityDirectoryListing", zap.Error(err))
+							continue
+						}
+
+					case protobuf.SpamBlocklist:
+						logger.Debug("Handling SpamBlocklist")
+						blocklist := msg.ParsedMessage.Interface().(protobuf.SpamBlocklist)
+						err = m.HandleSpamBlocklist(messageState, publicKey, blocklist)
+						if err != nil {
+							logger.Warn("failed to handle SpamBlocklist", zap.Error(err))
+							continue
+						}
+
+					case protobuf.AnonymousMetricBatch:
+						logger.Debug("Received AnonymousMetricBatch")
+						if m.anonMetricsServer == nil {
+							continue
+						}
+						logger.Debug("Handling AnonymousMetricBatch")
+						if err := m.anonMetricsServer.StoreMetrics(msg.ID, msg.ParsedMessage.Interface().(protobuf.AnonymousMetricBatch)); err != nil {
+							logger.Warn("failed to store AnonymousMetricBatch", zap.Error(err))
+						}
+						// We continue in any case, no changes to messenger
+						continue
+
 					default:
 						// Check if is an encrypted PushNotificationRegistration
 						if msg.Type == protobuf.ApplicationMetadataMessage_PUSH_NOTIFICATION_REGISTRATION {
@@ -3110,10 +3398,18 @@ func (m *Messenger) SaveMessages(messages []*common.Message) error {
 }
 
 func (m *Messenger) DeleteMessage(id string) error {
-	return m.persistence.DeleteMessage(id)
+	err := m.persistence.DeleteMessage(id)
+	if err != nil {
+		return err
+	}
+	return m.deleteFiles(id)
 }
 
 func (m *Messenger) DeleteMessagesByChatID(id string) error {
+	err := m.deleteChatFiles(id)
+	if err != nil {
+		return err
+	}
 	return m.persistence.DeleteMessagesByChatID(id)
 }
 
@@ -4123,6 +4419,10 @@ func generateAliasAndIdenticon(pk string) (string, string, error) {
 }
 
 func (m *Messenger) SendEmojiReaction(ctx context.Context, chatID, messageID string, emojiID protobuf.EmojiReaction_Type) (*MessengerResponse, error) {
+	return m.sendEmojiReaction(ctx, chatID, messageID, protobuf.EmojiReaction{Type: emojiID})
+}
+
+func (m *Messenger) sendEmojiReaction(ctx context.Context, chatID, messageID string, reaction protobuf.EmojiReaction) (*MessengerResponse, error) {
 	var response MessengerResponse
 
 	chat, ok := m.allChats.Load(chatID)
@@ -4133,14 +4433,18 @@ func (m *Messenger) SendEmojiReaction(ctx context.Context, chatID, messageID str
 
 	emojiR := &EmojiReaction{
 		EmojiReaction: protobuf.EmojiReaction{
-			Clock:     clock,
-			MessageId: messageID,
-			ChatId:    chatID,
-			Type:      emojiID,
+			Clock:            clock,
+			MessageId:        messageID,
+			ChatId:           chatID,
+			Type:             reaction.Type,
+			Emoji:            reaction.Emoji,
+			CommunityEmojiId: reaction.CommunityEmojiId,
 		},
 		LocalChatID: chatID,
 		From:        types.EncodeHex(crypto.FromECDSAPub(&m.identity.PublicKey)),
 	}
+	emojiR.normalize()
+
 	encodedMessage, err := m.encodeChatEntity(chat, emojiR)
 	if err != nil {
 		return nil, err
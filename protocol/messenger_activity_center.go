@@ -20,6 +20,16 @@ func (m *Messenger) processAcceptedActivityCenterNotifications(notifications []*
 	response := &MessengerResponse{}
 	var chats []*Chat
 	for _, notification := range notifications {
+		// Accepting a message caught by the spam filter shows it
+		if notification.Type == ActivityCenterNotificationTypeFilteredMessage && notification.Message != nil {
+			err := m.persistence.UnhideMessage(notification.Message.ID)
+			if err != nil {
+				return nil, err
+			}
+			response.AddMessage(notification.Message)
+			continue
+		}
+
 		if notification.ChatID != "" {
 			chat, ok := m.allChats.Load(notification.ChatID)
 			if !ok {
@@ -0,0 +1,237 @@
+// In order to run the tests storing metrics, you must run a PostgreSQL database.
+//
+// Using Docker:
+//   docker run --name anon-metrics-db -e POSTGRES_HOST_AUTH_METHOD=trust -d -p 5432:5432 postgres:9.6-alpine
+//
+
+package protocol
+
+import (
+	"crypto/ecdsa"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/appmetrics"
+	gethbridge "github.com/status-im/status-go/eth-node/bridge/geth"
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/multiaccounts/accounts"
+	"github.com/status-im/status-go/params"
+	"github.com/status-im/status-go/protocol/anonmetrics"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/tt"
+	"github.com/status-im/status-go/waku"
+)
+
+const testAnonMetricsPostgresURI = "postgres://postgres@127.0.0.1:5432/postgres?sslmode=disable"
+
+func TestMessengerAnonMetricsSuite(t *testing.T) {
+	suite.Run(t, new(MessengerAnonMetricsSuite))
+}
+
+type MessengerAnonMetricsSuite struct {
+	suite.Suite
+	db     *sql.DB
+	shh    types.Waku
+	logger *zap.Logger
+}
+
+func (s *MessengerAnonMetricsSuite) SetupTest() {
+	s.logger = tt.MustCreateTestLogger()
+
+	config := waku.DefaultConfig
+	config.MinimumAcceptedPoW = 0
+	shh := waku.New(&config, s.logger)
+	s.shh = gethbridge.NewGethWakuWrapper(shh)
+	s.Require().NoError(shh.Start())
+}
+
+func (s *MessengerAnonMetricsSuite) TearDownTest() {
+	if s.db != nil {
+		s.Require().NoError(s.db.Close())
+	}
+}
+
+// setupDatabase empties the database metrics are stored in,
+// the test is skipped if no database is running
+func (s *MessengerAnonMetricsSuite) setupDatabase() {
+	db, err := sql.Open("postgres", testAnonMetricsPostgresURI)
+	s.Require().NoError(err)
+	if err := db.Ping(); err != nil {
+		s.T().Skipf("no postgres database running: %v", err)
+	}
+	_, err = db.Exec("DROP TABLE IF EXISTS anonymous_metrics, schema_migrations")
+	s.Require().NoError(err)
+	s.db = db
+}
+
+func (s *MessengerAnonMetricsSuite) newMessenger(privateKey *ecdsa.PrivateKey, options []Option) *Messenger {
+	messenger, err := newMessengerWithKey(s.shh, privateKey, s.logger, options)
+	s.Require().NoError(err)
+
+	networks := json.RawMessage("{}")
+	settings := accounts.Settings{
+		Address:           types.HexToAddress("0x1122334455667788990011223344556677889900"),
+		CurrentNetwork:    "mainnet_rpc",
+		DappsAddress:      types.HexToAddress("0x1122334455667788990011223344556677889900"),
+		InstallationID:    "d3efcff6-cffa-560e-a547-21d3858cbc51",
+		KeyUID:            "0x1122334455667788990011223344556677889900",
+		Name:              "Test",
+		Networks:          &networks,
+		PublicKey:         "0x04112233445566778899001122334455667788990011223344556677889900112233445566778899001122334455667788990011223344556677889900",
+		SigningPhrase:     "yurt joey vibe",
+		WalletRootAddress: types.HexToAddress("0x1122334455667788990011223344556677889900")}
+	s.Require().NoError(messenger.settings.CreateSettings(settings, params.NodeConfig{NetworkID: 10, DataDir: "test"}))
+
+	_, err = messenger.Start()
+	s.Require().NoError(err)
+	return messenger
+}
+
+func (s *MessengerAnonMetricsSuite) storedMetrics() int {
+	var count int
+	err := s.db.QueryRow("SELECT COUNT(*) FROM anonymous_metrics").Scan(&count)
+	s.Require().NoError(err)
+	return count
+}
+
+func (s *MessengerAnonMetricsSuite) saveAppMetrics(m *Messenger) *appmetrics.Database {
+	appMetrics := appmetrics.NewDB(m.database)
+	s.Require().NoError(appMetrics.SaveAppMetrics([]appmetrics.AppMetric{
+		{Event: appmetrics.NavigateTo, Value: json.RawMessage(`{"view_id": "view-1", "params": {"screen": "login"}}`), OS: "android", AppVersion: "1.11"},
+		{Event: appmetrics.NavigateTo, Value: json.RawMessage(`{"view_id": "view-2", "params": {"screen": "chat"}}`), OS: "android", AppVersion: "1.11"},
+	}, "rand-omse-ssid"))
+	return appMetrics
+}
+
+func (s *MessengerAnonMetricsSuite) TestSendBatch() {
+	collectorKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	collector := s.newMessenger(collectorKey, nil)
+	defer collector.Shutdown() // nolint: errcheck
+
+	clientKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	client := s.newMessenger(clientKey, []Option{
+		WithAnonMetricsClientConfig(&anonmetrics.ClientConfig{
+			SendAddress: &collectorKey.PublicKey,
+		}),
+	})
+	defer client.Shutdown() // nolint: errcheck
+
+	appMetrics := s.saveAppMetrics(client)
+
+	// Nothing is sent until the user opts in
+	s.Require().NoError(client.anonMetricsClient.Send())
+	unsent, err := appMetrics.GetUnsentAppMetrics(10)
+	s.Require().NoError(err)
+	s.Require().Len(unsent, 2)
+
+	s.Require().NoError(client.settings.SaveSetting("anon-metrics/should-send?", true))
+	s.Require().NoError(client.anonMetricsClient.Send())
+	unsent, err = appMetrics.GetUnsentAppMetrics(10)
+	s.Require().NoError(err)
+	s.Require().Empty(unsent)
+
+	// The collector receives the batch, sent from an ephemeral key
+	var batch *protobuf.AnonymousMetricBatch
+	err = tt.RetryWithBackOff(func() error {
+		messages, err := collector.transport.RetrieveRawAll()
+		if err != nil {
+			return err
+		}
+		for _, filterMessages := range messages {
+			for _, shhMessage := range filterMessages {
+				statusMessages, _, err := collector.sender.HandleMessages(shhMessage, true)
+				if err != nil {
+					continue
+				}
+				for _, statusMessage := range statusMessages {
+					if statusMessage.Type != protobuf.ApplicationMetadataMessage_ANONYMOUS_METRIC_BATCH {
+						continue
+					}
+					s.Require().False(statusMessage.SigPubKey().Equal(&clientKey.PublicKey))
+					received := statusMessage.ParsedMessage.Interface().(protobuf.AnonymousMetricBatch)
+					batch = &received
+				}
+			}
+		}
+		if batch == nil {
+			return errors.New("batch not received")
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+	s.Require().Len(batch.Metrics, 2)
+
+	metric := anonmetrics.ProtobufToAppMetric(batch.Metrics[0])
+	s.Require().Equal(appmetrics.NavigateTo, metric.Event)
+	s.Require().JSONEq(`{"view_id": "view-1", "params": {"screen": "login"}}`, string(metric.Value))
+	s.Require().Empty(metric.SessionID)
+	s.Require().NoError(appmetrics.ValidateAppMetrics([]appmetrics.AppMetric{metric}))
+}
+
+func (s *MessengerAnonMetricsSuite) TestStoreMetrics() {
+	s.setupDatabase()
+
+	serverKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	clientKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	server := s.newMessenger(serverKey, []Option{
+		WithAnonMetricsServerConfig(&anonmetrics.ServerConfig{
+			Enabled:     true,
+			PostgresURI: testAnonMetricsPostgresURI,
+		}),
+	})
+	defer server.Shutdown() // nolint: errcheck
+
+	client := s.newMessenger(clientKey, []Option{
+		WithAnonMetricsClientConfig(&anonmetrics.ClientConfig{
+			SendAddress: &serverKey.PublicKey,
+		}),
+	})
+	defer client.Shutdown() // nolint: errcheck
+
+	appMetrics := s.saveAppMetrics(client)
+
+	// Nothing is sent until the user opts in
+	s.Require().NoError(client.anonMetricsClient.Send())
+	unsent, err := appMetrics.GetUnsentAppMetrics(10)
+	s.Require().NoError(err)
+	s.Require().Len(unsent, 2)
+
+	s.Require().NoError(client.settings.SaveSetting("anon-metrics/should-send?", true))
+	s.Require().NoError(client.anonMetricsClient.Send())
+	unsent, err = appMetrics.GetUnsentAppMetrics(10)
+	s.Require().NoError(err)
+	s.Require().Empty(unsent)
+
+	_, err = WaitOnMessengerResponse(
+		server,
+		func(r *MessengerResponse) bool { return s.storedMetrics() == 2 },
+		"metrics not stored",
+	)
+	s.Require().NoError(err)
+
+	var viewIDs []string
+	rows, err := s.db.Query("SELECT value->>'view_id' FROM anonymous_metrics ORDER BY position")
+	s.Require().NoError(err)
+	defer rows.Close()
+	for rows.Next() {
+		var viewID string
+		s.Require().NoError(rows.Scan(&viewID))
+		viewIDs = append(viewIDs, viewID)
+	}
+	s.Require().Equal([]string{"view-1", "view-2"}, viewIDs)
+
+	// The metrics aren't sent again
+	s.Require().NoError(client.anonMetricsClient.Send())
+	s.Require().Equal(2, s.storedMetrics())
+}
@@ -426,7 +426,12 @@ func (m *Messenger) clearHistory(id string) (*MessengerResponse, error) {
 
 	clock, _ := chat.NextClockAndTimestamp(m.transport)
 
-	err := m.persistence.ClearHistory(chat, clock)
+	err := m.deleteChatFiles(chat.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.persistence.ClearHistory(chat, clock)
 	if err != nil {
 		return nil, err
 	}
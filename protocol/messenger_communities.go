@@ -78,6 +78,11 @@ func (m *Messenger) handleCommunitiesSubscription(c chan *communities.Subscripti
 					if err != nil {
 						m.logger.Warn("failed to publish org", zap.Error(err))
 					}
+
+					err = m.handleCommunityControl(sub.Community)
+					if err != nil {
+						m.logger.Warn("failed to handle community control", zap.Error(err))
+					}
 				}
 
 				for _, invitation := range sub.Invitations {
@@ -112,6 +117,8 @@ func (m *Messenger) handleCommunitiesSubscription(c chan *communities.Subscripti
 					}
 				}
 
+				m.publishCommunityDirectoryListings()
+
 				// set lastPublished
 				lastPublished = time.Now().Unix()
 
@@ -188,6 +195,11 @@ func (m *Messenger) joinCommunity(ctx context.Context, communityID types.HexByte
 		return nil, err
 	}
 
+	err = m.requestCommunityMessageArchives(community)
+	if err != nil {
+		m.logger.Warn("failed to request community message archives", zap.Error(err))
+	}
+
 	return response, nil
 }
 
@@ -221,7 +233,7 @@ func (m *Messenger) RequestToJoinCommunity(request *requests.RequestToJoinCommun
 		SkipEncryption: true,
 		MessageType:    protobuf.ApplicationMetadataMessage_COMMUNITY_REQUEST_TO_JOIN,
 	}
-	_, err = m.sender.SendCommunityMessage(context.Background(), community.PublicKey(), rawMessage)
+	_, err = m.sender.SendCommunityMessage(context.Background(), community.OwnerPublicKey(), rawMessage)
 	if err != nil {
 		return nil, err
 	}
@@ -331,6 +343,36 @@ func (m *Messenger) DeleteCommunityCategory(request *requests.DeleteCommunityCat
 	return &response, nil
 }
 
+func (m *Messenger) AddCommunityEmoji(request *requests.AddCommunityEmoji) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	community, err := m.communitiesManager.AddEmoji(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.AddCommunity(community)
+	return response, nil
+}
+
+func (m *Messenger) DeleteCommunityEmoji(request *requests.DeleteCommunityEmoji) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	community, err := m.communitiesManager.DeleteEmoji(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.AddCommunity(community)
+	return response, nil
+}
+
 func (m *Messenger) AcceptRequestToJoinCommunity(request *requests.AcceptRequestToJoinCommunity) (*MessengerResponse, error) {
 	if err := request.Validate(); err != nil {
 		return nil, err
@@ -778,7 +820,10 @@ func (m *Messenger) handleCommunityDescription(state *ReceivedMessageState, sign
 		return err
 	}
 
-	community := communityResponse.Community
+	community, err := m.takeCommunityControl(communityResponse.Community)
+	if err != nil {
+		return err
+	}
 
 	state.Response.AddCommunity(community)
 	state.Response.CommunityChanges = append(state.Response.CommunityChanges, communityResponse.Changes)
@@ -822,5 +867,10 @@ func (m *Messenger) handleCommunityDescription(state *ReceivedMessageState, sign
 		return err
 	}
 
+	err = m.requestCommunityMessageArchives(community)
+	if err != nil {
+		m.logger.Warn("failed to request community message archives", zap.Error(err))
+	}
+
 	return nil
 }
@@ -0,0 +1,185 @@
+package protocol
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/communities"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/transport"
+)
+
+// How often we check whether the communities we own have envelopes to archive
+const communityMessageArchivesInterval = 1 * time.Hour
+
+// maxCommunityMessageArchivesImport is the number of archives imported
+// on each RetrieveAll, so that a large history doesn't hold it up
+const maxCommunityMessageArchivesImport = 5
+
+// watchCommunityMessageArchives periodically archives the envelopes
+// received in the chats of the communities we own
+func (m *Messenger) watchCommunityMessageArchives() {
+	ticker := time.NewTicker(communityMessageArchivesInterval)
+
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				m.createCommunityMessageArchives()
+			case <-m.quit:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+}
+
+func (m *Messenger) createCommunityMessageArchives() {
+	created, err := m.communitiesManager.Created()
+	if err != nil {
+		m.logger.Warn("failed to retrieve orgs", zap.Error(err))
+		return
+	}
+
+	now := m.getTimesource().GetCurrentTime() / 1000
+	for _, community := range created {
+		_, err := m.communitiesManager.CreateMessageArchives(community, now)
+		if err != nil {
+			m.logger.Warn("failed to create community message archives", zap.String("community-id", community.IDString()), zap.Error(err))
+		}
+	}
+}
+
+// storeCommunityMessageArchiveEnvelopes keeps the raw envelopes received in the
+// chats of the communities we own
+func (m *Messenger) storeCommunityMessageArchiveEnvelopes(chatWithMessages map[transport.Filter][]*types.Message) {
+	for filter, messages := range chatWithMessages {
+		chat, ok := m.allChats.Load(filter.ChatID)
+		if !ok || !chat.CommunityChat() {
+			continue
+		}
+
+		err := m.communitiesManager.StoreMessageArchiveEnvelopes(chat.CommunityID, chat.CommunityChatID(), messages)
+		if err != nil {
+			m.logger.Warn("failed to store community message archive envelopes", zap.String("chat-id", chat.ID), zap.Error(err))
+		}
+	}
+}
+
+// addCommunityMessageArchives adds the envelopes of the archives we received to
+// the ones to be handled, so they go through the usual pipeline and are
+// deduplicated by message ID. It returns the hashes of the archives added.
+func (m *Messenger) addCommunityMessageArchives(chatWithMessages map[transport.Filter][]*types.Message) [][]byte {
+	archives, err := m.communitiesManager.UnimportedMessageArchives(maxCommunityMessageArchivesImport)
+	if err != nil {
+		m.logger.Warn("failed to retrieve community message archives", zap.Error(err))
+		return nil
+	}
+
+	var hashes [][]byte
+	for _, archive := range archives {
+		hashes = append(hashes, archive.Hash)
+
+		chatID := archive.CommunityID.String() + archive.ChatID
+		filter := m.transport.FilterByChatID(chatID)
+		if filter == nil {
+			// We have left the community or the chat, nothing to import
+			continue
+		}
+
+		content, err := communities.OpenMessageArchive(archive.Data)
+		if err != nil {
+			m.logger.Warn("failed to open community message archive", zap.String("chat-id", chatID), zap.Error(err))
+			continue
+		}
+
+		for _, envelope := range content.Messages {
+			chatWithMessages[*filter] = append(chatWithMessages[*filter], communities.FromWakuMessage(envelope))
+		}
+	}
+
+	return hashes
+}
+
+func (m *Messenger) markCommunityMessageArchivesImported(hashes [][]byte) {
+	for _, hash := range hashes {
+		err := m.communitiesManager.SetMessageArchiveImported(hash)
+		if err != nil {
+			m.logger.Warn("failed to mark community message archive as imported", zap.Error(err))
+		}
+	}
+}
+
+// requestCommunityMessageArchives asks the community for the archives it
+// published that we don't have yet
+func (m *Messenger) requestCommunityMessageArchives(community *communities.Community) error {
+	now := m.getTimesource().GetCurrentTime()
+	hashes, err := m.communitiesManager.MessageArchivesToRequest(community, now/1000)
+	if err != nil {
+		return err
+	}
+	if len(hashes) == 0 {
+		return nil
+	}
+
+	request := &protobuf.CommunityMessageArchiveRequest{
+		Clock:       now,
+		CommunityId: community.ID(),
+		Hashes:      hashes,
+	}
+
+	payload, err := proto.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	rawMessage := common.RawMessage{
+		Payload:        payload,
+		SkipEncryption: true,
+		MessageType:    protobuf.ApplicationMetadataMessage_COMMUNITY_MESSAGE_ARCHIVE_REQUEST,
+	}
+	_, err = m.sender.SendCommunityMessage(context.Background(), community.OwnerPublicKey(), rawMessage)
+	return err
+}
+
+// HandleCommunityMessageArchiveRequest sends the requested archives of a community we own
+func (m *Messenger) HandleCommunityMessageArchiveRequest(state *ReceivedMessageState, signer *ecdsa.PublicKey, request protobuf.CommunityMessageArchiveRequest) error {
+	community, archives, err := m.communitiesManager.MessageArchivesForRequest(signer, &request)
+	if err != nil {
+		return err
+	}
+
+	for _, archive := range archives {
+		payload, err := proto.Marshal(archive.ToResponse(m.getTimesource().GetCurrentTime()))
+		if err != nil {
+			return err
+		}
+
+		rawMessage := common.RawMessage{
+			Payload: payload,
+			Sender:  community.PrivateKey(),
+			// we don't want to wrap in an encryption layer message
+			SkipEncryption: true,
+			MessageType:    protobuf.ApplicationMetadataMessage_COMMUNITY_MESSAGE_ARCHIVE_RESPONSE,
+		}
+		_, err = m.sender.SendPrivate(context.Background(), signer, &rawMessage)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// HandleCommunityMessageArchiveResponse stores an archive sent by a community,
+// its envelopes are imported on the next retrieval
+func (m *Messenger) HandleCommunityMessageArchiveResponse(state *ReceivedMessageState, response protobuf.CommunityMessageArchiveResponse) error {
+	_, err := m.communitiesManager.HandleMessageArchiveResponse(&response)
+	return err
+}
@@ -0,0 +1,203 @@
+package protocol
+
+import (
+	"context"
+	"crypto/ecdsa"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/communities"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+)
+
+// TransferCommunityOwnership hands control of a community we own to another key
+func (m *Messenger) TransferCommunityOwnership(request *requests.TransferCommunityOwnership) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	community, err := m.communitiesManager.TransferOwnership(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.AddCommunity(community)
+	return response, nil
+}
+
+// SetCommunityControl sets the members whose signatures are required to
+// change the critical settings of a community we own
+func (m *Messenger) SetCommunityControl(request *requests.SetCommunityControl) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	community, err := m.communitiesManager.SetControl(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.AddCommunity(community)
+	return response, nil
+}
+
+func (m *Messenger) CommunityControlSignatureRequests() ([]*communities.ControlSignatureRequest, error) {
+	return m.communitiesManager.ControlSignatureRequests()
+}
+
+// SignCommunityControl approves the pending control signature request of a
+// community, and sends the signature to its owner
+func (m *Messenger) SignCommunityControl(communityID types.HexBytes) (*MessengerResponse, error) {
+	community, signature, err := m.communitiesManager.SignControlSignatureRequest(communityID, m.identity)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := proto.Marshal(signature)
+	if err != nil {
+		return nil, err
+	}
+
+	rawMessage := common.RawMessage{
+		Payload:        payload,
+		SkipEncryption: true,
+		MessageType:    protobuf.ApplicationMetadataMessage_COMMUNITY_CONTROL_SIGNATURE,
+	}
+	_, err = m.sender.SendPrivate(context.Background(), community.OwnerPublicKey(), &rawMessage)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.AddCommunity(community)
+	return response, nil
+}
+
+func (m *Messenger) DeclineCommunityControl(communityID types.HexBytes) error {
+	return m.communitiesManager.DeclineControlSignatureRequest(communityID)
+}
+
+// handleCommunityControl is called once a community we own has been published.
+// It asks the control signers to approve its critical settings, if needed,
+// and drops our key if we have just handed the community over.
+func (m *Messenger) handleCommunityControl(community *communities.Community) error {
+	if !community.IsAdmin() {
+		return nil
+	}
+
+	if !community.IsOwner(&community.PrivateKey().PublicKey) {
+		_, err := m.communitiesManager.ReleaseControl(community)
+		return err
+	}
+
+	if !community.NeedsControlSignatures() {
+		return nil
+	}
+
+	return m.requestCommunityControlSignatures(community)
+}
+
+func (m *Messenger) requestCommunityControlSignatures(community *communities.Community) error {
+	hash, err := community.ControlStateHash()
+	if err != nil {
+		return err
+	}
+
+	// We only ask once for each state
+	key := community.IDString() + types.EncodeHex(hash)
+	if _, ok := m.communityControlRequests.Load(key); ok {
+		return nil
+	}
+
+	description, err := community.MarshaledDescription()
+	if err != nil {
+		return err
+	}
+
+	signers, err := community.ControlSigners()
+	if err != nil {
+		return err
+	}
+
+	request := &protobuf.CommunityControlSignatureRequest{
+		Clock:       m.getTimesource().GetCurrentTime(),
+		CommunityId: community.ID(),
+		Description: description,
+	}
+
+	payload, err := proto.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	for _, signer := range signers {
+		if common.IsPubKeyEqual(signer, &m.identity.PublicKey) {
+			continue
+		}
+
+		rawMessage := common.RawMessage{
+			Payload: payload,
+			Sender:  community.PrivateKey(),
+			// we don't want to wrap in an encryption layer message
+			SkipEncryption: true,
+			MessageType:    protobuf.ApplicationMetadataMessage_COMMUNITY_CONTROL_SIGNATURE_REQUEST,
+		}
+		_, err = m.sender.SendPrivate(context.Background(), signer, &rawMessage)
+		if err != nil {
+			return err
+		}
+	}
+
+	m.communityControlRequests.Store(key, true)
+
+	return nil
+}
+
+// HandleCommunityControlSignatureRequest stores a request to approve the
+// critical settings of a community, to be signed or declined by the user
+func (m *Messenger) HandleCommunityControlSignatureRequest(state *ReceivedMessageState, signer *ecdsa.PublicKey, request protobuf.CommunityControlSignatureRequest) error {
+	controlRequest, err := m.communitiesManager.HandleControlSignatureRequest(signer, &request)
+	if err != nil {
+		return err
+	}
+
+	state.Response.AddCommunityControlSignatureRequest(controlRequest)
+	return nil
+}
+
+// HandleCommunityControlSignature adds the signature of a control signer to a
+// community we own, the description is published again with it
+func (m *Messenger) HandleCommunityControlSignature(state *ReceivedMessageState, signature protobuf.CommunityControlSignature) error {
+	community, err := m.communitiesManager.HandleControlSignature(&signature)
+	if err != nil {
+		return err
+	}
+
+	state.Response.AddCommunity(community)
+	return nil
+}
+
+// takeCommunityControl starts controlling a community whose ownership has
+// been transferred to us
+func (m *Messenger) takeCommunityControl(community *communities.Community) (*communities.Community, error) {
+	if community.IsAdmin() || !community.IsOwner(&m.identity.PublicKey) {
+		return community, nil
+	}
+
+	community, err := m.communitiesManager.TakeControl(community.ID(), m.identity)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = m.transport.InitCommunityFilters([]*ecdsa.PrivateKey{m.identity})
+	if err != nil {
+		return nil, err
+	}
+
+	return community, nil
+}
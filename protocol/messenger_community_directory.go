@@ -0,0 +1,131 @@
+package protocol
+
+import (
+	"context"
+	"crypto/ecdsa"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/communities"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+)
+
+// ListCommunityInDirectory publishes a listing of a community we own in the
+// directory, it's published again along with the community description
+func (m *Messenger) ListCommunityInDirectory(request *requests.ListCommunityInDirectory) error {
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	ownListing, err := m.communitiesManager.ListInDirectory(request, m.getTimesource().GetCurrentTime())
+	if err != nil {
+		return err
+	}
+
+	return m.publishCommunityDirectoryListing(ownListing)
+}
+
+// UnlistCommunityFromDirectory stops publishing the listing of a community,
+// it disappears from the directory once expired
+func (m *Messenger) UnlistCommunityFromDirectory(communityID types.HexBytes) error {
+	return m.communitiesManager.UnlistFromDirectory(communityID)
+}
+
+// JoinCommunityDirectory starts collecting the listings of the directory
+func (m *Messenger) JoinCommunityDirectory() error {
+	filters, err := m.transport.InitPublicFilters([]string{communities.DirectoryChatID})
+	if err != nil {
+		return err
+	}
+
+	_, err = m.scheduleSyncFilters(filters)
+	return err
+}
+
+// LeaveCommunityDirectory stops collecting the listings of the directory,
+// the ones already received are kept until they expire
+func (m *Messenger) LeaveCommunityDirectory() error {
+	_, err := m.transport.RemoveFilterByChatID(communities.DirectoryChatID)
+	return err
+}
+
+// CommunityDirectory returns the listings collected matching request
+func (m *Messenger) CommunityDirectory(request *requests.SearchCommunityDirectory) ([]*communities.DirectoryListing, error) {
+	query := &communities.DirectoryQuery{
+		Name:           request.Name,
+		Tags:           request.Tags,
+		IncludeFlagged: request.IncludeFlagged,
+	}
+	return m.communitiesManager.DirectoryListings(query, m.getTimesource().GetCurrentTime())
+}
+
+// FlagCommunityDirectoryListing marks a community as spam, its listing is
+// then left out of the directory
+func (m *Messenger) FlagCommunityDirectoryListing(communityID types.HexBytes, flagged bool) error {
+	return m.communitiesManager.SetDirectoryListingFlagged(communityID, flagged)
+}
+
+func (m *Messenger) publishCommunityDirectoryListing(ownListing *communities.OwnDirectoryListing) error {
+	payload, err := proto.Marshal(ownListing.Listing)
+	if err != nil {
+		return err
+	}
+
+	rawMessage := common.RawMessage{
+		Payload: payload,
+		Sender:  ownListing.Community.PrivateKey(),
+		// we don't want to wrap in an encryption layer message
+		SkipEncryption: true,
+		MessageType:    protobuf.ApplicationMetadataMessage_COMMUNITY_DIRECTORY_LISTING,
+	}
+	_, err = m.sender.SendPublic(context.Background(), communities.DirectoryChatID, rawMessage)
+	return err
+}
+
+// publishCommunityDirectoryListings publishes again the listings of the
+// communities we list, and drops the expired ones we received
+func (m *Messenger) publishCommunityDirectoryListings() {
+	now := m.getTimesource().GetCurrentTime()
+
+	ownListings, err := m.communitiesManager.OwnDirectoryListings(now)
+	if err != nil {
+		m.logger.Warn("failed to retrieve community directory listings", zap.Error(err))
+		return
+	}
+
+	for _, ownListing := range ownListings {
+		err := m.publishCommunityDirectoryListing(ownListing)
+		if err != nil {
+			m.logger.Warn("failed to publish community directory listing", zap.String("community-id", ownListing.Community.IDString()), zap.Error(err))
+		}
+	}
+
+	err = m.communitiesManager.PruneDirectoryListings(now)
+	if err != nil {
+		m.logger.Warn("failed to prune community directory listings", zap.Error(err))
+	}
+}
+
+// HandleCommunityDirectoryListing stores a listing published on the directory
+func (m *Messenger) HandleCommunityDirectoryListing(state *ReceivedMessageState, signer *ecdsa.PublicKey, listing protobuf.CommunityDirectoryListing) error {
+	// Listings expire based on their clock, which can't be ahead of the envelope
+	err := validateClockValue(listing.Clock, state.CurrentMessageState.WhisperTimestamp)
+	if err != nil {
+		return err
+	}
+
+	directoryListing, err := m.communitiesManager.HandleDirectoryListing(signer, &listing)
+	if err != nil {
+		return err
+	}
+	if directoryListing == nil {
+		return nil
+	}
+
+	state.Response.AddCommunityDirectoryListing(directoryListing)
+	return nil
+}
@@ -0,0 +1,89 @@
+package protocol
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	gethbridge "github.com/status-im/status-go/eth-node/bridge/geth"
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/communities"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+	"github.com/status-im/status-go/protocol/tt"
+	"github.com/status-im/status-go/waku"
+)
+
+func TestMessengerCommunityDirectorySuite(t *testing.T) {
+	suite.Run(t, new(MessengerCommunityDirectorySuite))
+}
+
+type MessengerCommunityDirectorySuite struct {
+	suite.Suite
+	m      *Messenger
+	shh    types.Waku
+	logger *zap.Logger
+}
+
+func (s *MessengerCommunityDirectorySuite) SetupTest() {
+	s.logger = tt.MustCreateTestLogger()
+
+	config := waku.DefaultConfig
+	config.MinimumAcceptedPoW = 0
+	shh := waku.New(&config, s.logger)
+	s.shh = gethbridge.NewGethWakuWrapper(shh)
+	s.Require().NoError(shh.Start())
+
+	privateKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	s.m, err = newMessengerWithKey(s.shh, privateKey, s.logger, nil)
+	s.Require().NoError(err)
+}
+
+func (s *MessengerCommunityDirectorySuite) TearDownTest() {
+	s.Require().NoError(s.m.Shutdown())
+}
+
+func (s *MessengerCommunityDirectorySuite) TestListingClockAheadOfEnvelope() {
+	response, err := s.m.CreateCommunity(&requests.CreateCommunity{
+		Membership:  protobuf.CommunityPermissions_NO_MEMBERSHIP,
+		Name:        "status",
+		Color:       "#ffffff",
+		Description: "status community description",
+	})
+	s.Require().NoError(err)
+	s.Require().Len(response.Communities(), 1)
+	community := response.Communities()[0]
+
+	now := s.m.getTimesource().GetCurrentTime()
+	ownListing, err := s.m.communitiesManager.ListInDirectory(&requests.ListCommunityInDirectory{
+		CommunityID: community.ID(),
+		Tags:        []string{"crypto"},
+	}, now)
+	s.Require().NoError(err)
+
+	state := &ReceivedMessageState{
+		Response: &MessengerResponse{},
+		CurrentMessageState: &CurrentMessageState{
+			WhisperTimestamp: now,
+		},
+	}
+	signer := &community.PrivateKey().PublicKey
+
+	// A listing from the future would never expire
+	future := proto.Clone(ownListing.Listing).(*protobuf.CommunityDirectoryListing)
+	future.Clock = now + communities.DirectoryListingTTL
+	s.Require().Error(s.m.HandleCommunityDirectoryListing(state, signer, *future))
+	listings, err := s.m.CommunityDirectory(&requests.SearchCommunityDirectory{})
+	s.Require().NoError(err)
+	s.Require().Empty(listings)
+
+	s.Require().NoError(s.m.HandleCommunityDirectoryListing(state, signer, *ownListing.Listing))
+	s.Require().Len(state.Response.CommunityDirectoryListings(), 1)
+	listings, err = s.m.CommunityDirectory(&requests.SearchCommunityDirectory{})
+	s.Require().NoError(err)
+	s.Require().Len(listings, 1)
+}
@@ -6,6 +6,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/status-im/status-go/multiaccounts"
+	"github.com/status-im/status-go/protocol/anonmetrics"
 	"github.com/status-im/status-go/protocol/common"
 	"github.com/status-im/status-go/protocol/communities"
 	"github.com/status-im/status-go/protocol/protobuf"
@@ -21,6 +22,8 @@ type MessengerSignalsHandler interface {
 	MessageDelivered(chatID string, messageID string)
 	CommunityInfoFound(community *communities.Community)
 	MessengerResponse(response *MessengerResponse)
+	MessageQueueStatusChanged(chatID string, messageID string, status string, attempts int)
+	FileDownloadProgress(chatID string, messageID string, received uint32, total uint32)
 }
 
 type config struct {
@@ -51,6 +54,14 @@ type config struct {
 	pushNotificationServerConfig *pushnotificationserver.Config
 	pushNotificationClientConfig *pushnotificationclient.Config
 
+	anonMetricsClientConfig *anonmetrics.ClientConfig
+	anonMetricsServerConfig *anonmetrics.ServerConfig
+
+	// filesDir is where the files attached to messages are stored,
+	// files are disabled if not set
+	filesDir    string
+	maxFileSize uint64
+
 	logger *zap.Logger
 
 	messengerSignalsHandler MessengerSignalsHandler
@@ -130,6 +141,20 @@ func WithPushNotificationClientConfig(pushNotificationClientConfig *pushnotifica
 	}
 }
 
+func WithAnonMetricsClientConfig(anonMetricsClientConfig *anonmetrics.ClientConfig) Option {
+	return func(c *config) error {
+		c.anonMetricsClientConfig = anonMetricsClientConfig
+		return nil
+	}
+}
+
+func WithAnonMetricsServerConfig(anonMetricsServerConfig *anonmetrics.ServerConfig) Option {
+	return func(c *config) error {
+		c.anonMetricsServerConfig = anonMetricsServerConfig
+		return nil
+	}
+}
+
 func WithDatasync() func(c *config) error {
 	return func(c *config) error {
 		c.featureFlags.Datasync = true
@@ -166,3 +191,13 @@ func WithENSVerificationConfig(onENSVerified func(*MessengerResponse), url, addr
 		return nil
 	}
 }
+
+// WithFiles enables file attachments, stored in dir. Files larger
+// than maxSize are neither sent nor accepted, 0 uses the default cap.
+func WithFiles(dir string, maxSize uint64) Option {
+	return func(c *config) error {
+		c.filesDir = dir
+		c.maxFileSize = maxSize
+		return nil
+	}
+}
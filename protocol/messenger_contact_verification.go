@@ -0,0 +1,474 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/encryption/multidevice"
+	"github.com/status-im/status-go/protocol/identity/safetynumber"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+var ErrVerificationRequestNotFound = errors.New("verification request not found")
+var ErrVerificationRequestInvalidStatus = errors.New("verification request has an invalid status")
+var ErrContactNotAdded = errors.New("contact has not been added")
+var ErrInvalidTrustStatus = errors.New("invalid trust status")
+
+// SendContactVerificationRequest asks a contact to answer a challenge that
+// only the person we know would be able to answer
+func (m *Messenger) SendContactVerificationRequest(ctx context.Context, contactID string, challenge string) (*MessengerResponse, error) {
+	if challenge == "" {
+		return nil, errors.New("challenge can't be empty")
+	}
+
+	contact, ok := m.allContacts.Load(contactID)
+	if !ok || !contact.IsAdded() {
+		return nil, ErrContactNotAdded
+	}
+
+	chat, clock, err := m.contactVerificationChat(contact)
+	if err != nil {
+		return nil, err
+	}
+
+	encodedMessage, err := proto.Marshal(&protobuf.RequestContactVerification{
+		Clock:     clock,
+		Challenge: challenge,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rawMessage, err := m.dispatchMessage(ctx, common.RawMessage{
+		LocalChatID:         chat.ID,
+		Payload:             encodedMessage,
+		MessageType:         protobuf.ApplicationMetadataMessage_REQUEST_CONTACT_VERIFICATION,
+		ResendAutomatically: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	request := &VerificationRequest{
+		ID:          rawMessage.ID,
+		From:        contactIDFromPublicKey(&m.identity.PublicKey),
+		To:          contact.ID,
+		Challenge:   challenge,
+		RequestedAt: clock,
+		Status:      VerificationStatusPending,
+	}
+
+	err = m.persistence.SaveVerificationRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	chat.LastClockValue = clock
+	err = m.saveChat(chat)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.syncVerificationRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.AddVerificationRequest(request)
+	return response, nil
+}
+
+// AcceptContactVerificationRequest answers the challenge of a verification request
+// received from a contact
+func (m *Messenger) AcceptContactVerificationRequest(ctx context.Context, id string, answer string) (*MessengerResponse, error) {
+	request, err := m.persistence.VerificationRequestByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if request == nil || request.To != contactIDFromPublicKey(&m.identity.PublicKey) {
+		return nil, ErrVerificationRequestNotFound
+	}
+	if request.Status != VerificationStatusPending {
+		return nil, ErrVerificationRequestInvalidStatus
+	}
+
+	contact, ok := m.allContacts.Load(request.From)
+	if !ok {
+		return nil, ErrContactNotAdded
+	}
+
+	chat, clock, err := m.contactVerificationChat(contact)
+	if err != nil {
+		return nil, err
+	}
+
+	encodedMessage, err := proto.Marshal(&protobuf.AcceptContactVerification{
+		Clock:    clock,
+		Id:       request.ID,
+		Response: answer,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = m.dispatchMessage(ctx, common.RawMessage{
+		LocalChatID:         chat.ID,
+		Payload:             encodedMessage,
+		MessageType:         protobuf.ApplicationMetadataMessage_ACCEPT_CONTACT_VERIFICATION,
+		ResendAutomatically: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	request.Response = answer
+	request.RepliedAt = clock
+	request.Status = VerificationStatusAccepted
+	err = m.persistence.SaveVerificationRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	chat.LastClockValue = clock
+	err = m.saveChat(chat)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.syncVerificationRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.AddVerificationRequest(request)
+	return response, nil
+}
+
+// VerifiedTrusted marks the contact as trusted after the user checked the
+// response to a verification request
+func (m *Messenger) VerifiedTrusted(ctx context.Context, id string) (*MessengerResponse, error) {
+	return m.completeVerificationRequest(ctx, id, VerificationStatusTrusted, TrustStatusTrusted)
+}
+
+// VerifiedUntrustworthy marks the contact as untrustworthy after the user
+// rejected the response to a verification request
+func (m *Messenger) VerifiedUntrustworthy(ctx context.Context, id string) (*MessengerResponse, error) {
+	return m.completeVerificationRequest(ctx, id, VerificationStatusUntrustworthy, TrustStatusUntrustworthy)
+}
+
+func (m *Messenger) completeVerificationRequest(ctx context.Context, id string, status VerificationStatus, trustStatus TrustStatus) (*MessengerResponse, error) {
+	request, err := m.persistence.VerificationRequestByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if request == nil || request.From != contactIDFromPublicKey(&m.identity.PublicKey) {
+		return nil, ErrVerificationRequestNotFound
+	}
+	if request.Status != VerificationStatusAccepted {
+		return nil, ErrVerificationRequestInvalidStatus
+	}
+
+	request.Status = status
+	err = m.persistence.SaveVerificationRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.syncVerificationRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := m.SetContactTrustStatus(ctx, request.To, trustStatus)
+	if err != nil {
+		return nil, err
+	}
+	response.AddVerificationRequest(request)
+	return response, nil
+}
+
+// SetContactTrustStatus stores the outcome of the verification of a contact,
+// for example after comparing safety numbers, and syncs it with paired devices
+func (m *Messenger) SetContactTrustStatus(ctx context.Context, contactID string, trustStatus TrustStatus) (*MessengerResponse, error) {
+	if !trustStatus.Valid() {
+		return nil, ErrInvalidTrustStatus
+	}
+
+	contact, ok := m.allContacts.Load(contactID)
+	if !ok {
+		return nil, ErrContactNotAdded
+	}
+
+	contact.TrustStatus = trustStatus
+
+	err := m.syncContact(ctx, contact)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.saveContact(contact)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.Contacts = []*Contact{contact}
+	return response, nil
+}
+
+// GetSafetyNumber returns the number derived from our identity key and the
+// contact's, which both parties can compare out-of-band
+func (m *Messenger) GetSafetyNumber(contactID string) (string, error) {
+	contact, ok := m.allContacts.Load(contactID)
+	if !ok {
+		var err error
+		contact, err = buildContactFromPkString(contactID)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	publicKey, err := contact.PublicKey()
+	if err != nil {
+		return "", err
+	}
+
+	return safetynumber.Format(safetynumber.Generate(&m.identity.PublicKey, publicKey)), nil
+}
+
+// GetVerificationRequestsWithContact returns the verification requests sent to
+// or received from a contact
+func (m *Messenger) GetVerificationRequestsWithContact(contactID string) ([]*VerificationRequest, error) {
+	return m.persistence.VerificationRequestsWithContact(contactID)
+}
+
+// syncVerificationRequest sends the request to our paired devices, so that they
+// can handle the response of the contact or complete the verification
+func (m *Messenger) syncVerificationRequest(ctx context.Context, request *VerificationRequest) error {
+	if !m.hasPairedDevices() {
+		return nil
+	}
+	chatID := contactIDFromPublicKey(&m.identity.PublicKey)
+
+	chat, ok := m.allChats.Load(chatID)
+	if !ok {
+		chat = OneToOneFromPublicKey(&m.identity.PublicKey, m.getTimesource())
+		// We don't want to show the chat to the user
+		chat.Active = false
+	}
+
+	m.allChats.Store(chat.ID, chat)
+	clock, _ := chat.NextClockAndTimestamp(m.getTimesource())
+
+	encodedMessage, err := proto.Marshal(&protobuf.SyncVerificationRequest{
+		Clock:              clock,
+		Id:                 request.ID,
+		From:               request.From,
+		To:                 request.To,
+		Challenge:          request.Challenge,
+		Response:           request.Response,
+		RequestedAt:        request.RequestedAt,
+		RepliedAt:          request.RepliedAt,
+		VerificationStatus: protobuf.VerificationStatus(request.Status),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = m.dispatchMessage(ctx, common.RawMessage{
+		LocalChatID:         chatID,
+		Payload:             encodedMessage,
+		MessageType:         protobuf.ApplicationMetadataMessage_SYNC_VERIFICATION_REQUEST,
+		ResendAutomatically: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	chat.LastClockValue = clock
+	return m.saveChat(chat)
+}
+
+func (m *Messenger) contactVerificationChat(contact *Contact) (*Chat, uint64, error) {
+	chat, ok := m.allChats.Load(contact.ID)
+	if !ok {
+		publicKey, err := contact.PublicKey()
+		if err != nil {
+			return nil, 0, err
+		}
+		chat = OneToOneFromPublicKey(publicKey, m.getTimesource())
+		// We don't want to show the chat to the user
+		chat.Active = false
+	}
+
+	m.allChats.Store(chat.ID, chat)
+	clock, _ := chat.NextClockAndTimestamp(m.getTimesource())
+	return chat, clock, nil
+}
+
+func (m *Messenger) HandleRequestContactVerification(state *ReceivedMessageState, message protobuf.RequestContactVerification) error {
+	contact := state.CurrentMessageState.Contact
+	if contact.ID == contactIDFromPublicKey(&m.identity.PublicKey) {
+		// Sent from one of our devices, nothing to answer
+		return nil
+	}
+
+	// Only contacts can ask us to verify our identity
+	if !contact.IsAdded() {
+		return ErrMessageNotAllowed
+	}
+
+	existing, err := m.persistence.VerificationRequestByID(state.CurrentMessageState.MessageID)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return nil
+	}
+
+	request := &VerificationRequest{
+		ID:          state.CurrentMessageState.MessageID,
+		From:        contact.ID,
+		To:          contactIDFromPublicKey(&m.identity.PublicKey),
+		Challenge:   message.Challenge,
+		RequestedAt: message.Clock,
+		Status:      VerificationStatusPending,
+	}
+
+	err = m.persistence.SaveVerificationRequest(request)
+	if err != nil {
+		return err
+	}
+
+	// Our paired devices might not have received the request, make sure the
+	// user can answer from any of them
+	err = m.syncVerificationRequest(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	state.Response.AddVerificationRequest(request)
+	return nil
+}
+
+func (m *Messenger) HandleAcceptContactVerification(state *ReceivedMessageState, message protobuf.AcceptContactVerification) error {
+	request, err := m.persistence.VerificationRequestByID(message.Id)
+	if err != nil {
+		return err
+	}
+	// The response must come from the contact the request was sent to
+	if request == nil || request.To != state.CurrentMessageState.Contact.ID {
+		return ErrVerificationRequestNotFound
+	}
+	if request.Status != VerificationStatusPending {
+		return nil
+	}
+
+	request.Response = message.Response
+	request.RepliedAt = message.Clock
+	request.Status = VerificationStatusAccepted
+
+	err = m.persistence.SaveVerificationRequest(request)
+	if err != nil {
+		return err
+	}
+
+	err = m.syncVerificationRequest(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	state.Response.AddVerificationRequest(request)
+	return nil
+}
+
+// HandleSyncVerificationRequest stores a verification request sent or answered
+// from one of our paired devices. Requests only move forward, so a status older
+// than the one we know is ignored.
+func (m *Messenger) HandleSyncVerificationRequest(state *ReceivedMessageState, message protobuf.SyncVerificationRequest) error {
+	status := VerificationStatus(message.VerificationStatus)
+	if status < VerificationStatusPending || status > VerificationStatusUntrustworthy {
+		return fmt.Errorf("invalid verification status %d", message.VerificationStatus)
+	}
+
+	myID := contactIDFromPublicKey(&m.identity.PublicKey)
+	if message.From != myID && message.To != myID {
+		return ErrVerificationRequestNotFound
+	}
+
+	existing, err := m.persistence.VerificationRequestByID(message.Id)
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.Status >= status {
+		return nil
+	}
+
+	request := &VerificationRequest{
+		ID:          message.Id,
+		From:        message.From,
+		To:          message.To,
+		Challenge:   message.Challenge,
+		Response:    message.Response,
+		RequestedAt: message.RequestedAt,
+		RepliedAt:   message.RepliedAt,
+		Status:      status,
+	}
+
+	err = m.persistence.SaveVerificationRequest(request)
+	if err != nil {
+		return err
+	}
+
+	state.Response.AddVerificationRequest(request)
+	return nil
+}
+
+// handleContactInstallations resets the trust status of contacts who
+// published new installations, as their identity needs to be verified again.
+// The change is synced with our paired devices and the user is notified through
+// the activity center.
+func (m *Messenger) handleContactInstallations(state *ReceivedMessageState, installations []*multidevice.Installation) {
+	for _, installation := range installations {
+		if installation.Identity == contactIDFromPublicKey(&m.identity.PublicKey) {
+			continue
+		}
+
+		contact, ok := state.AllContacts.Load(installation.Identity)
+		if !ok || contact.TrustStatus == TrustStatusUnknown {
+			continue
+		}
+
+		m.logger.Info("resetting trust status after installation change", zap.String("contact", contact.ID), zap.String("installation", installation.ID))
+		contact.TrustStatus = TrustStatusUnknown
+		state.ModifiedContacts.Store(contact.ID, true)
+		state.AllContacts.Store(contact.ID, contact)
+
+		err := m.syncContact(context.Background(), contact)
+		if err != nil {
+			m.logger.Warn("failed to sync trust status reset", zap.String("contact", contact.ID), zap.Error(err))
+		}
+
+		notification := &ActivityCenterNotification{
+			ID:        types.HexBytes(crypto.Keccak256([]byte(contact.ID + installation.ID))),
+			Name:      contact.CanonicalName(),
+			Type:      ActivityCenterNotificationTypeContactTrustReset,
+			Author:    contact.ID,
+			Timestamp: m.getTimesource().GetCurrentTime(),
+			ChatID:    contact.ID,
+		}
+		err = m.addActivityCenterNotification(state, notification)
+		if err != nil {
+			m.logger.Warn("failed to notify trust status reset", zap.String("contact", contact.ID), zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,301 @@
+package protocol
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	gethbridge "github.com/status-im/status-go/eth-node/bridge/geth"
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/encryption/multidevice"
+	"github.com/status-im/status-go/protocol/tt"
+	"github.com/status-im/status-go/waku"
+)
+
+func TestMessengerContactVerificationSuite(t *testing.T) {
+	suite.Run(t, new(MessengerContactVerificationSuite))
+}
+
+type MessengerContactVerificationSuite struct {
+	suite.Suite
+	shh    types.Waku
+	logger *zap.Logger
+}
+
+func (s *MessengerContactVerificationSuite) SetupTest() {
+	s.logger = tt.MustCreateTestLogger()
+
+	config := waku.DefaultConfig
+	config.MinimumAcceptedPoW = 0
+	shh := waku.New(&config, s.logger)
+	s.shh = gethbridge.NewGethWakuWrapper(shh)
+	s.Require().NoError(shh.Start())
+}
+
+func (s *MessengerContactVerificationSuite) newMessengerWithKey(privateKey *ecdsa.PrivateKey) *Messenger {
+	messenger, err := newMessengerWithKey(s.shh, privateKey, s.logger, nil)
+	s.Require().NoError(err)
+	_, err = messenger.Start()
+	s.Require().NoError(err)
+	return messenger
+}
+
+func (s *MessengerContactVerificationSuite) newMessenger() *Messenger {
+	privateKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	return s.newMessengerWithKey(privateKey)
+}
+
+func (s *MessengerContactVerificationSuite) addContact(m *Messenger, other *Messenger) {
+	contact, err := buildContactFromPkString(contactIDFromPublicKey(&other.identity.PublicKey))
+	s.Require().NoError(err)
+	contact.SystemTags = []string{contactAdded}
+	s.Require().NoError(m.SaveContact(contact))
+}
+
+// pair makes the installations of device known to m and enables them
+func (s *MessengerContactVerificationSuite) pair(m *Messenger, device *Messenger) {
+	err := device.SetInstallationMetadata(device.installationID, &multidevice.InstallationMetadata{
+		Name:       "device",
+		DeviceType: "device-type",
+	})
+	s.Require().NoError(err)
+	_, err = device.SendPairInstallation(context.Background())
+	s.Require().NoError(err)
+	_, err = WaitOnMessengerResponse(
+		m,
+		func(r *MessengerResponse) bool {
+			for _, installation := range r.Installations {
+				if installation.ID == device.installationID {
+					return true
+				}
+			}
+			return false
+		},
+		"installation not received",
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(m.EnableInstallation(device.installationID))
+}
+
+// verify runs a verification request from m to contact up to the response of the contact
+func (s *MessengerContactVerificationSuite) verify(m *Messenger, contact *Messenger) *VerificationRequest {
+	contactID := contactIDFromPublicKey(&contact.identity.PublicKey)
+	response, err := m.SendContactVerificationRequest(context.Background(), contactID, "where did we meet?")
+	s.Require().NoError(err)
+	s.Require().Len(response.VerificationRequests(), 1)
+	requestID := response.VerificationRequests()[0].ID
+
+	response, err = WaitOnMessengerResponse(
+		contact,
+		func(r *MessengerResponse) bool { return len(r.VerificationRequests()) > 0 },
+		"verification request not received",
+	)
+	s.Require().NoError(err)
+	received := response.VerificationRequests()[0]
+	s.Require().Equal(requestID, received.ID)
+	s.Require().Equal("where did we meet?", received.Challenge)
+	s.Require().Equal(VerificationStatusPending, received.Status)
+
+	_, err = contact.AcceptContactVerificationRequest(context.Background(), requestID, "devcon")
+	s.Require().NoError(err)
+
+	response, err = WaitOnMessengerResponse(
+		m,
+		func(r *MessengerResponse) bool {
+			return len(r.VerificationRequests()) > 0 && r.VerificationRequests()[0].Status == VerificationStatusAccepted
+		},
+		"verification response not received",
+	)
+	s.Require().NoError(err)
+	accepted := response.VerificationRequests()[0]
+	s.Require().Equal("devcon", accepted.Response)
+	return accepted
+}
+
+func (s *MessengerContactVerificationSuite) TestVerifyContact() {
+	alice := s.newMessenger()
+	defer alice.Shutdown() // nolint: errcheck
+	bob := s.newMessenger()
+	defer bob.Shutdown() // nolint: errcheck
+	s.addContact(alice, bob)
+	s.addContact(bob, alice)
+	bobID := contactIDFromPublicKey(&bob.identity.PublicKey)
+
+	request := s.verify(alice, bob)
+
+	// Only the sender of the request can complete it
+	_, err := bob.VerifiedTrusted(context.Background(), request.ID)
+	s.Require().Equal(ErrVerificationRequestNotFound, err)
+
+	response, err := alice.VerifiedTrusted(context.Background(), request.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(VerificationStatusTrusted, response.VerificationRequests()[0].Status)
+	s.Require().Len(response.Contacts, 1)
+	s.Require().Equal(TrustStatusTrusted, response.Contacts[0].TrustStatus)
+
+	_, err = alice.VerifiedUntrustworthy(context.Background(), request.ID)
+	s.Require().Equal(ErrVerificationRequestInvalidStatus, err)
+
+	requests, err := alice.GetVerificationRequestsWithContact(bobID)
+	s.Require().NoError(err)
+	s.Require().Len(requests, 1)
+}
+
+func (s *MessengerContactVerificationSuite) TestSetInvalidTrustStatus() {
+	alice := s.newMessenger()
+	defer alice.Shutdown() // nolint: errcheck
+	bob := s.newMessenger()
+	defer bob.Shutdown() // nolint: errcheck
+	s.addContact(alice, bob)
+	bobID := contactIDFromPublicKey(&bob.identity.PublicKey)
+
+	_, err := alice.SetContactTrustStatus(context.Background(), bobID, TrustStatus(3))
+	s.Require().Equal(ErrInvalidTrustStatus, err)
+	_, err = alice.SetContactTrustStatus(context.Background(), bobID, TrustStatus(-1))
+	s.Require().Equal(ErrInvalidTrustStatus, err)
+
+	response, err := alice.SetContactTrustStatus(context.Background(), bobID, TrustStatusUntrustworthy)
+	s.Require().NoError(err)
+	s.Require().Equal(TrustStatusUntrustworthy, response.Contacts[0].TrustStatus)
+}
+
+func (s *MessengerContactVerificationSuite) TestVerificationSyncedWithPairedDevice() {
+	alice := s.newMessenger()
+	defer alice.Shutdown() // nolint: errcheck
+	alice2 := s.newMessengerWithKey(alice.identity)
+	defer alice2.Shutdown() // nolint: errcheck
+	bob := s.newMessenger()
+	defer bob.Shutdown() // nolint: errcheck
+	s.addContact(alice, bob)
+	s.addContact(alice2, bob)
+	s.addContact(bob, alice)
+	s.pair(alice, alice2)
+	s.pair(alice2, alice)
+
+	request := s.verify(alice, bob)
+
+	// The paired device knows the request and received the response of bob
+	response, err := WaitOnMessengerResponse(
+		alice2,
+		func(r *MessengerResponse) bool {
+			return len(r.VerificationRequests()) > 0 && r.VerificationRequests()[0].Status == VerificationStatusAccepted
+		},
+		"verification request not synced",
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(request.ID, response.VerificationRequests()[0].ID)
+
+	// The verification can be completed from the paired device
+	_, err = alice2.VerifiedTrusted(context.Background(), request.ID)
+	s.Require().NoError(err)
+
+	response, err = WaitOnMessengerResponse(
+		alice,
+		func(r *MessengerResponse) bool {
+			return len(r.Contacts) > 0 && r.Contacts[0].TrustStatus == TrustStatusTrusted &&
+				len(r.VerificationRequests()) > 0 && r.VerificationRequests()[0].Status == VerificationStatusTrusted
+		},
+		"trust status not synced",
+	)
+	s.Require().NoError(err)
+}
+
+func (s *MessengerContactVerificationSuite) TestAcceptFromPairedDevice() {
+	alice := s.newMessenger()
+	defer alice.Shutdown() // nolint: errcheck
+	bob := s.newMessenger()
+	defer bob.Shutdown() // nolint: errcheck
+	bob2 := s.newMessengerWithKey(bob.identity)
+	defer bob2.Shutdown() // nolint: errcheck
+	s.addContact(alice, bob)
+	s.addContact(bob, alice)
+	s.addContact(bob2, alice)
+	s.pair(bob, bob2)
+	s.pair(bob2, bob)
+	bobID := contactIDFromPublicKey(&bob.identity.PublicKey)
+
+	response, err := alice.SendContactVerificationRequest(context.Background(), bobID, "where did we meet?")
+	s.Require().NoError(err)
+	requestID := response.VerificationRequests()[0].ID
+
+	_, err = WaitOnMessengerResponse(
+		bob2,
+		func(r *MessengerResponse) bool { return len(r.VerificationRequests()) > 0 },
+		"verification request not synced",
+	)
+	s.Require().NoError(err)
+
+	_, err = bob2.AcceptContactVerificationRequest(context.Background(), requestID, "devcon")
+	s.Require().NoError(err)
+
+	_, err = WaitOnMessengerResponse(
+		alice,
+		func(r *MessengerResponse) bool {
+			return len(r.VerificationRequests()) > 0 && r.VerificationRequests()[0].Status == VerificationStatusAccepted
+		},
+		"verification response not received",
+	)
+	s.Require().NoError(err)
+}
+
+func (s *MessengerContactVerificationSuite) TestTrustResetOnNewInstallation() {
+	alice := s.newMessenger()
+	defer alice.Shutdown() // nolint: errcheck
+	alice2 := s.newMessengerWithKey(alice.identity)
+	defer alice2.Shutdown() // nolint: errcheck
+	bob := s.newMessenger()
+	defer bob.Shutdown() // nolint: errcheck
+	s.addContact(alice, bob)
+	s.addContact(alice2, bob)
+	s.addContact(bob, alice)
+	s.pair(alice, alice2)
+	s.pair(alice2, alice)
+	bobID := contactIDFromPublicKey(&bob.identity.PublicKey)
+
+	request := s.verify(alice, bob)
+	_, err := alice.VerifiedTrusted(context.Background(), request.ID)
+	s.Require().NoError(err)
+	_, err = WaitOnMessengerResponse(
+		alice2,
+		func(r *MessengerResponse) bool {
+			return len(r.Contacts) > 0 && r.Contacts[0].TrustStatus == TrustStatusTrusted
+		},
+		"trust status not synced",
+	)
+	s.Require().NoError(err)
+
+	// Bob writes from a new device
+	bob2 := s.newMessengerWithKey(bob.identity)
+	defer bob2.Shutdown() // nolint: errcheck
+	chat := CreateOneToOneChat(bobID, &alice.identity.PublicKey, bob2.transport)
+	s.Require().NoError(bob2.SaveChat(chat))
+	_, err = bob2.SendChatMessage(context.Background(), buildTestMessage(*chat))
+	s.Require().NoError(err)
+
+	response, err := WaitOnMessengerResponse(
+		alice,
+		func(r *MessengerResponse) bool { return len(r.ActivityCenterNotifications()) > 0 },
+		"trust status reset not notified",
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(ActivityCenterNotificationTypeContactTrustReset, response.ActivityCenterNotifications()[0].Type)
+	s.Require().Equal(bobID, response.ActivityCenterNotifications()[0].Author)
+	contact, ok := alice.allContacts.Load(bobID)
+	s.Require().True(ok)
+	s.Require().Equal(TrustStatusUnknown, contact.TrustStatus)
+
+	// The reset is synced with the paired device
+	_, err = WaitOnMessengerResponse(
+		alice2,
+		func(r *MessengerResponse) bool {
+			return len(r.Contacts) > 0 && r.Contacts[0].TrustStatus == TrustStatusUnknown
+		},
+		"trust status reset not synced",
+	)
+	s.Require().NoError(err)
+}
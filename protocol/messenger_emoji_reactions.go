@@ -0,0 +1,68 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/emojis"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+var ErrCommunityEmojiNotFound = errors.New("community emoji not found")
+
+// SendCustomEmojiReaction reacts to a message with any unicode emoji sequence.
+// Reactions matching one of the legacy types are also visible to older clients
+func (m *Messenger) SendCustomEmojiReaction(ctx context.Context, chatID, messageID string, emoji string) (*MessengerResponse, error) {
+	if err := emojis.Validate(emoji); err != nil {
+		return nil, err
+	}
+
+	return m.sendEmojiReaction(ctx, chatID, messageID, protobuf.EmojiReaction{Emoji: emoji})
+}
+
+// SendCommunityEmojiReaction reacts to a message in a community chat with
+// one of the custom emoji defined by the community
+func (m *Messenger) SendCommunityEmojiReaction(ctx context.Context, chatID, messageID string, emojiID string) (*MessengerResponse, error) {
+	chat, ok := m.allChats.Load(chatID)
+	if !ok {
+		return nil, ErrChatNotFound
+	}
+
+	if err := m.validateCommunityEmoji(chat, emojiID); err != nil {
+		return nil, err
+	}
+
+	return m.sendEmojiReaction(ctx, chatID, messageID, protobuf.EmojiReaction{CommunityEmojiId: emojiID})
+}
+
+// EmojiReactionsCountByMessageID returns the number of reactions to a message for each emoji
+func (m *Messenger) EmojiReactionsCountByMessageID(messageID string) ([]*EmojiReactionCount, error) {
+	message, err := m.persistence.MessageByID(messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	myPublicKey := types.EncodeHex(crypto.FromECDSAPub(&m.identity.PublicKey))
+	return m.persistence.EmojiReactionsCountByMessageID(message.LocalChatID, messageID, myPublicKey)
+}
+
+// validateCommunityEmoji checks that the chat belongs to a community
+// which defines the emoji
+func (m *Messenger) validateCommunityEmoji(chat *Chat, emojiID string) error {
+	if chat.CommunityID == "" {
+		return ErrCommunityEmojiNotFound
+	}
+
+	community, err := m.communitiesManager.GetByIDString(chat.CommunityID)
+	if err != nil {
+		return err
+	}
+
+	if community == nil || !community.HasEmoji(emojiID) {
+		return ErrCommunityEmojiNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,321 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/files"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// fileChunksBufferTTL is how long the chunks received before the
+// message describing their file are kept in memory
+const fileChunksBufferTTL = 10 * time.Minute
+
+// maxBufferedFileChunksPerSender and maxBufferedFileChunks bound the memory
+// used by the chunks received before the message describing their file
+const maxBufferedFileChunksPerSender = 8
+const maxBufferedFileChunks = 64
+
+// staleFileChunksAge is how long the chunks of a file which isn't completed
+// are kept on disk since the last one was received
+const staleFileChunksAge = 24 * time.Hour
+
+// How often we look for stale file chunks
+const staleFileChunksInterval = 1 * time.Hour
+
+var ErrFilesNotEnabled = errors.New("file attachments are not enabled")
+var ErrFileNotFound = errors.New("file not found")
+var ErrEmptyFile = errors.New("file is empty")
+
+// FilePath returns where the file attached to a message is stored,
+// once it has been completely received
+func (m *Messenger) FilePath(messageID string) (string, error) {
+	if m.files == nil {
+		return "", ErrFilesNotEnabled
+	}
+
+	exists, err := m.files.Exists(messageID)
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		return "", ErrFileNotFound
+	}
+
+	return m.files.Path(messageID)
+}
+
+// prepareFileMessage reads the file to be sent and describes it in the message,
+// the content itself is sent in chunks once the message is dispatched
+func (m *Messenger) prepareFileMessage(message *common.Message) ([]byte, error) {
+	if m.files == nil {
+		return nil, ErrFilesNotEnabled
+	}
+
+	data, err := ioutil.ReadFile(message.FilePath)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, ErrEmptyFile
+	}
+	if uint64(len(data)) > m.files.MaxSize() {
+		return nil, files.ErrFileTooLarge
+	}
+
+	message.ContentType = protobuf.ChatMessage_FILE
+	message.Payload = &protobuf.ChatMessage_File{
+		File: &protobuf.FileMessage{
+			Name:        filepath.Base(message.FilePath),
+			MimeType:    files.MimeType(data),
+			Size:        uint64(len(data)),
+			Hash:        files.Hash(data),
+			ChunksCount: files.ChunksCount(uint64(len(data))),
+		},
+	}
+
+	return data, nil
+}
+
+// sendFileChunks stores the file attached to a message we sent, and sends
+// its content in chunks over the same channel as the message
+func (m *Messenger) sendFileChunks(ctx context.Context, chat *Chat, message *common.Message, data []byte) error {
+	err := m.files.Save(message.ID, data)
+	if err != nil {
+		return err
+	}
+
+	chunks := files.Split(data)
+	for i, payload := range chunks {
+		clock, _ := chat.NextClockAndTimestamp(m.getTimesource())
+
+		chunk := &FileChunk{
+			FileChunk: protobuf.FileChunk{
+				Clock:       clock,
+				ChatId:      message.ChatId,
+				MessageId:   message.ID,
+				Index:       uint32(i),
+				ChunksCount: uint32(len(chunks)),
+				Payload:     payload,
+			},
+		}
+
+		encodedMessage, err := m.encodeChatEntity(chat, chunk)
+		if err != nil {
+			return err
+		}
+
+		_, err = m.dispatchMessage(ctx, common.RawMessage{
+			LocalChatID:          chat.ID,
+			Payload:              encodedMessage,
+			MessageType:          protobuf.ApplicationMetadataMessage_FILE_CHUNK,
+			SkipGroupMessageWrap: true,
+			ResendAutomatically:  true,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteFiles removes the files attached to the messages from disk
+func (m *Messenger) deleteFiles(messageIDs ...string) error {
+	if m.files == nil || len(messageIDs) == 0 {
+		return nil
+	}
+	return m.files.Delete(messageIDs...)
+}
+
+// deleteChatFiles removes the files attached to the messages of a chat from disk
+func (m *Messenger) deleteChatFiles(chatID string) error {
+	if m.files == nil {
+		return nil
+	}
+
+	ids, err := m.persistence.FileMessageIDsByChatID(chatID)
+	if err != nil {
+		return err
+	}
+
+	return m.deleteFiles(ids...)
+}
+
+func (m *Messenger) notifyFileDownloadProgress(chatID string, messageID string, received uint32, total uint32) {
+	if m.config.messengerSignalsHandler != nil {
+		m.config.messengerSignalsHandler.FileDownloadProgress(chatID, messageID, received, total)
+	}
+}
+
+// processFileChunks notifies about the download progress of the file attached
+// to a message, and assembles it once all the chunks have been received.
+// The message is nil if it hasn't been received yet.
+func (m *Messenger) processFileChunks(chatID string, messageID string, total uint32, message *common.Message) error {
+	received, err := m.files.ReceivedChunks(messageID)
+	if err != nil {
+		return err
+	}
+	if received == 0 {
+		return nil
+	}
+
+	if received < total || message == nil {
+		m.notifyFileDownloadProgress(chatID, messageID, received, total)
+		return nil
+	}
+
+	_, err = m.files.Assemble(messageID, message.GetFile())
+	if err == files.ErrHashMismatch {
+		// The chunks can't be trusted, they might be requested again
+		// from the sender once deleted
+		if err := m.files.Delete(messageID); err != nil {
+			m.logger.Warn("failed to delete invalid file", zap.Error(err))
+		}
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	m.notifyFileDownloadProgress(chatID, messageID, total, total)
+	return nil
+}
+
+func (m *Messenger) HandleFileChunk(state *ReceivedMessageState, pbChunk protobuf.FileChunk) error {
+	if m.files == nil {
+		return ErrFilesNotEnabled
+	}
+
+	if err := ValidateReceivedFileChunk(&pbChunk, state.CurrentMessageState.WhisperTimestamp); err != nil {
+		return err
+	}
+
+	chunk := &FileChunk{
+		FileChunk: pbChunk,
+		From:      state.CurrentMessageState.Contact.ID,
+		SigPubKey: state.CurrentMessageState.PublicKey,
+	}
+
+	chat, err := m.matchChatEntity(chunk)
+	if err != nil {
+		return err // matchChatEntity returns a descriptive error message
+	}
+
+	// Check if it's already in the response
+	message := state.Response.GetMessage(chunk.MessageId)
+	// otherwise pull from database
+	if message == nil {
+		message, err = m.persistence.MessageByID(chunk.MessageId)
+		if err != nil && err != common.ErrRecordNotFound {
+			return err
+		}
+	}
+
+	// The message might not be received yet, the chunks are kept in memory until
+	// then. They are verified against the message once it's received.
+	if message == nil {
+		m.fileChunks.Add(chunk.From, &files.BufferedChunk{
+			ChatID:      chat.ID,
+			MessageID:   chunk.MessageId,
+			Index:       chunk.Index,
+			ChunksCount: chunk.ChunksCount,
+			Payload:     chunk.Payload,
+		}, time.Now())
+		return nil
+	}
+
+	err = m.validateFileChunk(chat.ID, message, chunk.From, chunk.ChunksCount)
+	if err != nil {
+		return err
+	}
+
+	exists, err := m.files.Exists(message.ID)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return nil
+	}
+
+	err = m.files.SaveChunk(chunk.MessageId, chunk.Index, chunk.Payload)
+	if err != nil {
+		return err
+	}
+
+	return m.processFileChunks(chat.ID, chunk.MessageId, chunk.ChunksCount, message)
+}
+
+// validateFileChunk checks that a chunk was sent along with the file message
+func (m *Messenger) validateFileChunk(chatID string, message *common.Message, from string, chunksCount uint32) error {
+	file := message.GetFile()
+	if message.ContentType != protobuf.ChatMessage_FILE || file == nil {
+		return errors.New("no file attached to the message")
+	}
+	if message.From != from {
+		return errors.New("file chunk not sent by the author of the message")
+	}
+	if message.LocalChatID != chatID {
+		return errors.New("file chunk sent to the wrong chat")
+	}
+	if file.ChunksCount != chunksCount {
+		return errors.New("invalid file chunks count")
+	}
+	return nil
+}
+
+// saveBufferedFileChunks stores the chunks of the file attached to
+// the message which have been received before it
+func (m *Messenger) saveBufferedFileChunks(chatID string, message *common.Message) {
+	for _, chunk := range m.fileChunks.Take(message.From, message.ID) {
+		err := m.validateFileChunk(chatID, message, message.From, chunk.ChunksCount)
+		if err == nil && chunk.ChatID != chatID {
+			err = errors.New("file chunk sent to the wrong chat")
+		}
+		if err == nil {
+			err = m.files.SaveChunk(chunk.MessageID, chunk.Index, chunk.Payload)
+		}
+		if err != nil {
+			m.logger.Warn("failed to save file chunk", zap.String("messageID", message.ID), zap.Error(err))
+		}
+	}
+}
+
+// watchStaleFileChunks periodically removes the chunks of the files
+// which won't be completed
+func (m *Messenger) watchStaleFileChunks() {
+	if m.files == nil {
+		return
+	}
+
+	ticker := time.NewTicker(staleFileChunksInterval)
+
+	go func() {
+		m.deleteStaleFileChunks()
+		for {
+			select {
+			case <-ticker.C:
+				m.deleteStaleFileChunks()
+			case <-m.quit:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+}
+
+func (m *Messenger) deleteStaleFileChunks() {
+	m.fileChunks.Expire(time.Now())
+
+	err := m.files.DeleteStaleChunks(time.Now().Add(-staleFileChunksAge))
+	if err != nil {
+		m.logger.Warn("failed to delete stale file chunks", zap.Error(err))
+	}
+}
@@ -0,0 +1,230 @@
+package protocol
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	gethbridge "github.com/status-im/status-go/eth-node/bridge/geth"
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/files"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/tt"
+	"github.com/status-im/status-go/waku"
+)
+
+func TestMessengerFilesSuite(t *testing.T) {
+	suite.Run(t, new(MessengerFilesSuite))
+}
+
+type MessengerFilesSuite struct {
+	suite.Suite
+	m      *Messenger
+	dir    string
+	shh    types.Waku
+	logger *zap.Logger
+}
+
+func (s *MessengerFilesSuite) SetupTest() {
+	s.logger = tt.MustCreateTestLogger()
+
+	config := waku.DefaultConfig
+	config.MinimumAcceptedPoW = 0
+	shh := waku.New(&config, s.logger)
+	s.shh = gethbridge.NewGethWakuWrapper(shh)
+	s.Require().NoError(shh.Start())
+
+	dir, err := ioutil.TempDir("", "messenger-files")
+	s.Require().NoError(err)
+	s.dir = dir
+
+	s.m = s.newMessenger()
+}
+
+func (s *MessengerFilesSuite) TearDownTest() {
+	s.Require().NoError(s.m.Shutdown())
+	s.Require().NoError(os.RemoveAll(s.dir))
+}
+
+func (s *MessengerFilesSuite) newMessenger() *Messenger {
+	privateKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	filesDir, err := ioutil.TempDir(s.dir, "files")
+	s.Require().NoError(err)
+
+	messenger, err := newMessengerWithKey(s.shh, privateKey, s.logger, []Option{WithFiles(filesDir, 0)})
+	s.Require().NoError(err)
+	_, err = messenger.Start()
+	s.Require().NoError(err)
+	return messenger
+}
+
+func (s *MessengerFilesSuite) writeFile(data []byte) string {
+	path := filepath.Join(s.dir, "file.bin")
+	s.Require().NoError(ioutil.WriteFile(path, data, 0600))
+	return path
+}
+
+func (s *MessengerFilesSuite) TestSendFile() {
+	theirMessenger := s.newMessenger()
+	defer theirMessenger.Shutdown() // nolint: errcheck
+
+	chat := CreateOneToOneChat("their", &theirMessenger.identity.PublicKey, s.m.transport)
+	s.Require().NoError(s.m.SaveChat(chat))
+
+	data := bytes.Repeat([]byte("status"), files.ChunkSize/2)
+	message := buildTestMessage(*chat)
+	message.FilePath = s.writeFile(data)
+	response, err := s.m.SendChatMessage(context.Background(), message)
+	s.Require().NoError(err)
+	s.Require().Len(response.Messages(), 1)
+	sent := response.Messages()[0]
+	s.Require().Equal(protobuf.ChatMessage_FILE, sent.ContentType)
+	s.Require().Equal("file.bin", sent.GetFile().Name)
+	s.Require().Equal(uint32(3), sent.GetFile().ChunksCount)
+
+	// The sent file is kept by the sender
+	path, err := s.m.FilePath(sent.ID)
+	s.Require().NoError(err)
+	stored, err := ioutil.ReadFile(path)
+	s.Require().NoError(err)
+	s.Require().Equal(data, stored)
+
+	// The file is assembled once all the chunks are received
+	_, err = WaitOnMessengerResponse(
+		theirMessenger,
+		func(r *MessengerResponse) bool {
+			_, err := theirMessenger.FilePath(sent.ID)
+			return err == nil
+		},
+		"file not received",
+	)
+	s.Require().NoError(err)
+	path, err = theirMessenger.FilePath(sent.ID)
+	s.Require().NoError(err)
+	received, err := ioutil.ReadFile(path)
+	s.Require().NoError(err)
+	s.Require().Equal(data, received)
+}
+
+func (s *MessengerFilesSuite) receivedState(sender *ecdsa.PublicKey) *ReceivedMessageState {
+	contact, err := BuildContactFromPublicKey(sender)
+	s.Require().NoError(err)
+
+	return &ReceivedMessageState{
+		Response: &MessengerResponse{},
+		CurrentMessageState: &CurrentMessageState{
+			WhisperTimestamp: s.m.getTimesource().GetCurrentTime(),
+			Contact:          contact,
+			PublicKey:        sender,
+		},
+	}
+}
+
+func (s *MessengerFilesSuite) TestChunksReceivedBeforeMessage() {
+	theirKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	theirChat := CreateOneToOneChat("their", &s.m.identity.PublicKey, s.m.transport)
+	ourChat := CreateOneToOneChat("our", &theirKey.PublicKey, s.m.transport)
+	s.Require().NoError(s.m.SaveChat(ourChat))
+
+	data := []byte("status")
+	messageID := "0x01"
+	chunk := protobuf.FileChunk{
+		Clock:       1,
+		ChatId:      theirChat.ID,
+		MessageId:   messageID,
+		ChunksCount: 1,
+		Payload:     data,
+		MessageType: protobuf.MessageType_ONE_TO_ONE,
+	}
+
+	// The chunk of an unknown message is kept in memory only
+	s.Require().NoError(s.m.HandleFileChunk(s.receivedState(&theirKey.PublicKey), chunk))
+	s.Require().Equal(1, s.m.fileChunks.Len())
+	received, err := s.m.files.ReceivedChunks(messageID)
+	s.Require().NoError(err)
+	s.Require().Zero(received)
+
+	// The file is assembled once the message is received
+	message := buildTestMessage(*theirChat)
+	message.ContentType = protobuf.ChatMessage_FILE
+	message.Payload = &protobuf.ChatMessage_File{
+		File: &protobuf.FileMessage{
+			Name:        "file.txt",
+			MimeType:    files.MimeType(data),
+			Size:        uint64(len(data)),
+			Hash:        files.Hash(data),
+			ChunksCount: 1,
+		},
+	}
+	state := s.receivedState(&theirKey.PublicKey)
+	state.CurrentMessageState.Message = message.ChatMessage
+	state.CurrentMessageState.MessageID = messageID
+	s.Require().NoError(s.m.HandleChatMessage(state))
+
+	s.Require().Zero(s.m.fileChunks.Len())
+	path, err := s.m.FilePath(messageID)
+	s.Require().NoError(err)
+	stored, err := ioutil.ReadFile(path)
+	s.Require().NoError(err)
+	s.Require().Equal(data, stored)
+}
+
+func (s *MessengerFilesSuite) TestChunksOfAnotherSender() {
+	theirKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	otherKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	theirChat := CreateOneToOneChat("their", &s.m.identity.PublicKey, s.m.transport)
+	s.Require().NoError(s.m.SaveChat(CreateOneToOneChat("their", &theirKey.PublicKey, s.m.transport)))
+	s.Require().NoError(s.m.SaveChat(CreateOneToOneChat("other", &otherKey.PublicKey, s.m.transport)))
+
+	data := []byte("status")
+	messageID := "0x01"
+	chunk := protobuf.FileChunk{
+		Clock:       1,
+		ChatId:      common.PubkeyToHex(&s.m.identity.PublicKey),
+		MessageId:   messageID,
+		ChunksCount: 1,
+		Payload:     []byte("spam"),
+		MessageType: protobuf.MessageType_ONE_TO_ONE,
+	}
+	s.Require().NoError(s.m.HandleFileChunk(s.receivedState(&otherKey.PublicKey), chunk))
+
+	message := buildTestMessage(*theirChat)
+	message.ContentType = protobuf.ChatMessage_FILE
+	message.Payload = &protobuf.ChatMessage_File{
+		File: &protobuf.FileMessage{
+			Name:        "file.txt",
+			MimeType:    files.MimeType(data),
+			Size:        uint64(len(data)),
+			Hash:        files.Hash(data),
+			ChunksCount: 1,
+		},
+	}
+	state := s.receivedState(&theirKey.PublicKey)
+	state.CurrentMessageState.Message = message.ChatMessage
+	state.CurrentMessageState.MessageID = messageID
+	s.Require().NoError(s.m.HandleChatMessage(state))
+
+	// The chunks of other senders aren't used for the file of the message
+	_, err = s.m.FilePath(messageID)
+	s.Require().Equal(ErrFileNotFound, err)
+	s.Require().Equal(1, s.m.fileChunks.Len())
+
+	// Chunks sent once the message is saved are checked against it
+	s.Require().NoError(s.m.persistence.SaveMessages(state.Response.Messages()))
+	err = s.m.HandleFileChunk(s.receivedState(&otherKey.PublicKey), chunk)
+	s.Require().Error(err)
+}
@@ -289,6 +289,9 @@ func (m *Messenger) HandleSyncInstallationContact(state *ReceivedMessageState, m
 		}
 		contact.LastUpdated = message.Clock
 		contact.LocalNickname = message.LocalNickname
+		if trustStatus := TrustStatus(message.TrustStatus); trustStatus.Valid() {
+			contact.TrustStatus = trustStatus
+		}
 
 		state.ModifiedContacts.Store(contact.ID, true)
 		state.AllContacts.Store(contact.ID, contact)
@@ -603,6 +606,11 @@ func (m *Messenger) HandleDeleteMessage(response *MessengerResponse, deleteMessa
 		return err
 	}
 
+	err = m.deleteFiles(deleteMessage.MessageId)
+	if err != nil {
+		return err
+	}
+
 	if chat.LastMessage != nil && chat.LastMessage.ID == originalMessage.ID {
 		// Get last message that is not hidden
 		messages, _, err := m.persistence.MessageByChatID(originalMessage.LocalChatID, "", 1)
@@ -681,6 +689,14 @@ func (m *Messenger) HandleChatMessage(state *ReceivedMessageState) error {
 	// Set the LocalChatID for the message
 	receivedMessage.LocalChatID = chat.ID
 
+	filtered, reason, err := m.isSpam(chat, receivedMessage, state.CurrentMessageState.Contact)
+	if err != nil {
+		return err
+	}
+	if filtered {
+		return m.handleFilteredMessage(state, chat, receivedMessage, reason)
+	}
+
 	// Increase unviewed count
 	if !common.IsPubKeyEqual(receivedMessage.SigPubKey, &m.identity.PublicKey) {
 		m.updateUnviewedCounts(chat, receivedMessage.Mentioned)
@@ -742,6 +758,15 @@ func (m *Messenger) HandleChatMessage(state *ReceivedMessageState) error {
 		state.Response.CommunityChanges = append(state.Response.CommunityChanges, communityResponse.Changes)
 	}
 
+	if file := receivedMessage.GetFile(); file != nil && m.files != nil {
+		// Chunks might have been received before the message
+		m.saveBufferedFileChunks(chat.ID, receivedMessage)
+		err = m.processFileChunks(chat.ID, receivedMessage.ID, file.ChunksCount, receivedMessage)
+		if err != nil {
+			logger.Warn("failed to process file chunks", zap.Error(err))
+		}
+	}
+
 	receivedMessage.New = true
 	state.Response.AddMessage(receivedMessage)
 
@@ -1111,6 +1136,7 @@ func (m *Messenger) HandleEmojiReaction(state *ReceivedMessageState, pbEmojiR pr
 		From:          from,
 		SigPubKey:     state.CurrentMessageState.PublicKey,
 	}
+	emojiReaction.normalize()
 
 	existingEmoji, err := m.persistence.EmojiReactionByID(emojiReaction.ID())
 	if err != common.ErrRecordNotFound && err != nil {
@@ -1127,6 +1153,12 @@ func (m *Messenger) HandleEmojiReaction(state *ReceivedMessageState, pbEmojiR pr
 		return err // matchChatEntity returns a descriptive error message
 	}
 
+	if emojiReaction.CommunityEmojiId != "" {
+		if err := m.validateCommunityEmoji(chat, emojiReaction.CommunityEmojiId); err != nil {
+			return err
+		}
+	}
+
 	// Set local chat id
 	emojiReaction.LocalChatID = chat.ID
 
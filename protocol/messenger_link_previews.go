@@ -0,0 +1,86 @@
+package protocol
+
+import (
+	"errors"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/images"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/urls"
+)
+
+var ErrUnfurlLinksDisabled = errors.New("unfurling links is disabled")
+
+// UnfurlLinks fetches the previews of the links of a message the user is
+// writing, so that the client can attach them to the message before sending
+// it and receivers can display them without making any request.
+// Links which can't be unfurled are skipped.
+func (m *Messenger) UnfurlLinks(links []string) ([]*protobuf.LinkPreview, error) {
+	enabled, err := m.settings.UnfurlLinksEnabled()
+	if err != nil {
+		return nil, err
+	}
+	if !enabled {
+		return nil, ErrUnfurlLinksDisabled
+	}
+
+	if len(links) > maxLinkPreviews {
+		links = links[:maxLinkPreviews]
+	}
+
+	// The links are unfurled concurrently, keeping their order
+	unfurled := make([]*protobuf.LinkPreview, len(links))
+	var wg sync.WaitGroup
+	for i, link := range links {
+		wg.Add(1)
+		go func(i int, link string) {
+			defer wg.Done()
+			preview, err := m.unfurlLink(link)
+			if err != nil {
+				m.logger.Debug("failed to unfurl link", zap.String("link", link), zap.Error(err))
+				return
+			}
+			unfurled[i] = preview
+		}(i, link)
+	}
+	wg.Wait()
+
+	previews := []*protobuf.LinkPreview{}
+	for _, preview := range unfurled {
+		if preview != nil {
+			previews = append(previews, preview)
+		}
+	}
+
+	return previews, nil
+}
+
+func (m *Messenger) unfurlLink(link string) (*protobuf.LinkPreview, error) {
+	unfurled, err := urls.UnfurlLink(link)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &protobuf.LinkPreview{
+		Url:         unfurled.URL,
+		Site:        unfurled.Site,
+		Title:       unfurled.Title,
+		Description: unfurled.Description,
+	}
+
+	if len(unfurled.Thumbnail) != 0 {
+		thumbnail, err := images.GenerateThumbnail(unfurled.Thumbnail, images.LargeDim)
+		if err != nil {
+			// The preview is kept, just without the image
+			m.logger.Debug("failed to generate link thumbnail", zap.String("link", link), zap.Error(err))
+			return preview, nil
+		}
+		preview.ThumbnailPayload = thumbnail.Payload
+		preview.ThumbnailWidth = uint32(thumbnail.Width)
+		preview.ThumbnailHeight = uint32(thumbnail.Height)
+	}
+
+	return preview, nil
+}
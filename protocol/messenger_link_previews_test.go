@@ -0,0 +1,179 @@
+package protocol
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"image"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	gethbridge "github.com/status-im/status-go/eth-node/bridge/geth"
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/multiaccounts/accounts"
+	"github.com/status-im/status-go/params"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/tt"
+	"github.com/status-im/status-go/waku"
+)
+
+func TestMessengerLinkPreviewsSuite(t *testing.T) {
+	suite.Run(t, new(MessengerLinkPreviewsSuite))
+}
+
+type MessengerLinkPreviewsSuite struct {
+	suite.Suite
+	m      *Messenger
+	server *httptest.Server
+	shh    types.Waku
+	logger *zap.Logger
+}
+
+func (s *MessengerLinkPreviewsSuite) SetupTest() {
+	s.logger = tt.MustCreateTestLogger()
+
+	config := waku.DefaultConfig
+	config.MinimumAcceptedPoW = 0
+	shh := waku.New(&config, s.logger)
+	s.shh = gethbridge.NewGethWakuWrapper(shh)
+	s.Require().NoError(shh.Start())
+
+	s.m = s.newMessenger()
+	s.server = s.newPagesServer()
+}
+
+func (s *MessengerLinkPreviewsSuite) TearDownTest() {
+	s.server.Close()
+	s.Require().NoError(s.m.Shutdown())
+}
+
+func (s *MessengerLinkPreviewsSuite) newMessenger() *Messenger {
+	privateKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	messenger, err := newMessengerWithKey(s.shh, privateKey, s.logger, nil)
+	s.Require().NoError(err)
+
+	networks := json.RawMessage("{}")
+	settings := accounts.Settings{
+		Address:           types.HexToAddress("0x1122334455667788990011223344556677889900"),
+		CurrentNetwork:    "mainnet_rpc",
+		DappsAddress:      types.HexToAddress("0x1122334455667788990011223344556677889900"),
+		InstallationID:    "d3efcff6-cffa-560e-a547-21d3858cbc51",
+		KeyUID:            "0x1122334455667788990011223344556677889900",
+		Name:              "Test",
+		Networks:          &networks,
+		PublicKey:         "0x04112233445566778899001122334455667788990011223344556677889900112233445566778899001122334455667788990011223344556677889900",
+		SigningPhrase:     "yurt joey vibe",
+		WalletRootAddress: types.HexToAddress("0x1122334455667788990011223344556677889900")}
+	s.Require().NoError(messenger.settings.CreateSettings(settings, params.NodeConfig{NetworkID: 10, DataDir: "test"}))
+
+	_, err = messenger.Start()
+	s.Require().NoError(err)
+	return messenger
+}
+
+func (s *MessengerLinkPreviewsSuite) newPagesServer() *httptest.Server {
+	var thumbnail bytes.Buffer
+	s.Require().NoError(png.Encode(&thumbnail, image.NewRGBA(image.Rect(0, 0, 10, 10))))
+
+	page := func(title string, image string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `<html><head>
+<meta property="og:title" content="%s" />
+<meta property="og:image" content="%s" />
+</head><body></body></html>`, title, image)
+		}
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/article", page("Article", "/thumbnail.png"))
+	mux.HandleFunc("/broken-thumbnail", page("Broken thumbnail", "/broken.png"))
+	mux.HandleFunc("/no-meta", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `<html><head></head><body></body></html>`)
+	})
+	mux.HandleFunc("/thumbnail.png", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(thumbnail.Bytes())
+	})
+	mux.HandleFunc("/broken.png", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("not an image"))
+	})
+	return httptest.NewServer(mux)
+}
+
+func (s *MessengerLinkPreviewsSuite) TestUnfurlLinksDisabled() {
+	_, err := s.m.UnfurlLinks([]string{s.server.URL + "/article"})
+	s.Require().Equal(ErrUnfurlLinksDisabled, err)
+}
+
+func (s *MessengerLinkPreviewsSuite) TestUnfurlLinks() {
+	s.Require().NoError(s.m.settings.SaveSetting("unfurl-links-enabled?", true))
+
+	previews, err := s.m.UnfurlLinks([]string{
+		s.server.URL + "/no-meta",
+		s.server.URL + "/broken-thumbnail",
+		s.server.URL + "/article",
+	})
+	s.Require().NoError(err)
+	s.Require().Len(previews, 2)
+
+	// Previews are kept without their thumbnail if it can't be generated
+	s.Require().Equal("Broken thumbnail", previews[0].Title)
+	s.Require().Empty(previews[0].ThumbnailPayload)
+
+	s.Require().Equal(s.server.URL+"/article", previews[1].Url)
+	s.Require().Equal("Article", previews[1].Title)
+	s.Require().Equal("127.0.0.1", previews[1].Site)
+	s.Require().NotEmpty(previews[1].ThumbnailPayload)
+	s.Require().NotZero(previews[1].ThumbnailWidth)
+}
+
+func (s *MessengerLinkPreviewsSuite) TestSendLinkPreviews() {
+	s.Require().NoError(s.m.settings.SaveSetting("unfurl-links-enabled?", true))
+	link := s.server.URL + "/article"
+	previews, err := s.m.UnfurlLinks([]string{link})
+	s.Require().NoError(err)
+
+	theirMessenger := s.newMessenger()
+	defer theirMessenger.Shutdown() // nolint: errcheck
+
+	chat := CreateOneToOneChat("their", &theirMessenger.identity.PublicKey, s.m.transport)
+	s.Require().NoError(s.m.SaveChat(chat))
+
+	message := buildTestMessage(*chat)
+	message.Text = "have a look at " + link
+	message.LinkPreviews = previews
+	_, err = s.m.SendChatMessage(context.Background(), message)
+	s.Require().NoError(err)
+
+	response, err := WaitOnMessengerResponse(
+		theirMessenger,
+		func(r *MessengerResponse) bool { return len(r.Messages()) > 0 },
+		"message with link previews not received",
+	)
+	s.Require().NoError(err)
+	received := response.Messages()[0].LinkPreviews
+	s.Require().Len(received, 1)
+	s.Require().Equal("Article", received[0].Title)
+	s.Require().Equal(previews[0].ThumbnailPayload, received[0].ThumbnailPayload)
+}
+
+func (s *MessengerLinkPreviewsSuite) TestSendInvalidLinkPreviews() {
+	theirMessenger := s.newMessenger()
+	defer theirMessenger.Shutdown() // nolint: errcheck
+
+	chat := CreateOneToOneChat("their", &theirMessenger.identity.PublicKey, s.m.transport)
+	s.Require().NoError(s.m.SaveChat(chat))
+
+	message := buildTestMessage(*chat)
+	message.LinkPreviews = []*protobuf.LinkPreview{{Title: "no url"}}
+	_, err := s.m.SendChatMessage(context.Background(), message)
+	s.Require().Error(err)
+}
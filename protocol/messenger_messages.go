@@ -104,7 +104,9 @@ func (m *Messenger) DeleteMessageAndSend(ctx context.Context, messageID string)
 		message.ContentType != protobuf.ChatMessage_STICKER &&
 		message.ContentType != protobuf.ChatMessage_EMOJI &&
 		message.ContentType != protobuf.ChatMessage_IMAGE &&
-		message.ContentType != protobuf.ChatMessage_AUDIO {
+		message.ContentType != protobuf.ChatMessage_AUDIO &&
+		message.ContentType != protobuf.ChatMessage_POLL &&
+		message.ContentType != protobuf.ChatMessage_FILE {
 		return nil, ErrInvalidDeleteTypeAuthor
 	}
 
@@ -145,6 +147,11 @@ func (m *Messenger) DeleteMessageAndSend(ctx context.Context, messageID string)
 		return nil, err
 	}
 
+	err = m.deleteFiles(messageID)
+	if err != nil {
+		return nil, err
+	}
+
 	if chat.LastMessage != nil && chat.LastMessage.ID == message.ID {
 		chat.LastMessage = message
 		err := m.saveChat(chat)
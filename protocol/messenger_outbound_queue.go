@@ -0,0 +1,240 @@
+package protocol
+
+import (
+	"context"
+	"database/sql"
+	"math"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+	v1protocol "github.com/status-im/status-go/protocol/v1"
+)
+
+// outboundQueueMinDelay is the delay in ms before a queued message is re-sent for the first time
+const outboundQueueMinDelay = 5 * 1000
+
+// outboundQueueMaxDelay caps the exponential backoff between two attempts
+const outboundQueueMaxDelay = 10 * 60 * 1000
+
+// outboundQueueMaxAttempts is the number of times a message is re-sent before giving up
+const outboundQueueMaxAttempts = 10
+
+const (
+	// QueuedMessageQueued is sent when a message is added to the outbound queue
+	QueuedMessageQueued = "queued"
+	// QueuedMessageResent is sent each time a queued message is sent again
+	QueuedMessageResent = "resent"
+	// QueuedMessageSent is sent when a queued message reached at least one peer
+	QueuedMessageSent = "sent"
+	// QueuedMessageFailed is sent when a queued message can't be sent anymore
+	QueuedMessageFailed = "failed"
+)
+
+// outboundQueueBackoff returns the delay in ms after the given number of attempts
+func outboundQueueBackoff(attempts int) uint64 {
+	backoff := uint64(math.Pow(2, float64(attempts))) * outboundQueueMinDelay
+	if backoff > outboundQueueMaxDelay {
+		return outboundQueueMaxDelay
+	}
+	return backoff
+}
+
+func shouldQueueRawMessage(message *common.RawMessage) bool {
+	// Emoji reactions are re-sent by watchExpiredEmojis
+	return !message.Sent && message.MessageType != protobuf.ApplicationMetadataMessage_EMOJI_REACTION
+}
+
+// rawMessageClock returns the clock value of the message in the payload. Messages
+// without a clock value are ordered by the time they were sent.
+func rawMessageClock(message *common.RawMessage) uint64 {
+	statusMessage := &v1protocol.StatusMessage{Type: message.MessageType, UnwrappedPayload: message.Payload}
+	if err := statusMessage.HandleApplication(); err == nil && statusMessage.ParsedMessage != nil {
+		if clocked, ok := statusMessage.ParsedMessage.Addr().Interface().(interface{ GetClock() uint64 }); ok && clocked.GetClock() != 0 {
+			return clocked.GetClock()
+		}
+	}
+	return message.LastSent
+}
+
+func (m *Messenger) notifyQueuedMessage(queued *common.QueuedRawMessage, status string) {
+	m.logger.Debug("outbound queue changed", zap.String("id", queued.ID), zap.String("status", status), zap.Int("attempts", queued.Attempts))
+	if m.config.messengerSignalsHandler != nil {
+		m.config.messengerSignalsHandler.MessageQueueStatusChanged(queued.LocalChatID, queued.ID, status, queued.Attempts)
+	}
+}
+
+// queueRawMessage adds a message that hasn't reached any peer to the outbound queue
+func (m *Messenger) queueRawMessage(message *common.RawMessage, nextAttempt uint64) error {
+	if !shouldQueueRawMessage(message) {
+		return nil
+	}
+
+	queued := &common.QueuedRawMessage{
+		ID:          message.ID,
+		MessageID:   message.ID,
+		LocalChatID: message.LocalChatID,
+		Clock:       rawMessageClock(message),
+		NextAttempt: nextAttempt,
+	}
+	err := m.persistence.SaveQueuedRawMessage(queued)
+	if err != nil {
+		return err
+	}
+	m.notifyQueuedMessage(queued, QueuedMessageQueued)
+	return nil
+}
+
+// processExpiredMessages queues messages whose envelopes expired without reaching any peer
+func (m *Messenger) processExpiredMessages(ids []string) error {
+	for _, id := range ids {
+		queued, err := m.persistence.QueuedRawMessageByMessageID(id)
+		if err != nil {
+			return err
+		}
+		// The next attempt has already been scheduled
+		if queued != nil {
+			continue
+		}
+
+		rawMessage, err := m.persistence.RawMessageByID(id)
+		if err == sql.ErrNoRows {
+			continue
+		}
+		if err != nil {
+			return errors.Wrapf(err, "Can't get raw message with id %v", id)
+		}
+
+		// Re-sent messages are either tracked by the queue or re-sent manually
+		if rawMessage.SendCount > 1 {
+			continue
+		}
+
+		err = m.queueRawMessage(rawMessage, m.getTimesource().GetCurrentTime()+outboundQueueBackoff(0))
+		if err != nil {
+			return errors.Wrapf(err, "Can't queue expired message with id %v", id)
+		}
+	}
+	return nil
+}
+
+// processSentQueuedMessage removes a message from the queue once it reached a peer
+func (m *Messenger) processSentQueuedMessage(id string) error {
+	queued, err := m.persistence.QueuedRawMessageByMessageID(id)
+	if err != nil || queued == nil {
+		return err
+	}
+	err = m.persistence.DeleteQueuedRawMessage(queued.ID)
+	if err != nil {
+		return err
+	}
+	m.notifyQueuedMessage(queued, QueuedMessageSent)
+	return nil
+}
+
+// resendQueuedMessages sends again the queued messages in the order of their clock
+// values. If all is set the backoff is ignored, which is used when we go back online.
+func (m *Messenger) resendQueuedMessages(all bool) error {
+	now := m.getTimesource().GetCurrentTime()
+	until := now
+	if all {
+		until = math.MaxInt64
+	}
+
+	queue, err := m.persistence.QueuedRawMessages(until)
+	if err != nil {
+		return errors.Wrap(err, "Can't get queued messages from db")
+	}
+
+	for _, queued := range queue {
+		if !m.online() {
+			return nil
+		}
+		err := m.resendQueuedMessage(queued, now)
+		if err != nil {
+			m.logger.Warn("failed to resend queued message", zap.String("id", queued.ID), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+func (m *Messenger) resendQueuedMessage(queued *common.QueuedRawMessage, now uint64) error {
+	rawMessage, err := m.persistence.RawMessageByID(queued.MessageID)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+
+	// The envelope might have been delivered in the meantime
+	if rawMessage != nil && rawMessage.Sent {
+		return m.processSentQueuedMessage(queued.MessageID)
+	}
+
+	if rawMessage == nil || queued.Attempts >= outboundQueueMaxAttempts {
+		err = m.persistence.DeleteQueuedRawMessage(queued.ID)
+		if err != nil {
+			return err
+		}
+		m.notifyQueuedMessage(queued, QueuedMessageFailed)
+		return nil
+	}
+
+	spec, err := m.reSendRawMessage(context.Background(), queued.MessageID)
+	if err != nil {
+		// The chat might have been removed, there's no point in trying again
+		if deleteErr := m.persistence.DeleteQueuedRawMessage(queued.ID); deleteErr != nil {
+			return deleteErr
+		}
+		m.notifyQueuedMessage(queued, QueuedMessageFailed)
+		return err
+	}
+
+	queued.MessageID = spec.ID
+	queued.Attempts++
+	queued.NextAttempt = now + outboundQueueBackoff(queued.Attempts)
+
+	// Nothing has been sent out, for example a group chat without other members
+	if spec.Sent {
+		err = m.persistence.DeleteQueuedRawMessage(queued.ID)
+		if err != nil {
+			return err
+		}
+		m.notifyQueuedMessage(queued, QueuedMessageSent)
+		return nil
+	}
+
+	err = m.persistence.SaveQueuedRawMessage(queued)
+	if err != nil {
+		return err
+	}
+	m.notifyQueuedMessage(queued, QueuedMessageResent)
+	return nil
+}
+
+// watchOutboundQueue regularly re-sends queued messages whose backoff elapsed,
+// and flushes the whole queue when we go back online
+func (m *Messenger) watchOutboundQueue() {
+	m.logger.Debug("watching outbound queue")
+	go func() {
+		for {
+			select {
+			case <-time.After(time.Second):
+				if m.online() {
+					err := m.resendQueuedMessages(false)
+					if err != nil {
+						m.logger.Debug("Error when resending queued messages", zap.Error(err))
+					}
+				}
+			case <-m.outboundQueueFlush:
+				err := m.resendQueuedMessages(true)
+				if err != nil {
+					m.logger.Debug("Error when flushing outbound queue", zap.Error(err))
+				}
+			case <-m.quit:
+				return
+			}
+		}
+	}()
+}
@@ -0,0 +1,137 @@
+package protocol
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	gethbridge "github.com/status-im/status-go/eth-node/bridge/geth"
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/communities"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/tt"
+	"github.com/status-im/status-go/waku"
+)
+
+func TestMessengerOutboundQueueSuite(t *testing.T) {
+	suite.Run(t, new(MessengerOutboundQueueSuite))
+}
+
+type queueStatusChange struct {
+	messageID string
+	status    string
+}
+
+// queueSignalsHandler records the changes of the outbound queue
+type queueSignalsHandler struct {
+	mu      sync.Mutex
+	changes []queueStatusChange
+}
+
+func (h *queueSignalsHandler) MessageDelivered(chatID string, messageID string)    {}
+func (h *queueSignalsHandler) CommunityInfoFound(community *communities.Community) {}
+func (h *queueSignalsHandler) MessengerResponse(response *MessengerResponse)       {}
+func (h *queueSignalsHandler) FileDownloadProgress(chatID string, messageID string, received uint32, total uint32) {
+}
+
+func (h *queueSignalsHandler) MessageQueueStatusChanged(chatID string, messageID string, status string, attempts int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.changes = append(h.changes, queueStatusChange{messageID: messageID, status: status})
+}
+
+func (h *queueSignalsHandler) resent() []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	var ids []string
+	for _, change := range h.changes {
+		if change.status == QueuedMessageResent || change.status == QueuedMessageSent {
+			ids = append(ids, change.messageID)
+		}
+	}
+	return ids
+}
+
+type MessengerOutboundQueueSuite struct {
+	suite.Suite
+	m       *Messenger
+	signals *queueSignalsHandler
+	shh     types.Waku
+	logger  *zap.Logger
+}
+
+func (s *MessengerOutboundQueueSuite) SetupTest() {
+	s.logger = tt.MustCreateTestLogger()
+
+	config := waku.DefaultConfig
+	config.MinimumAcceptedPoW = 0
+	shh := waku.New(&config, s.logger)
+	s.shh = gethbridge.NewGethWakuWrapper(shh)
+	s.Require().NoError(shh.Start())
+
+	privateKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	s.signals = &queueSignalsHandler{}
+	s.m, err = newMessengerWithKey(s.shh, privateKey, s.logger, []Option{WithSignalsHandler(s.signals)})
+	s.Require().NoError(err)
+}
+
+func (s *MessengerOutboundQueueSuite) TearDownTest() {
+	s.Require().NoError(s.m.Shutdown())
+}
+
+// saveUnsentMessage stores a chat message that hasn't reached any peer
+func (s *MessengerOutboundQueueSuite) saveUnsentMessage(chat *Chat, id string, clock uint64, lastSent uint64) *common.RawMessage {
+	payload, err := proto.Marshal(&protobuf.ChatMessage{
+		Clock:       clock,
+		Text:        id,
+		ChatId:      chat.ID,
+		MessageType: protobuf.MessageType_PUBLIC_GROUP,
+		ContentType: protobuf.ChatMessage_TEXT_PLAIN,
+	})
+	s.Require().NoError(err)
+	rawMessage := &common.RawMessage{
+		ID:          id,
+		LocalChatID: chat.ID,
+		LastSent:    lastSent,
+		SendCount:   1,
+		MessageType: protobuf.ApplicationMetadataMessage_CHAT_MESSAGE,
+		Payload:     payload,
+	}
+	s.Require().NoError(s.m.persistence.SaveRawMessage(rawMessage))
+	return rawMessage
+}
+
+func (s *MessengerOutboundQueueSuite) TestQueueOrderedByClock() {
+	chat := CreatePublicChat("status", s.m.transport)
+	s.Require().NoError(s.m.SaveChat(chat))
+
+	// The second message was sent first, for example after being edited offline
+	first := s.saveUnsentMessage(chat, "0x01", 100, 2000)
+	second := s.saveUnsentMessage(chat, "0x02", 101, 1000)
+
+	// Only a flush re-sends the messages before the backoff elapses
+	nextAttempt := s.m.getTimesource().GetCurrentTime() + outboundQueueMaxDelay
+	s.Require().NoError(s.m.queueRawMessage(second, nextAttempt))
+	s.Require().NoError(s.m.queueRawMessage(first, nextAttempt))
+
+	queued, err := s.m.persistence.QueuedRawMessageByMessageID("0x01")
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(100), queued.Clock)
+
+	s.Require().NoError(s.m.resendQueuedMessages(true))
+	s.Require().Equal([]string{"0x01", "0x02"}, s.signals.resent())
+}
+
+func (s *MessengerOutboundQueueSuite) TestQueueClockFallsBackToLastSent() {
+	rawMessage := &common.RawMessage{
+		LastSent:    1000,
+		MessageType: protobuf.ApplicationMetadataMessage_CONTACT_CODE_ADVERTISEMENT,
+	}
+	s.Require().Equal(uint64(1000), rawMessageClock(rawMessage))
+}
@@ -0,0 +1,243 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/polls"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+)
+
+var ErrNotAPoll = errors.New("message is not a poll")
+
+// SendPoll sends a poll to a chat, members of the chat can then vote on it
+func (m *Messenger) SendPoll(ctx context.Context, request *requests.SendPoll) (*MessengerResponse, error) {
+	err := request.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	poll := request.ToPollMessage()
+	err = ValidatePoll(poll)
+	if err != nil {
+		return nil, err
+	}
+
+	if polls.Closed(poll, m.getTimesource().GetCurrentTime()) {
+		return nil, polls.ErrPollClosed
+	}
+
+	message := &common.Message{}
+	message.ChatId = request.ChatID
+	// The question is set as text so that clients that don't
+	// support polls still show something meaningful
+	message.Text = poll.Question
+	message.ContentType = protobuf.ChatMessage_POLL
+	message.Payload = &protobuf.ChatMessage_Poll{Poll: poll}
+
+	return m.sendChatMessage(ctx, message)
+}
+
+// SendPollVote casts the vote of the user on a poll, replacing any previous vote.
+// An empty list of options retracts the vote.
+func (m *Messenger) SendPollVote(ctx context.Context, request *requests.SendPollVote) (*MessengerResponse, error) {
+	err := request.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := m.persistence.MessageByID(request.MessageID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	poll := message.GetPoll()
+	if message.ContentType != protobuf.ChatMessage_POLL || poll == nil {
+		return nil, ErrNotAPoll
+	}
+
+	now := m.getTimesource().GetCurrentTime()
+	if polls.Closed(poll, now) {
+		return nil, polls.ErrPollClosed
+	}
+
+	err = polls.ValidateVote(poll, request.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	chat, ok := m.allChats.Load(message.LocalChatID)
+	if !ok {
+		return nil, ErrChatNotFound
+	}
+
+	clock, _ := chat.NextClockAndTimestamp(m.getTimesource())
+
+	vote := &PollVote{
+		PollVote: protobuf.PollVote{
+			Clock:     clock,
+			ChatId:    chat.ID,
+			MessageId: message.ID,
+			Options:   request.Options,
+		},
+		From:        contactIDFromPublicKey(&m.identity.PublicKey),
+		LocalChatID: chat.ID,
+	}
+
+	encodedMessage, err := m.encodeChatEntity(chat, vote)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = m.dispatchMessage(ctx, common.RawMessage{
+		LocalChatID:          chat.ID,
+		Payload:              encodedMessage,
+		SkipGroupMessageWrap: true,
+		MessageType:          protobuf.ApplicationMetadataMessage_POLL_VOTE,
+		ResendAutomatically:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.persistence.SavePollVote(vote, now)
+	if err != nil {
+		return nil, err
+	}
+
+	chat.LastClockValue = clock
+	err = m.saveChat(chat)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := m.pollResults(message)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.AddPollResult(results)
+	response.AddChat(chat)
+	return response, nil
+}
+
+// PollResults returns the current tally of the votes on a poll
+func (m *Messenger) PollResults(messageID string) (*PollResults, error) {
+	message, err := m.persistence.MessageByID(messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if message.ContentType != protobuf.ChatMessage_POLL || message.GetPoll() == nil {
+		return nil, ErrNotAPoll
+	}
+
+	return m.pollResults(message)
+}
+
+func (m *Messenger) pollResults(message *common.Message) (*PollResults, error) {
+	votes, err := m.persistence.PollVotes(message.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	results := &PollResults{
+		Results:     polls.Tally(message.GetPoll(), votes, m.getTimesource().GetCurrentTime()),
+		MessageID:   message.ID,
+		LocalChatID: message.LocalChatID,
+		MyVote:      []uint32{},
+	}
+
+	myID := contactIDFromPublicKey(&m.identity.PublicKey)
+	for _, vote := range votes {
+		if vote.Voter == myID && vote.Options != nil {
+			results.MyVote = vote.Options
+		}
+	}
+
+	return results, nil
+}
+
+func (m *Messenger) HandlePollVote(state *ReceivedMessageState, pbVote protobuf.PollVote) error {
+	logger := m.logger.With(zap.String("site", "HandlePollVote"))
+	if err := ValidateReceivedPollVote(&pbVote, state.CurrentMessageState.WhisperTimestamp); err != nil {
+		logger.Error("invalid poll vote", zap.Error(err))
+		return err
+	}
+
+	vote := &PollVote{
+		PollVote:  pbVote,
+		From:      state.CurrentMessageState.Contact.ID,
+		SigPubKey: state.CurrentMessageState.PublicKey,
+	}
+
+	// In communities this checks that the voter is allowed to post in the chat
+	chat, err := m.matchChatEntity(vote)
+	if err != nil {
+		return err // matchChatEntity returns a descriptive error message
+	}
+	vote.LocalChatID = chat.ID
+
+	existing, err := m.persistence.PollVoteByVoter(vote.MessageId, vote.From)
+	if err != nil {
+		return err
+	}
+
+	// Last vote wins
+	if existing != nil && existing.Clock >= vote.Clock {
+		return nil
+	}
+
+	// Check if it's already in the response
+	message := state.Response.GetMessage(vote.MessageId)
+	// otherwise pull from database
+	if message == nil {
+		message, err = m.persistence.MessageByID(vote.MessageId)
+		if err != nil && err != common.ErrRecordNotFound {
+			return err
+		}
+	}
+
+	// We might receive the vote before the poll, in which case it's
+	// checked against the poll when the results are tallied
+	if message != nil {
+		poll := message.GetPoll()
+		if message.ContentType != protobuf.ChatMessage_POLL || poll == nil {
+			return ErrNotAPoll
+		}
+		if message.LocalChatID != chat.ID {
+			return errors.New("poll vote sent to the wrong chat")
+		}
+		if polls.Closed(poll, state.CurrentMessageState.WhisperTimestamp) {
+			return polls.ErrPollClosed
+		}
+		if err := polls.ValidateVote(poll, vote.Options); err != nil {
+			return err
+		}
+	}
+
+	err = m.persistence.SavePollVote(vote, state.CurrentMessageState.WhisperTimestamp)
+	if err != nil {
+		return err
+	}
+
+	if chat.LastClockValue < vote.Clock {
+		chat.LastClockValue = vote.Clock
+	}
+	state.AllChats.Store(chat.ID, chat)
+
+	if message != nil {
+		results, err := m.pollResults(message)
+		if err != nil {
+			return err
+		}
+		state.Response.AddPollResult(results)
+	}
+
+	return nil
+}
@@ -31,6 +31,13 @@ type MessengerResponse struct {
 	pinMessages                 map[string]*common.PinMessage
 	currentStatus               *UserStatus
 	statusUpdates               map[string]UserStatus
+	verificationRequests        map[string]*VerificationRequest
+	pollResults                 map[string]*PollResults
+	communityControlRequests    map[string]*communities.ControlSignatureRequest
+	communityDirectoryListings  map[string]*communities.DirectoryListing
+	socialRecoveryRequests      map[string]*SocialRecoveryRequest
+	socialRecoveryShares        map[string]*SocialRecoveryShare
+	safeTransactionProposals    map[string]*SafeTransactionProposal
 }
 
 func (r *MessengerResponse) MarshalJSON() ([]byte, error) {
@@ -49,11 +56,18 @@ func (r *MessengerResponse) MarshalJSON() ([]byte, error) {
 		Mailservers             []mailservers.Mailserver        `json:"mailservers,omitempty"`
 		// Notifications a list of notifications derived from messenger events
 		// that are useful to notify the user about
-		Notifications               []*localnotifications.Notification `json:"notifications"`
-		Communities                 []*communities.Community           `json:"communities,omitempty"`
-		ActivityCenterNotifications []*ActivityCenterNotification      `json:"activityCenterNotifications,omitempty"`
-		CurrentStatus               *UserStatus                        `json:"currentStatus,omitempty"`
-		StatusUpdates               []UserStatus                       `json:"statusUpdates,omitempty"`
+		Notifications               []*localnotifications.Notification     `json:"notifications"`
+		Communities                 []*communities.Community               `json:"communities,omitempty"`
+		ActivityCenterNotifications []*ActivityCenterNotification          `json:"activityCenterNotifications,omitempty"`
+		CurrentStatus               *UserStatus                            `json:"currentStatus,omitempty"`
+		StatusUpdates               []UserStatus                           `json:"statusUpdates,omitempty"`
+		VerificationRequests        []*VerificationRequest                 `json:"verificationRequests,omitempty"`
+		PollResults                 []*PollResults                         `json:"pollResults,omitempty"`
+		CommunityControlRequests    []*communities.ControlSignatureRequest `json:"communityControlSignatureRequests,omitempty"`
+		CommunityDirectoryListings  []*communities.DirectoryListing        `json:"communityDirectoryListings,omitempty"`
+		SocialRecoveryRequests      []*SocialRecoveryRequest               `json:"socialRecoveryRequests,omitempty"`
+		SocialRecoveryShares        []*SocialRecoveryShare                 `json:"socialRecoveryShares,omitempty"`
+		SafeTransactionProposals    []*SafeTransactionProposal             `json:"safeTransactionProposals,omitempty"`
 	}{
 		Contacts:                r.Contacts,
 		Installations:           r.Installations,
@@ -74,6 +88,13 @@ func (r *MessengerResponse) MarshalJSON() ([]byte, error) {
 	responseItem.ActivityCenterNotifications = r.ActivityCenterNotifications()
 	responseItem.PinMessages = r.PinMessages()
 	responseItem.StatusUpdates = r.StatusUpdates()
+	responseItem.VerificationRequests = r.VerificationRequests()
+	responseItem.PollResults = r.PollResults()
+	responseItem.CommunityControlRequests = r.CommunityControlSignatureRequests()
+	responseItem.CommunityDirectoryListings = r.CommunityDirectoryListings()
+	responseItem.SocialRecoveryRequests = r.SocialRecoveryRequests()
+	responseItem.SocialRecoveryShares = r.SocialRecoveryShares()
+	responseItem.SafeTransactionProposals = r.SafeTransactionProposals()
 
 	return json.Marshal(responseItem)
 }
@@ -135,6 +156,62 @@ func (r *MessengerResponse) StatusUpdates() []UserStatus {
 	return userStatus
 }
 
+func (r *MessengerResponse) VerificationRequests() []*VerificationRequest {
+	var requests []*VerificationRequest
+	for _, request := range r.verificationRequests {
+		requests = append(requests, request)
+	}
+	return requests
+}
+
+func (r *MessengerResponse) PollResults() []*PollResults {
+	var results []*PollResults
+	for _, result := range r.pollResults {
+		results = append(results, result)
+	}
+	return results
+}
+
+func (r *MessengerResponse) CommunityControlSignatureRequests() []*communities.ControlSignatureRequest {
+	var requests []*communities.ControlSignatureRequest
+	for _, request := range r.communityControlRequests {
+		requests = append(requests, request)
+	}
+	return requests
+}
+
+func (r *MessengerResponse) CommunityDirectoryListings() []*communities.DirectoryListing {
+	var listings []*communities.DirectoryListing
+	for _, listing := range r.communityDirectoryListings {
+		listings = append(listings, listing)
+	}
+	return listings
+}
+
+func (r *MessengerResponse) SocialRecoveryRequests() []*SocialRecoveryRequest {
+	var requests []*SocialRecoveryRequest
+	for _, request := range r.socialRecoveryRequests {
+		requests = append(requests, request)
+	}
+	return requests
+}
+
+func (r *MessengerResponse) SocialRecoveryShares() []*SocialRecoveryShare {
+	var shares []*SocialRecoveryShare
+	for _, share := range r.socialRecoveryShares {
+		shares = append(shares, share)
+	}
+	return shares
+}
+
+func (r *MessengerResponse) SafeTransactionProposals() []*SafeTransactionProposal {
+	var proposals []*SafeTransactionProposal
+	for _, proposal := range r.safeTransactionProposals {
+		proposals = append(proposals, proposal)
+	}
+	return proposals
+}
+
 func (r *MessengerResponse) IsEmpty() bool {
 	return len(r.chats)+
 		len(r.messages)+
@@ -150,6 +227,13 @@ func (r *MessengerResponse) IsEmpty() bool {
 		len(r.Mailservers)+
 		len(r.notifications)+
 		len(r.statusUpdates)+
+		len(r.verificationRequests)+
+		len(r.pollResults)+
+		len(r.communityControlRequests)+
+		len(r.communityDirectoryListings)+
+		len(r.socialRecoveryRequests)+
+		len(r.socialRecoveryShares)+
+		len(r.safeTransactionProposals)+
 		len(r.activityCenterNotifications)+
 		len(r.RequestsToJoinCommunity) == 0 &&
 		r.currentStatus == nil
@@ -176,6 +260,13 @@ func (r *MessengerResponse) Merge(response *MessengerResponse) error {
 	r.AddMessages(response.Messages())
 	r.AddCommunities(response.Communities())
 	r.AddPinMessages(response.PinMessages())
+	r.AddVerificationRequests(response.VerificationRequests())
+	r.AddPollResults(response.PollResults())
+	r.AddCommunityControlSignatureRequests(response.CommunityControlSignatureRequests())
+	r.AddCommunityDirectoryListings(response.CommunityDirectoryListings())
+	r.AddSocialRecoveryRequests(response.SocialRecoveryRequests())
+	r.AddSocialRecoveryShares(response.SocialRecoveryShares())
+	r.AddSafeTransactionProposals(response.SafeTransactionProposals())
 
 	return nil
 }
@@ -302,6 +393,104 @@ func (r *MessengerResponse) AddStatusUpdate(upd UserStatus) {
 	r.statusUpdates[upd.PublicKey] = upd
 }
 
+func (r *MessengerResponse) AddVerificationRequest(request *VerificationRequest) {
+	if r.verificationRequests == nil {
+		r.verificationRequests = make(map[string]*VerificationRequest)
+	}
+
+	r.verificationRequests[request.ID] = request
+}
+
+func (r *MessengerResponse) AddVerificationRequests(requests []*VerificationRequest) {
+	for _, request := range requests {
+		r.AddVerificationRequest(request)
+	}
+}
+
+func (r *MessengerResponse) AddSocialRecoveryRequest(request *SocialRecoveryRequest) {
+	if r.socialRecoveryRequests == nil {
+		r.socialRecoveryRequests = make(map[string]*SocialRecoveryRequest)
+	}
+
+	r.socialRecoveryRequests[request.ID] = request
+}
+
+func (r *MessengerResponse) AddSocialRecoveryRequests(requests []*SocialRecoveryRequest) {
+	for _, request := range requests {
+		r.AddSocialRecoveryRequest(request)
+	}
+}
+
+func (r *MessengerResponse) AddSocialRecoveryShare(share *SocialRecoveryShare) {
+	if r.socialRecoveryShares == nil {
+		r.socialRecoveryShares = make(map[string]*SocialRecoveryShare)
+	}
+
+	r.socialRecoveryShares[share.Owner+share.Holder] = share
+}
+
+func (r *MessengerResponse) AddSocialRecoveryShares(shares []*SocialRecoveryShare) {
+	for _, share := range shares {
+		r.AddSocialRecoveryShare(share)
+	}
+}
+
+func (r *MessengerResponse) AddSafeTransactionProposal(proposal *SafeTransactionProposal) {
+	if r.safeTransactionProposals == nil {
+		r.safeTransactionProposals = make(map[string]*SafeTransactionProposal)
+	}
+
+	r.safeTransactionProposals[proposal.MessageID] = proposal
+}
+
+func (r *MessengerResponse) AddSafeTransactionProposals(proposals []*SafeTransactionProposal) {
+	for _, proposal := range proposals {
+		r.AddSafeTransactionProposal(proposal)
+	}
+}
+
+func (r *MessengerResponse) AddPollResult(result *PollResults) {
+	if r.pollResults == nil {
+		r.pollResults = make(map[string]*PollResults)
+	}
+
+	r.pollResults[result.MessageID] = result
+}
+
+func (r *MessengerResponse) AddPollResults(results []*PollResults) {
+	for _, result := range results {
+		r.AddPollResult(result)
+	}
+}
+
+func (r *MessengerResponse) AddCommunityControlSignatureRequest(request *communities.ControlSignatureRequest) {
+	if r.communityControlRequests == nil {
+		r.communityControlRequests = make(map[string]*communities.ControlSignatureRequest)
+	}
+
+	r.communityControlRequests[request.CommunityID.String()] = request
+}
+
+func (r *MessengerResponse) AddCommunityControlSignatureRequests(requests []*communities.ControlSignatureRequest) {
+	for _, request := range requests {
+		r.AddCommunityControlSignatureRequest(request)
+	}
+}
+
+func (r *MessengerResponse) AddCommunityDirectoryListing(listing *communities.DirectoryListing) {
+	if r.communityDirectoryListings == nil {
+		r.communityDirectoryListings = make(map[string]*communities.DirectoryListing)
+	}
+
+	r.communityDirectoryListings[listing.CommunityID.String()] = listing
+}
+
+func (r *MessengerResponse) AddCommunityDirectoryListings(listings []*communities.DirectoryListing) {
+	for _, listing := range listings {
+		r.AddCommunityDirectoryListing(listing)
+	}
+}
+
 func (r *MessengerResponse) Messages() []*common.Message {
 	var ms []*common.Message
 	for _, m := range r.messages {
@@ -0,0 +1,297 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+	"github.com/status-im/status-go/protocol/safe"
+)
+
+var ErrNotASafeTransactionProposal = errors.New("message is not a safe transaction proposal")
+var ErrSafeTransactionProposalExecuted = errors.New("safe transaction proposal already executed")
+
+// SendSafeTransactionProposal proposes a Safe transaction to the members of a chat,
+// who can then sign it with the accounts owning the Safe
+func (m *Messenger) SendSafeTransactionProposal(ctx context.Context, request *requests.SendSafeTransactionProposal) (*MessengerResponse, error) {
+	err := request.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	proposal := request.Transaction.ToProtobuf()
+	proposal.Signature, err = safe.NormalizeSignature(request.Signature)
+	if err != nil {
+		return nil, err
+	}
+	err = ValidateSafeTransactionProposal(proposal)
+	if err != nil {
+		return nil, err
+	}
+
+	message := &common.Message{}
+	message.ChatId = request.ChatID
+	// Clients that don't support Safe transactions show the text instead
+	message.Text = "Safe transaction proposal"
+	message.ContentType = protobuf.ChatMessage_SAFE_TRANSACTION_PROPOSAL
+	message.Payload = &protobuf.ChatMessage_SafeTransactionProposal{SafeTransactionProposal: proposal}
+
+	response, err := m.sendChatMessage(ctx, message)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, sent := range response.Messages() {
+		state, err := m.safeTransactionProposal(sent)
+		if err != nil {
+			return nil, err
+		}
+		response.AddSafeTransactionProposal(state)
+	}
+
+	return response, nil
+}
+
+// SendSafeTransactionSignature sends the signature of an owner of the Safe over a
+// proposed transaction to the chat it was proposed in
+func (m *Messenger) SendSafeTransactionSignature(ctx context.Context, request *requests.SendSafeTransactionSignature) (*MessengerResponse, error) {
+	err := request.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := m.persistence.MessageByID(request.MessageID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := m.safeTransactionProposal(message)
+	if err != nil {
+		return nil, err
+	}
+	if state.Status == SafeTransactionProposalExecuted {
+		return nil, ErrSafeTransactionProposalExecuted
+	}
+
+	normalized, err := safe.NormalizeSignature(request.Signature)
+	if err != nil {
+		return nil, err
+	}
+	_, err = safe.RecoverSigner(state.Hash, normalized)
+	if err != nil {
+		return nil, err
+	}
+
+	chat, ok := m.allChats.Load(message.LocalChatID)
+	if !ok {
+		return nil, ErrChatNotFound
+	}
+
+	clock, _ := chat.NextClockAndTimestamp(m.getTimesource())
+
+	signature := &SafeTransactionSignature{
+		SafeTransactionSignature: protobuf.SafeTransactionSignature{
+			Clock:     clock,
+			ChatId:    chat.ID,
+			MessageId: message.ID,
+			Signature: normalized,
+		},
+		From:        contactIDFromPublicKey(&m.identity.PublicKey),
+		LocalChatID: chat.ID,
+	}
+
+	encodedMessage, err := m.encodeChatEntity(chat, signature)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = m.dispatchMessage(ctx, common.RawMessage{
+		LocalChatID:          chat.ID,
+		Payload:              encodedMessage,
+		SkipGroupMessageWrap: true,
+		MessageType:          protobuf.ApplicationMetadataMessage_SAFE_TRANSACTION_SIGNATURE,
+		ResendAutomatically:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.persistence.SaveSafeTransactionSignature(signature)
+	if err != nil {
+		return nil, err
+	}
+
+	chat.LastClockValue = clock
+	err = m.saveChat(chat)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err = m.safeTransactionProposal(message)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.AddSafeTransactionProposal(state)
+	response.AddChat(chat)
+	return response, nil
+}
+
+// SafeTransactionProposal returns a proposed Safe transaction along with the signatures
+// collected so far
+func (m *Messenger) SafeTransactionProposal(messageID string) (*SafeTransactionProposal, error) {
+	message, err := m.persistence.MessageByID(messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.safeTransactionProposal(message)
+}
+
+// SetSafeTransactionProposalStatus updates the status of a proposal, once it has been
+// signed by enough owners or executed
+func (m *Messenger) SetSafeTransactionProposalStatus(messageID string, status SafeTransactionProposalStatus, transactionHash string) (*SafeTransactionProposal, error) {
+	message, err := m.persistence.MessageByID(messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if message.ContentType != protobuf.ChatMessage_SAFE_TRANSACTION_PROPOSAL || message.GetSafeTransactionProposal() == nil {
+		return nil, ErrNotASafeTransactionProposal
+	}
+
+	err = m.persistence.SetSafeTransactionProposalStatus(messageID, status, transactionHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.safeTransactionProposal(message)
+}
+
+func (m *Messenger) safeTransactionProposal(message *common.Message) (*SafeTransactionProposal, error) {
+	proposal := message.GetSafeTransactionProposal()
+	if message.ContentType != protobuf.ChatMessage_SAFE_TRANSACTION_PROPOSAL || proposal == nil {
+		return nil, ErrNotASafeTransactionProposal
+	}
+
+	transaction, err := safe.FromProtobuf(proposal)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := transaction.Hash()
+	if err != nil {
+		return nil, err
+	}
+
+	signatures, err := m.persistence.SafeTransactionSignatures(message.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &SafeTransactionProposal{
+		MessageID:   message.ID,
+		LocalChatID: message.LocalChatID,
+		Proposer:    message.From,
+		Transaction: transaction,
+		Hash:        hash,
+		Signatures:  make(map[gethcommon.Address]types.HexBytes),
+	}
+
+	// Signatures which don't match the transaction are ignored
+	for _, signature := range append([][]byte{proposal.Signature}, signatures...) {
+		// Signatures stored before they were normalized might have a recovery id of 0 or 1
+		normalized, err := safe.NormalizeSignature(signature)
+		if err != nil {
+			continue
+		}
+		signer, err := safe.RecoverSigner(hash, normalized)
+		if err != nil {
+			continue
+		}
+		state.Signatures[signer] = normalized
+	}
+
+	state.Status, state.TransactionHash, err = m.persistence.SafeTransactionProposalStatus(message.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+func (m *Messenger) HandleSafeTransactionSignature(state *ReceivedMessageState, pbSignature protobuf.SafeTransactionSignature) error {
+	logger := m.logger.With(zap.String("site", "HandleSafeTransactionSignature"))
+	if err := ValidateReceivedSafeTransactionSignature(&pbSignature, state.CurrentMessageState.WhisperTimestamp); err != nil {
+		logger.Error("invalid safe transaction signature", zap.Error(err))
+		return err
+	}
+
+	// Signatures are stored with the recovery id the Safe contract expects
+	normalized, err := safe.NormalizeSignature(pbSignature.Signature)
+	if err != nil {
+		logger.Error("invalid safe transaction signature", zap.Error(err))
+		return err
+	}
+	pbSignature.Signature = normalized
+
+	signature := &SafeTransactionSignature{
+		SafeTransactionSignature: pbSignature,
+		From:                     state.CurrentMessageState.Contact.ID,
+		SigPubKey:                state.CurrentMessageState.PublicKey,
+	}
+
+	chat, err := m.matchChatEntity(signature)
+	if err != nil {
+		return err // matchChatEntity returns a descriptive error message
+	}
+	signature.LocalChatID = chat.ID
+
+	// Check if it's already in the response
+	message := state.Response.GetMessage(signature.MessageId)
+	// otherwise pull from database
+	if message == nil {
+		message, err = m.persistence.MessageByID(signature.MessageId)
+		if err != nil && err != common.ErrRecordNotFound {
+			return err
+		}
+	}
+
+	// We might receive the signature before the proposal, in which case it's
+	// checked against the proposal when the proposal is read
+	if message != nil {
+		if message.ContentType != protobuf.ChatMessage_SAFE_TRANSACTION_PROPOSAL || message.GetSafeTransactionProposal() == nil {
+			return ErrNotASafeTransactionProposal
+		}
+		if message.LocalChatID != chat.ID {
+			return errors.New("safe transaction signature sent to the wrong chat")
+		}
+	}
+
+	err = m.persistence.SaveSafeTransactionSignature(signature)
+	if err != nil {
+		return err
+	}
+
+	if chat.LastClockValue < signature.Clock {
+		chat.LastClockValue = signature.Clock
+	}
+	state.AllChats.Store(chat.ID, chat)
+
+	if message != nil {
+		proposal, err := m.safeTransactionProposal(message)
+		if err != nil {
+			return err
+		}
+		state.Response.AddSafeTransactionProposal(proposal)
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package protocol
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+
+	gethbridge "github.com/status-im/status-go/eth-node/bridge/geth"
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/requests"
+	"github.com/status-im/status-go/protocol/safe"
+	"github.com/status-im/status-go/protocol/tt"
+	"github.com/status-im/status-go/waku"
+)
+
+func TestMessengerSafeSuite(t *testing.T) {
+	suite.Run(t, new(MessengerSafeSuite))
+}
+
+type MessengerSafeSuite struct {
+	suite.Suite
+	shh    types.Waku
+	logger *zap.Logger
+}
+
+func (s *MessengerSafeSuite) SetupTest() {
+	s.logger = tt.MustCreateTestLogger()
+
+	config := waku.DefaultConfig
+	config.MinimumAcceptedPoW = 0
+	shh := waku.New(&config, s.logger)
+	s.shh = gethbridge.NewGethWakuWrapper(shh)
+	s.Require().NoError(shh.Start())
+}
+
+func (s *MessengerSafeSuite) newMessenger() *Messenger {
+	privateKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	messenger, err := newMessengerWithKey(s.shh, privateKey, s.logger, nil)
+	s.Require().NoError(err)
+	_, err = messenger.Start()
+	s.Require().NoError(err)
+	return messenger
+}
+
+func (s *MessengerSafeSuite) TestProposeAndSign() {
+	alice := s.newMessenger()
+	defer alice.Shutdown() // nolint: errcheck
+	bob := s.newMessenger()
+	defer bob.Shutdown() // nolint: errcheck
+
+	chat := CreateOneToOneChat(common.PubkeyToHex(&bob.identity.PublicKey), &bob.identity.PublicKey, alice.transport)
+	s.Require().NoError(alice.SaveChat(chat))
+
+	// The wallet accounts owning the Safe
+	aliceOwner, err := gethcrypto.GenerateKey()
+	s.Require().NoError(err)
+	bobOwner, err := gethcrypto.GenerateKey()
+	s.Require().NoError(err)
+
+	transaction := &safe.Transaction{
+		ChainID: (*hexutil.Big)(big.NewInt(1)),
+		Safe:    gethcommon.HexToAddress("0x1111111111111111111111111111111111111111"),
+		To:      gethcommon.HexToAddress("0x2222222222222222222222222222222222222222"),
+		Value:   (*hexutil.Big)(big.NewInt(1000)),
+		Nonce:   (*hexutil.Big)(big.NewInt(3)),
+	}
+	hash, err := transaction.Hash()
+	s.Require().NoError(err)
+	aliceSignature, err := safe.Sign(hash, aliceOwner)
+	s.Require().NoError(err)
+
+	response, err := alice.SendSafeTransactionProposal(context.Background(), &requests.SendSafeTransactionProposal{
+		ChatID:      chat.ID,
+		Transaction: transaction,
+		Signature:   aliceSignature,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(response.SafeTransactionProposals(), 1)
+	messageID := response.SafeTransactionProposals()[0].MessageID
+
+	response, err = WaitOnMessengerResponse(
+		bob,
+		func(r *MessengerResponse) bool { return len(r.Messages()) > 0 },
+		"proposal not received",
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(messageID, response.Messages()[0].ID)
+
+	proposal, err := bob.SafeTransactionProposal(messageID)
+	s.Require().NoError(err)
+	s.Require().Equal(hash, proposal.Hash)
+	s.Require().Len(proposal.Signatures, 1)
+	s.Require().Equal(types.HexBytes(aliceSignature), proposal.Signatures[gethcrypto.PubkeyToAddress(aliceOwner.PublicKey)])
+
+	bobSignature, err := safe.Sign(hash, bobOwner)
+	s.Require().NoError(err)
+	// Some wallets sign with a recovery id of 0 or 1
+	walletSignature := append([]byte{}, bobSignature...)
+	walletSignature[64] -= 27
+	_, err = bob.SendSafeTransactionSignature(context.Background(), &requests.SendSafeTransactionSignature{
+		MessageID: types.FromHex(messageID),
+		Signature: walletSignature,
+	})
+	s.Require().NoError(err)
+
+	response, err = WaitOnMessengerResponse(
+		alice,
+		func(r *MessengerResponse) bool { return len(r.SafeTransactionProposals()) > 0 },
+		"signature not received",
+	)
+	s.Require().NoError(err)
+	proposal = response.SafeTransactionProposals()[0]
+	s.Require().Len(proposal.Signatures, 2)
+	s.Require().Equal(types.HexBytes(bobSignature), proposal.Signatures[gethcrypto.PubkeyToAddress(bobOwner.PublicKey)])
+	stored, err := alice.persistence.SafeTransactionSignatures(messageID)
+	s.Require().NoError(err)
+	s.Require().Equal([][]byte{bobSignature}, stored)
+
+	proposal, err = alice.SetSafeTransactionProposalStatus(messageID, SafeTransactionProposalExecuted, "0x01")
+	s.Require().NoError(err)
+	s.Require().Equal(SafeTransactionProposalExecuted, proposal.Status)
+
+	_, err = alice.SendSafeTransactionSignature(context.Background(), &requests.SendSafeTransactionSignature{
+		MessageID: types.FromHex(messageID),
+		Signature: aliceSignature,
+	})
+	s.Require().Equal(ErrSafeTransactionProposalExecuted, err)
+}
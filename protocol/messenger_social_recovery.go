@@ -0,0 +1,468 @@
+package protocol
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"errors"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/google/uuid"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/extkeys"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+var ErrSocialRecoveryRequestNotFound = errors.New("social recovery request not found")
+var ErrSocialRecoveryRequestInvalidStatus = errors.New("social recovery request has an invalid status")
+var ErrSocialRecoverySafetyNumberMismatch = errors.New("safety number doesn't match the one of the requester")
+var ErrSocialRecoveryNotEnoughShares = errors.New("not enough shares to recover the seed phrase")
+var ErrSocialRecoveryInvalidShare = errors.New("invalid social recovery share")
+
+// socialRecoveryChecksumLength is the number of bytes of the hash of the
+// entropy sent along the shares
+const socialRecoveryChecksumLength = 4
+
+func socialRecoveryChecksum(entropy []byte) []byte {
+	hash := sha256.Sum256(entropy)
+	return hash[:socialRecoveryChecksumLength]
+}
+
+// SetupSocialRecovery splits the entropy of the seed phrase in shares, any
+// threshold of which recover it, and sends one to each of the contacts.
+// It returns the id of the set of shares
+func (m *Messenger) SetupSocialRecovery(ctx context.Context, mnemonic string, threshold int, contactIDs []string) (string, error) {
+	var contacts []*Contact
+	seen := make(map[string]bool)
+	for _, contactID := range contactIDs {
+		contact, ok := m.allContacts.Load(contactID)
+		if !ok || !contact.IsAdded() {
+			return "", ErrContactNotAdded
+		}
+		if seen[contact.ID] {
+			return "", errors.New("shares must be sent to different contacts")
+		}
+		seen[contact.ID] = true
+		contacts = append(contacts, contact)
+	}
+
+	entropy, err := extkeys.NewMnemonic().MnemonicToEntropy(mnemonic, extkeys.EnglishLanguage)
+	if err != nil {
+		return "", err
+	}
+
+	shares, err := extkeys.SplitSecret(entropy, threshold, len(contacts))
+	if err != nil {
+		return "", err
+	}
+
+	id := uuid.New().String()
+	checksum := socialRecoveryChecksum(entropy)
+	for i, contact := range contacts {
+		err = m.sendSocialRecoveryShare(ctx, contact, &protobuf.SocialRecoveryShare{
+			Id:        id,
+			Index:     uint32(shares[i].Index),
+			Value:     shares[i].Value,
+			Threshold: uint32(threshold),
+			Total:     uint32(len(contacts)),
+			Checksum:  checksum,
+		})
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return id, nil
+}
+
+// RequestSocialRecovery asks the contacts holding the shares of the seed
+// phrase of the owner to release them to our identity
+func (m *Messenger) RequestSocialRecovery(ctx context.Context, owner string, holderIDs []string) error {
+	ownerPublicKey, err := socialRecoveryOwnerPublicKey(owner)
+	if err != nil {
+		return err
+	}
+
+	for _, holderID := range holderIDs {
+		holder, err := m.socialRecoveryContact(holderID)
+		if err != nil {
+			return err
+		}
+
+		chat, clock, err := m.contactVerificationChat(holder)
+		if err != nil {
+			return err
+		}
+
+		encodedMessage, err := proto.Marshal(&protobuf.SocialRecoveryRequest{
+			Clock:          clock,
+			OwnerPublicKey: ownerPublicKey,
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = m.dispatchMessage(ctx, common.RawMessage{
+			LocalChatID:         chat.ID,
+			Payload:             encodedMessage,
+			MessageType:         protobuf.ApplicationMetadataMessage_SOCIAL_RECOVERY_REQUEST,
+			ResendAutomatically: true,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = m.persistence.SaveSocialRecoverySentRequest(owner, holder.ID, clock)
+		if err != nil {
+			return err
+		}
+
+		chat.LastClockValue = clock
+		err = m.saveChat(chat)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PendingSocialRecoveryRequests returns the requests to release a share we
+// hold which haven't been answered yet
+func (m *Messenger) PendingSocialRecoveryRequests() ([]*SocialRecoveryRequest, error) {
+	return m.persistence.PendingSocialRecoveryRequests()
+}
+
+// ReleaseSocialRecoveryShare sends the share of the owner to the requester,
+// once the user checked out-of-band, with the owner, that the safety number
+// shared with the requester is the one of the new identity of the owner
+func (m *Messenger) ReleaseSocialRecoveryShare(ctx context.Context, requestID string, safetyNumber string) (*MessengerResponse, error) {
+	request, err := m.pendingSocialRecoveryRequest(requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	expectedSafetyNumber, err := m.GetSafetyNumber(request.Requester)
+	if err != nil {
+		return nil, err
+	}
+	if safetyNumber != expectedSafetyNumber {
+		return nil, ErrSocialRecoverySafetyNumberMismatch
+	}
+
+	share, err := m.persistence.SocialRecoveryShareByOwner(request.Owner)
+	if err != nil {
+		return nil, err
+	}
+	if share == nil {
+		return nil, ErrSocialRecoveryRequestNotFound
+	}
+
+	ownerPublicKey, err := socialRecoveryOwnerPublicKey(request.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	requester, err := m.socialRecoveryContact(request.Requester)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.sendSocialRecoveryShare(ctx, requester, &protobuf.SocialRecoveryShare{
+		Id:             share.ID,
+		Index:          share.Index,
+		Value:          share.Value,
+		Threshold:      share.Threshold,
+		Total:          share.Total,
+		Checksum:       share.Checksum,
+		OwnerPublicKey: ownerPublicKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	request.Status = SocialRecoveryRequestReleased
+	err = m.persistence.SaveSocialRecoveryRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.AddSocialRecoveryRequest(request)
+	return response, nil
+}
+
+// DeclineSocialRecoveryRequest refuses to release the share of the owner
+// to the requester
+func (m *Messenger) DeclineSocialRecoveryRequest(requestID string) (*MessengerResponse, error) {
+	request, err := m.pendingSocialRecoveryRequest(requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Status = SocialRecoveryRequestDeclined
+	err = m.persistence.SaveSocialRecoveryRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.AddSocialRecoveryRequest(request)
+	return response, nil
+}
+
+// CollectedSocialRecoveryShares returns the shares of the seed phrase of
+// the owner released to us so far
+func (m *Messenger) CollectedSocialRecoveryShares(owner string) ([]*SocialRecoveryShare, error) {
+	return m.persistence.CollectedSocialRecoveryShares(owner)
+}
+
+// RecoverSocialRecoveryMnemonic combines the shares released to us and
+// returns the seed phrase of the owner
+func (m *Messenger) RecoverSocialRecoveryMnemonic(owner string) (string, error) {
+	collected, err := m.persistence.CollectedSocialRecoveryShares(owner)
+	if err != nil {
+		return "", err
+	}
+
+	// The owner might have set up social recovery more than once, only
+	// shares of the same set can be combined
+	sets := make(map[string][]*SocialRecoveryShare)
+	for _, share := range collected {
+		sets[share.ID] = append(sets[share.ID], share)
+	}
+
+	for _, set := range sets {
+		if len(set) < int(set[0].Threshold) {
+			continue
+		}
+
+		var shares []extkeys.Share
+		for _, share := range set {
+			shares = append(shares, extkeys.Share{Index: byte(share.Index), Value: share.Value})
+		}
+
+		entropy, err := extkeys.CombineShares(shares)
+		if err != nil {
+			return "", err
+		}
+		if !bytes.Equal(socialRecoveryChecksum(entropy), set[0].Checksum) {
+			return "", ErrSocialRecoveryInvalidShare
+		}
+
+		return extkeys.NewMnemonic().EntropyToMnemonic(entropy, extkeys.EnglishLanguage)
+	}
+
+	return "", ErrSocialRecoveryNotEnoughShares
+}
+
+// DeleteCollectedSocialRecoveryShares removes the shares of the owner
+// released to us, once the account has been recovered
+func (m *Messenger) DeleteCollectedSocialRecoveryShares(owner string) error {
+	return m.persistence.DeleteCollectedSocialRecoveryShares(owner)
+}
+
+func (m *Messenger) pendingSocialRecoveryRequest(requestID string) (*SocialRecoveryRequest, error) {
+	request, err := m.persistence.SocialRecoveryRequestByID(requestID)
+	if err != nil {
+		return nil, err
+	}
+	if request == nil {
+		return nil, ErrSocialRecoveryRequestNotFound
+	}
+	if request.Status != SocialRecoveryRequestPending {
+		return nil, ErrSocialRecoveryRequestInvalidStatus
+	}
+	return request, nil
+}
+
+// socialRecoveryContact returns the contact with the given id. The holders
+// of the shares are not contacts of the new identity of the owner, and the
+// new identity is not a contact of the holders
+func (m *Messenger) socialRecoveryContact(contactID string) (*Contact, error) {
+	contact, ok := m.allContacts.Load(contactID)
+	if ok {
+		return contact, nil
+	}
+	return buildContactFromPkString(contactID)
+}
+
+func (m *Messenger) sendSocialRecoveryShare(ctx context.Context, contact *Contact, share *protobuf.SocialRecoveryShare) error {
+	chat, clock, err := m.contactVerificationChat(contact)
+	if err != nil {
+		return err
+	}
+
+	share.Clock = clock
+	encodedMessage, err := proto.Marshal(share)
+	if err != nil {
+		return err
+	}
+
+	_, err = m.dispatchMessage(ctx, common.RawMessage{
+		LocalChatID:         chat.ID,
+		Payload:             encodedMessage,
+		MessageType:         protobuf.ApplicationMetadataMessage_SOCIAL_RECOVERY_SHARE,
+		ResendAutomatically: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	chat.LastClockValue = clock
+	return m.saveChat(chat)
+}
+
+// deleteConfirmedSocialRecoveryShare removes the copy of a share kept to
+// resend it once the holder confirmed receiving it, so that the shares of
+// the seed phrase aren't left in the database
+func (m *Messenger) deleteConfirmedSocialRecoveryShare(messageID string) error {
+	rawMessage, err := m.persistence.RawMessageByID(messageID)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if rawMessage.MessageType != protobuf.ApplicationMetadataMessage_SOCIAL_RECOVERY_SHARE {
+		return nil
+	}
+	return m.persistence.DeleteRawMessage(messageID)
+}
+
+func socialRecoveryOwnerPublicKey(owner string) ([]byte, error) {
+	publicKeyBytes, err := types.DecodeHex(owner)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := crypto.UnmarshalPubkey(publicKeyBytes); err != nil {
+		return nil, err
+	}
+	return publicKeyBytes, nil
+}
+
+func validSocialRecoveryShare(message protobuf.SocialRecoveryShare) bool {
+	length := len(message.Value)
+	return message.Index > 0 && message.Index <= 255 &&
+		message.Threshold > 0 && message.Threshold <= message.Total && message.Total <= 255 &&
+		length >= 16 && length <= 32 && length%4 == 0 &&
+		len(message.Checksum) == socialRecoveryChecksumLength
+}
+
+func (m *Messenger) HandleSocialRecoveryShare(state *ReceivedMessageState, message protobuf.SocialRecoveryShare) error {
+	contact := state.CurrentMessageState.Contact
+	if contact.ID == contactIDFromPublicKey(&m.identity.PublicKey) {
+		// Sent from one of our devices
+		return nil
+	}
+
+	if !validSocialRecoveryShare(message) {
+		return ErrSocialRecoveryInvalidShare
+	}
+
+	share := &SocialRecoveryShare{
+		ID:        message.Id,
+		Index:     message.Index,
+		Value:     message.Value,
+		Threshold: message.Threshold,
+		Total:     message.Total,
+		Checksum:  message.Checksum,
+		Clock:     message.Clock,
+	}
+
+	if len(message.OwnerPublicKey) == 0 {
+		// A contact asks us to hold a share of their seed phrase
+		if !contact.IsAdded() {
+			return ErrMessageNotAllowed
+		}
+
+		existing, err := m.persistence.SocialRecoveryShareByOwner(contact.ID)
+		if err != nil {
+			return err
+		}
+		if existing != nil && existing.Clock >= message.Clock {
+			return nil
+		}
+
+		share.Owner = contact.ID
+		err = m.persistence.SaveSocialRecoveryShare(share)
+		if err != nil {
+			return err
+		}
+
+		state.Response.AddSocialRecoveryShare(share)
+		return nil
+	}
+
+	// A holder released the share of an owner, which we must have asked for
+	if _, err := crypto.UnmarshalPubkey(message.OwnerPublicKey); err != nil {
+		return err
+	}
+	owner := types.EncodeHex(message.OwnerPublicKey)
+	requested, err := m.persistence.HasSocialRecoverySentRequest(owner, contact.ID)
+	if err != nil {
+		return err
+	}
+	if !requested {
+		return ErrMessageNotAllowed
+	}
+
+	share.Owner = owner
+	share.Holder = contact.ID
+	err = m.persistence.SaveCollectedSocialRecoveryShare(share)
+	if err != nil {
+		return err
+	}
+
+	state.Response.AddSocialRecoveryShare(share)
+	return nil
+}
+
+func (m *Messenger) HandleSocialRecoveryRequest(state *ReceivedMessageState, message protobuf.SocialRecoveryRequest) error {
+	requester := state.CurrentMessageState.Contact
+	if requester.ID == contactIDFromPublicKey(&m.identity.PublicKey) {
+		return nil
+	}
+
+	if _, err := crypto.UnmarshalPubkey(message.OwnerPublicKey); err != nil {
+		return err
+	}
+	owner := types.EncodeHex(message.OwnerPublicKey)
+
+	share, err := m.persistence.SocialRecoveryShareByOwner(owner)
+	if err != nil {
+		return err
+	}
+	if share == nil {
+		// We don't hold a share for this owner
+		return nil
+	}
+
+	existing, err := m.persistence.SocialRecoveryRequestByID(state.CurrentMessageState.MessageID)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return nil
+	}
+
+	request := &SocialRecoveryRequest{
+		ID:        state.CurrentMessageState.MessageID,
+		Owner:     owner,
+		Requester: requester.ID,
+		Clock:     message.Clock,
+		Status:    SocialRecoveryRequestPending,
+	}
+
+	err = m.persistence.SaveSocialRecoveryRequest(request)
+	if err != nil {
+		return err
+	}
+
+	state.Response.AddSocialRecoveryRequest(request)
+	return nil
+}
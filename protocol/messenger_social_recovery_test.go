@@ -0,0 +1,168 @@
+package protocol
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	gethbridge "github.com/status-im/status-go/eth-node/bridge/geth"
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/tt"
+	"github.com/status-im/status-go/waku"
+)
+
+const testSocialRecoveryMnemonic = "legal winner thank year wave sausage worth useful legal winner thank yellow"
+
+func TestMessengerSocialRecoverySuite(t *testing.T) {
+	suite.Run(t, new(MessengerSocialRecoverySuite))
+}
+
+type MessengerSocialRecoverySuite struct {
+	suite.Suite
+	shh    types.Waku
+	logger *zap.Logger
+}
+
+func (s *MessengerSocialRecoverySuite) SetupTest() {
+	s.logger = tt.MustCreateTestLogger()
+
+	config := waku.DefaultConfig
+	config.MinimumAcceptedPoW = 0
+	shh := waku.New(&config, s.logger)
+	s.shh = gethbridge.NewGethWakuWrapper(shh)
+	s.Require().NoError(shh.Start())
+}
+
+func (s *MessengerSocialRecoverySuite) newMessenger() *Messenger {
+	privateKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	messenger, err := newMessengerWithKey(s.shh, privateKey, s.logger, nil)
+	s.Require().NoError(err)
+	_, err = messenger.Start()
+	s.Require().NoError(err)
+	return messenger
+}
+
+func (s *MessengerSocialRecoverySuite) addContact(m *Messenger, other *Messenger) {
+	contact, err := buildContactFromPkString(contactIDFromPublicKey(&other.identity.PublicKey))
+	s.Require().NoError(err)
+	contact.SystemTags = []string{contactAdded}
+	s.Require().NoError(m.SaveContact(contact))
+}
+
+func (s *MessengerSocialRecoverySuite) TestRecoverAccount() {
+	owner := s.newMessenger()
+	defer owner.Shutdown() // nolint: errcheck
+	ownerID := contactIDFromPublicKey(&owner.identity.PublicKey)
+
+	var holders []*Messenger
+	var holderIDs []string
+	for i := 0; i < 3; i++ {
+		holder := s.newMessenger()
+		defer holder.Shutdown() // nolint: errcheck
+		s.addContact(owner, holder)
+		s.addContact(holder, owner)
+		holders = append(holders, holder)
+		holderIDs = append(holderIDs, contactIDFromPublicKey(&holder.identity.PublicKey))
+	}
+
+	_, err := owner.SetupSocialRecovery(context.Background(), testSocialRecoveryMnemonic, 2, holderIDs)
+	s.Require().NoError(err)
+
+	for _, holder := range holders {
+		response, err := WaitOnMessengerResponse(
+			holder,
+			func(r *MessengerResponse) bool { return len(r.SocialRecoveryShares()) > 0 },
+			"share not received",
+		)
+		s.Require().NoError(err)
+		s.Require().Equal(ownerID, response.SocialRecoveryShares()[0].Owner)
+	}
+
+	// The owner lost their device and asks two of the holders from a new identity
+	newIdentity := s.newMessenger()
+	defer newIdentity.Shutdown() // nolint: errcheck
+	newIdentityID := contactIDFromPublicKey(&newIdentity.identity.PublicKey)
+	s.Require().NoError(newIdentity.RequestSocialRecovery(context.Background(), ownerID, holderIDs[:2]))
+
+	for i, holder := range holders[:2] {
+		response, err := WaitOnMessengerResponse(
+			holder,
+			func(r *MessengerResponse) bool { return len(r.SocialRecoveryRequests()) > 0 },
+			"request not received",
+		)
+		s.Require().NoError(err)
+		request := response.SocialRecoveryRequests()[0]
+		s.Require().Equal(ownerID, request.Owner)
+		s.Require().Equal(newIdentityID, request.Requester)
+
+		_, err = holder.ReleaseSocialRecoveryShare(context.Background(), request.ID, "wrong")
+		s.Require().Equal(ErrSocialRecoverySafetyNumberMismatch, err)
+
+		// The holder compares the safety number with the owner out-of-band
+		safetyNumber, err := newIdentity.GetSafetyNumber(holderIDs[i])
+		s.Require().NoError(err)
+		_, err = holder.ReleaseSocialRecoveryShare(context.Background(), request.ID, safetyNumber)
+		s.Require().NoError(err)
+
+		_, err = WaitOnMessengerResponse(
+			newIdentity,
+			func(r *MessengerResponse) bool { return len(r.SocialRecoveryShares()) > 0 },
+			"share not released",
+		)
+		s.Require().NoError(err)
+
+		if i == 0 {
+			_, err = newIdentity.RecoverSocialRecoveryMnemonic(ownerID)
+			s.Require().Equal(ErrSocialRecoveryNotEnoughShares, err)
+		}
+	}
+
+	mnemonic, err := newIdentity.RecoverSocialRecoveryMnemonic(ownerID)
+	s.Require().NoError(err)
+	s.Require().Equal(testSocialRecoveryMnemonic, mnemonic)
+
+	s.Require().NoError(newIdentity.DeleteCollectedSocialRecoveryShares(ownerID))
+	shares, err := newIdentity.CollectedSocialRecoveryShares(ownerID)
+	s.Require().NoError(err)
+	s.Require().Empty(shares)
+}
+
+func (s *MessengerSocialRecoverySuite) TestConfirmedSharesDeleted() {
+	owner := s.newMessenger()
+	defer owner.Shutdown() // nolint: errcheck
+	holder := s.newMessenger()
+	defer holder.Shutdown() // nolint: errcheck
+	s.addContact(owner, holder)
+	s.addContact(holder, owner)
+
+	_, err := owner.SetupSocialRecovery(context.Background(), testSocialRecoveryMnemonic, 1, []string{contactIDFromPublicKey(&holder.identity.PublicKey)})
+	s.Require().NoError(err)
+
+	// A copy of the share is kept until the holder confirms receiving it
+	sent, err := owner.persistence.RawMessagesIDsByType(protobuf.ApplicationMetadataMessage_SOCIAL_RECOVERY_SHARE)
+	s.Require().NoError(err)
+	s.Require().Len(sent, 1)
+
+	_, err = WaitOnMessengerResponse(
+		holder,
+		func(r *MessengerResponse) bool { return len(r.SocialRecoveryShares()) > 0 },
+		"share not received",
+	)
+	s.Require().NoError(err)
+
+	_, err = WaitOnMessengerResponse(
+		owner,
+		func(r *MessengerResponse) bool {
+			sent, err := owner.persistence.RawMessagesIDsByType(protobuf.ApplicationMetadataMessage_SOCIAL_RECOVERY_SHARE)
+			return err == nil && len(sent) == 0
+		},
+		"share not deleted",
+	)
+	s.Require().NoError(err)
+}
@@ -0,0 +1,400 @@
+package protocol
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/communities"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+	"github.com/status-im/status-go/protocol/spam"
+)
+
+var ErrSpamBlocklistNotFollowed = errors.New("spam blocklist not followed")
+var ErrSpamBlocklistTooLarge = errors.New("spam blocklist too large")
+
+// How often we publish again our blocklists, so that they remain available
+// to new followers
+const spamBlocklistsPublishInterval = 24 * time.Hour
+
+// maxSpamBlocklistSize is the number of senders a blocklist can hold,
+// a full one is about 350KB once published
+const maxSpamBlocklistSize = 10000
+
+// loadSpamFilter sets up the spam filter from the stored rules and
+// blocklists, and listens to the blocklists we follow
+func (m *Messenger) loadSpamFilter() error {
+	rules, err := m.persistence.SpamFilterRules()
+	if err != nil {
+		return err
+	}
+	if rules == nil {
+		rules = &spam.Rules{}
+	}
+
+	filter, err := spam.NewFilter(*rules)
+	if err != nil {
+		return err
+	}
+
+	blocklists, err := m.persistence.SpamBlocklists()
+	if err != nil {
+		return err
+	}
+	filter.SetBlocked(spam.Blocked(blocklists))
+
+	var chatIDs []string
+	for _, blocklist := range blocklists {
+		if !blocklist.Own {
+			chatIDs = append(chatIDs, spam.BlocklistChatID(blocklist.Publisher))
+		}
+	}
+	if len(chatIDs) != 0 {
+		_, err = m.transport.InitPublicFilters(chatIDs)
+		if err != nil {
+			return err
+		}
+	}
+
+	m.spamFilter = filter
+	return nil
+}
+
+func (m *Messenger) reloadSpamBlocklists() error {
+	blocklists, err := m.persistence.SpamBlocklists()
+	if err != nil {
+		return err
+	}
+	m.spamFilter.SetBlocked(spam.Blocked(blocklists))
+	return nil
+}
+
+func (m *Messenger) SpamFilterRules() spam.Rules {
+	return m.spamFilter.Rules()
+}
+
+func (m *Messenger) SetSpamFilterRules(rules spam.Rules) error {
+	err := m.spamFilter.SetRules(rules)
+	if err != nil {
+		return err
+	}
+	return m.persistence.SaveSpamFilterRules(rules)
+}
+
+// isSpam applies the spam filter to messages received in public chats.
+// Our own messages and the ones of our contacts are not filtered.
+func (m *Messenger) isSpam(chat *Chat, message *common.Message, contact *Contact) (bool, spam.Reason, error) {
+	if m.spamFilter == nil || !chat.Public() {
+		return false, spam.ReasonNone, nil
+	}
+
+	if common.IsPubKeyEqual(message.SigPubKey, &m.identity.PublicKey) || contact.IsAdded() {
+		return false, spam.ReasonNone, nil
+	}
+
+	firstSeen, err := m.persistence.SpamSenderFirstSeen(message.From, message.WhisperTimestamp)
+	if err != nil {
+		return false, spam.ReasonNone, err
+	}
+
+	filtered, reason := m.spamFilter.Check(&spam.Message{
+		ChatID:    chat.ID,
+		From:      message.From,
+		Text:      message.Text,
+		Timestamp: message.WhisperTimestamp,
+		FirstSeen: firstSeen,
+	})
+	return filtered, reason, nil
+}
+
+// handleFilteredMessage stores a message caught by the spam filter as
+// hidden, and adds it to the activity center so that it can be reviewed
+func (m *Messenger) handleFilteredMessage(state *ReceivedMessageState, chat *Chat, message *common.Message, reason spam.Reason) error {
+	m.logger.Debug("message filtered as spam", zap.String("message-id", message.ID), zap.String("reason", string(reason)))
+
+	message.LocalChatID = chat.ID
+	err := m.persistence.SaveMessages([]*common.Message{message})
+	if err != nil {
+		return err
+	}
+
+	err = m.persistence.HideMessage(message.ID)
+	if err != nil {
+		return err
+	}
+
+	notification := &ActivityCenterNotification{
+		ID:        types.FromHex(message.ID),
+		Name:      chat.Name,
+		Message:   message,
+		Type:      ActivityCenterNotificationTypeFilteredMessage,
+		Author:    message.From,
+		Timestamp: m.getTimesource().GetCurrentTime(),
+		ChatID:    chat.ID,
+	}
+
+	return m.addActivityCenterNotification(state, notification)
+}
+
+// normalizeSpamBlocklistPublisher accepts the key of a user or the ID of a
+// community, which is a compressed key
+func normalizeSpamBlocklistPublisher(publisher string) (string, error) {
+	publicKeyBytes, err := types.DecodeHex(publisher)
+	if err != nil {
+		return "", err
+	}
+
+	var publicKey *ecdsa.PublicKey
+	if len(publicKeyBytes) == 33 {
+		publicKey, err = crypto.DecompressPubkey(publicKeyBytes)
+	} else {
+		publicKey, err = crypto.UnmarshalPubkey(publicKeyBytes)
+	}
+	if err != nil {
+		return "", err
+	}
+	return common.PubkeyToHex(publicKey), nil
+}
+
+// FollowSpamBlocklist filters the senders blocked by publisher, who can be
+// a user or a community
+func (m *Messenger) FollowSpamBlocklist(publisher string) error {
+	publisher, err := normalizeSpamBlocklistPublisher(publisher)
+	if err != nil {
+		return err
+	}
+
+	existing, err := m.persistence.SpamBlocklist(publisher)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		err = m.persistence.SaveSpamBlocklist(&spam.Blocklist{Publisher: publisher})
+		if err != nil {
+			return err
+		}
+	}
+
+	filters, err := m.transport.InitPublicFilters([]string{spam.BlocklistChatID(publisher)})
+	if err != nil {
+		return err
+	}
+
+	// Fetch the list which has already been published
+	_, err = m.scheduleSyncFilters(filters)
+	return err
+}
+
+func (m *Messenger) UnfollowSpamBlocklist(publisher string) error {
+	publisher, err := normalizeSpamBlocklistPublisher(publisher)
+	if err != nil {
+		return err
+	}
+
+	existing, err := m.persistence.SpamBlocklist(publisher)
+	if err != nil {
+		return err
+	}
+	if existing == nil || existing.Own {
+		return ErrSpamBlocklistNotFollowed
+	}
+
+	err = m.persistence.DeleteSpamBlocklist(publisher)
+	if err != nil {
+		return err
+	}
+
+	_, err = m.transport.RemoveFilterByChatID(spam.BlocklistChatID(publisher))
+	if err != nil {
+		return err
+	}
+
+	return m.reloadSpamBlocklists()
+}
+
+func (m *Messenger) SpamBlocklists() ([]*spam.Blocklist, error) {
+	return m.persistence.SpamBlocklists()
+}
+
+// PublishSpamBlocklist publishes the senders we block, as ourselves or on
+// behalf of a community we own, for others to follow
+func (m *Messenger) PublishSpamBlocklist(request *requests.PublishSpamBlocklist) error {
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	if len(request.PublicKeys) > maxSpamBlocklistSize {
+		return ErrSpamBlocklistTooLarge
+	}
+
+	key := m.identity
+	var communityID string
+	if len(request.CommunityID) != 0 {
+		community, err := m.communitiesManager.GetByID(request.CommunityID)
+		if err != nil {
+			return err
+		}
+		if community == nil || !community.IsAdmin() {
+			return communities.ErrNotAdmin
+		}
+		key = community.PrivateKey()
+		communityID = community.IDString()
+	}
+
+	blocklist := &spam.Blocklist{
+		Publisher:   common.PubkeyToHex(&key.PublicKey),
+		Own:         true,
+		CommunityID: communityID,
+	}
+	for _, pk := range request.PublicKeys {
+		publicKey, err := common.HexToPubkey(pk.String())
+		if err != nil {
+			return err
+		}
+		blocklist.PublicKeys = append(blocklist.PublicKeys, common.PubkeyToHex(publicKey))
+	}
+
+	existing, err := m.persistence.SpamBlocklist(blocklist.Publisher)
+	if err != nil {
+		return err
+	}
+	blocklist.Clock = m.getTimesource().GetCurrentTime()
+	if existing != nil && existing.Clock >= blocklist.Clock {
+		blocklist.Clock = existing.Clock + 1
+	}
+
+	err = m.persistence.SaveSpamBlocklist(blocklist)
+	if err != nil {
+		return err
+	}
+
+	err = m.reloadSpamBlocklists()
+	if err != nil {
+		return err
+	}
+
+	return m.publishSpamBlocklist(key, blocklist)
+}
+
+func (m *Messenger) publishSpamBlocklist(key *ecdsa.PrivateKey, blocklist *spam.Blocklist) error {
+	message := &protobuf.SpamBlocklist{Clock: blocklist.Clock}
+	for _, pk := range blocklist.PublicKeys {
+		publicKey, err := common.HexToPubkey(pk)
+		if err != nil {
+			return err
+		}
+		message.PublicKeys = append(message.PublicKeys, crypto.CompressPubkey(publicKey))
+	}
+
+	payload, err := proto.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	rawMessage := common.RawMessage{
+		Payload:        payload,
+		Sender:         key,
+		SkipEncryption: true,
+		MessageType:    protobuf.ApplicationMetadataMessage_SPAM_BLOCKLIST,
+	}
+	_, err = m.sender.SendPublic(context.Background(), spam.BlocklistChatID(blocklist.Publisher), rawMessage)
+	return err
+}
+
+// watchSpamBlocklists periodically publishes again the blocklists we own
+func (m *Messenger) watchSpamBlocklists() {
+	ticker := time.NewTicker(spamBlocklistsPublishInterval)
+
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				m.publishSpamBlocklists()
+			case <-m.quit:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+}
+
+func (m *Messenger) publishSpamBlocklists() {
+	blocklists, err := m.persistence.SpamBlocklists()
+	if err != nil {
+		m.logger.Warn("failed to retrieve spam blocklists", zap.Error(err))
+		return
+	}
+
+	for _, blocklist := range blocklists {
+		if !blocklist.Own {
+			continue
+		}
+
+		key := m.identity
+		if len(blocklist.CommunityID) != 0 {
+			communityID, err := types.DecodeHex(blocklist.CommunityID)
+			if err != nil {
+				continue
+			}
+			community, err := m.communitiesManager.GetByID(communityID)
+			if err != nil || community == nil || !community.IsAdmin() {
+				continue
+			}
+			key = community.PrivateKey()
+		}
+
+		// The list is published with the key which signed it
+		if common.PubkeyToHex(&key.PublicKey) != blocklist.Publisher {
+			continue
+		}
+
+		err := m.publishSpamBlocklist(key, blocklist)
+		if err != nil {
+			m.logger.Warn("failed to publish spam blocklist", zap.Error(err))
+		}
+	}
+}
+
+// HandleSpamBlocklist updates a blocklist we follow
+func (m *Messenger) HandleSpamBlocklist(state *ReceivedMessageState, signer *ecdsa.PublicKey, message protobuf.SpamBlocklist) error {
+	publisher := common.PubkeyToHex(signer)
+
+	existing, err := m.persistence.SpamBlocklist(publisher)
+	if err != nil {
+		return err
+	}
+	if existing == nil || existing.Own || existing.Clock >= message.Clock {
+		return nil
+	}
+
+	if len(message.PublicKeys) > maxSpamBlocklistSize {
+		return ErrSpamBlocklistTooLarge
+	}
+
+	blocklist := &spam.Blocklist{
+		Publisher: publisher,
+		Clock:     message.Clock,
+	}
+	for _, pk := range message.PublicKeys {
+		publicKey, err := crypto.DecompressPubkey(pk)
+		if err != nil {
+			return err
+		}
+		blocklist.PublicKeys = append(blocklist.PublicKeys, common.PubkeyToHex(publicKey))
+	}
+
+	err = m.persistence.SaveSpamBlocklist(blocklist)
+	if err != nil {
+		return err
+	}
+
+	return m.reloadSpamBlocklists()
+}
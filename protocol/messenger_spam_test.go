@@ -0,0 +1,89 @@
+package protocol
+
+import (
+	"crypto/ecdsa"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	gethbridge "github.com/status-im/status-go/eth-node/bridge/geth"
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/requests"
+	"github.com/status-im/status-go/protocol/tt"
+	"github.com/status-im/status-go/waku"
+)
+
+func TestMessengerSpamSuite(t *testing.T) {
+	suite.Run(t, new(MessengerSpamSuite))
+}
+
+type MessengerSpamSuite struct {
+	suite.Suite
+	shh    types.Waku
+	logger *zap.Logger
+}
+
+func (s *MessengerSpamSuite) SetupTest() {
+	s.logger = tt.MustCreateTestLogger()
+
+	config := waku.DefaultConfig
+	config.MinimumAcceptedPoW = 0
+	shh := waku.New(&config, s.logger)
+	s.shh = gethbridge.NewGethWakuWrapper(shh)
+	s.Require().NoError(shh.Start())
+}
+
+func (s *MessengerSpamSuite) newMessenger() *Messenger {
+	privateKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	messenger, err := newMessengerWithKey(s.shh, privateKey, s.logger, nil)
+	s.Require().NoError(err)
+	_, err = messenger.Start()
+	s.Require().NoError(err)
+	return messenger
+}
+
+func (s *MessengerSpamSuite) TestPublishFullBlocklist() {
+	publisher := s.newMessenger()
+	defer publisher.Shutdown() // nolint: errcheck
+	follower := s.newMessenger()
+	defer follower.Shutdown() // nolint: errcheck
+
+	publisherID := common.PubkeyToHex(&publisher.identity.PublicKey)
+	s.Require().NoError(follower.FollowSpamBlocklist(publisherID))
+
+	var blocked []*ecdsa.PublicKey
+	request := &requests.PublishSpamBlocklist{}
+	for i := 0; i < maxSpamBlocklistSize; i++ {
+		key, err := crypto.GenerateKey()
+		s.Require().NoError(err)
+		blocked = append(blocked, &key.PublicKey)
+		request.PublicKeys = append(request.PublicKeys, crypto.FromECDSAPub(&key.PublicKey))
+	}
+
+	// Lists can't be larger than what fits in a message
+	tooLarge := &requests.PublishSpamBlocklist{PublicKeys: append(request.PublicKeys, request.PublicKeys[0])}
+	s.Require().Equal(ErrSpamBlocklistTooLarge, publisher.PublishSpamBlocklist(tooLarge))
+
+	s.Require().NoError(publisher.PublishSpamBlocklist(request))
+
+	_, err := WaitOnMessengerResponse(
+		follower,
+		func(r *MessengerResponse) bool {
+			blocklist, err := follower.persistence.SpamBlocklist(publisherID)
+			return err == nil && blocklist != nil && len(blocklist.PublicKeys) != 0
+		},
+		"blocklist not received",
+	)
+	s.Require().NoError(err)
+
+	blocklist, err := follower.persistence.SpamBlocklist(publisherID)
+	s.Require().NoError(err)
+	s.Require().Len(blocklist.PublicKeys, maxSpamBlocklistSize)
+	s.Require().Equal(common.PubkeyToHex(blocked[0]), blocklist.PublicKeys[0])
+	s.Require().Equal(common.PubkeyToHex(blocked[maxSpamBlocklistSize-1]), blocklist.PublicKeys[maxSpamBlocklistSize-1])
+}
@@ -41,6 +41,18 @@
 // 1624978434_add_muted_community.up.sql (82B)
 // 1625018910_add_repply_message_activity_center_notification_field.up.sql (86B)
 // 1625762506_add_deleted_messages.up.sql (357B)
+// 1627917060_add_raw_messages_queue.up.sql (404B)
+// 1628072503_add_contact_verification.up.sql (496B)
+// 1628158903_add_custom_emoji_reactions.up.sql (354B)
+// 1628245318_add_polls.up.sql (332B)
+// 1628265401_add_link_previews.up.sql (57B)
+// 1628265402_add_file_attachments.up.sql (56B)
+// 1628265403_add_community_message_archives.up.sql (966B)
+// 1628265404_add_community_control_signature_requests.up.sql (174B)
+// 1628265405_add_communities_directory.up.sql (597B)
+// 1628265406_add_spam_filter.up.sql (499B)
+// 1628265411_add_social_recovery.up.sql (1.241kB)
+// 1628265412_add_safe_transactions.up.sql (630B)
 // README.md (554B)
 // doc.go (850B)
 
@@ -931,6 +943,246 @@ func _1625762506_add_deleted_messagesUpSql() (*asset, error) {
 	return a, nil
 }
 
+var __1627917060_add_raw_messages_queueUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x90\x41\x4f\x03\x21\x14\x84\xef\xfc\x8a\x39\x76\x13\x0f\xde\xf7\x84\xf4\x6d\x24\x22\x34\x94\x9a\xf6\x44\x08\x12\x35\xa5\xae\x0a\x8d\xfe\x7c\x43\x5c\xa3\x4d\xb6\xe9\x11\xe6\x9b\x79\x6f\x9e\xb0\xc4\x1d\xc1\xf1\x1b\x45\x90\x03\xb4\x71\xa0\xad\x5c\xbb\x35\x3e\xc2\xa7\x3f\xa4\x52\xc2\x53\x2a\xfe\xfd\x98\x8e\x09\x0b\x06\xbc\x3c\xe2\x81\x5b\x71\xcb\x2d\x56\x56\xde\x73\xbb\xc3\x1d\xed\x60\x34\x84\xd1\x83\x92\xc2\xc1\xd2\x4a\x71\x41\x57\x0c\x98\x02\xfc\x3f\x57\x1b\xa1\x37\x4a\x35\x39\x8f\x31\x64\x1f\x9f\x43\x3d\x47\xc4\x3c\xc6\x3d\xa4\x76\x27\xbf\xa1\xd6\x74\x78\xab\xe5\x44\xc0\x92\x06\xbe\x51\x0e\xd7\xcd\xf8\x9a\xbe\xaa\x9f\xb8\x33\x18\xeb\x7a\xc6\xa6\x0b\x48\xbd\xa4\xed\x4c\xe7\xdf\x67\xdb\xcf\xe8\x19\x60\xf1\x07\x74\xfd\xc5\xb4\x9f\x3a\xf3\x41\x31\x8f\x71\xdf\xf5\xec\x7b\x00\xc8\xb0\xf0\x62\x94\x01\x00\x00")
+
+func _1627917060_add_raw_messages_queueUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1627917060_add_raw_messages_queueUpSql,
+		"1627917060_add_raw_messages_queue.up.sql",
+	)
+}
+
+func _1627917060_add_raw_messages_queueUpSql() (*asset, error) {
+	bytes, err := _1627917060_add_raw_messages_queueUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1627917060_add_raw_messages_queue.up.sql", size: 404, mode: os.FileMode(0644), modTime: time.Unix(1792391239, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x27, 0xdd, 0x38, 0xef, 0xa, 0x35, 0x6, 0x2b, 0x56, 0x23, 0x7, 0xdb, 0xc, 0x3e, 0xfa, 0x7f, 0x1d, 0xdd, 0xd, 0x3d, 0x93, 0x93, 0xd3, 0x49, 0xa, 0x34, 0x8e, 0x51, 0x86, 0x9, 0x37, 0xf3}}
+	return a, nil
+}
+
+var __1628072503_add_contact_verificationUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x90\x31\x6f\xc2\x30\x10\x46\x77\xff\x8a\x6f\x03\x24\x86\xee\x4c\xae\x63\x54\xab\xc6\x41\xc6\x54\x30\x59\x51\x30\x6d\x24\x1a\x53\xfb\xd2\xdf\x5f\xa5\x85\xa0\x8a\xb4\xf3\x7b\x77\xba\x7b\x5c\x3b\x69\xe1\xf8\xa3\x96\xa8\x63\x4b\x55\x4d\x19\xbc\x28\x20\x4a\xbd\x5d\x19\x50\xea\x32\xf9\x4c\x15\x75\x19\xca\x38\x98\xd2\xc1\x6c\xb5\x46\x21\x97\x7c\xab\x1d\x1e\x16\x8c\x09\x2b\xb9\x93\x97\x35\x6a\xf9\x2d\xc9\x9d\xda\xb8\x0d\x3e\x43\x6a\x8e\x4d\x5d\x51\x13\x5b\x9f\xc2\x47\x17\x32\x65\x4c\x19\xd0\x1c\xf0\xc2\xad\x78\xe2\x16\x6b\xab\x56\xdc\xee\xf1\x2c\xf7\x28\x0d\x44\x69\x96\x5a\x09\x07\x2b\xd7\x9a\x0b\x39\x67\xc0\x31\xc5\x77\xdf\xe5\x90\x86\xa1\xeb\x25\x3d\xa5\xf8\x37\xab\xdf\xaa\xd3\x29\xb4\xaf\x61\x94\xa6\x90\xcf\xb1\xcd\xf7\x70\x78\x70\x32\xe9\xb7\x5c\x4e\x0f\x07\x5f\xd1\xaf\x10\x3f\xf0\x7c\x6a\xee\xd1\xad\x51\x2f\xfd\x1b\x91\xcd\x6e\x19\x95\x29\xe4\x6e\x3c\x9c\x1f\x32\xf8\xeb\xcb\xa5\x19\x57\xa7\x83\x3a\x07\x45\xdf\xe5\x90\x66\x0b\xf6\x35\x00\x22\x6b\x89\x27\xf0\x01\x00\x00")
+
+func _1628072503_add_contact_verificationUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1628072503_add_contact_verificationUpSql,
+		"1628072503_add_contact_verification.up.sql",
+	)
+}
+
+func _1628072503_add_contact_verificationUpSql() (*asset, error) {
+	bytes, err := _1628072503_add_contact_verificationUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1628072503_add_contact_verification.up.sql", size: 496, mode: os.FileMode(0644), modTime: time.Unix(1792391546, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x1a, 0x67, 0x36, 0xbc, 0x6d, 0x5d, 0x15, 0xd4, 0xc5, 0xc6, 0xd9, 0xdc, 0x25, 0xb0, 0x7c, 0xe, 0x28, 0x67, 0x95, 0x6b, 0xce, 0x62, 0x1a, 0x2e, 0x87, 0x7a, 0x3, 0x30, 0xae, 0x98, 0xb7, 0x2f}}
+	return a, nil
+}
+
+var __1628158903_add_custom_emoji_reactionsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x72\xf4\x09\x71\x0d\x52\x08\x71\x74\xf2\x71\x55\x48\xcd\xcd\xcf\xca\x8c\x2f\x4a\x4d\x4c\x2e\xc9\xcc\xcf\x2b\x56\x70\x74\x71\x51\x70\xf6\xf7\x09\xf5\xf5\x83\x48\x29\x84\x39\x06\x39\x7b\x38\x06\x29\xf8\xf9\x87\x28\xf8\x85\xfa\xf8\x28\xb8\xb8\xba\x39\x86\xfa\x84\x28\xa8\xab\x5b\x73\x11\x69\x52\x72\x7e\x6e\x6e\x69\x5e\x66\x49\x65\x3c\x44\x51\x66\x0a\x7e\x63\xb9\x42\x03\x5c\x1c\x43\x30\x8d\x0c\x76\x0d\x81\xba\xca\x56\xc1\xd9\x31\x18\xa6\x20\x33\x85\x4b\x41\x21\xdc\xc3\xd5\x4f\xc1\x50\x21\x04\x44\xa9\x3f\x9a\xbb\xe4\xfd\x8e\x7e\x75\x98\xb0\x11\x54\xf8\xc3\xfc\x89\xbd\x70\x41\x63\x84\x60\x1f\x5c\xd0\x04\x2e\x38\xa3\x09\x2e\x68\x8a\x10\x5c\x04\x17\x34\x43\x08\x2e\x04\x09\xba\xfa\x04\xbb\x2a\xa8\xab\x73\xb9\xfa\xb9\x58\x73\x01\x06\x00\x84\x34\x92\x3e\x62\x01\x00\x00")
+
+func _1628158903_add_custom_emoji_reactionsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1628158903_add_custom_emoji_reactionsUpSql,
+		"1628158903_add_custom_emoji_reactions.up.sql",
+	)
+}
+
+func _1628158903_add_custom_emoji_reactionsUpSql() (*asset, error) {
+	bytes, err := _1628158903_add_custom_emoji_reactionsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1628158903_add_custom_emoji_reactions.up.sql", size: 354, mode: os.FileMode(0644), modTime: time.Unix(1792392574, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xa8, 0x8a, 0xe3, 0x39, 0x96, 0x40, 0x52, 0x67, 0x98, 0xf4, 0x51, 0xa1, 0x19, 0xc1, 0xf5, 0x9f, 0x5c, 0xb9, 0x80, 0x51, 0x31, 0x37, 0x5, 0x25, 0x26, 0x86, 0xab, 0xef, 0x86, 0x44, 0xe1, 0x74}}
+	return a, nil
+}
+
+var __1628245318_add_pollsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x8e\x41\x6a\xc3\x30\x14\x44\xf7\x3a\xc5\xec\x92\x40\x6e\x90\x95\x2c\x7f\x53\xd3\x1f\x29\x28\x72\x69\x56\x46\x38\xa2\x35\x95\x2b\x13\xa9\x85\xde\xbe\xa4\x09\x94\x92\x66\x3b\xf3\xe6\x31\x92\x1d\x59\x38\x59\x31\xe1\x23\x87\x53\x3f\x85\x9c\xfd\x4b\xc8\x90\x75\x0d\x65\xb8\xdb\x6a\xcc\x29\xc6\x7e\xf6\x5f\x31\xf9\x23\x2a\x36\xd5\x46\x08\x65\x49\x3a\xba\x2e\xdb\x06\xda\x38\xd0\x73\xbb\x77\xfb\x0b\xfe\x99\x4a\xc8\x58\x0a\xe0\x6a\xec\xc7\x23\x9e\xa4\x55\x0f\xd2\xfe\xc0\xba\x63\x5e\x0b\xe0\x0c\x9e\xfe\x6d\x62\x1a\x7c\xec\x87\x57\x5f\xee\x6d\x87\x98\x86\x37\xb4\xda\xfd\x49\xcb\x38\x85\x5c\xfc\x34\xdf\x34\x69\x2e\x63\x7a\xcf\x37\x2e\xd4\xd4\xc8\x8e\x1d\x16\x8b\xb3\x76\x67\xdb\xad\xb4\x07\x3c\xd2\x01\xcb\xdf\xfb\xeb\xcb\xd7\x15\x8c\x86\x32\xba\xe1\x56\x39\x58\xda\xb1\x54\x24\x56\x1b\xf1\x3d\x00\x5f\xac\x39\xd8\x4c\x01\x00\x00")
+
+func _1628245318_add_pollsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1628245318_add_pollsUpSql,
+		"1628245318_add_polls.up.sql",
+	)
+}
+
+func _1628245318_add_pollsUpSql() (*asset, error) {
+	bytes, err := _1628245318_add_pollsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1628245318_add_polls.up.sql", size: 332, mode: os.FileMode(0644), modTime: time.Unix(1792392917, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x91, 0x8, 0xd5, 0x70, 0x64, 0x19, 0x94, 0x29, 0xbf, 0x41, 0xf1, 0x85, 0x48, 0x5f, 0xce, 0x46, 0x29, 0xe4, 0xe9, 0xc7, 0xf, 0xc4, 0x84, 0xa9, 0xb4, 0x35, 0x6c, 0x14, 0xc, 0x81, 0xe3, 0xa3}}
+	return a, nil
+}
+
+var __1628265401_add_link_previewsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x39\x00\xc6\xff\x41\x4c\x54\x45\x52\x20\x54\x41\x42\x4c\x45\x20\x75\x73\x65\x72\x5f\x6d\x65\x73\x73\x61\x67\x65\x73\x20\x41\x44\x44\x20\x43\x4f\x4c\x55\x4d\x4e\x20\x6c\x69\x6e\x6b\x5f\x70\x72\x65\x76\x69\x65\x77\x73\x20\x42\x4c\x4f\x42\x3b\x0a\x03\x00\x2f\xae\x96\x4c\x39\x00\x00\x00")
+
+func _1628265401_add_link_previewsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1628265401_add_link_previewsUpSql,
+		"1628265401_add_link_previews.up.sql",
+	)
+}
+
+func _1628265401_add_link_previewsUpSql() (*asset, error) {
+	bytes, err := _1628265401_add_link_previewsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1628265401_add_link_previews.up.sql", size: 57, mode: os.FileMode(0644), modTime: time.Unix(1792393167, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x84, 0xa0, 0x96, 0xe6, 0xeb, 0x91, 0xe1, 0xbb, 0x75, 0xd, 0x9, 0x4c, 0x59, 0x84, 0x9f, 0xd2, 0xd5, 0xf4, 0x14, 0x87, 0x49, 0x4d, 0xf0, 0x33, 0xd8, 0x1c, 0xeb, 0xd9, 0xad, 0x4, 0xbf, 0xa4}}
+	return a, nil
+}
+
+var __1628265402_add_file_attachmentsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x38\x00\xc7\xff\x41\x4c\x54\x45\x52\x20\x54\x41\x42\x4c\x45\x20\x75\x73\x65\x72\x5f\x6d\x65\x73\x73\x61\x67\x65\x73\x20\x41\x44\x44\x20\x43\x4f\x4c\x55\x4d\x4e\x20\x66\x69\x6c\x65\x5f\x70\x61\x79\x6c\x6f\x61\x64\x20\x42\x4c\x4f\x42\x3b\x0a\x03\x00\x92\x80\x40\xca\x38\x00\x00\x00")
+
+func _1628265402_add_file_attachmentsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1628265402_add_file_attachmentsUpSql,
+		"1628265402_add_file_attachments.up.sql",
+	)
+}
+
+func _1628265402_add_file_attachmentsUpSql() (*asset, error) {
+	bytes, err := _1628265402_add_file_attachmentsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1628265402_add_file_attachments.up.sql", size: 56, mode: os.FileMode(0644), modTime: time.Unix(1792393347, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x86, 0xf4, 0x7e, 0x69, 0xd4, 0x18, 0xdb, 0x7d, 0xec, 0x99, 0x37, 0xa6, 0x38, 0x62, 0x57, 0x82, 0x73, 0x21, 0x1c, 0x88, 0xd7, 0x1d, 0xd6, 0x62, 0x46, 0xae, 0xcb, 0xce, 0x6f, 0x3c, 0x6b, 0x9e}}
+	return a, nil
+}
+
+var __1628265403_add_community_message_archivesUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x91\xc1\x6e\xf2\x30\x10\x84\xef\x7e\x8a\x3d\x82\xc4\x1b\x70\x72\x82\xf3\xff\x56\x5d\x1b\x19\x53\xc1\xc9\xb2\x88\x4b\x2c\xd5\x31\x8d\x1d\xa4\xbe\x7d\x05\x85\x40\x8a\x8a\x42\x0f\x3d\x7f\xbb\xb3\xb3\x33\xb9\x24\x58\x11\x50\x38\x63\x04\x68\x01\x5c\x28\x20\x2b\xba\x50\x0b\xd8\x04\xef\xdb\xda\xa5\x0f\xed\x6d\x8c\x66\x6b\xb5\x69\x36\x95\xdb\x5b\x6d\xeb\xbd\x7d\x0b\x3b\x1b\x61\x84\x00\x2a\x13\x2b\xc8\x98\xc8\x60\x2e\xe9\x33\x96\x6b\x78\x22\x6b\x10\x1c\x72\xc1\x0b\x46\x73\x05\xf4\x1f\x17\x92\x4c\x10\x5c\x69\xba\xf2\x6b\xe7\x70\x90\x2f\x19\x3b\xd2\xca\x24\xed\x4a\x78\xc1\x32\xff\x8f\x65\x8f\x25\xe7\x6d\x4c\xc6\xef\x80\x72\xd5\x23\x67\x37\x7d\x3d\x34\x9e\x22\x74\xfa\x8e\xf2\x19\x59\x0d\xf9\x47\x1f\x1d\x5c\x4e\x09\x3e\x64\x6b\x74\x99\x71\xe5\xe4\xfc\xc5\x04\x3a\x9d\x2b\x2b\x0f\x05\xfd\x97\xf9\xbe\x36\xc1\xeb\x9f\x43\x4e\xe1\x0e\x3c\xd9\x8e\x7a\x13\xda\x3a\xdd\xe0\xd2\x24\x73\x6b\x26\xba\x6d\x6d\x52\xdb\x7c\xeb\xed\x80\x9c\xdf\x85\x26\xd9\x12\x32\x21\x18\xc1\xbc\x83\x30\x23\x05\x5e\x32\x05\x05\x66\x0b\xf2\x40\xc5\x51\x77\x9a\xf7\x3a\x8d\xa3\xf3\xd8\x6f\x2b\xd3\x8d\x7d\x6f\x6d\x4c\x43\xab\x93\x64\xce\x70\x3e\xa0\xbb\x93\xae\x2d\xb5\xe9\x27\x8c\xc6\x53\xf4\x39\x00\x1a\x90\x0b\x7f\xc6\x03\x00\x00")
+
+func _1628265403_add_community_message_archivesUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1628265403_add_community_message_archivesUpSql,
+		"1628265403_add_community_message_archives.up.sql",
+	)
+}
+
+func _1628265403_add_community_message_archivesUpSql() (*asset, error) {
+	bytes, err := _1628265403_add_community_message_archivesUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1628265403_add_community_message_archives.up.sql", size: 966, mode: os.FileMode(0644), modTime: time.Unix(1792394236, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe4, 0x95, 0xf4, 0xba, 0x61, 0xc0, 0x3f, 0x8d, 0xcf, 0x49, 0xf8, 0xe8, 0xdc, 0xad, 0xae, 0xd3, 0xc5, 0x87, 0xfb, 0xc8, 0x81, 0x1a, 0xf5, 0x7b, 0x66, 0x6, 0x67, 0xd8, 0xc3, 0xf, 0xe9, 0x2c}}
+	return a, nil
+}
+
+var __1628265404_add_community_control_signature_requestsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x3c\x8d\x4f\xcb\xc2\x20\x00\xc6\xef\xfb\x14\xcf\xf1\x7d\xa1\x6f\xd0\xc9\x89\x03\xc9\x74\x38\x83\x76\x92\x70\x12\xd2\xa6\xa5\xee\xd0\xb7\x8f\x15\x75\x7d\xfe\xfc\x7e\x54\x33\x62\x18\x0c\x69\x05\x03\xef\x20\x95\x01\x3b\xf3\xc1\x0c\x70\x69\x59\xd6\x18\x6a\xf0\xc5\xba\x14\x6b\x4e\xb3\x2d\xe1\x1a\x2f\x75\xcd\xde\x66\xff\x58\x7d\xa9\x05\x7f\x0d\x7e\xd3\xa7\x0d\x13\x5a\xa1\x5a\xf4\x9a\x1f\x89\x1e\x71\x60\x23\x94\x04\x55\xb2\x13\x9c\x1a\x68\xd6\x0b\x42\xd9\x6e\x3b\xcd\xc9\xdd\xc0\xa5\x79\x4b\xe5\x49\x88\x2d\x9d\x7c\x71\x39\xdc\x6b\x48\xf1\x43\xfa\x96\xcd\xff\xbe\x79\x0d\x00\x7a\xab\xc4\xd8\xae\x00\x00\x00")
+
+func _1628265404_add_community_control_signature_requestsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1628265404_add_community_control_signature_requestsUpSql,
+		"1628265404_add_community_control_signature_requests.up.sql",
+	)
+}
+
+func _1628265404_add_community_control_signature_requestsUpSql() (*asset, error) {
+	bytes, err := _1628265404_add_community_control_signature_requestsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1628265404_add_community_control_signature_requests.up.sql", size: 174, mode: os.FileMode(0644), modTime: time.Unix(1792394653, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x92, 0xb5, 0x4f, 0x87, 0x4e, 0xf8, 0xbe, 0x30, 0x54, 0x31, 0xae, 0xf4, 0x96, 0xa2, 0xc3, 0xfd, 0x91, 0x79, 0xde, 0xd6, 0x82, 0xec, 0x31, 0xc7, 0x40, 0x6c, 0xca, 0xdc, 0xd2, 0x67, 0x49, 0xcf}}
+	return a, nil
+}
+
+var __1628265405_add_communities_directoryUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x91\x41\x6b\x03\x21\x10\x85\xef\xfe\x8a\x21\xa7\x06\x7a\xe8\x3d\x27\xd7\xba\x54\x6a\xdd\x60\x4c\x69\x4e\x8b\x75\xed\x32\x74\xd5\xb2\x1a\x4a\xfe\x7d\x31\x87\x40\x28\x25\x34\xe4\xa8\xef\x9b\x79\x33\x6f\x98\xe6\xd4\x70\x30\xb4\x91\x1c\x44\x0b\xaa\x33\xc0\xdf\xc4\xc6\x6c\xc0\xa5\x10\xf6\x11\x0b\xfa\xdc\x0f\x38\x7b\x57\xd2\x7c\xe8\x27\xcc\x05\xe3\x98\xe1\x8e\xc0\x09\x39\xf4\x38\x40\x23\xbb\x06\xd6\x5a\xbc\x50\xbd\x83\x67\xbe\x83\x4e\x01\xeb\x54\x2b\x05\x33\xa0\xf9\x5a\x52\xc6\xef\x6b\xd1\x94\xdc\x27\x08\x65\x8e\x66\x6a\x2b\x65\xfd\x8d\x36\x78\x78\xa5\x9a\x3d\x51\x7d\x26\x0c\x3e\xbb\x19\xbf\x0a\xa6\xf8\x4b\x87\x47\xde\xd2\xad\x34\xb0\x58\xd4\x1e\xc5\x8e\xf9\x12\x13\x7c\x78\xf7\x73\xee\x5d\xda\xc7\x72\x36\xc5\x09\x7c\xa8\x1c\x06\x3b\xfa\xe3\x4e\xf5\x65\x9d\xf3\x39\xff\x81\x93\xe5\x8a\x90\x7f\x07\xf9\x31\xd9\x6b\x53\xbc\xce\x30\x7d\xc7\x1b\x5c\xef\x52\xc6\x64\xb9\x22\x3f\x03\x00\xb1\x7d\x8e\x2c\x55\x02\x00\x00")
+
+func _1628265405_add_communities_directoryUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1628265405_add_communities_directoryUpSql,
+		"1628265405_add_communities_directory.up.sql",
+	)
+}
+
+func _1628265405_add_communities_directoryUpSql() (*asset, error) {
+	bytes, err := _1628265405_add_communities_directoryUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1628265405_add_communities_directory.up.sql", size: 597, mode: os.FileMode(0644), modTime: time.Unix(1792395063, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe1, 0x74, 0x5b, 0x8f, 0xcc, 0xa2, 0x29, 0x13, 0x84, 0x75, 0xb2, 0x33, 0x4d, 0x50, 0xd3, 0x9b, 0xdd, 0x6e, 0x0, 0xc3, 0xe0, 0xd4, 0x3c, 0xe3, 0x12, 0x8c, 0x95, 0x98, 0x4b, 0x43, 0x8a, 0xf9}}
+	return a, nil
+}
+
+var __1628265406_add_spam_filterUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\xcf\x41\x4b\xc3\x30\x18\xc6\xf1\x7b\x3e\xc5\x43\x4f\x0e\x76\xf0\xbe\x53\x5a\x53\x0d\xc6\x64\xa4\x99\x38\x44\x8a\x6b\x33\x0c\x4b\xdb\x91\xb4\xc8\xbe\xbd\xac\x05\xd9\x18\x3a\x76\x7f\xf3\xff\xe5\xc9\x34\xa3\x86\xc1\xd0\x54\x30\xf0\x1c\x52\x19\xb0\x37\x5e\x98\x02\x71\xff\xd9\x94\x5b\xe7\x7b\x1b\xca\x30\x78\x1b\x71\x47\x00\x57\x83\x4b\x83\xa5\xe6\x2f\x54\xaf\xf1\xcc\xd6\x50\x12\x99\x92\xb9\xe0\x99\x81\x66\x4b\x41\x33\x36\x27\xc0\xf4\x26\x15\x2a\x1d\xab\x72\x25\x04\x99\x2d\x08\xb9\x46\x46\xdb\xd6\x36\x4c\xda\x7e\xd8\x78\x57\x95\x3b\x7b\xc0\x2b\xd5\xd9\x13\xd5\x7f\xca\xfc\x51\x2a\x3d\xc2\x5b\x17\x62\x5f\x46\x6b\xdb\xf1\xab\x37\xe1\x1b\xdf\x55\x3b\xef\x62\x7f\xe2\xc7\x2f\x1b\xae\xf2\x27\xc3\xab\x63\xe3\x8c\xc6\x03\xcb\xe9\x4a\x18\xdc\xcf\xcf\x46\xc5\xdf\xec\xc5\x65\xf2\xfe\x91\x1c\x8f\xbb\xef\x16\xa9\x52\x82\x51\x79\x99\xcb\xa9\x28\x26\xb2\x6b\x9a\xa1\x75\xfd\xa1\x74\xf5\x3f\xcd\x84\xcc\x16\xe4\x67\x00\x21\xf9\x09\x65\xf3\x01\x00\x00")
+
+func _1628265406_add_spam_filterUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1628265406_add_spam_filterUpSql,
+		"1628265406_add_spam_filter.up.sql",
+	)
+}
+
+func _1628265406_add_spam_filterUpSql() (*asset, error) {
+	bytes, err := _1628265406_add_spam_filterUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1628265406_add_spam_filter.up.sql", size: 499, mode: os.FileMode(0644), modTime: time.Unix(1792395254, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb6, 0x9e, 0x99, 0xb2, 0x6a, 0xe4, 0xb6, 0x48, 0x6d, 0x6a, 0xb8, 0x5b, 0xda, 0x2f, 0x6e, 0x10, 0x6e, 0xbd, 0xf5, 0x3, 0x75, 0x36, 0x21, 0x47, 0x80, 0xe8, 0x80, 0x7f, 0x45, 0x93, 0xbc, 0xdb}}
+	return a, nil
+}
+
+var __1628265411_add_social_recoveryUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd5\x93\xc1\x4f\xc2\x30\x14\xc6\xef\xfb\x2b\xde\x11\x12\x48\xbc\x7b\x1a\x73\xc4\xc5\xb9\x91\x31\x8c\x9c\x48\x6d\x9f\x76\xa1\xb6\xb3\xed\x40\xfe\x7b\xdb\x0d\x0d\x03\x49\xc0\x9b\xc7\x7e\x7d\xef\xeb\xd7\xdf\x6b\xc7\x63\x98\x73\xa2\xd1\x80\x7a\x05\xcb\x11\x0c\x22\x83\x9a\x6b\x62\x3a\x8d\x2a\x69\x09\xb5\x06\x38\x0a\x06\x2f\xbb\xb6\xa8\x31\xa8\x47\xa0\x24\x42\x8d\x1a\xd4\x56\xba\x65\x30\x1e\x77\x06\xde\xce\x77\x12\x90\xb8\x75\x7e\x16\x34\xd6\x82\xd0\x4a\xbe\xb5\x05\xb5\xc6\x4d\xa5\x1a\xe3\xfb\x83\xa8\x88\xc3\x32\x86\x32\x9c\xa4\x31\x24\x53\xc8\xf2\x12\xe2\xe7\x64\x5e\xce\xc1\x28\x5a\x11\xb1\xd2\x48\xd5\x06\xf5\x6e\x65\xba\x9c\x83\x00\xba\x13\xe1\x29\x2c\xa2\xfb\xb0\x80\x59\x91\x3c\x86\xc5\x12\x1e\xe2\x25\xe4\x19\x44\x79\x36\x4d\x93\xa8\x84\x22\x9e\xa5\x61\x14\x8f\x5c\x43\xc5\x7e\xaa\xfd\x09\xd9\x22\x4d\xbd\xdc\x5a\xae\x2a\xc9\xf0\x13\x92\xac\xec\xed\x6d\x88\x68\x10\x26\x69\x3e\xe9\xc9\x96\xbb\x10\x5c\x39\x16\xc7\x0d\x56\x59\x22\x4e\x54\xca\x91\xae\x4d\xf3\x7e\xea\x44\x85\xa2\xeb\x5e\x7d\x30\xbc\x0d\x3c\xc6\x02\x3f\x1a\x34\x8e\xb9\x55\x8e\x9d\x40\x37\x0b\x47\xb3\x03\xeb\x70\x60\xb5\xc1\xa3\x51\x10\xc9\x60\xcb\xd1\x09\xda\xab\x3b\xd8\xa2\x46\x6f\xb5\x6f\x67\xa0\x34\x30\xa4\xa2\x92\xc8\xae\x81\xae\xbf\xa3\x0c\xfa\x14\x2f\x60\xde\x1f\xd2\xe1\xcd\xf7\xa6\x67\x76\x4f\xb9\xb4\xa3\xb2\xc4\xba\x37\x73\x28\xc3\x5d\x3c\x0d\x17\x69\x09\x37\x2d\xb8\x6b\x9e\x12\x4a\xdb\xbf\xda\xf9\xb0\x7e\xd6\x57\x25\x3d\x44\x33\xe8\xfe\xc6\xde\x64\xf8\x1b\xaa\x6b\xb3\x53\x25\x04\x52\x8b\xec\xfc\x87\xb8\x30\xfe\xff\xf8\x14\x7f\x44\xfa\x05\xb1\x4e\x41\x3c\xd9\x04\x00\x00")
+
+func _1628265411_add_social_recoveryUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1628265411_add_social_recoveryUpSql,
+		"1628265411_add_social_recovery.up.sql",
+	)
+}
+
+func _1628265411_add_social_recoveryUpSql() (*asset, error) {
+	bytes, err := _1628265411_add_social_recoveryUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1628265411_add_social_recovery.up.sql", size: 1241, mode: os.FileMode(0644), modTime: time.Unix(1792397083, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x8f, 0x18, 0x29, 0xc8, 0x43, 0xc2, 0x49, 0xc5, 0x92, 0x48, 0xd8, 0x52, 0x29, 0x43, 0x42, 0x83, 0x3e, 0xf3, 0x96, 0xb8, 0xfc, 0x59, 0x3c, 0x5d, 0x76, 0x4f, 0xf2, 0xc4, 0x79, 0x24, 0x51, 0xe}}
+	return a, nil
+}
+
+var __1628265412_add_safe_transactionsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x90\xcd\x0e\xda\x30\x10\x84\xef\x7e\x8a\xb9\x01\x12\x48\xbd\x73\x32\xc1\xa8\x51\x4d\x82\x12\x53\x95\x53\xb4\x4a\x36\x24\x2a\xc4\xc8\x4e\x8a\xfa\xf6\x55\x52\x7e\x82\xa0\x87\x1e\xbd\xbb\x33\xe3\x6f\xa4\x36\x2a\x81\x91\x2b\xad\xd0\x79\x76\xd9\x99\xbd\xa7\x23\x7b\xc8\xf5\x1a\x41\xac\xf7\xdb\x08\x9e\x4a\xce\x5a\x47\x8d\xa7\xbc\xad\x6d\x93\x5d\xe8\xf7\xc9\x52\x81\x95\x8e\x57\x4b\x21\x16\x0b\xa4\xf5\xb1\xa1\xb6\x73\xec\x61\x4b\xb4\x15\xc3\x5e\x1b\x76\xc3\x8b\x90\x52\xc9\xb0\xbf\xd8\x81\x70\x71\xf6\x62\x3d\x17\x18\x19\xce\x7b\x8b\x5e\xe4\xeb\xe3\xa0\x22\xc7\x70\x9c\xf7\x12\x2e\x50\x3a\x7b\x7e\xac\x6f\x29\xd7\x8a\x9b\x61\xf6\xd7\x8f\x4e\xa8\x3d\x1c\x53\x21\x82\x44\x49\xa3\x6e\x4c\xe1\x06\x51\x6c\xa0\x7e\x84\xa9\x49\xdf\x41\x46\x86\x53\x01\xdc\xe0\xb3\xba\xc0\x77\x99\x04\x5f\x65\x32\xa8\xa3\xbd\xd6\x73\x01\x78\xdb\xb9\x9c\x3f\xaf\xee\x46\x43\x27\x2f\xab\xfc\x64\xf3\x9f\x08\x23\xf3\x32\xdd\x25\xe1\x56\x26\x07\x7c\x53\x07\x4c\x9f\xb9\xf3\xa7\xd3\x0c\x71\x84\x20\x8e\x36\x3a\x0c\x0c\x12\xb5\xd3\x32\x50\x62\xb6\x14\xff\x45\x78\xaf\xe7\x9f\x80\xe3\x8f\x7c\x08\x1c\xb8\x5b\x6a\x3b\xff\x82\x80\xb5\xda\xc8\xbd\x36\xf8\xd2\x1f\x8c\x03\x2b\xf2\xd5\x5b\x45\x8f\xf3\xc9\x44\xcc\x96\xe2\xcf\x00\x92\x59\xfb\xc3\x76\x02\x00\x00")
+
+func _1628265412_add_safe_transactionsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1628265412_add_safe_transactionsUpSql,
+		"1628265412_add_safe_transactions.up.sql",
+	)
+}
+
+func _1628265412_add_safe_transactionsUpSql() (*asset, error) {
+	bytes, err := _1628265412_add_safe_transactionsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1628265412_add_safe_transactions.up.sql", size: 630, mode: os.FileMode(0644), modTime: time.Unix(1792398341, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x9e, 0x2f, 0xe, 0x7a, 0x9d, 0x24, 0x8f, 0xe, 0xbb, 0xd0, 0xf3, 0x70, 0xa4, 0x49, 0xf, 0x4c, 0xc8, 0x82, 0x4c, 0x70, 0xde, 0x27, 0xab, 0x13, 0x34, 0xbd, 0xc, 0x6b, 0x2, 0x8e, 0x63, 0x33}}
+	return a, nil
+}
+
 var _readmeMd = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x91\xc1\xce\xd3\x30\x10\x84\xef\x7e\x8a\x91\x7a\x01\xa9\x2a\x8f\xc0\x0d\x71\x82\x03\x48\x1c\xc9\x36\x9e\x36\x96\x1c\x6f\xf0\xae\x93\xe6\xed\x91\xa3\xc2\xdf\xff\x66\xed\xd8\x33\xdf\x78\x4f\xa7\x13\xbe\xea\x06\x57\x6c\x35\x39\x31\xa7\x7b\x15\x4f\x5a\xec\x73\x08\xbf\x08\x2d\x79\x7f\x4a\x43\x5b\x86\x17\xfd\x8c\x21\xea\x56\x5e\x47\x90\x4a\x14\x75\x48\xde\x64\x37\x2c\x6a\x96\xae\x99\x48\x05\xf6\x27\x77\x13\xad\x08\xae\x8a\x51\xe7\x25\xf3\xf1\xa9\x9f\xf9\x58\x58\x2c\xad\xbc\xe0\x8b\x56\xf0\x21\x5d\xeb\x4c\x95\xb3\xae\x84\x60\xd4\xdc\xe6\x82\x5d\x1b\x36\x6d\x39\x62\x92\xf5\xb8\x11\xdb\x92\xd3\x28\xce\xe0\x13\xe1\x72\xcd\x3c\x63\xd4\x65\x87\xae\xac\xe8\xc3\x28\x2e\x67\x44\x66\x3a\x21\x25\xa2\x72\xac\x14\x67\xbc\x84\x9f\x53\x32\x8c\x52\x70\x25\x56\xd6\xfd\x8d\x05\x37\xad\x30\x9d\x9f\xa6\x86\x0f\xcd\x58\x7f\xcf\x34\x93\x3b\xed\x90\x9f\xa4\x1f\xcf\x30\x85\x4d\x07\x58\xaf\x7f\x25\xc4\x9d\xf3\x72\x64\x84\xd0\x7f\xf9\x9b\x3a\x2d\x84\xef\x85\x48\x66\x8d\xd8\x88\x9b\x8c\x8c\x98\x5b\xf6\x74\x14\x4e\x33\x0d\xc9\xe0\x93\x38\xda\x12\xc5\x69\xbd\xe4\xf0\x2e\x7a\x78\x07\x1c\xfe\x13\x9f\x91\x29\x31\x95\x7b\x7f\x62\x59\x37\xb4\xe5\x5e\x25\xfe\x33\xee\xd5\x53\x71\xd6\xda\x3a\xd8\xcb\xde\x2e\xf8\xa1\x90\x55\x53\x0c\xc7\xaa\x0d\xe9\x76\x14\x29\x1c\x7b\x68\xdd\x2f\xe1\x6f\x00\x00\x00\xff\xff\x3c\x0a\xc2\xfe\x2a\x02\x00\x00")
 
 func readmeMdBytes() ([]byte, error) {
@@ -1144,6 +1396,30 @@ var _bindata = map[string]func() (*asset, error){
 
 	"1625762506_add_deleted_messages.up.sql": _1625762506_add_deleted_messagesUpSql,
 
+	"1627917060_add_raw_messages_queue.up.sql": _1627917060_add_raw_messages_queueUpSql,
+
+	"1628072503_add_contact_verification.up.sql": _1628072503_add_contact_verificationUpSql,
+
+	"1628158903_add_custom_emoji_reactions.up.sql": _1628158903_add_custom_emoji_reactionsUpSql,
+
+	"1628245318_add_polls.up.sql": _1628245318_add_pollsUpSql,
+
+	"1628265401_add_link_previews.up.sql": _1628265401_add_link_previewsUpSql,
+
+	"1628265402_add_file_attachments.up.sql": _1628265402_add_file_attachmentsUpSql,
+
+	"1628265403_add_community_message_archives.up.sql": _1628265403_add_community_message_archivesUpSql,
+
+	"1628265404_add_community_control_signature_requests.up.sql": _1628265404_add_community_control_signature_requestsUpSql,
+
+	"1628265405_add_communities_directory.up.sql": _1628265405_add_communities_directoryUpSql,
+
+	"1628265406_add_spam_filter.up.sql": _1628265406_add_spam_filterUpSql,
+
+	"1628265411_add_social_recovery.up.sql": _1628265411_add_social_recoveryUpSql,
+
+	"1628265412_add_safe_transactions.up.sql": _1628265412_add_safe_transactionsUpSql,
+
 	"README.md": readmeMd,
 
 	"doc.go": docGo,
@@ -1231,8 +1507,20 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"1624978434_add_muted_community.up.sql":                                   &bintree{_1624978434_add_muted_communityUpSql, map[string]*bintree{}},
 	"1625018910_add_repply_message_activity_center_notification_field.up.sql": &bintree{_1625018910_add_repply_message_activity_center_notification_fieldUpSql, map[string]*bintree{}},
 	"1625762506_add_deleted_messages.up.sql":                                  &bintree{_1625762506_add_deleted_messagesUpSql, map[string]*bintree{}},
-	"README.md":                                                               &bintree{readmeMd, map[string]*bintree{}},
-	"doc.go":                                                                  &bintree{docGo, map[string]*bintree{}},
+	"1627917060_add_raw_messages_queue.up.sql":                                &bintree{_1627917060_add_raw_messages_queueUpSql, map[string]*bintree{}},
+	"1628072503_add_contact_verification.up.sql":                              &bintree{_1628072503_add_contact_verificationUpSql, map[string]*bintree{}},
+	"1628158903_add_custom_emoji_reactions.up.sql":                            &bintree{_1628158903_add_custom_emoji_reactionsUpSql, map[string]*bintree{}},
+	"1628245318_add_polls.up.sql":                                             &bintree{_1628245318_add_pollsUpSql, map[string]*bintree{}},
+	"1628265401_add_link_previews.up.sql":                                     &bintree{_1628265401_add_link_previewsUpSql, map[string]*bintree{}},
+	"1628265402_add_file_attachments.up.sql":                                  &bintree{_1628265402_add_file_attachmentsUpSql, map[string]*bintree{}},
+	"1628265403_add_community_message_archives.up.sql":                        &bintree{_1628265403_add_community_message_archivesUpSql, map[string]*bintree{}},
+	"1628265404_add_community_control_signature_requests.up.sql":              &bintree{_1628265404_add_community_control_signature_requestsUpSql, map[string]*bintree{}},
+	"1628265405_add_communities_directory.up.sql":                             &bintree{_1628265405_add_communities_directoryUpSql, map[string]*bintree{}},
+	"1628265406_add_spam_filter.up.sql":                                       &bintree{_1628265406_add_spam_filterUpSql, map[string]*bintree{}},
+	"1628265411_add_social_recovery.up.sql":                                   &bintree{_1628265411_add_social_recoveryUpSql, map[string]*bintree{}},
+	"1628265412_add_safe_transactions.up.sql":                                 &bintree{_1628265412_add_safe_transactionsUpSql, map[string]*bintree{}},
+	"README.md": &bintree{readmeMd, map[string]*bintree{}},
+	"doc.go":    &bintree{docGo, map[string]*bintree{}},
 }}
 
 // RestoreAsset restores an asset under the given directory.
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS raw_messages_queue (
+  id VARCHAR PRIMARY KEY ON CONFLICT REPLACE,
+  message_id VARCHAR NOT NULL,
+  local_chat_id VARCHAR NOT NULL,
+  clock INT NOT NULL,
+  attempts INT NOT NULL DEFAULT 0,
+  next_attempt INT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX raw_messages_queue_message_id ON raw_messages_queue(message_id);
+CREATE INDEX raw_messages_queue_clock ON raw_messages_queue(clock);
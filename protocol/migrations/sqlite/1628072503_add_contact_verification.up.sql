@@ -0,0 +1,14 @@
+ALTER TABLE contacts ADD COLUMN trust_status INT NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS verification_requests (
+  id VARCHAR PRIMARY KEY ON CONFLICT REPLACE,
+  from_user VARCHAR NOT NULL,
+  to_user VARCHAR NOT NULL,
+  challenge VARCHAR NOT NULL,
+  response VARCHAR NOT NULL DEFAULT '',
+  requested_at INT NOT NULL,
+  replied_at INT NOT NULL DEFAULT 0,
+  status INT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX verification_requests_from_user_to_user ON verification_requests(from_user, to_user);
@@ -0,0 +1,12 @@
+ALTER TABLE emoji_reactions ADD COLUMN emoji VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE emoji_reactions ADD COLUMN community_emoji_id VARCHAR NOT NULL DEFAULT '';
+
+UPDATE emoji_reactions SET emoji = CASE emoji_id
+  WHEN 1 THEN '❤️'
+  WHEN 2 THEN '👍'
+  WHEN 3 THEN '👎'
+  WHEN 4 THEN '😂'
+  WHEN 5 THEN '😢'
+  WHEN 6 THEN '😡'
+  ELSE ''
+END;
@@ -0,0 +1,11 @@
+ALTER TABLE user_messages ADD COLUMN poll_payload BLOB;
+
+CREATE TABLE IF NOT EXISTS poll_votes (
+  message_id VARCHAR NOT NULL,
+  voter VARCHAR NOT NULL,
+  local_chat_id VARCHAR NOT NULL,
+  clock INT NOT NULL,
+  timestamp INT NOT NULL,
+  options VARCHAR NOT NULL DEFAULT '',
+  PRIMARY KEY (message_id, voter) ON CONFLICT REPLACE
+);
@@ -0,0 +1 @@
+ALTER TABLE user_messages ADD COLUMN link_previews BLOB;
@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS community_message_archive_envelopes (
+  hash BLOB PRIMARY KEY ON CONFLICT IGNORE,
+  community_id BLOB NOT NULL,
+  chat_id VARCHAR NOT NULL,
+  timestamp INT NOT NULL,
+  envelope BLOB NOT NULL
+);
+
+CREATE INDEX community_message_archive_envelopes_chat_timestamp ON community_message_archive_envelopes(community_id, chat_id, timestamp);
+
+CREATE TABLE IF NOT EXISTS community_message_archives (
+  hash BLOB PRIMARY KEY ON CONFLICT IGNORE,
+  community_id BLOB NOT NULL,
+  chat_id VARCHAR NOT NULL,
+  from_timestamp INT NOT NULL,
+  to_timestamp INT NOT NULL,
+  messages_count INT NOT NULL,
+  data BLOB NOT NULL,
+  signature BLOB NOT NULL,
+  imported BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE INDEX community_message_archives_imported ON community_message_archives(imported);
+
+CREATE TABLE IF NOT EXISTS community_message_archive_requests (
+  hash BLOB PRIMARY KEY ON CONFLICT REPLACE,
+  community_id BLOB NOT NULL,
+  requested_at INT NOT NULL
+);
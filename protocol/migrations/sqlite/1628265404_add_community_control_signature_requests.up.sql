@@ -0,0 +1,5 @@
+CREATE TABLE IF NOT EXISTS communities_control_signature_requests (
+  community_id BLOB PRIMARY KEY ON CONFLICT REPLACE,
+  clock INT NOT NULL,
+  description BLOB NOT NULL
+);
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS communities_directory_listings (
+  community_id BLOB PRIMARY KEY ON CONFLICT REPLACE,
+  clock INT NOT NULL,
+  name VARCHAR NOT NULL,
+  description VARCHAR NOT NULL DEFAULT "",
+  tags VARCHAR NOT NULL DEFAULT "",
+  members_count INT NOT NULL DEFAULT 0,
+  image BLOB,
+  access INT NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS communities_directory_flags (
+  community_id BLOB PRIMARY KEY ON CONFLICT REPLACE
+);
+
+CREATE TABLE IF NOT EXISTS communities_directory_own_listings (
+  community_id BLOB PRIMARY KEY ON CONFLICT REPLACE,
+  tags VARCHAR NOT NULL DEFAULT ""
+);
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS spam_filter_rules (
+  id INT PRIMARY KEY ON CONFLICT REPLACE,
+  rules BLOB NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS spam_senders (
+  public_key VARCHAR PRIMARY KEY ON CONFLICT IGNORE,
+  first_seen INT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS spam_blocklists (
+  publisher VARCHAR PRIMARY KEY ON CONFLICT REPLACE,
+  clock INT NOT NULL DEFAULT 0,
+  public_keys VARCHAR NOT NULL DEFAULT "[]",
+  own BOOLEAN NOT NULL DEFAULT FALSE,
+  community_id VARCHAR NOT NULL DEFAULT ""
+);
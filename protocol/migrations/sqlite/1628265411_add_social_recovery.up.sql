@@ -0,0 +1,42 @@
+-- Shares of the seed phrases of contacts held by the user, one per owner,
+-- the share of a new set replacing the previous one
+CREATE TABLE IF NOT EXISTS social_recovery_shares (
+  owner VARCHAR PRIMARY KEY ON CONFLICT REPLACE,
+  id VARCHAR NOT NULL,
+  share_index INT NOT NULL,
+  value BLOB NOT NULL,
+  threshold INT NOT NULL,
+  total INT NOT NULL,
+  checksum BLOB NOT NULL,
+  clock INT NOT NULL
+);
+
+-- Requests to release a share received by the user, and whether they were
+-- released or declined
+CREATE TABLE IF NOT EXISTS social_recovery_requests (
+  id VARCHAR PRIMARY KEY ON CONFLICT REPLACE,
+  owner VARCHAR NOT NULL,
+  requester VARCHAR NOT NULL,
+  clock INT NOT NULL,
+  status INT NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS social_recovery_sent_requests (
+  owner VARCHAR NOT NULL,
+  holder VARCHAR NOT NULL,
+  clock INT NOT NULL,
+  PRIMARY KEY (owner, holder) ON CONFLICT REPLACE
+);
+
+CREATE TABLE IF NOT EXISTS social_recovery_collected_shares (
+  owner VARCHAR NOT NULL,
+  holder VARCHAR NOT NULL,
+  id VARCHAR NOT NULL,
+  share_index INT NOT NULL,
+  value BLOB NOT NULL,
+  threshold INT NOT NULL,
+  total INT NOT NULL,
+  checksum BLOB NOT NULL,
+  clock INT NOT NULL,
+  PRIMARY KEY (owner, holder) ON CONFLICT REPLACE
+);
@@ -0,0 +1,17 @@
+ALTER TABLE user_messages ADD COLUMN safe_transaction_payload BLOB;
+
+-- Signatures of the owners of a Safe over a proposed transaction,
+-- the signers are recovered from the signatures when the proposal is read
+CREATE TABLE IF NOT EXISTS safe_transaction_signatures (
+  message_id VARCHAR NOT NULL,
+  source VARCHAR NOT NULL,
+  signature BLOB NOT NULL,
+  clock INT NOT NULL,
+  PRIMARY KEY (message_id, signature) ON CONFLICT REPLACE
+);
+
+CREATE TABLE IF NOT EXISTS safe_transaction_proposals (
+  message_id VARCHAR PRIMARY KEY ON CONFLICT REPLACE,
+  status INT NOT NULL DEFAULT 0,
+  transaction_hash VARCHAR NOT NULL DEFAULT ''
+);
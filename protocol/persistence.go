@@ -458,6 +458,7 @@ func (db sqlitePersistence) Contacts() ([]*Contact, error) {
 			c.system_tags,
 			c.device_info,
 			c.local_nickname,
+			c.trust_status,
 			i.image_type,
 			i.payload
 		FROM contacts c LEFT JOIN chat_identity_contacts i ON c.id = i.contact_id LEFT JOIN ens_verification_records v ON c.id = v.public_key
@@ -493,6 +494,7 @@ func (db sqlitePersistence) Contacts() ([]*Contact, error) {
 			&encodedSystemTags,
 			&encodedDeviceInfo,
 			&nickname,
+			&contact.TrustStatus,
 			&imageType,
 			&imagePayload,
 		)
@@ -685,9 +687,10 @@ func (db sqlitePersistence) SaveContact(contact *Contact, tx *sql.Tx) (err error
 			system_tags,
 			device_info,
 			local_nickname,
+			trust_status,
 			photo,
 			tribute_to_talk
-		) VALUES (?, ?, ?, ?, ?, ?, ?, ?,?, ?, "")
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, "")
 	`)
 	if err != nil {
 		return
@@ -704,6 +707,7 @@ func (db sqlitePersistence) SaveContact(contact *Contact, tx *sql.Tx) (err error
 		encodedSystemTags.Bytes(),
 		encodedDeviceInfo.Bytes(),
 		contact.LocalNickname,
+		contact.TrustStatus,
 		// Photo is not used anymore but constrained to be NOT NULL
 		// we set it to blank for now to avoid a migration of the table
 		"",
@@ -11,6 +11,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/require"
 
 	"github.com/status-im/status-go/eth-node/crypto"
@@ -1307,3 +1308,91 @@ func TestSaveCommunityChat(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, chat, retrievedChat)
 }
+
+func TestSavePollMessageAndVotes(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := NewSQLitePersistence(db)
+
+	poll := &protobuf.PollMessage{
+		Question:       "lunch?",
+		Options:        []string{"pizza", "sushi"},
+		MultipleChoice: true,
+		EndTime:        1000,
+	}
+	err = p.SaveMessages([]*common.Message{{
+		ID:          "poll-id",
+		LocalChatID: testPublicChatID,
+		ChatMessage: protobuf.ChatMessage{
+			Text:        poll.Question,
+			ContentType: protobuf.ChatMessage_POLL,
+			Payload:     &protobuf.ChatMessage_Poll{Poll: poll},
+		},
+		From: "me",
+	}})
+	require.NoError(t, err)
+
+	message, err := p.MessageByID("poll-id")
+	require.NoError(t, err)
+	require.True(t, proto.Equal(poll, message.GetPoll()))
+
+	vote := &PollVote{
+		PollVote: protobuf.PollVote{
+			Clock:     1,
+			MessageId: "poll-id",
+			Options:   []uint32{0, 1},
+		},
+		From:        "voter",
+		LocalChatID: testPublicChatID,
+	}
+	require.NoError(t, p.SavePollVote(vote, 10))
+
+	vote.Clock = 2
+	vote.Options = []uint32{1}
+	require.NoError(t, p.SavePollVote(vote, 20))
+
+	votes, err := p.PollVotes("poll-id")
+	require.NoError(t, err)
+	require.Len(t, votes, 1)
+	require.Equal(t, uint64(2), votes[0].Clock)
+	require.Equal(t, []uint32{1}, votes[0].Options)
+
+	existing, err := p.PollVoteByVoter("poll-id", "voter")
+	require.NoError(t, err)
+	require.Equal(t, uint64(20), existing.Timestamp)
+
+	missing, err := p.PollVoteByVoter("poll-id", "someone-else")
+	require.NoError(t, err)
+	require.Nil(t, missing)
+}
+
+func TestSaveLinkPreviews(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := NewSQLitePersistence(db)
+
+	previews := []*protobuf.LinkPreview{{
+		Url:              "https://status.im",
+		Site:             "Status",
+		Title:            "Status - Private, Secure Communication",
+		ThumbnailPayload: []byte{0xff, 0xd8, 0xff},
+		ThumbnailWidth:   240,
+		ThumbnailHeight:  120,
+	}}
+	err = p.SaveMessages([]*common.Message{{
+		ID:          "message-id",
+		LocalChatID: testPublicChatID,
+		ChatMessage: protobuf.ChatMessage{
+			Text:         "https://status.im",
+			ContentType:  protobuf.ChatMessage_TEXT_PLAIN,
+			LinkPreviews: previews,
+		},
+		From: "me",
+	}})
+	require.NoError(t, err)
+
+	message, err := p.MessageByID("message-id")
+	require.NoError(t, err)
+	require.Len(t, message.LinkPreviews, 1)
+	require.True(t, proto.Equal(previews[0], message.LinkPreviews[0]))
+}
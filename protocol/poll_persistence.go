@@ -0,0 +1,78 @@
+package protocol
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	"github.com/status-im/status-go/protocol/polls"
+)
+
+// SavePollVote stores the vote, replacing the previous vote of the same voter
+func (db sqlitePersistence) SavePollVote(vote *PollVote, timestamp uint64) error {
+	options, err := json.Marshal(vote.Options)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.db.Exec(`INSERT INTO poll_votes (message_id, voter, local_chat_id, clock, timestamp, options) VALUES (?, ?, ?, ?, ?, ?)`,
+		vote.MessageId,
+		vote.From,
+		vote.LocalChatID,
+		vote.Clock,
+		timestamp,
+		string(options),
+	)
+	return err
+}
+
+func scanPollVote(row interface{ Scan(...interface{}) error }) (*polls.Vote, error) {
+	vote := &polls.Vote{}
+	var options string
+	err := row.Scan(
+		&vote.Voter,
+		&vote.Clock,
+		&vote.Timestamp,
+		&options,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if options != "" {
+		err = json.Unmarshal([]byte(options), &vote.Options)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return vote, nil
+}
+
+// PollVoteByVoter returns the latest vote of the voter on the poll, or nil if they haven't voted
+func (db sqlitePersistence) PollVoteByVoter(messageID string, voter string) (*polls.Vote, error) {
+	vote, err := scanPollVote(db.db.QueryRow(`SELECT voter, clock, timestamp, options FROM poll_votes WHERE message_id = ? AND voter = ?`, messageID, voter))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return vote, err
+}
+
+// PollVotes returns the latest vote of each voter on the poll
+func (db sqlitePersistence) PollVotes(messageID string) ([]*polls.Vote, error) {
+	rows, err := db.db.Query(`SELECT voter, clock, timestamp, options FROM poll_votes WHERE message_id = ?`, messageID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var votes []*polls.Vote
+	for rows.Next() {
+		vote, err := scanPollVote(rows)
+		if err != nil {
+			return nil, err
+		}
+		votes = append(votes, vote)
+	}
+
+	return votes, rows.Err()
+}
@@ -0,0 +1,62 @@
+package protocol
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/status-im/status-go/protocol/polls"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// PollVote represents a vote on a poll in the application layer, used for persistence, querying and
+// signaling
+type PollVote struct {
+	protobuf.PollVote
+
+	// From is a public key of the voter
+	From string `json:"from,omitempty"`
+
+	// SigPubKey is the ecdsa encoded public key of the voter
+	SigPubKey *ecdsa.PublicKey `json:"-"`
+
+	// LocalChatID is the chatID of the local chat (one-to-one are not symmetric)
+	LocalChatID string `json:"localChatId"`
+}
+
+// GetSigPubKey returns an ecdsa encoded public key
+// this function is required to implement the ChatEntity interface
+func (v PollVote) GetSigPubKey() *ecdsa.PublicKey {
+	return v.SigPubKey
+}
+
+// GetProtoBuf returns the struct's embedded protobuf struct
+// this function is required to implement the ChatEntity interface
+func (v *PollVote) GetProtobuf() proto.Message {
+	return &v.PollVote
+}
+
+// SetMessageType a setter for the MessageType field
+// this function is required to implement the ChatEntity interface
+func (v *PollVote) SetMessageType(messageType protobuf.MessageType) {
+	v.MessageType = messageType
+}
+
+// WrapGroupMessage indicates whether we should wrap this in membership information
+func (v PollVote) WrapGroupMessage() bool {
+	return false
+}
+
+// PollResults is the current tally of a poll, along with the vote of the user
+type PollResults struct {
+	*polls.Results
+
+	// MessageID is the id of the poll message
+	MessageID string `json:"messageId"`
+
+	// LocalChatID is the chatID of the local chat the poll was sent to
+	LocalChatID string `json:"localChatId"`
+
+	// MyVote is the options chosen by the user, empty if they haven't voted
+	MyVote []uint32 `json:"myVote"`
+}
@@ -0,0 +1,90 @@
+package polls
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+var ErrPollClosed = errors.New("poll is closed")
+var ErrInvalidOption = errors.New("invalid poll option")
+var ErrDuplicateOption = errors.New("poll option chosen more than once")
+var ErrSingleChoice = errors.New("poll allows a single option")
+
+// Vote is the latest choice of a voter on a poll
+type Vote struct {
+	Voter string `json:"voter"`
+	Clock uint64 `json:"clock"`
+	// Timestamp is the time the vote was sent at, in milliseconds
+	Timestamp uint64   `json:"timestamp"`
+	Options   []uint32 `json:"options"`
+}
+
+// OptionResult is the number of votes received by an option
+type OptionResult struct {
+	Option string `json:"option"`
+	Votes  int    `json:"votes"`
+}
+
+// Results is the tally of the votes of a poll
+type Results struct {
+	Options     []OptionResult `json:"options"`
+	TotalVoters int            `json:"totalVoters"`
+	Closed      bool           `json:"closed"`
+}
+
+// Closed returns whether the poll doesn't accept votes at the given time,
+// in milliseconds
+func Closed(poll *protobuf.PollMessage, now uint64) bool {
+	return poll.EndTime != 0 && now > poll.EndTime
+}
+
+// ValidateVote checks that the chosen options can be cast on the poll.
+// An empty vote retracts any previous choice.
+func ValidateVote(poll *protobuf.PollMessage, options []uint32) error {
+	if !poll.MultipleChoice && len(options) > 1 {
+		return ErrSingleChoice
+	}
+
+	seen := make(map[uint32]bool)
+	for _, option := range options {
+		if int(option) >= len(poll.Options) {
+			return ErrInvalidOption
+		}
+		if seen[option] {
+			return ErrDuplicateOption
+		}
+		seen[option] = true
+	}
+
+	return nil
+}
+
+// Tally counts the votes cast on the poll, only the last vote of each voter
+// should be passed. Votes sent after the poll closed or that don't match the
+// poll are ignored.
+func Tally(poll *protobuf.PollMessage, votes []*Vote, now uint64) *Results {
+	results := &Results{
+		Options: make([]OptionResult, len(poll.Options)),
+		Closed:  Closed(poll, now),
+	}
+	for i, option := range poll.Options {
+		results.Options[i].Option = option
+	}
+
+	for _, vote := range votes {
+		if len(vote.Options) == 0 || Closed(poll, vote.Timestamp) {
+			continue
+		}
+		if ValidateVote(poll, vote.Options) != nil {
+			continue
+		}
+
+		for _, option := range vote.Options {
+			results.Options[option].Votes++
+		}
+		results.TotalVoters++
+	}
+
+	return results
+}
@@ -0,0 +1,60 @@
+package polls
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func TestValidateVote(t *testing.T) {
+	poll := &protobuf.PollMessage{
+		Question: "lunch?",
+		Options:  []string{"pizza", "sushi", "salad"},
+	}
+
+	require.NoError(t, ValidateVote(poll, nil))
+	require.NoError(t, ValidateVote(poll, []uint32{2}))
+	require.Equal(t, ErrInvalidOption, ValidateVote(poll, []uint32{3}))
+	require.Equal(t, ErrSingleChoice, ValidateVote(poll, []uint32{0, 1}))
+
+	poll.MultipleChoice = true
+	require.NoError(t, ValidateVote(poll, []uint32{0, 1}))
+	require.Equal(t, ErrDuplicateOption, ValidateVote(poll, []uint32{1, 1}))
+}
+
+func TestTally(t *testing.T) {
+	poll := &protobuf.PollMessage{
+		Question:       "lunch?",
+		Options:        []string{"pizza", "sushi", "salad"},
+		MultipleChoice: true,
+		EndTime:        100,
+	}
+
+	votes := []*Vote{
+		{Voter: "a", Timestamp: 10, Options: []uint32{0, 1}},
+		{Voter: "b", Timestamp: 20, Options: []uint32{1}},
+		// Retracted
+		{Voter: "c", Timestamp: 30},
+		// Sent after the poll closed
+		{Voter: "d", Timestamp: 101, Options: []uint32{2}},
+		// Not a valid option
+		{Voter: "e", Timestamp: 40, Options: []uint32{5}},
+	}
+
+	results := Tally(poll, votes, 50)
+	require.False(t, results.Closed)
+	require.Equal(t, 2, results.TotalVoters)
+	require.Equal(t, []OptionResult{
+		{Option: "pizza", Votes: 1},
+		{Option: "sushi", Votes: 2},
+		{Option: "salad", Votes: 0},
+	}, results.Options)
+
+	require.True(t, Tally(poll, votes, 101).Closed)
+
+	poll.EndTime = 0
+	require.False(t, Tally(poll, votes, 1000).Closed)
+	require.Equal(t, 3, Tally(poll, votes, 1000).TotalVoters)
+}
@@ -0,0 +1,162 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: anon_metrics.proto
+
+package protobuf
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// AnonymousMetric is an app metric stripped of anything linking it to the
+// session or the user it comes from
+type AnonymousMetric struct {
+	Event string `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// value is the json encoded value of the event
+	Value      []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	AppVersion string `protobuf:"bytes,3,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	Os         string `protobuf:"bytes,4,opt,name=os,proto3" json:"os,omitempty"`
+	// created_at is when the event happened in seconds, rounded down to the hour
+	CreatedAt            uint64   `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AnonymousMetric) Reset()         { *m = AnonymousMetric{} }
+func (m *AnonymousMetric) String() string { return proto.CompactTextString(m) }
+func (*AnonymousMetric) ProtoMessage()    {}
+func (*AnonymousMetric) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4be044a92fa0408c, []int{0}
+}
+
+func (m *AnonymousMetric) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AnonymousMetric.Unmarshal(m, b)
+}
+func (m *AnonymousMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AnonymousMetric.Marshal(b, m, deterministic)
+}
+func (m *AnonymousMetric) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnonymousMetric.Merge(m, src)
+}
+func (m *AnonymousMetric) XXX_Size() int {
+	return xxx_messageInfo_AnonymousMetric.Size(m)
+}
+func (m *AnonymousMetric) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnonymousMetric.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnonymousMetric proto.InternalMessageInfo
+
+func (m *AnonymousMetric) GetEvent() string {
+	if m != nil {
+		return m.Event
+	}
+	return ""
+}
+
+func (m *AnonymousMetric) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *AnonymousMetric) GetAppVersion() string {
+	if m != nil {
+		return m.AppVersion
+	}
+	return ""
+}
+
+func (m *AnonymousMetric) GetOs() string {
+	if m != nil {
+		return m.Os
+	}
+	return ""
+}
+
+func (m *AnonymousMetric) GetCreatedAt() uint64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+// AnonymousMetricBatch is sent to the collector with an ephemeral key
+type AnonymousMetricBatch struct {
+	Metrics              []*AnonymousMetric `protobuf:"bytes,1,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *AnonymousMetricBatch) Reset()         { *m = AnonymousMetricBatch{} }
+func (m *AnonymousMetricBatch) String() string { return proto.CompactTextString(m) }
+func (*AnonymousMetricBatch) ProtoMessage()    {}
+func (*AnonymousMetricBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4be044a92fa0408c, []int{1}
+}
+
+func (m *AnonymousMetricBatch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AnonymousMetricBatch.Unmarshal(m, b)
+}
+func (m *AnonymousMetricBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AnonymousMetricBatch.Marshal(b, m, deterministic)
+}
+func (m *AnonymousMetricBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnonymousMetricBatch.Merge(m, src)
+}
+func (m *AnonymousMetricBatch) XXX_Size() int {
+	return xxx_messageInfo_AnonymousMetricBatch.Size(m)
+}
+func (m *AnonymousMetricBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnonymousMetricBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnonymousMetricBatch proto.InternalMessageInfo
+
+func (m *AnonymousMetricBatch) GetMetrics() []*AnonymousMetric {
+	if m != nil {
+		return m.Metrics
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*AnonymousMetric)(nil), "protobuf.AnonymousMetric")
+	proto.RegisterType((*AnonymousMetricBatch)(nil), "protobuf.AnonymousMetricBatch")
+}
+
+func init() {
+	proto.RegisterFile("anon_metrics.proto", fileDescriptor_4be044a92fa0408c)
+}
+
+var fileDescriptor_4be044a92fa0408c = []byte{
+	// 201 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x8f, 0xbf, 0x4a, 0xc6, 0x30,
+	0x14, 0xc5, 0x49, 0xff, 0xa8, 0xbd, 0x15, 0x85, 0xd0, 0x21, 0x0e, 0x62, 0xe8, 0x94, 0xa9, 0x83,
+	0x7d, 0x82, 0xba, 0x8a, 0x4b, 0x06, 0xd7, 0x92, 0xd6, 0x88, 0x05, 0x9b, 0x1b, 0x92, 0xb4, 0xe0,
+	0x43, 0xf8, 0xce, 0x1f, 0x5f, 0xda, 0x2e, 0x9d, 0x2e, 0xe7, 0xc7, 0xef, 0xc0, 0x3d, 0x40, 0x95,
+	0x41, 0xd3, 0xcf, 0x3a, 0xb8, 0x69, 0xf4, 0x8d, 0x75, 0x18, 0x90, 0xde, 0xc5, 0x33, 0x2c, 0xdf,
+	0xf5, 0x3f, 0x81, 0xc7, 0xce, 0xa0, 0xf9, 0x9b, 0x71, 0xf1, 0x1f, 0x51, 0xa2, 0x15, 0xe4, 0x7a,
+	0xd5, 0x26, 0x30, 0xc2, 0x89, 0x28, 0xe4, 0x16, 0xae, 0x74, 0x55, 0xbf, 0x8b, 0x66, 0x09, 0x27,
+	0xe2, 0x5e, 0x6e, 0x81, 0xbe, 0x40, 0xa9, 0xac, 0xed, 0x57, 0xed, 0xfc, 0x84, 0x86, 0xa5, 0xb1,
+	0x01, 0xca, 0xda, 0xcf, 0x8d, 0xd0, 0x07, 0x48, 0xd0, 0xb3, 0x2c, 0xf2, 0x04, 0x3d, 0x7d, 0x06,
+	0x18, 0x9d, 0x56, 0x41, 0x7f, 0xf5, 0x2a, 0xb0, 0x9c, 0x13, 0x91, 0xc9, 0x62, 0x27, 0x5d, 0xa8,
+	0xdf, 0xa1, 0x3a, 0xbd, 0xf3, 0xa6, 0xc2, 0xf8, 0x43, 0x5b, 0xb8, 0xdd, 0x27, 0x30, 0xc2, 0x53,
+	0x51, 0xbe, 0x3e, 0x35, 0xc7, 0x86, 0xe6, 0x54, 0x90, 0x87, 0x39, 0xdc, 0x44, 0xa5, 0xbd, 0x0c,
+	0x00, 0xe5, 0x7a, 0x77, 0xf6, 0x03, 0x01, 0x00, 0x00,
+}
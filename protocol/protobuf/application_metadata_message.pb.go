@@ -55,6 +55,21 @@ const (
 	ApplicationMetadataMessage_EDIT_MESSAGE                            ApplicationMetadataMessage_Type = 29
 	ApplicationMetadataMessage_STATUS_UPDATE                           ApplicationMetadataMessage_Type = 30
 	ApplicationMetadataMessage_DELETE_MESSAGE                          ApplicationMetadataMessage_Type = 31
+	ApplicationMetadataMessage_REQUEST_CONTACT_VERIFICATION            ApplicationMetadataMessage_Type = 32
+	ApplicationMetadataMessage_ACCEPT_CONTACT_VERIFICATION             ApplicationMetadataMessage_Type = 33
+	ApplicationMetadataMessage_POLL_VOTE                               ApplicationMetadataMessage_Type = 34
+	ApplicationMetadataMessage_FILE_CHUNK                              ApplicationMetadataMessage_Type = 35
+	ApplicationMetadataMessage_COMMUNITY_MESSAGE_ARCHIVE_REQUEST       ApplicationMetadataMessage_Type = 36
+	ApplicationMetadataMessage_COMMUNITY_MESSAGE_ARCHIVE_RESPONSE      ApplicationMetadataMessage_Type = 37
+	ApplicationMetadataMessage_COMMUNITY_CONTROL_SIGNATURE_REQUEST     ApplicationMetadataMessage_Type = 38
+	ApplicationMetadataMessage_COMMUNITY_CONTROL_SIGNATURE             ApplicationMetadataMessage_Type = 39
+	ApplicationMetadataMessage_COMMUNITY_DIRECTORY_LISTING             ApplicationMetadataMessage_Type = 40
+	ApplicationMetadataMessage_SPAM_BLOCKLIST                          ApplicationMetadataMessage_Type = 41
+	ApplicationMetadataMessage_ANONYMOUS_METRIC_BATCH                  ApplicationMetadataMessage_Type = 42
+	ApplicationMetadataMessage_SOCIAL_RECOVERY_SHARE                   ApplicationMetadataMessage_Type = 43
+	ApplicationMetadataMessage_SOCIAL_RECOVERY_REQUEST                 ApplicationMetadataMessage_Type = 44
+	ApplicationMetadataMessage_SAFE_TRANSACTION_SIGNATURE              ApplicationMetadataMessage_Type = 45
+	ApplicationMetadataMessage_SYNC_VERIFICATION_REQUEST               ApplicationMetadataMessage_Type = 46
 )
 
 var ApplicationMetadataMessage_Type_name = map[int32]string{
@@ -90,6 +105,21 @@ var ApplicationMetadataMessage_Type_name = map[int32]string{
 	29: "EDIT_MESSAGE",
 	30: "STATUS_UPDATE",
 	31: "DELETE_MESSAGE",
+	32: "REQUEST_CONTACT_VERIFICATION",
+	33: "ACCEPT_CONTACT_VERIFICATION",
+	34: "POLL_VOTE",
+	35: "FILE_CHUNK",
+	36: "COMMUNITY_MESSAGE_ARCHIVE_REQUEST",
+	37: "COMMUNITY_MESSAGE_ARCHIVE_RESPONSE",
+	38: "COMMUNITY_CONTROL_SIGNATURE_REQUEST",
+	39: "COMMUNITY_CONTROL_SIGNATURE",
+	40: "COMMUNITY_DIRECTORY_LISTING",
+	41: "SPAM_BLOCKLIST",
+	42: "ANONYMOUS_METRIC_BATCH",
+	43: "SOCIAL_RECOVERY_SHARE",
+	44: "SOCIAL_RECOVERY_REQUEST",
+	45: "SAFE_TRANSACTION_SIGNATURE",
+	46: "SYNC_VERIFICATION_REQUEST",
 }
 
 var ApplicationMetadataMessage_Type_value = map[string]int32{
@@ -125,6 +155,21 @@ var ApplicationMetadataMessage_Type_value = map[string]int32{
 	"EDIT_MESSAGE":                            29,
 	"STATUS_UPDATE":                           30,
 	"DELETE_MESSAGE":                          31,
+	"REQUEST_CONTACT_VERIFICATION":            32,
+	"ACCEPT_CONTACT_VERIFICATION":             33,
+	"POLL_VOTE":                               34,
+	"FILE_CHUNK":                              35,
+	"COMMUNITY_MESSAGE_ARCHIVE_REQUEST":       36,
+	"COMMUNITY_MESSAGE_ARCHIVE_RESPONSE":      37,
+	"COMMUNITY_CONTROL_SIGNATURE_REQUEST":     38,
+	"COMMUNITY_CONTROL_SIGNATURE":             39,
+	"COMMUNITY_DIRECTORY_LISTING":             40,
+	"SPAM_BLOCKLIST":                          41,
+	"ANONYMOUS_METRIC_BATCH":                  42,
+	"SOCIAL_RECOVERY_SHARE":                   43,
+	"SOCIAL_RECOVERY_REQUEST":                 44,
+	"SAFE_TRANSACTION_SIGNATURE":              45,
+	"SYNC_VERIFICATION_REQUEST":               46,
 }
 
 func (x ApplicationMetadataMessage_Type) String() string {
@@ -203,43 +248,54 @@ func init() {
 }
 
 var fileDescriptor_ad09a6406fcf24c7 = []byte{
-	// 594 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x5d, 0x53, 0x13, 0x31,
-	0x14, 0xb5, 0x80, 0x14, 0x2e, 0x5f, 0xe1, 0x02, 0x52, 0xbe, 0x0a, 0x56, 0x47, 0x51, 0x67, 0xca,
-	0x8c, 0x3e, 0xfb, 0x10, 0xb2, 0x17, 0x08, 0x76, 0xb3, 0x4b, 0x92, 0xc5, 0xa9, 0x2f, 0x99, 0x05,
-	0x2a, 0x76, 0x06, 0x68, 0x07, 0x96, 0x07, 0xfe, 0x81, 0xbf, 0xc2, 0xdf, 0xea, 0x64, 0xdb, 0xd2,
-	0x22, 0x45, 0x9e, 0x76, 0x73, 0xce, 0xb9, 0x37, 0xb9, 0x27, 0x39, 0x50, 0x49, 0xdb, 0xed, 0x8b,
-	0xe6, 0x69, 0x9a, 0x35, 0x5b, 0x57, 0xee, 0xb2, 0x91, 0xa5, 0x67, 0x69, 0x96, 0xba, 0xcb, 0xc6,
-	0xcd, 0x4d, 0x7a, 0xde, 0xa8, 0xb6, 0xaf, 0x5b, 0x59, 0x0b, 0x27, 0xf2, 0xcf, 0xc9, 0xed, 0xcf,
-	0xca, 0x9f, 0x09, 0x58, 0xe5, 0xfd, 0x82, 0xb0, 0xab, 0x0f, 0x3b, 0x72, 0x5c, 0x87, 0xc9, 0x9b,
-	0xe6, 0xf9, 0x55, 0x9a, 0xdd, 0x5e, 0x37, 0x4a, 0x85, 0xad, 0xc2, 0xf6, 0xb4, 0xee, 0x03, 0x58,
-	0x82, 0x62, 0x3b, 0xbd, 0xbb, 0x68, 0xa5, 0x67, 0xa5, 0x91, 0x9c, 0xeb, 0x2d, 0xf1, 0x2b, 0x8c,
-	0x65, 0x77, 0xed, 0x46, 0x69, 0x74, 0xab, 0xb0, 0x3d, 0xfb, 0xf9, 0x43, 0xb5, 0xb7, 0x5f, 0xf5,
-	0xe9, 0xbd, 0xaa, 0xf6, 0xae, 0xdd, 0xd0, 0x79, 0x59, 0xe5, 0x77, 0x11, 0xc6, 0xfc, 0x12, 0xa7,
-	0xa0, 0x98, 0xa8, 0x6f, 0x2a, 0xfa, 0xae, 0xd8, 0x0b, 0x64, 0x30, 0x2d, 0x0e, 0xb8, 0x75, 0x21,
-	0x19, 0xc3, 0xf7, 0x89, 0x15, 0x10, 0x61, 0x56, 0x44, 0xca, 0x72, 0x61, 0x5d, 0x12, 0x07, 0xdc,
-	0x12, 0x1b, 0xc1, 0x0d, 0x58, 0x09, 0x29, 0xdc, 0x25, 0x6d, 0x0e, 0x64, 0xdc, 0x85, 0xef, 0x4b,
-	0x46, 0x71, 0x09, 0xe6, 0x63, 0x2e, 0xb5, 0x93, 0xca, 0x58, 0x5e, 0xab, 0x71, 0x2b, 0x23, 0xc5,
-	0xc6, 0x3c, 0x6c, 0xea, 0x4a, 0x3c, 0x84, 0x5f, 0xe2, 0x1b, 0xd8, 0xd4, 0x74, 0x94, 0x90, 0xb1,
-	0x8e, 0x07, 0x81, 0x26, 0x63, 0xdc, 0x5e, 0xa4, 0x9d, 0xd5, 0x5c, 0x19, 0x2e, 0x72, 0xd1, 0x38,
-	0x7e, 0x84, 0x77, 0x5c, 0x08, 0x8a, 0xad, 0x7b, 0x4e, 0x5b, 0xc4, 0x4f, 0xf0, 0x3e, 0x20, 0x51,
-	0x93, 0x8a, 0x9e, 0x15, 0x4f, 0xe0, 0x32, 0x2c, 0xf4, 0x44, 0x83, 0xc4, 0x24, 0x2e, 0x02, 0x33,
-	0xa4, 0x82, 0x07, 0x28, 0xe0, 0x26, 0xac, 0xfd, 0xdb, 0x7b, 0x50, 0x30, 0xe5, 0xad, 0x79, 0x34,
-	0xa4, 0xeb, 0x1a, 0xc8, 0xa6, 0x87, 0xd3, 0x5c, 0x88, 0x28, 0x51, 0x96, 0xcd, 0xe0, 0x6b, 0xd8,
-	0x78, 0x4c, 0xc7, 0xc9, 0x6e, 0x4d, 0x0a, 0xe7, 0xef, 0x85, 0xcd, 0x62, 0x19, 0x56, 0x7b, 0xf7,
-	0x21, 0xa2, 0x80, 0x1c, 0x0f, 0x8e, 0x49, 0x5b, 0x69, 0x28, 0x24, 0x65, 0xd9, 0x1c, 0x56, 0xa0,
-	0x1c, 0x27, 0xe6, 0xc0, 0xa9, 0xc8, 0xca, 0x3d, 0x29, 0x3a, 0x2d, 0x34, 0xed, 0x4b, 0x63, 0x75,
-	0xc7, 0x72, 0xe6, 0x1d, 0xfa, 0xbf, 0xc6, 0x69, 0x32, 0x71, 0xa4, 0x0c, 0xb1, 0x79, 0x5c, 0x83,
-	0xe5, 0xc7, 0xe2, 0xa3, 0x84, 0x74, 0x9d, 0x21, 0xbe, 0x85, 0xad, 0x27, 0xc8, 0x7e, 0x8b, 0x05,
-	0x3f, 0xf5, 0xb0, 0xfd, 0x72, 0xff, 0xd8, 0xa2, 0x1f, 0x69, 0x18, 0xdd, 0x2d, 0x5f, 0xf2, 0x4f,
-	0x90, 0xc2, 0xe8, 0x50, 0x3a, 0x4d, 0x5d, 0x9f, 0x5f, 0xe1, 0x0a, 0x2c, 0xed, 0xeb, 0x28, 0x89,
-	0x73, 0x5b, 0x9c, 0x54, 0xc7, 0xd2, 0x76, 0xa6, 0x5b, 0xc6, 0x79, 0x98, 0xe9, 0x80, 0x01, 0x29,
-	0x2b, 0x6d, 0x9d, 0x95, 0xbc, 0x5a, 0x44, 0x61, 0x98, 0x28, 0x69, 0xeb, 0x2e, 0x20, 0x23, 0xb4,
-	0x8c, 0x73, 0xf5, 0x0a, 0x96, 0x60, 0xb1, 0x4f, 0x0d, 0xf4, 0x59, 0xf5, 0xa7, 0xee, 0x33, 0xf7,
-	0xb7, 0x1d, 0xb9, 0xc3, 0x48, 0x2a, 0xb6, 0x86, 0x73, 0x30, 0x15, 0x4b, 0x75, 0xff, 0xec, 0xd7,
-	0x7d, 0x76, 0x28, 0x90, 0xfd, 0xec, 0x6c, 0xf8, 0x93, 0x18, 0xcb, 0x6d, 0x62, 0x7a, 0xd1, 0x29,
-	0xfb, 0x59, 0x02, 0xaa, 0xd1, 0x40, 0x5e, 0x36, 0x77, 0xcb, 0x3f, 0xd6, 0xcf, 0x9b, 0xd9, 0xaf,
-	0xdb, 0x93, 0xea, 0x69, 0xeb, 0x72, 0x27, 0xcf, 0xf1, 0x69, 0xeb, 0x62, 0xa7, 0x17, 0xe8, 0x93,
-	0xf1, 0xfc, 0xef, 0xcb, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xec, 0x5b, 0x99, 0xc1, 0x77, 0x04,
-	0x00, 0x00,
+	// 777 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xdb, 0x52, 0x1b, 0x47,
+	0x10, 0x0d, 0x36, 0x31, 0xa6, 0xb9, 0x78, 0x68, 0x83, 0x11, 0x77, 0x21, 0x6c, 0xc0, 0x76, 0x22,
+	0x57, 0x25, 0xcf, 0x79, 0x18, 0xcd, 0x36, 0xd2, 0x98, 0xdd, 0x99, 0xf5, 0xcc, 0xac, 0x52, 0xca,
+	0xcb, 0x94, 0xc0, 0x0a, 0xa1, 0x0a, 0x2c, 0x95, 0x11, 0x0f, 0x7c, 0x63, 0xbe, 0x22, 0x7f, 0x92,
+	0x9a, 0x45, 0xab, 0x15, 0x06, 0xe3, 0x27, 0x69, 0xfa, 0x9c, 0xbe, 0x9d, 0xee, 0x5e, 0xa8, 0x75,
+	0x07, 0x83, 0x8b, 0xf3, 0xd3, 0xee, 0xf0, 0xbc, 0xff, 0xc5, 0x5f, 0xf6, 0x86, 0xdd, 0xcf, 0xdd,
+	0x61, 0xd7, 0x5f, 0xf6, 0xae, 0xae, 0xba, 0x67, 0xbd, 0xfa, 0xe0, 0x6b, 0x7f, 0xd8, 0xc7, 0xe7,
+	0xf9, 0xcf, 0xc9, 0xf5, 0xdf, 0xb5, 0xff, 0xe6, 0x60, 0x9d, 0x97, 0x0e, 0xc9, 0x88, 0x9f, 0xdc,
+	0xd2, 0x71, 0x13, 0x66, 0xaf, 0xce, 0xcf, 0xbe, 0x74, 0x87, 0xd7, 0x5f, 0x7b, 0x95, 0xa9, 0xea,
+	0xd4, 0xe1, 0xbc, 0x29, 0x0d, 0x58, 0x81, 0x99, 0x41, 0xf7, 0xe6, 0xa2, 0xdf, 0xfd, 0x5c, 0x79,
+	0x92, 0x63, 0xc5, 0x13, 0xff, 0x80, 0xe9, 0xe1, 0xcd, 0xa0, 0x57, 0x79, 0x5a, 0x9d, 0x3a, 0x5c,
+	0xfc, 0xed, 0x6d, 0xbd, 0xc8, 0x57, 0xff, 0x7e, 0xae, 0xba, 0xbb, 0x19, 0xf4, 0x4c, 0xee, 0x56,
+	0xfb, 0x17, 0x60, 0x3a, 0x3c, 0x71, 0x0e, 0x66, 0x32, 0x75, 0xac, 0xf4, 0x9f, 0x8a, 0xfd, 0x84,
+	0x0c, 0xe6, 0x45, 0x8b, 0x3b, 0x9f, 0x90, 0xb5, 0xbc, 0x49, 0x6c, 0x0a, 0x11, 0x16, 0x85, 0x56,
+	0x8e, 0x0b, 0xe7, 0xb3, 0x34, 0xe2, 0x8e, 0xd8, 0x13, 0xdc, 0x82, 0xb5, 0x84, 0x92, 0x06, 0x19,
+	0xdb, 0x92, 0xe9, 0xc8, 0x3c, 0x76, 0x79, 0x8a, 0x2b, 0xb0, 0x94, 0x72, 0x69, 0xbc, 0x54, 0xd6,
+	0xf1, 0x38, 0xe6, 0x4e, 0x6a, 0xc5, 0xa6, 0x83, 0xd9, 0x76, 0x94, 0xb8, 0x6b, 0xfe, 0x19, 0xf7,
+	0x60, 0xc7, 0xd0, 0xa7, 0x8c, 0xac, 0xf3, 0x3c, 0x8a, 0x0c, 0x59, 0xeb, 0x8f, 0xb4, 0xf1, 0xce,
+	0x70, 0x65, 0xb9, 0xc8, 0x49, 0xcf, 0xf0, 0x1d, 0xec, 0x73, 0x21, 0x28, 0x75, 0xfe, 0x47, 0xdc,
+	0x19, 0x7c, 0x0f, 0x07, 0x11, 0x89, 0x58, 0x2a, 0xfa, 0x21, 0xf9, 0x39, 0xae, 0xc2, 0xcb, 0x82,
+	0x34, 0x09, 0xcc, 0xe2, 0x32, 0x30, 0x4b, 0x2a, 0xba, 0x63, 0x05, 0xdc, 0x81, 0x8d, 0x6f, 0x63,
+	0x4f, 0x12, 0xe6, 0x82, 0x34, 0xf7, 0x9a, 0xf4, 0x23, 0x01, 0xd9, 0xfc, 0xc3, 0x30, 0x17, 0x42,
+	0x67, 0xca, 0xb1, 0x05, 0xdc, 0x85, 0xad, 0xfb, 0x70, 0x9a, 0x35, 0x62, 0x29, 0x7c, 0x98, 0x0b,
+	0x5b, 0xc4, 0x6d, 0x58, 0x2f, 0xe6, 0x21, 0x74, 0x44, 0x9e, 0x47, 0x6d, 0x32, 0x4e, 0x5a, 0x4a,
+	0x48, 0x39, 0xf6, 0x02, 0x6b, 0xb0, 0x9d, 0x66, 0xb6, 0xe5, 0x95, 0x76, 0xf2, 0x48, 0x8a, 0xdb,
+	0x10, 0x86, 0x9a, 0xd2, 0x3a, 0x93, 0x3f, 0x18, 0x0b, 0x0a, 0x3d, 0xce, 0xf1, 0x86, 0x6c, 0xaa,
+	0x95, 0x25, 0xb6, 0x84, 0x1b, 0xb0, 0x7a, 0x9f, 0xfc, 0x29, 0x23, 0xd3, 0x61, 0x88, 0xaf, 0xa1,
+	0xfa, 0x1d, 0xb0, 0x0c, 0xf1, 0x32, 0x74, 0xfd, 0x50, 0xbe, 0x5c, 0x3f, 0xb6, 0x1c, 0x5a, 0x7a,
+	0x08, 0x1e, 0xb9, 0xaf, 0x84, 0x15, 0xa4, 0x44, 0x7f, 0x94, 0xde, 0xd0, 0x48, 0xe7, 0x57, 0xb8,
+	0x06, 0x2b, 0x4d, 0xa3, 0xb3, 0x34, 0x97, 0xc5, 0x4b, 0xd5, 0x96, 0xee, 0xb6, 0xbb, 0x55, 0x5c,
+	0x82, 0x85, 0x5b, 0x63, 0x44, 0xca, 0x49, 0xd7, 0x61, 0x95, 0xc0, 0x16, 0x3a, 0x49, 0x32, 0x25,
+	0x5d, 0xc7, 0x47, 0x64, 0x85, 0x91, 0x69, 0xce, 0x5e, 0xc3, 0x0a, 0x2c, 0x97, 0xd0, 0x44, 0x9c,
+	0xf5, 0x50, 0x75, 0x89, 0x8c, 0xa7, 0xad, 0xfd, 0x47, 0x2d, 0x15, 0xdb, 0xc0, 0x17, 0x30, 0x97,
+	0x4a, 0x35, 0x5e, 0xfb, 0xcd, 0x70, 0x3b, 0x14, 0xc9, 0xf2, 0x76, 0xb6, 0x42, 0x25, 0xd6, 0x71,
+	0x97, 0xd9, 0xe2, 0x74, 0xb6, 0x43, 0x2f, 0x11, 0xc5, 0x34, 0x71, 0x2f, 0x3b, 0x58, 0x85, 0xcd,
+	0x22, 0x7c, 0x31, 0xda, 0x36, 0x99, 0xb1, 0x14, 0xac, 0x1a, 0xd6, 0x6e, 0xb4, 0xfe, 0x0f, 0x12,
+	0x76, 0x71, 0x01, 0x66, 0x53, 0x1d, 0xc7, 0xbe, 0xad, 0x1d, 0xb1, 0x1a, 0x2e, 0x02, 0x1c, 0xc9,
+	0x98, 0xbc, 0x68, 0x65, 0xea, 0x98, 0xed, 0xe1, 0x1b, 0xd8, 0x2d, 0x5b, 0x19, 0x25, 0xf6, 0xdc,
+	0x88, 0x96, 0x6c, 0x8f, 0x17, 0x99, 0xbd, 0xc6, 0x7d, 0xa8, 0x3d, 0x46, 0x1b, 0x0d, 0xe4, 0x0d,
+	0x1e, 0xc0, 0x5e, 0xc9, 0x0b, 0x15, 0x19, 0x1d, 0x7b, 0x2b, 0x9b, 0x8a, 0xbb, 0xcc, 0x94, 0x01,
+	0xf7, 0x43, 0xdd, 0x8f, 0x10, 0xd9, 0xc1, 0x5d, 0x42, 0x24, 0x0d, 0x09, 0xa7, 0x4d, 0xc7, 0xc7,
+	0xd2, 0x3a, 0xa9, 0x9a, 0xec, 0x30, 0xe8, 0x65, 0x53, 0x9e, 0xf8, 0x46, 0xac, 0xc5, 0x71, 0x30,
+	0xb3, 0xb7, 0xb8, 0x0e, 0xaf, 0xb8, 0xd2, 0xaa, 0x93, 0xe8, 0xcc, 0xfa, 0x84, 0x9c, 0x91, 0xc2,
+	0x37, 0xb8, 0x13, 0x2d, 0xf6, 0x2e, 0x4c, 0xda, 0x6a, 0x21, 0x79, 0xec, 0x0d, 0x09, 0xdd, 0x0e,
+	0x8b, 0x68, 0x5b, 0xdc, 0x10, 0x7b, 0x1f, 0x16, 0xf9, 0x5b, 0xa8, 0xa8, 0xf4, 0x97, 0xb0, 0x83,
+	0x96, 0x1f, 0xd1, 0xe4, 0x35, 0x4f, 0x14, 0xfa, 0xeb, 0xf8, 0x70, 0x27, 0x75, 0x1f, 0xbb, 0xd7,
+	0x1b, 0xdb, 0x7f, 0x6d, 0x9e, 0x9d, 0x0f, 0xff, 0xb9, 0x3e, 0xa9, 0x9f, 0xf6, 0x2f, 0x3f, 0xe4,
+	0x9f, 0xe2, 0xd3, 0xfe, 0xc5, 0x87, 0xe2, 0x9b, 0x7c, 0xf2, 0x2c, 0xff, 0xf7, 0xfb, 0xff, 0x03,
+	0x00, 0x84, 0xc4, 0x03, 0xac, 0x3a, 0x06, 0x00, 0x00,
 }
@@ -63,7 +63,10 @@ const (
 	ChatMessage_AUDIO                                ChatMessage_ContentType = 8
 	ChatMessage_COMMUNITY                            ChatMessage_ContentType = 9
 	// Only local
-	ChatMessage_SYSTEM_MESSAGE_GAP ChatMessage_ContentType = 10
+	ChatMessage_SYSTEM_MESSAGE_GAP        ChatMessage_ContentType = 10
+	ChatMessage_POLL                      ChatMessage_ContentType = 11
+	ChatMessage_FILE                      ChatMessage_ContentType = 12
+	ChatMessage_SAFE_TRANSACTION_PROPOSAL ChatMessage_ContentType = 13
 )
 
 var ChatMessage_ContentType_name = map[int32]string{
@@ -78,6 +81,9 @@ var ChatMessage_ContentType_name = map[int32]string{
 	8:  "AUDIO",
 	9:  "COMMUNITY",
 	10: "SYSTEM_MESSAGE_GAP",
+	11: "POLL",
+	12: "FILE",
+	13: "SAFE_TRANSACTION_PROPOSAL",
 }
 
 var ChatMessage_ContentType_value = map[string]int32{
@@ -92,6 +98,9 @@ var ChatMessage_ContentType_value = map[string]int32{
 	"AUDIO":                                8,
 	"COMMUNITY":                            9,
 	"SYSTEM_MESSAGE_GAP":                   10,
+	"POLL":                                 11,
+	"FILE":                                 12,
+	"SAFE_TRANSACTION_PROPOSAL":            13,
 }
 
 func (x ChatMessage_ContentType) String() string {
@@ -99,7 +108,7 @@ func (x ChatMessage_ContentType) String() string {
 }
 
 func (ChatMessage_ContentType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_263952f55fd35689, []int{5, 0}
+	return fileDescriptor_263952f55fd35689, []int{12, 0}
 }
 
 type StickerMessage struct {
@@ -251,6 +260,639 @@ func (m *AudioMessage) GetDurationMs() uint64 {
 	return 0
 }
 
+type FileMessage struct {
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	MimeType string `protobuf:"bytes,2,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Size     uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// sha256 of the content of the file
+	Hash []byte `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Number of FileChunk messages the content is sent in
+	ChunksCount          uint32   `protobuf:"varint,5,opt,name=chunks_count,json=chunksCount,proto3" json:"chunks_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FileMessage) Reset()         { *m = FileMessage{} }
+func (m *FileMessage) String() string { return proto.CompactTextString(m) }
+func (*FileMessage) ProtoMessage()    {}
+func (*FileMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_263952f55fd35689, []int{3}
+}
+
+func (m *FileMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FileMessage.Unmarshal(m, b)
+}
+func (m *FileMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FileMessage.Marshal(b, m, deterministic)
+}
+func (m *FileMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileMessage.Merge(m, src)
+}
+func (m *FileMessage) XXX_Size() int {
+	return xxx_messageInfo_FileMessage.Size(m)
+}
+func (m *FileMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileMessage proto.InternalMessageInfo
+
+func (m *FileMessage) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *FileMessage) GetMimeType() string {
+	if m != nil {
+		return m.MimeType
+	}
+	return ""
+}
+
+func (m *FileMessage) GetSize() uint64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *FileMessage) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *FileMessage) GetChunksCount() uint32 {
+	if m != nil {
+		return m.ChunksCount
+	}
+	return 0
+}
+
+type FileChunk struct {
+	Clock  uint64 `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	ChatId string `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	// Id of the message the file is attached to
+	MessageId   string `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Index       uint32 `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	ChunksCount uint32 `protobuf:"varint,5,opt,name=chunks_count,json=chunksCount,proto3" json:"chunks_count,omitempty"`
+	Payload     []byte `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Grant for community file chunks
+	Grant []byte `protobuf:"bytes,7,opt,name=grant,proto3" json:"grant,omitempty"`
+	// The type of message (public/one-to-one/private-group-chat)
+	MessageType          MessageType `protobuf:"varint,8,opt,name=message_type,json=messageType,proto3,enum=protobuf.MessageType" json:"message_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *FileChunk) Reset()         { *m = FileChunk{} }
+func (m *FileChunk) String() string { return proto.CompactTextString(m) }
+func (*FileChunk) ProtoMessage()    {}
+func (*FileChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_263952f55fd35689, []int{4}
+}
+
+func (m *FileChunk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FileChunk.Unmarshal(m, b)
+}
+func (m *FileChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FileChunk.Marshal(b, m, deterministic)
+}
+func (m *FileChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileChunk.Merge(m, src)
+}
+func (m *FileChunk) XXX_Size() int {
+	return xxx_messageInfo_FileChunk.Size(m)
+}
+func (m *FileChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileChunk proto.InternalMessageInfo
+
+func (m *FileChunk) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *FileChunk) GetChatId() string {
+	if m != nil {
+		return m.ChatId
+	}
+	return ""
+}
+
+func (m *FileChunk) GetMessageId() string {
+	if m != nil {
+		return m.MessageId
+	}
+	return ""
+}
+
+func (m *FileChunk) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *FileChunk) GetChunksCount() uint32 {
+	if m != nil {
+		return m.ChunksCount
+	}
+	return 0
+}
+
+func (m *FileChunk) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *FileChunk) GetGrant() []byte {
+	if m != nil {
+		return m.Grant
+	}
+	return nil
+}
+
+func (m *FileChunk) GetMessageType() MessageType {
+	if m != nil {
+		return m.MessageType
+	}
+	return MessageType_UNKNOWN_MESSAGE_TYPE
+}
+
+type LinkPreview struct {
+	Url         string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Site        string `protobuf:"bytes,2,opt,name=site,proto3" json:"site,omitempty"`
+	Title       string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// Thumbnail resized by the sender, so that receivers don't
+	// need to fetch anything from the linked site
+	ThumbnailPayload     []byte   `protobuf:"bytes,5,opt,name=thumbnail_payload,json=thumbnailPayload,proto3" json:"thumbnail_payload,omitempty"`
+	ThumbnailWidth       uint32   `protobuf:"varint,6,opt,name=thumbnail_width,json=thumbnailWidth,proto3" json:"thumbnail_width,omitempty"`
+	ThumbnailHeight      uint32   `protobuf:"varint,7,opt,name=thumbnail_height,json=thumbnailHeight,proto3" json:"thumbnail_height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LinkPreview) Reset()         { *m = LinkPreview{} }
+func (m *LinkPreview) String() string { return proto.CompactTextString(m) }
+func (*LinkPreview) ProtoMessage()    {}
+func (*LinkPreview) Descriptor() ([]byte, []int) {
+	return fileDescriptor_263952f55fd35689, []int{5}
+}
+
+func (m *LinkPreview) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LinkPreview.Unmarshal(m, b)
+}
+func (m *LinkPreview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LinkPreview.Marshal(b, m, deterministic)
+}
+func (m *LinkPreview) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LinkPreview.Merge(m, src)
+}
+func (m *LinkPreview) XXX_Size() int {
+	return xxx_messageInfo_LinkPreview.Size(m)
+}
+func (m *LinkPreview) XXX_DiscardUnknown() {
+	xxx_messageInfo_LinkPreview.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LinkPreview proto.InternalMessageInfo
+
+func (m *LinkPreview) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *LinkPreview) GetSite() string {
+	if m != nil {
+		return m.Site
+	}
+	return ""
+}
+
+func (m *LinkPreview) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *LinkPreview) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *LinkPreview) GetThumbnailPayload() []byte {
+	if m != nil {
+		return m.ThumbnailPayload
+	}
+	return nil
+}
+
+func (m *LinkPreview) GetThumbnailWidth() uint32 {
+	if m != nil {
+		return m.ThumbnailWidth
+	}
+	return 0
+}
+
+func (m *LinkPreview) GetThumbnailHeight() uint32 {
+	if m != nil {
+		return m.ThumbnailHeight
+	}
+	return 0
+}
+
+type PollMessage struct {
+	// The question being asked
+	Question string `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`
+	// The options voters can choose from
+	Options []string `protobuf:"bytes,2,rep,name=options,proto3" json:"options,omitempty"`
+	// Whether voters can pick more than one option
+	MultipleChoice bool `protobuf:"varint,3,opt,name=multiple_choice,json=multipleChoice,proto3" json:"multiple_choice,omitempty"`
+	// Unix timestamp in milliseconds after which votes are not accepted,
+	// 0 if the poll never closes
+	EndTime              uint64   `protobuf:"varint,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PollMessage) Reset()         { *m = PollMessage{} }
+func (m *PollMessage) String() string { return proto.CompactTextString(m) }
+func (*PollMessage) ProtoMessage()    {}
+func (*PollMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_263952f55fd35689, []int{6}
+}
+
+func (m *PollMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PollMessage.Unmarshal(m, b)
+}
+func (m *PollMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PollMessage.Marshal(b, m, deterministic)
+}
+func (m *PollMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PollMessage.Merge(m, src)
+}
+func (m *PollMessage) XXX_Size() int {
+	return xxx_messageInfo_PollMessage.Size(m)
+}
+func (m *PollMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_PollMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PollMessage proto.InternalMessageInfo
+
+func (m *PollMessage) GetQuestion() string {
+	if m != nil {
+		return m.Question
+	}
+	return ""
+}
+
+func (m *PollMessage) GetOptions() []string {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *PollMessage) GetMultipleChoice() bool {
+	if m != nil {
+		return m.MultipleChoice
+	}
+	return false
+}
+
+func (m *PollMessage) GetEndTime() uint64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+type PollVote struct {
+	Clock  uint64 `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	ChatId string `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	// Id of the poll message
+	MessageId string `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	// Indexes of the chosen options, empty to retract the vote
+	Options []uint32 `protobuf:"varint,4,rep,packed,name=options,proto3" json:"options,omitempty"`
+	// Grant for community poll votes
+	Grant []byte `protobuf:"bytes,5,opt,name=grant,proto3" json:"grant,omitempty"`
+	// The type of message (public/one-to-one/private-group-chat)
+	MessageType          MessageType `protobuf:"varint,6,opt,name=message_type,json=messageType,proto3,enum=protobuf.MessageType" json:"message_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *PollVote) Reset()         { *m = PollVote{} }
+func (m *PollVote) String() string { return proto.CompactTextString(m) }
+func (*PollVote) ProtoMessage()    {}
+func (*PollVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_263952f55fd35689, []int{7}
+}
+
+func (m *PollVote) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PollVote.Unmarshal(m, b)
+}
+func (m *PollVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PollVote.Marshal(b, m, deterministic)
+}
+func (m *PollVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PollVote.Merge(m, src)
+}
+func (m *PollVote) XXX_Size() int {
+	return xxx_messageInfo_PollVote.Size(m)
+}
+func (m *PollVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_PollVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PollVote proto.InternalMessageInfo
+
+func (m *PollVote) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *PollVote) GetChatId() string {
+	if m != nil {
+		return m.ChatId
+	}
+	return ""
+}
+
+func (m *PollVote) GetMessageId() string {
+	if m != nil {
+		return m.MessageId
+	}
+	return ""
+}
+
+func (m *PollVote) GetOptions() []uint32 {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *PollVote) GetGrant() []byte {
+	if m != nil {
+		return m.Grant
+	}
+	return nil
+}
+
+func (m *PollVote) GetMessageType() MessageType {
+	if m != nil {
+		return m.MessageType
+	}
+	return MessageType_UNKNOWN_MESSAGE_TYPE
+}
+
+type SafeTransactionProposal struct {
+	ChainId uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Address of the Safe contract executing the transaction
+	Safe string `protobuf:"bytes,2,opt,name=safe,proto3" json:"safe,omitempty"`
+	To   string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	// Amounts and gas values are decimal strings
+	Value string `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Data  []byte `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	// 0 for a call, 1 for a delegate call
+	Operation      uint32 `protobuf:"varint,6,opt,name=operation,proto3" json:"operation,omitempty"`
+	SafeTxGas      string `protobuf:"bytes,7,opt,name=safe_tx_gas,json=safeTxGas,proto3" json:"safe_tx_gas,omitempty"`
+	BaseGas        string `protobuf:"bytes,8,opt,name=base_gas,json=baseGas,proto3" json:"base_gas,omitempty"`
+	GasPrice       string `protobuf:"bytes,9,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	GasToken       string `protobuf:"bytes,10,opt,name=gas_token,json=gasToken,proto3" json:"gas_token,omitempty"`
+	RefundReceiver string `protobuf:"bytes,11,opt,name=refund_receiver,json=refundReceiver,proto3" json:"refund_receiver,omitempty"`
+	Nonce          string `protobuf:"bytes,12,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// Signature of the proposer over the EIP-712 Safe transaction hash
+	Signature            []byte   `protobuf:"bytes,13,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SafeTransactionProposal) Reset()         { *m = SafeTransactionProposal{} }
+func (m *SafeTransactionProposal) String() string { return proto.CompactTextString(m) }
+func (*SafeTransactionProposal) ProtoMessage()    {}
+func (*SafeTransactionProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_263952f55fd35689, []int{8}
+}
+
+func (m *SafeTransactionProposal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SafeTransactionProposal.Unmarshal(m, b)
+}
+func (m *SafeTransactionProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SafeTransactionProposal.Marshal(b, m, deterministic)
+}
+func (m *SafeTransactionProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SafeTransactionProposal.Merge(m, src)
+}
+func (m *SafeTransactionProposal) XXX_Size() int {
+	return xxx_messageInfo_SafeTransactionProposal.Size(m)
+}
+func (m *SafeTransactionProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SafeTransactionProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SafeTransactionProposal proto.InternalMessageInfo
+
+func (m *SafeTransactionProposal) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *SafeTransactionProposal) GetSafe() string {
+	if m != nil {
+		return m.Safe
+	}
+	return ""
+}
+
+func (m *SafeTransactionProposal) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *SafeTransactionProposal) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *SafeTransactionProposal) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *SafeTransactionProposal) GetOperation() uint32 {
+	if m != nil {
+		return m.Operation
+	}
+	return 0
+}
+
+func (m *SafeTransactionProposal) GetSafeTxGas() string {
+	if m != nil {
+		return m.SafeTxGas
+	}
+	return ""
+}
+
+func (m *SafeTransactionProposal) GetBaseGas() string {
+	if m != nil {
+		return m.BaseGas
+	}
+	return ""
+}
+
+func (m *SafeTransactionProposal) GetGasPrice() string {
+	if m != nil {
+		return m.GasPrice
+	}
+	return ""
+}
+
+func (m *SafeTransactionProposal) GetGasToken() string {
+	if m != nil {
+		return m.GasToken
+	}
+	return ""
+}
+
+func (m *SafeTransactionProposal) GetRefundReceiver() string {
+	if m != nil {
+		return m.RefundReceiver
+	}
+	return ""
+}
+
+func (m *SafeTransactionProposal) GetNonce() string {
+	if m != nil {
+		return m.Nonce
+	}
+	return ""
+}
+
+func (m *SafeTransactionProposal) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type SafeTransactionSignature struct {
+	Clock  uint64 `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	ChatId string `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	// Id of the proposal message
+	MessageId string `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	// Signature of an owner over the EIP-712 Safe transaction hash
+	Signature []byte `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	// Grant for community signatures
+	Grant []byte `protobuf:"bytes,5,opt,name=grant,proto3" json:"grant,omitempty"`
+	// The type of message (public/one-to-one/private-group-chat)
+	MessageType          MessageType `protobuf:"varint,6,opt,name=message_type,json=messageType,proto3,enum=protobuf.MessageType" json:"message_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *SafeTransactionSignature) Reset()         { *m = SafeTransactionSignature{} }
+func (m *SafeTransactionSignature) String() string { return proto.CompactTextString(m) }
+func (*SafeTransactionSignature) ProtoMessage()    {}
+func (*SafeTransactionSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_263952f55fd35689, []int{9}
+}
+
+func (m *SafeTransactionSignature) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SafeTransactionSignature.Unmarshal(m, b)
+}
+func (m *SafeTransactionSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SafeTransactionSignature.Marshal(b, m, deterministic)
+}
+func (m *SafeTransactionSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SafeTransactionSignature.Merge(m, src)
+}
+func (m *SafeTransactionSignature) XXX_Size() int {
+	return xxx_messageInfo_SafeTransactionSignature.Size(m)
+}
+func (m *SafeTransactionSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_SafeTransactionSignature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SafeTransactionSignature proto.InternalMessageInfo
+
+func (m *SafeTransactionSignature) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *SafeTransactionSignature) GetChatId() string {
+	if m != nil {
+		return m.ChatId
+	}
+	return ""
+}
+
+func (m *SafeTransactionSignature) GetMessageId() string {
+	if m != nil {
+		return m.MessageId
+	}
+	return ""
+}
+
+func (m *SafeTransactionSignature) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *SafeTransactionSignature) GetGrant() []byte {
+	if m != nil {
+		return m.Grant
+	}
+	return nil
+}
+
+func (m *SafeTransactionSignature) GetMessageType() MessageType {
+	if m != nil {
+		return m.MessageType
+	}
+	return MessageType_UNKNOWN_MESSAGE_TYPE
+}
+
 type EditMessage struct {
 	Clock uint64 `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
 	// Text of the message
@@ -270,7 +912,7 @@ func (m *EditMessage) Reset()         { *m = EditMessage{} }
 func (m *EditMessage) String() string { return proto.CompactTextString(m) }
 func (*EditMessage) ProtoMessage()    {}
 func (*EditMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_263952f55fd35689, []int{3}
+	return fileDescriptor_263952f55fd35689, []int{10}
 }
 
 func (m *EditMessage) XXX_Unmarshal(b []byte) error {
@@ -350,7 +992,7 @@ func (m *DeleteMessage) Reset()         { *m = DeleteMessage{} }
 func (m *DeleteMessage) String() string { return proto.CompactTextString(m) }
 func (*DeleteMessage) ProtoMessage()    {}
 func (*DeleteMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_263952f55fd35689, []int{4}
+	return fileDescriptor_263952f55fd35689, []int{11}
 }
 
 func (m *DeleteMessage) XXX_Unmarshal(b []byte) error {
@@ -432,19 +1074,24 @@ type ChatMessage struct {
 	//	*ChatMessage_Image
 	//	*ChatMessage_Audio
 	//	*ChatMessage_Community
+	//	*ChatMessage_Poll
+	//	*ChatMessage_File
+	//	*ChatMessage_SafeTransactionProposal
 	Payload isChatMessage_Payload `protobuf_oneof:"payload"`
 	// Grant for community chat messages
-	Grant                []byte   `protobuf:"bytes,13,opt,name=grant,proto3" json:"grant,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Grant []byte `protobuf:"bytes,13,opt,name=grant,proto3" json:"grant,omitempty"`
+	// Previews of the links in the text, unfurled by the sender
+	LinkPreviews         []*LinkPreview `protobuf:"bytes,15,rep,name=link_previews,json=linkPreviews,proto3" json:"link_previews,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *ChatMessage) Reset()         { *m = ChatMessage{} }
 func (m *ChatMessage) String() string { return proto.CompactTextString(m) }
 func (*ChatMessage) ProtoMessage()    {}
 func (*ChatMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_263952f55fd35689, []int{5}
+	return fileDescriptor_263952f55fd35689, []int{12}
 }
 
 func (m *ChatMessage) XXX_Unmarshal(b []byte) error {
@@ -541,6 +1188,18 @@ type ChatMessage_Community struct {
 	Community []byte `protobuf:"bytes,12,opt,name=community,proto3,oneof"`
 }
 
+type ChatMessage_Poll struct {
+	Poll *PollMessage `protobuf:"bytes,14,opt,name=poll,proto3,oneof"`
+}
+
+type ChatMessage_File struct {
+	File *FileMessage `protobuf:"bytes,16,opt,name=file,proto3,oneof"`
+}
+
+type ChatMessage_SafeTransactionProposal struct {
+	SafeTransactionProposal *SafeTransactionProposal `protobuf:"bytes,17,opt,name=safe_transaction_proposal,json=safeTransactionProposal,proto3,oneof"`
+}
+
 func (*ChatMessage_Sticker) isChatMessage_Payload() {}
 
 func (*ChatMessage_Image) isChatMessage_Payload() {}
@@ -549,6 +1208,12 @@ func (*ChatMessage_Audio) isChatMessage_Payload() {}
 
 func (*ChatMessage_Community) isChatMessage_Payload() {}
 
+func (*ChatMessage_Poll) isChatMessage_Payload() {}
+
+func (*ChatMessage_File) isChatMessage_Payload() {}
+
+func (*ChatMessage_SafeTransactionProposal) isChatMessage_Payload() {}
+
 func (m *ChatMessage) GetPayload() isChatMessage_Payload {
 	if m != nil {
 		return m.Payload
@@ -584,6 +1249,27 @@ func (m *ChatMessage) GetCommunity() []byte {
 	return nil
 }
 
+func (m *ChatMessage) GetPoll() *PollMessage {
+	if x, ok := m.GetPayload().(*ChatMessage_Poll); ok {
+		return x.Poll
+	}
+	return nil
+}
+
+func (m *ChatMessage) GetFile() *FileMessage {
+	if x, ok := m.GetPayload().(*ChatMessage_File); ok {
+		return x.File
+	}
+	return nil
+}
+
+func (m *ChatMessage) GetSafeTransactionProposal() *SafeTransactionProposal {
+	if x, ok := m.GetPayload().(*ChatMessage_SafeTransactionProposal); ok {
+		return x.SafeTransactionProposal
+	}
+	return nil
+}
+
 func (m *ChatMessage) GetGrant() []byte {
 	if m != nil {
 		return m.Grant
@@ -591,6 +1277,13 @@ func (m *ChatMessage) GetGrant() []byte {
 	return nil
 }
 
+func (m *ChatMessage) GetLinkPreviews() []*LinkPreview {
+	if m != nil {
+		return m.LinkPreviews
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ChatMessage) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -598,6 +1291,9 @@ func (*ChatMessage) XXX_OneofWrappers() []interface{} {
 		(*ChatMessage_Image)(nil),
 		(*ChatMessage_Audio)(nil),
 		(*ChatMessage_Community)(nil),
+		(*ChatMessage_Poll)(nil),
+		(*ChatMessage_File)(nil),
+		(*ChatMessage_SafeTransactionProposal)(nil),
 	}
 }
 
@@ -607,6 +1303,13 @@ func init() {
 	proto.RegisterType((*StickerMessage)(nil), "protobuf.StickerMessage")
 	proto.RegisterType((*ImageMessage)(nil), "protobuf.ImageMessage")
 	proto.RegisterType((*AudioMessage)(nil), "protobuf.AudioMessage")
+	proto.RegisterType((*FileMessage)(nil), "protobuf.FileMessage")
+	proto.RegisterType((*FileChunk)(nil), "protobuf.FileChunk")
+	proto.RegisterType((*LinkPreview)(nil), "protobuf.LinkPreview")
+	proto.RegisterType((*PollMessage)(nil), "protobuf.PollMessage")
+	proto.RegisterType((*PollVote)(nil), "protobuf.PollVote")
+	proto.RegisterType((*SafeTransactionProposal)(nil), "protobuf.SafeTransactionProposal")
+	proto.RegisterType((*SafeTransactionSignature)(nil), "protobuf.SafeTransactionSignature")
 	proto.RegisterType((*EditMessage)(nil), "protobuf.EditMessage")
 	proto.RegisterType((*DeleteMessage)(nil), "protobuf.DeleteMessage")
 	proto.RegisterType((*ChatMessage)(nil), "protobuf.ChatMessage")
@@ -617,50 +1320,92 @@ func init() {
 }
 
 var fileDescriptor_263952f55fd35689 = []byte{
-	// 708 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x41, 0x6f, 0xa3, 0x46,
-	0x14, 0x0e, 0x36, 0x18, 0xf3, 0x70, 0x22, 0x34, 0x49, 0x13, 0x5a, 0xb5, 0x8d, 0x6b, 0x55, 0xaa,
-	0x4f, 0x3e, 0xa4, 0xa9, 0x94, 0x2b, 0xb5, 0x91, 0x43, 0x53, 0xb0, 0x77, 0x18, 0xef, 0x6e, 0x4e,
-	0x88, 0xe0, 0xd9, 0x18, 0x25, 0x80, 0x65, 0xc6, 0xd2, 0xfa, 0x47, 0xed, 0x75, 0xaf, 0xfb, 0x43,
-	0xf6, 0xb0, 0x7f, 0x65, 0x35, 0x83, 0x31, 0xc4, 0xd2, 0x26, 0x39, 0xf1, 0xde, 0xe3, 0x7d, 0xdf,
-	0x7c, 0xf3, 0xcd, 0xbc, 0x01, 0x14, 0x2d, 0x42, 0x16, 0x24, 0x34, 0xcf, 0xc3, 0x7b, 0x3a, 0x58,
-	0xae, 0x32, 0x96, 0xa1, 0xb6, 0xf8, 0xdc, 0xad, 0x3f, 0xfc, 0xa2, 0xd3, 0x74, 0x9d, 0xe4, 0x45,
-	0xb9, 0x77, 0x05, 0x47, 0x3e, 0x8b, 0xa3, 0x07, 0xba, 0x72, 0x8b, 0x76, 0x84, 0x40, 0x5e, 0x84,
-	0xf9, 0xc2, 0x94, 0xba, 0x52, 0x5f, 0xc3, 0x22, 0xe6, 0xb5, 0x65, 0x18, 0x3d, 0x98, 0x8d, 0xae,
-	0xd4, 0x57, 0xb0, 0x88, 0x7b, 0x6f, 0xa0, 0xe3, 0x24, 0xe1, 0x3d, 0x2d, 0x71, 0x26, 0xa8, 0xcb,
-	0x70, 0xf3, 0x98, 0x85, 0x73, 0x01, 0xed, 0xe0, 0x32, 0x45, 0x7f, 0x81, 0xcc, 0x36, 0x4b, 0x2a,
-	0xd0, 0x47, 0x17, 0xc7, 0x83, 0x52, 0xc9, 0x40, 0xe0, 0xc9, 0x66, 0x49, 0xb1, 0x68, 0xe8, 0x7d,
-	0x96, 0xa0, 0x63, 0xad, 0xe7, 0x71, 0xf6, 0x32, 0xe7, 0xe5, 0x13, 0xce, 0x6e, 0xc5, 0x59, 0xc7,
-	0x17, 0x49, 0xb5, 0x00, 0x3a, 0x07, 0x7d, 0xbe, 0x5e, 0x85, 0x2c, 0xce, 0xd2, 0x20, 0xc9, 0xcd,
-	0x66, 0x57, 0xea, 0xcb, 0x18, 0xca, 0x92, 0x9b, 0xf7, 0xfe, 0x01, 0x6d, 0x87, 0x41, 0xa7, 0x80,
-	0x66, 0xde, 0x8d, 0x37, 0x79, 0xe7, 0x05, 0xd6, 0x6c, 0xe4, 0x4c, 0x02, 0x72, 0x3b, 0xb5, 0x8d,
-	0x03, 0xa4, 0x42, 0xd3, 0xb2, 0x86, 0x86, 0x24, 0x02, 0x17, 0x1b, 0x8d, 0xde, 0x17, 0x09, 0x74,
-	0x7b, 0x1e, 0xb3, 0x52, 0xf7, 0x09, 0x28, 0xd1, 0x63, 0x16, 0x3d, 0x08, 0xd5, 0x32, 0x2e, 0x12,
-	0xee, 0x22, 0xa3, 0x1f, 0x99, 0xd0, 0xac, 0x61, 0x11, 0xa3, 0x33, 0x50, 0xc5, 0x61, 0xc5, 0x73,
-	0xa1, 0x46, 0xc3, 0x2d, 0x9e, 0x3a, 0x73, 0xf4, 0x1b, 0xc0, 0xf6, 0x00, 0xf9, 0x3f, 0x59, 0xfc,
-	0xd3, 0xb6, 0x15, 0x67, 0xce, 0x57, 0xb8, 0x5f, 0x85, 0x29, 0x33, 0x15, 0xe1, 0x4b, 0x91, 0xa0,
-	0x2b, 0xe8, 0x94, 0x20, 0xe1, 0x4e, 0x4b, 0xb8, 0xf3, 0x53, 0xe5, 0xce, 0x56, 0xa0, 0xb0, 0x44,
-	0x4f, 0xaa, 0xa4, 0xf7, 0x49, 0x82, 0xc3, 0x11, 0x7d, 0xa4, 0x8c, 0x3e, 0xbf, 0x87, 0x9a, 0xde,
-	0xc6, 0x33, 0x7a, 0x9b, 0x3f, 0xd4, 0x2b, 0x3f, 0xa7, 0x57, 0x79, 0xb5, 0xde, 0x6f, 0x0a, 0xe8,
-	0xc3, 0x45, 0xf8, 0x82, 0xe3, 0xbf, 0x82, 0xc6, 0xe2, 0x84, 0xe6, 0x2c, 0x4c, 0x96, 0x42, 0xaf,
-	0x8c, 0xab, 0xc2, 0xee, 0x3c, 0x9a, 0xb5, 0xf3, 0x38, 0x07, 0x7d, 0x45, 0xf3, 0x65, 0x96, 0xe6,
-	0x34, 0x60, 0xd9, 0xd6, 0x77, 0x28, 0x4b, 0x24, 0x43, 0x3f, 0x43, 0x9b, 0xa6, 0x79, 0x90, 0x86,
-	0x49, 0x21, 0x57, 0xc3, 0x2a, 0x4d, 0x73, 0x2f, 0x4c, 0x68, 0xdd, 0x9b, 0xd6, 0x13, 0x6f, 0xf6,
-	0xb7, 0xa9, 0xbe, 0x76, 0x9b, 0x68, 0x04, 0x9d, 0x28, 0x4b, 0x19, 0x4d, 0x59, 0x81, 0x6c, 0x0b,
-	0xe4, 0x1f, 0x15, 0xb2, 0xe6, 0xc1, 0x60, 0x58, 0x74, 0x16, 0x2c, 0x51, 0x95, 0xa0, 0x4b, 0x50,
-	0xf3, 0x62, 0xc8, 0x4d, 0xad, 0x2b, 0xf5, 0xf5, 0x0b, 0xb3, 0x22, 0x78, 0x3a, 0xfd, 0xd7, 0x07,
-	0xb8, 0x6c, 0x45, 0x03, 0x50, 0x62, 0x3e, 0xa0, 0x26, 0x08, 0xcc, 0xe9, 0xde, 0xdc, 0x56, 0x88,
-	0xa2, 0x8d, 0xf7, 0x87, 0x7c, 0x76, 0x4c, 0x7d, 0xbf, 0xbf, 0x3e, 0x93, 0xbc, 0x5f, 0xb4, 0xa1,
-	0xdf, 0x41, 0x8b, 0xb2, 0x24, 0x59, 0xa7, 0x31, 0xdb, 0x98, 0x1d, 0x7e, 0x2d, 0xae, 0x0f, 0x70,
-	0x55, 0xaa, 0xae, 0xcc, 0x61, 0xed, 0xca, 0xf4, 0xbe, 0x4a, 0xa0, 0xd7, 0x36, 0x8a, 0x4c, 0x38,
-	0x29, 0x87, 0x74, 0x38, 0xf1, 0x88, 0xed, 0x91, 0x72, 0x4c, 0x8f, 0x00, 0x88, 0xfd, 0x9e, 0x04,
-	0xd3, 0xff, 0x2d, 0xc7, 0x33, 0x24, 0xa4, 0x83, 0xea, 0x13, 0x67, 0x78, 0x63, 0x63, 0xa3, 0x81,
-	0x00, 0x5a, 0x3e, 0xb1, 0xc8, 0xcc, 0x37, 0x9a, 0x48, 0x03, 0xc5, 0x76, 0x27, 0xff, 0x39, 0x86,
-	0x8c, 0xce, 0xe0, 0x98, 0x60, 0xcb, 0xf3, 0xad, 0x21, 0x71, 0x26, 0x9c, 0xd1, 0x75, 0x2d, 0x6f,
-	0x64, 0x28, 0xa8, 0x0f, 0x7f, 0xfa, 0xb7, 0x3e, 0xb1, 0xdd, 0xc0, 0xb5, 0x7d, 0xdf, 0x1a, 0xdb,
-	0xbb, 0xd5, 0xa6, 0xd8, 0x79, 0x6b, 0x11, 0x3b, 0x18, 0xe3, 0xc9, 0x6c, 0x6a, 0xb4, 0x38, 0x9b,
-	0xe3, 0x5a, 0x63, 0xdb, 0x50, 0x79, 0x28, 0x1e, 0x0e, 0xa3, 0x8d, 0x0e, 0x41, 0xe3, 0x64, 0x33,
-	0xcf, 0x21, 0xb7, 0x86, 0xc6, 0x9f, 0x96, 0x3d, 0xba, 0xb1, 0x35, 0x35, 0xe0, 0x5f, 0x6d, 0xf7,
-	0xe0, 0xdd, 0xb5, 0x84, 0x7d, 0x7f, 0x7f, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x67, 0x37, 0x27, 0xf9,
-	0xcd, 0x05, 0x00, 0x00,
+	// 1377 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x36, 0x25, 0xca, 0x12, 0x87, 0x92, 0xc2, 0x6c, 0xd2, 0x98, 0xf9, 0x57, 0x84, 0x02, 0x75,
+	0x11, 0xc0, 0x01, 0xd2, 0x14, 0x08, 0x7a, 0x63, 0x64, 0xc5, 0x56, 0x63, 0xfd, 0x74, 0x45, 0x27,
+	0x4d, 0x2f, 0xc4, 0x9a, 0x5c, 0x4b, 0x84, 0xf9, 0x57, 0x72, 0x99, 0xd8, 0x7d, 0x81, 0x22, 0x97,
+	0xbe, 0x49, 0xaf, 0xbd, 0x16, 0xe8, 0x43, 0xf4, 0x29, 0x7a, 0xea, 0xb9, 0x87, 0x62, 0x97, 0xa4,
+	0x48, 0x0b, 0xa9, 0x63, 0xa0, 0x3e, 0x69, 0xe6, 0xdb, 0xd9, 0xe1, 0x37, 0x3f, 0x9a, 0x1d, 0x40,
+	0xf6, 0x92, 0x30, 0xcb, 0xa7, 0x49, 0x42, 0x16, 0x74, 0x27, 0x8a, 0x43, 0x16, 0xa2, 0x96, 0xf8,
+	0x39, 0x4a, 0x8f, 0xef, 0xa8, 0x34, 0x48, 0xfd, 0x24, 0x83, 0xfb, 0xcf, 0xa1, 0x3b, 0x67, 0xae,
+	0x7d, 0x42, 0xe3, 0x71, 0x66, 0x8e, 0x10, 0xc8, 0x4b, 0x92, 0x2c, 0x75, 0xa9, 0x27, 0x6d, 0x2b,
+	0x58, 0xc8, 0x1c, 0x8b, 0x88, 0x7d, 0xa2, 0xd7, 0x7a, 0xd2, 0x76, 0x03, 0x0b, 0xb9, 0xff, 0x1d,
+	0xb4, 0x47, 0x3e, 0x59, 0xd0, 0xe2, 0x9e, 0x0e, 0xcd, 0x88, 0x9c, 0x79, 0x21, 0x71, 0xc4, 0xd5,
+	0x36, 0x2e, 0x54, 0xf4, 0x05, 0xc8, 0xec, 0x2c, 0xa2, 0xe2, 0x76, 0xf7, 0xe9, 0x8d, 0x9d, 0x82,
+	0xc9, 0x8e, 0xb8, 0x6f, 0x9e, 0x45, 0x14, 0x0b, 0x83, 0xfe, 0x6f, 0x12, 0xb4, 0x8d, 0xd4, 0x71,
+	0xc3, 0x4f, 0xfb, 0x7c, 0x76, 0xce, 0x67, 0xaf, 0xf4, 0x59, 0xbd, 0x9f, 0x29, 0xe5, 0x07, 0xd0,
+	0x43, 0x50, 0x9d, 0x34, 0x26, 0xcc, 0x0d, 0x03, 0xcb, 0x4f, 0xf4, 0x7a, 0x4f, 0xda, 0x96, 0x31,
+	0x14, 0xd0, 0x38, 0xe9, 0x7f, 0x0d, 0xca, 0xea, 0x0e, 0xba, 0x05, 0xe8, 0x70, 0xf2, 0x6a, 0x32,
+	0x7d, 0x33, 0xb1, 0x8c, 0xc3, 0xdd, 0xd1, 0xd4, 0x32, 0xdf, 0xce, 0x86, 0xda, 0x06, 0x6a, 0x42,
+	0xdd, 0x30, 0x06, 0x9a, 0x24, 0x84, 0x31, 0xd6, 0x6a, 0xfd, 0x0f, 0x12, 0xa8, 0x2f, 0x5d, 0x8f,
+	0x56, 0x72, 0x18, 0x10, 0x9f, 0x16, 0x39, 0xe4, 0x32, 0xba, 0x0b, 0x8a, 0xef, 0xfa, 0xd4, 0x5a,
+	0xd1, 0x56, 0x70, 0x8b, 0x03, 0xe2, 0x53, 0x08, 0xe4, 0xc4, 0xfd, 0x89, 0xe6, 0x8c, 0x84, 0xbc,
+	0x2a, 0x84, 0x2c, 0x22, 0x17, 0x32, 0x7a, 0x04, 0x6d, 0x7b, 0x99, 0x06, 0x27, 0x89, 0x65, 0x87,
+	0x69, 0xc0, 0xf4, 0x46, 0x4f, 0xda, 0xee, 0x60, 0x35, 0xc3, 0x06, 0x1c, 0xea, 0xff, 0x23, 0x81,
+	0xc2, 0xb9, 0x0c, 0x38, 0x86, 0x6e, 0x42, 0xc3, 0xf6, 0x42, 0xfb, 0x44, 0x50, 0x91, 0x71, 0xa6,
+	0xa0, 0x2d, 0x68, 0x8a, 0x16, 0x71, 0x9d, 0x9c, 0xc9, 0x26, 0x57, 0x47, 0x0e, 0xba, 0x0f, 0x90,
+	0xb7, 0x0d, 0x3f, 0xab, 0x8b, 0x33, 0x25, 0x47, 0x46, 0x0e, 0xf7, 0xe6, 0x06, 0x0e, 0x3d, 0x15,
+	0x9c, 0x3a, 0x38, 0x53, 0x2e, 0x41, 0xaa, 0x5a, 0xc8, 0xcd, 0xf3, 0x85, 0xbc, 0x09, 0x8d, 0x45,
+	0x4c, 0x02, 0xa6, 0x37, 0x05, 0x9e, 0x29, 0xe8, 0x39, 0xb4, 0x0b, 0x1e, 0x22, 0x5f, 0x2d, 0x51,
+	0xe6, 0xcf, 0xca, 0x32, 0xe7, 0x99, 0x16, 0xb5, 0x55, 0xfd, 0x52, 0xe9, 0xff, 0x25, 0x81, 0x7a,
+	0xe0, 0x06, 0x27, 0xb3, 0x98, 0xbe, 0x73, 0xe9, 0x7b, 0xa4, 0x41, 0x3d, 0x8d, 0xbd, 0xbc, 0x12,
+	0x5c, 0xcc, 0x72, 0xcd, 0x8a, 0x1a, 0x08, 0x99, 0xb3, 0x60, 0x2e, 0xf3, 0x68, 0x1e, 0x72, 0xa6,
+	0xa0, 0x1e, 0xa8, 0x0e, 0x4d, 0xec, 0xd8, 0x8d, 0x78, 0x7b, 0x88, 0xa0, 0x15, 0x5c, 0x85, 0xd0,
+	0x63, 0xb8, 0xce, 0x96, 0xa9, 0x7f, 0x14, 0x10, 0xd7, 0xb3, 0x8a, 0x08, 0x1b, 0x22, 0x12, 0x6d,
+	0x75, 0x30, 0x5b, 0xfd, 0x0f, 0xae, 0x95, 0xc6, 0xef, 0x5d, 0x87, 0x2d, 0x45, 0x32, 0x3a, 0xb8,
+	0xbb, 0x82, 0xdf, 0x70, 0x14, 0x7d, 0x09, 0xe5, 0x65, 0x6b, 0x49, 0xdd, 0xc5, 0x32, 0x4b, 0x4f,
+	0x07, 0x97, 0x0e, 0xf6, 0x05, 0xdc, 0xff, 0x59, 0x02, 0x75, 0x16, 0x7a, 0x5e, 0xd1, 0x79, 0x77,
+	0xa0, 0xf5, 0x63, 0x4a, 0x13, 0xc1, 0x37, 0x8b, 0x79, 0xa5, 0xf3, 0x22, 0x84, 0x82, 0x76, 0xa2,
+	0xd7, 0x7a, 0xf5, 0x6d, 0x05, 0x17, 0x2a, 0x67, 0xe6, 0xa7, 0x1e, 0x73, 0x23, 0x8f, 0x5a, 0xf6,
+	0x32, 0x74, 0xed, 0x2c, 0x11, 0x2d, 0xdc, 0x2d, 0xe0, 0x81, 0x40, 0xd1, 0x6d, 0x68, 0xd1, 0xc0,
+	0xb1, 0x98, 0xeb, 0x53, 0x91, 0x0e, 0x19, 0x37, 0x69, 0xe0, 0x98, 0xae, 0x4f, 0xfb, 0x7f, 0x48,
+	0xd0, 0xe2, 0x4c, 0x5e, 0x87, 0x59, 0x3e, 0xaf, 0xb0, 0xed, 0x2a, 0xc4, 0xe5, 0x5e, 0x7d, 0xbb,
+	0x53, 0x12, 0x5f, 0x75, 0x4f, 0xe3, 0xa2, 0xee, 0xd9, 0xbc, 0x74, 0xf7, 0xfc, 0x5d, 0x83, 0xad,
+	0x39, 0x39, 0xa6, 0x66, 0x4c, 0x82, 0x84, 0xd8, 0xfc, 0x23, 0xb3, 0x38, 0x8c, 0xc2, 0x84, 0x78,
+	0x3c, 0x76, 0x7b, 0x49, 0xdc, 0x80, 0x53, 0xcc, 0xc2, 0x6a, 0x0a, 0x7d, 0xe4, 0x88, 0x96, 0x22,
+	0xc7, 0x65, 0x4b, 0x91, 0x63, 0x8a, 0xba, 0x50, 0x63, 0x61, 0x1e, 0x4b, 0x8d, 0x85, 0x9c, 0xea,
+	0x3b, 0xe2, 0xa5, 0x34, 0x6f, 0xa3, 0x4c, 0xe1, 0x37, 0x1d, 0xc2, 0x48, 0xce, 0x5f, 0xc8, 0xe8,
+	0x1e, 0x28, 0x61, 0x44, 0xb3, 0x99, 0x94, 0x77, 0x48, 0x09, 0xa0, 0x07, 0xa0, 0x72, 0xff, 0x16,
+	0x3b, 0xb5, 0x16, 0x24, 0x11, 0x7d, 0xa1, 0x60, 0x85, 0x43, 0xe6, 0xe9, 0x1e, 0x49, 0x38, 0xcd,
+	0x23, 0x92, 0x50, 0x71, 0xd8, 0x12, 0x87, 0x4d, 0xae, 0xf3, 0xa3, 0xbb, 0xa0, 0x2c, 0x48, 0x62,
+	0x45, 0x31, 0x2f, 0xb0, 0x92, 0x75, 0xc7, 0x82, 0x24, 0x33, 0xae, 0x17, 0x87, 0x2c, 0x3c, 0xa1,
+	0x81, 0x0e, 0xab, 0x43, 0x93, 0xeb, 0xbc, 0x41, 0x62, 0x7a, 0x9c, 0x06, 0x8e, 0x15, 0x53, 0x9b,
+	0xba, 0xef, 0x68, 0xac, 0xab, 0xc2, 0xa4, 0x9b, 0xc1, 0x38, 0x47, 0x79, 0x94, 0x41, 0x18, 0xd8,
+	0x54, 0x6f, 0x67, 0x51, 0x0a, 0x85, 0x47, 0x94, 0xb8, 0x8b, 0x80, 0xb0, 0x34, 0xa6, 0x7a, 0x47,
+	0x84, 0x5a, 0x02, 0xfd, 0x3f, 0x25, 0xd0, 0xd7, 0x92, 0x3e, 0x2f, 0x0e, 0xaf, 0xb8, 0x93, 0xce,
+	0x11, 0x91, 0xd7, 0x88, 0x5c, 0x79, 0x37, 0xfd, 0x2e, 0x81, 0x3a, 0x74, 0x5c, 0x56, 0xfc, 0x39,
+	0x3f, 0x1e, 0x0b, 0x02, 0x99, 0xd1, 0x53, 0x56, 0x34, 0x0f, 0x97, 0xab, 0xf1, 0xd5, 0x2f, 0x88,
+	0x4f, 0xfe, 0xc8, 0x80, 0xbe, 0xd2, 0x08, 0x7e, 0x95, 0xa0, 0xb3, 0x4b, 0x3d, 0xca, 0xe8, 0xc5,
+	0x31, 0xfc, 0x8f, 0x07, 0x25, 0xe3, 0x2b, 0x5f, 0xc4, 0xb7, 0x71, 0x69, 0xbe, 0x1f, 0x5a, 0xa0,
+	0x0e, 0x96, 0xe4, 0x13, 0x19, 0xbf, 0x07, 0x0a, 0x9f, 0x60, 0x09, 0x23, 0x7e, 0x24, 0xf8, 0xca,
+	0xb8, 0x04, 0x56, 0xf5, 0xa8, 0x57, 0xea, 0xf1, 0x10, 0xd4, 0x98, 0x26, 0x51, 0x18, 0x24, 0xd4,
+	0x62, 0x61, 0x9e, 0x77, 0x28, 0x20, 0x33, 0xcc, 0x06, 0x63, 0x62, 0x89, 0x57, 0xbf, 0x91, 0xfd,
+	0xeb, 0x68, 0x90, 0x4c, 0xf8, 0xc3, 0x5f, 0xc9, 0xcd, 0xe6, 0xb9, 0xdc, 0xac, 0x87, 0xd9, 0xbc,
+	0x6c, 0x98, 0x68, 0x17, 0xda, 0x76, 0x18, 0x30, 0x1a, 0xb0, 0xea, 0xf3, 0xf8, 0xa8, 0xbc, 0x59,
+	0xc9, 0xc1, 0xce, 0x20, 0xb3, 0xcc, 0xbc, 0xd8, 0xa5, 0x82, 0x9e, 0x41, 0x33, 0xc9, 0x76, 0x3f,
+	0x31, 0x0c, 0xd4, 0xa7, 0x7a, 0xe9, 0xe0, 0xfc, 0x52, 0xb8, 0xbf, 0x81, 0x0b, 0x53, 0xb4, 0x03,
+	0x0d, 0x97, 0xef, 0x6d, 0x62, 0x46, 0xa8, 0x4f, 0x6f, 0xad, 0xad, 0x73, 0xe5, 0x8d, 0xcc, 0x8c,
+	0xdb, 0x13, 0xbe, 0x52, 0xe9, 0xea, 0xba, 0x7d, 0x75, 0x55, 0xe3, 0xf6, 0xc2, 0x0c, 0x3d, 0x00,
+	0xc5, 0x0e, 0x7d, 0x3f, 0x0d, 0x5c, 0x76, 0x26, 0xa6, 0x48, 0x7b, 0x7f, 0x03, 0x97, 0x10, 0x7a,
+	0x0c, 0x72, 0x14, 0x7a, 0x9e, 0xde, 0x15, 0xee, 0x2a, 0xd9, 0xaa, 0x3c, 0x83, 0xfb, 0x1b, 0x58,
+	0x18, 0x71, 0xe3, 0x63, 0xd7, 0xa3, 0xba, 0xb6, 0x6e, 0x5c, 0xd9, 0xd6, 0xb8, 0x31, 0x37, 0x42,
+	0x16, 0xdc, 0xce, 0x26, 0x6b, 0x39, 0x87, 0xac, 0x28, 0x9f, 0xfe, 0xfa, 0x75, 0xe1, 0xa1, 0x92,
+	0xe2, 0xff, 0x78, 0x26, 0xf6, 0x37, 0xf0, 0x56, 0xf2, 0xf1, 0xa3, 0xb2, 0xdb, 0x3b, 0xd5, 0x6e,
+	0xff, 0x06, 0x3a, 0x9e, 0x1b, 0x9c, 0x58, 0x51, 0xb6, 0xb1, 0x24, 0xfa, 0xb5, 0x5e, 0xfd, 0x3c,
+	0xd9, 0xca, 0x3e, 0x83, 0xdb, 0x5e, 0xa9, 0x24, 0xfd, 0x5f, 0x6a, 0xa0, 0x56, 0xea, 0x8b, 0x74,
+	0xb8, 0x59, 0xac, 0xac, 0x83, 0xe9, 0xc4, 0x1c, 0x4e, 0xcc, 0x62, 0x69, 0xed, 0x02, 0x98, 0xc3,
+	0xef, 0x4d, 0x6b, 0x76, 0x60, 0x8c, 0x26, 0x9a, 0x84, 0x54, 0x68, 0xce, 0xcd, 0xd1, 0xe0, 0xd5,
+	0x10, 0x6b, 0x35, 0x04, 0xb0, 0x39, 0x37, 0x0d, 0xf3, 0x70, 0xae, 0xd5, 0x91, 0x02, 0x8d, 0xe1,
+	0x78, 0xfa, 0xed, 0x48, 0x93, 0xd1, 0x16, 0xdc, 0x30, 0xb1, 0x31, 0x99, 0x1b, 0x03, 0x73, 0x34,
+	0xe5, 0x1e, 0xc7, 0x63, 0x63, 0xb2, 0xab, 0x35, 0xd0, 0x36, 0x7c, 0x3e, 0x7f, 0x3b, 0x37, 0x87,
+	0x63, 0x6b, 0x3c, 0x9c, 0xcf, 0x8d, 0xbd, 0xe1, 0xea, 0x6b, 0x33, 0x3c, 0x7a, 0x6d, 0x98, 0x43,
+	0x6b, 0x0f, 0x4f, 0x0f, 0x67, 0xda, 0x26, 0xf7, 0x36, 0x1a, 0x1b, 0x7b, 0x43, 0xad, 0xc9, 0x45,
+	0xb1, 0x46, 0x6b, 0x2d, 0xd4, 0x01, 0x85, 0x3b, 0x3b, 0x9c, 0x8c, 0xcc, 0xb7, 0x9a, 0xc2, 0x17,
+	0xed, 0x35, 0x77, 0x7b, 0xc6, 0x4c, 0x03, 0xd4, 0x02, 0x79, 0x36, 0x3d, 0x38, 0xd0, 0x54, 0x2e,
+	0xbd, 0x1c, 0x1d, 0x0c, 0xb5, 0x36, 0xba, 0x0f, 0xb7, 0xe7, 0xc6, 0xcb, 0xa1, 0x55, 0x25, 0x36,
+	0xc3, 0xd3, 0xd9, 0x74, 0x6e, 0x1c, 0x68, 0x9d, 0x17, 0xca, 0x6a, 0xd1, 0x7c, 0xf1, 0xe0, 0x87,
+	0x7b, 0x0b, 0x97, 0x2d, 0xd3, 0xa3, 0x1d, 0x3b, 0xf4, 0x9f, 0x88, 0x64, 0xda, 0xa1, 0xf7, 0xa4,
+	0xc8, 0xea, 0xd1, 0xa6, 0x90, 0xbe, 0xfa, 0x77, 0x00, 0x65, 0x9f, 0xde, 0x15, 0x2e, 0x0d, 0x00,
+	0x00,
 }
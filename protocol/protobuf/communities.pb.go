@@ -238,16 +238,27 @@ func (m *CommunityPermissions) GetAccess() CommunityPermissions_Access {
 }
 
 type CommunityDescription struct {
-	Clock                uint64                        `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
-	Members              map[string]*CommunityMember   `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Permissions          *CommunityPermissions         `protobuf:"bytes,3,opt,name=permissions,proto3" json:"permissions,omitempty"`
-	Identity             *ChatIdentity                 `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
-	Chats                map[string]*CommunityChat     `protobuf:"bytes,6,rep,name=chats,proto3" json:"chats,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	BanList              []string                      `protobuf:"bytes,7,rep,name=ban_list,json=banList,proto3" json:"ban_list,omitempty"`
-	Categories           map[string]*CommunityCategory `protobuf:"bytes,8,rep,name=categories,proto3" json:"categories,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
-	XXX_unrecognized     []byte                        `json:"-"`
-	XXX_sizecache        int32                         `json:"-"`
+	Clock        uint64                               `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	Members      map[string]*CommunityMember          `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Permissions  *CommunityPermissions                `protobuf:"bytes,3,opt,name=permissions,proto3" json:"permissions,omitempty"`
+	Identity     *ChatIdentity                        `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
+	Chats        map[string]*CommunityChat            `protobuf:"bytes,6,rep,name=chats,proto3" json:"chats,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	BanList      []string                             `protobuf:"bytes,7,rep,name=ban_list,json=banList,proto3" json:"ban_list,omitempty"`
+	Categories   map[string]*CommunityCategory        `protobuf:"bytes,8,rep,name=categories,proto3" json:"categories,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Emojis       map[string]*CommunityEmoji           `protobuf:"bytes,9,rep,name=emojis,proto3" json:"emojis,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ArchiveIndex []*CommunityMessageArchiveIndexEntry `protobuf:"bytes,10,rep,name=archive_index,json=archiveIndex,proto3" json:"archive_index,omitempty"`
+	// Compressed public key of the community, set when the description
+	// might be signed by an owner other than the community key
+	Id                 []byte                        `protobuf:"bytes,11,opt,name=id,proto3" json:"id,omitempty"`
+	OwnershipTransfers []*CommunityOwnershipTransfer `protobuf:"bytes,12,rep,name=ownership_transfers,json=ownershipTransfers,proto3" json:"ownership_transfers,omitempty"`
+	Control            *CommunityControl             `protobuf:"bytes,13,opt,name=control,proto3" json:"control,omitempty"`
+	// Signatures of the control state by the control signers
+	ControlSignatures [][]byte `protobuf:"bytes,14,rep,name=control_signatures,json=controlSignatures,proto3" json:"control_signatures,omitempty"`
+	// IDs of the chats deleted from the community
+	RemovedChats         []string `protobuf:"bytes,15,rep,name=removed_chats,json=removedChats,proto3" json:"removed_chats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CommunityDescription) Reset()         { *m = CommunityDescription{} }
@@ -324,6 +335,55 @@ func (m *CommunityDescription) GetCategories() map[string]*CommunityCategory {
 	return nil
 }
 
+func (m *CommunityDescription) GetEmojis() map[string]*CommunityEmoji {
+	if m != nil {
+		return m.Emojis
+	}
+	return nil
+}
+
+func (m *CommunityDescription) GetArchiveIndex() []*CommunityMessageArchiveIndexEntry {
+	if m != nil {
+		return m.ArchiveIndex
+	}
+	return nil
+}
+
+func (m *CommunityDescription) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *CommunityDescription) GetOwnershipTransfers() []*CommunityOwnershipTransfer {
+	if m != nil {
+		return m.OwnershipTransfers
+	}
+	return nil
+}
+
+func (m *CommunityDescription) GetControl() *CommunityControl {
+	if m != nil {
+		return m.Control
+	}
+	return nil
+}
+
+func (m *CommunityDescription) GetControlSignatures() [][]byte {
+	if m != nil {
+		return m.ControlSignatures
+	}
+	return nil
+}
+
+func (m *CommunityDescription) GetRemovedChats() []string {
+	if m != nil {
+		return m.RemovedChats
+	}
+	return nil
+}
+
 type CommunityChat struct {
 	Members              map[string]*CommunityMember `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Permissions          *CommunityPermissions       `protobuf:"bytes,2,opt,name=permissions,proto3" json:"permissions,omitempty"`
@@ -450,6 +510,61 @@ func (m *CommunityCategory) GetPosition() int32 {
 	return 0
 }
 
+type CommunityEmoji struct {
+	EmojiId              string         `protobuf:"bytes,1,opt,name=emoji_id,json=emojiId,proto3" json:"emoji_id,omitempty"`
+	Name                 string         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Image                *IdentityImage `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *CommunityEmoji) Reset()         { *m = CommunityEmoji{} }
+func (m *CommunityEmoji) String() string { return proto.CompactTextString(m) }
+func (*CommunityEmoji) ProtoMessage()    {}
+func (*CommunityEmoji) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{6}
+}
+
+func (m *CommunityEmoji) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommunityEmoji.Unmarshal(m, b)
+}
+func (m *CommunityEmoji) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommunityEmoji.Marshal(b, m, deterministic)
+}
+func (m *CommunityEmoji) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityEmoji.Merge(m, src)
+}
+func (m *CommunityEmoji) XXX_Size() int {
+	return xxx_messageInfo_CommunityEmoji.Size(m)
+}
+func (m *CommunityEmoji) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityEmoji.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityEmoji proto.InternalMessageInfo
+
+func (m *CommunityEmoji) GetEmojiId() string {
+	if m != nil {
+		return m.EmojiId
+	}
+	return ""
+}
+
+func (m *CommunityEmoji) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CommunityEmoji) GetImage() *IdentityImage {
+	if m != nil {
+		return m.Image
+	}
+	return nil
+}
+
 type CommunityInvitation struct {
 	CommunityDescription []byte   `protobuf:"bytes,1,opt,name=community_description,json=communityDescription,proto3" json:"community_description,omitempty"`
 	Grant                []byte   `protobuf:"bytes,2,opt,name=grant,proto3" json:"grant,omitempty"`
@@ -464,7 +579,7 @@ func (m *CommunityInvitation) Reset()         { *m = CommunityInvitation{} }
 func (m *CommunityInvitation) String() string { return proto.CompactTextString(m) }
 func (*CommunityInvitation) ProtoMessage()    {}
 func (*CommunityInvitation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{6}
+	return fileDescriptor_f937943d74c1cd8b, []int{7}
 }
 
 func (m *CommunityInvitation) XXX_Unmarshal(b []byte) error {
@@ -527,7 +642,7 @@ func (m *CommunityRequestToJoin) Reset()         { *m = CommunityRequestToJoin{}
 func (m *CommunityRequestToJoin) String() string { return proto.CompactTextString(m) }
 func (*CommunityRequestToJoin) ProtoMessage()    {}
 func (*CommunityRequestToJoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{7}
+	return fileDescriptor_f937943d74c1cd8b, []int{8}
 }
 
 func (m *CommunityRequestToJoin) XXX_Unmarshal(b []byte) error {
@@ -590,7 +705,7 @@ func (m *CommunityRequestToJoinResponse) Reset()         { *m = CommunityRequest
 func (m *CommunityRequestToJoinResponse) String() string { return proto.CompactTextString(m) }
 func (*CommunityRequestToJoinResponse) ProtoMessage()    {}
 func (*CommunityRequestToJoinResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f937943d74c1cd8b, []int{8}
+	return fileDescriptor_f937943d74c1cd8b, []int{9}
 }
 
 func (m *CommunityRequestToJoinResponse) XXX_Unmarshal(b []byte) error {
@@ -639,6 +754,760 @@ func (m *CommunityRequestToJoinResponse) GetGrant() []byte {
 	return nil
 }
 
+type CommunityMessageArchiveIndexEntry struct {
+	// keccak256 of the compressed archive
+	Hash   []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	ChatId string `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	// Unix time in seconds, from inclusive and to exclusive
+	From                 uint64   `protobuf:"varint,3,opt,name=from,proto3" json:"from,omitempty"`
+	To                   uint64   `protobuf:"varint,4,opt,name=to,proto3" json:"to,omitempty"`
+	MessagesCount        uint32   `protobuf:"varint,5,opt,name=messages_count,json=messagesCount,proto3" json:"messages_count,omitempty"`
+	Size                 uint64   `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommunityMessageArchiveIndexEntry) Reset()         { *m = CommunityMessageArchiveIndexEntry{} }
+func (m *CommunityMessageArchiveIndexEntry) String() string { return proto.CompactTextString(m) }
+func (*CommunityMessageArchiveIndexEntry) ProtoMessage()    {}
+func (*CommunityMessageArchiveIndexEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{10}
+}
+
+func (m *CommunityMessageArchiveIndexEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommunityMessageArchiveIndexEntry.Unmarshal(m, b)
+}
+func (m *CommunityMessageArchiveIndexEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommunityMessageArchiveIndexEntry.Marshal(b, m, deterministic)
+}
+func (m *CommunityMessageArchiveIndexEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityMessageArchiveIndexEntry.Merge(m, src)
+}
+func (m *CommunityMessageArchiveIndexEntry) XXX_Size() int {
+	return xxx_messageInfo_CommunityMessageArchiveIndexEntry.Size(m)
+}
+func (m *CommunityMessageArchiveIndexEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityMessageArchiveIndexEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityMessageArchiveIndexEntry proto.InternalMessageInfo
+
+func (m *CommunityMessageArchiveIndexEntry) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *CommunityMessageArchiveIndexEntry) GetChatId() string {
+	if m != nil {
+		return m.ChatId
+	}
+	return ""
+}
+
+func (m *CommunityMessageArchiveIndexEntry) GetFrom() uint64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *CommunityMessageArchiveIndexEntry) GetTo() uint64 {
+	if m != nil {
+		return m.To
+	}
+	return 0
+}
+
+func (m *CommunityMessageArchiveIndexEntry) GetMessagesCount() uint32 {
+	if m != nil {
+		return m.MessagesCount
+	}
+	return 0
+}
+
+func (m *CommunityMessageArchiveIndexEntry) GetSize() uint64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+type WakuMessage struct {
+	Sig                  []byte   `protobuf:"bytes,1,opt,name=sig,proto3" json:"sig,omitempty"`
+	Timestamp            uint32   `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Topic                []byte   `protobuf:"bytes,3,opt,name=topic,proto3" json:"topic,omitempty"`
+	Payload              []byte   `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	Padding              []byte   `protobuf:"bytes,5,opt,name=padding,proto3" json:"padding,omitempty"`
+	Hash                 []byte   `protobuf:"bytes,6,opt,name=hash,proto3" json:"hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WakuMessage) Reset()         { *m = WakuMessage{} }
+func (m *WakuMessage) String() string { return proto.CompactTextString(m) }
+func (*WakuMessage) ProtoMessage()    {}
+func (*WakuMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{11}
+}
+
+func (m *WakuMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WakuMessage.Unmarshal(m, b)
+}
+func (m *WakuMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WakuMessage.Marshal(b, m, deterministic)
+}
+func (m *WakuMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WakuMessage.Merge(m, src)
+}
+func (m *WakuMessage) XXX_Size() int {
+	return xxx_messageInfo_WakuMessage.Size(m)
+}
+func (m *WakuMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_WakuMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WakuMessage proto.InternalMessageInfo
+
+func (m *WakuMessage) GetSig() []byte {
+	if m != nil {
+		return m.Sig
+	}
+	return nil
+}
+
+func (m *WakuMessage) GetTimestamp() uint32 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *WakuMessage) GetTopic() []byte {
+	if m != nil {
+		return m.Topic
+	}
+	return nil
+}
+
+func (m *WakuMessage) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *WakuMessage) GetPadding() []byte {
+	if m != nil {
+		return m.Padding
+	}
+	return nil
+}
+
+func (m *WakuMessage) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+type CommunityMessageArchive struct {
+	ChatId               string         `protobuf:"bytes,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	From                 uint64         `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                   uint64         `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
+	Messages             []*WakuMessage `protobuf:"bytes,4,rep,name=messages,proto3" json:"messages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *CommunityMessageArchive) Reset()         { *m = CommunityMessageArchive{} }
+func (m *CommunityMessageArchive) String() string { return proto.CompactTextString(m) }
+func (*CommunityMessageArchive) ProtoMessage()    {}
+func (*CommunityMessageArchive) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{12}
+}
+
+func (m *CommunityMessageArchive) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommunityMessageArchive.Unmarshal(m, b)
+}
+func (m *CommunityMessageArchive) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommunityMessageArchive.Marshal(b, m, deterministic)
+}
+func (m *CommunityMessageArchive) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityMessageArchive.Merge(m, src)
+}
+func (m *CommunityMessageArchive) XXX_Size() int {
+	return xxx_messageInfo_CommunityMessageArchive.Size(m)
+}
+func (m *CommunityMessageArchive) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityMessageArchive.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityMessageArchive proto.InternalMessageInfo
+
+func (m *CommunityMessageArchive) GetChatId() string {
+	if m != nil {
+		return m.ChatId
+	}
+	return ""
+}
+
+func (m *CommunityMessageArchive) GetFrom() uint64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *CommunityMessageArchive) GetTo() uint64 {
+	if m != nil {
+		return m.To
+	}
+	return 0
+}
+
+func (m *CommunityMessageArchive) GetMessages() []*WakuMessage {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+type CommunityMessageArchiveRequest struct {
+	Clock                uint64   `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	CommunityId          []byte   `protobuf:"bytes,2,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	Hashes               [][]byte `protobuf:"bytes,3,rep,name=hashes,proto3" json:"hashes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommunityMessageArchiveRequest) Reset()         { *m = CommunityMessageArchiveRequest{} }
+func (m *CommunityMessageArchiveRequest) String() string { return proto.CompactTextString(m) }
+func (*CommunityMessageArchiveRequest) ProtoMessage()    {}
+func (*CommunityMessageArchiveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{13}
+}
+
+func (m *CommunityMessageArchiveRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommunityMessageArchiveRequest.Unmarshal(m, b)
+}
+func (m *CommunityMessageArchiveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommunityMessageArchiveRequest.Marshal(b, m, deterministic)
+}
+func (m *CommunityMessageArchiveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityMessageArchiveRequest.Merge(m, src)
+}
+func (m *CommunityMessageArchiveRequest) XXX_Size() int {
+	return xxx_messageInfo_CommunityMessageArchiveRequest.Size(m)
+}
+func (m *CommunityMessageArchiveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityMessageArchiveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityMessageArchiveRequest proto.InternalMessageInfo
+
+func (m *CommunityMessageArchiveRequest) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *CommunityMessageArchiveRequest) GetCommunityId() []byte {
+	if m != nil {
+		return m.CommunityId
+	}
+	return nil
+}
+
+func (m *CommunityMessageArchiveRequest) GetHashes() [][]byte {
+	if m != nil {
+		return m.Hashes
+	}
+	return nil
+}
+
+type CommunityMessageArchiveResponse struct {
+	Clock       uint64 `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	CommunityId []byte `protobuf:"bytes,2,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	Hash        []byte `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	// gzip compressed CommunityMessageArchive
+	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	// signature of the hash by the community key
+	Signature            []byte   `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommunityMessageArchiveResponse) Reset()         { *m = CommunityMessageArchiveResponse{} }
+func (m *CommunityMessageArchiveResponse) String() string { return proto.CompactTextString(m) }
+func (*CommunityMessageArchiveResponse) ProtoMessage()    {}
+func (*CommunityMessageArchiveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{14}
+}
+
+func (m *CommunityMessageArchiveResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommunityMessageArchiveResponse.Unmarshal(m, b)
+}
+func (m *CommunityMessageArchiveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommunityMessageArchiveResponse.Marshal(b, m, deterministic)
+}
+func (m *CommunityMessageArchiveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityMessageArchiveResponse.Merge(m, src)
+}
+func (m *CommunityMessageArchiveResponse) XXX_Size() int {
+	return xxx_messageInfo_CommunityMessageArchiveResponse.Size(m)
+}
+func (m *CommunityMessageArchiveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityMessageArchiveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityMessageArchiveResponse proto.InternalMessageInfo
+
+func (m *CommunityMessageArchiveResponse) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *CommunityMessageArchiveResponse) GetCommunityId() []byte {
+	if m != nil {
+		return m.CommunityId
+	}
+	return nil
+}
+
+func (m *CommunityMessageArchiveResponse) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *CommunityMessageArchiveResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *CommunityMessageArchiveResponse) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type CommunityOwnershipTransfer struct {
+	// Compressed public key of the new owner
+	NewOwner []byte `protobuf:"bytes,1,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	Clock    uint64 `protobuf:"varint,2,opt,name=clock,proto3" json:"clock,omitempty"`
+	// Signature by the previous owner
+	Signature            []byte   `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommunityOwnershipTransfer) Reset()         { *m = CommunityOwnershipTransfer{} }
+func (m *CommunityOwnershipTransfer) String() string { return proto.CompactTextString(m) }
+func (*CommunityOwnershipTransfer) ProtoMessage()    {}
+func (*CommunityOwnershipTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{15}
+}
+
+func (m *CommunityOwnershipTransfer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommunityOwnershipTransfer.Unmarshal(m, b)
+}
+func (m *CommunityOwnershipTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommunityOwnershipTransfer.Marshal(b, m, deterministic)
+}
+func (m *CommunityOwnershipTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityOwnershipTransfer.Merge(m, src)
+}
+func (m *CommunityOwnershipTransfer) XXX_Size() int {
+	return xxx_messageInfo_CommunityOwnershipTransfer.Size(m)
+}
+func (m *CommunityOwnershipTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityOwnershipTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityOwnershipTransfer proto.InternalMessageInfo
+
+func (m *CommunityOwnershipTransfer) GetNewOwner() []byte {
+	if m != nil {
+		return m.NewOwner
+	}
+	return nil
+}
+
+func (m *CommunityOwnershipTransfer) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *CommunityOwnershipTransfer) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type CommunityControl struct {
+	// Compressed public keys of the admins co-signing critical changes
+	Signers [][]byte `protobuf:"bytes,1,rep,name=signers,proto3" json:"signers,omitempty"`
+	// Number of signatures required, 0 disables control signing
+	Threshold            uint32   `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommunityControl) Reset()         { *m = CommunityControl{} }
+func (m *CommunityControl) String() string { return proto.CompactTextString(m) }
+func (*CommunityControl) ProtoMessage()    {}
+func (*CommunityControl) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{16}
+}
+
+func (m *CommunityControl) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommunityControl.Unmarshal(m, b)
+}
+func (m *CommunityControl) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommunityControl.Marshal(b, m, deterministic)
+}
+func (m *CommunityControl) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityControl.Merge(m, src)
+}
+func (m *CommunityControl) XXX_Size() int {
+	return xxx_messageInfo_CommunityControl.Size(m)
+}
+func (m *CommunityControl) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityControl.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityControl proto.InternalMessageInfo
+
+func (m *CommunityControl) GetSigners() [][]byte {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+func (m *CommunityControl) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+// The parts of a community description which can only be changed
+// with the approval of the control signers
+type CommunityControlState struct {
+	CommunityId          []byte                        `protobuf:"bytes,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	OwnershipTransfers   []*CommunityOwnershipTransfer `protobuf:"bytes,2,rep,name=ownership_transfers,json=ownershipTransfers,proto3" json:"ownership_transfers,omitempty"`
+	Control              *CommunityControl             `protobuf:"bytes,3,opt,name=control,proto3" json:"control,omitempty"`
+	Permissions          *CommunityPermissions         `protobuf:"bytes,4,opt,name=permissions,proto3" json:"permissions,omitempty"`
+	BanList              []string                      `protobuf:"bytes,5,rep,name=ban_list,json=banList,proto3" json:"ban_list,omitempty"`
+	RemovedChats         []string                      `protobuf:"bytes,6,rep,name=removed_chats,json=removedChats,proto3" json:"removed_chats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *CommunityControlState) Reset()         { *m = CommunityControlState{} }
+func (m *CommunityControlState) String() string { return proto.CompactTextString(m) }
+func (*CommunityControlState) ProtoMessage()    {}
+func (*CommunityControlState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{17}
+}
+
+func (m *CommunityControlState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommunityControlState.Unmarshal(m, b)
+}
+func (m *CommunityControlState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommunityControlState.Marshal(b, m, deterministic)
+}
+func (m *CommunityControlState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityControlState.Merge(m, src)
+}
+func (m *CommunityControlState) XXX_Size() int {
+	return xxx_messageInfo_CommunityControlState.Size(m)
+}
+func (m *CommunityControlState) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityControlState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityControlState proto.InternalMessageInfo
+
+func (m *CommunityControlState) GetCommunityId() []byte {
+	if m != nil {
+		return m.CommunityId
+	}
+	return nil
+}
+
+func (m *CommunityControlState) GetOwnershipTransfers() []*CommunityOwnershipTransfer {
+	if m != nil {
+		return m.OwnershipTransfers
+	}
+	return nil
+}
+
+func (m *CommunityControlState) GetControl() *CommunityControl {
+	if m != nil {
+		return m.Control
+	}
+	return nil
+}
+
+func (m *CommunityControlState) GetPermissions() *CommunityPermissions {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+func (m *CommunityControlState) GetBanList() []string {
+	if m != nil {
+		return m.BanList
+	}
+	return nil
+}
+
+func (m *CommunityControlState) GetRemovedChats() []string {
+	if m != nil {
+		return m.RemovedChats
+	}
+	return nil
+}
+
+type CommunityControlSignatureRequest struct {
+	Clock       uint64 `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	CommunityId []byte `protobuf:"bytes,2,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	// Marshaled CommunityDescription to be approved
+	Description          []byte   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommunityControlSignatureRequest) Reset()         { *m = CommunityControlSignatureRequest{} }
+func (m *CommunityControlSignatureRequest) String() string { return proto.CompactTextString(m) }
+func (*CommunityControlSignatureRequest) ProtoMessage()    {}
+func (*CommunityControlSignatureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{18}
+}
+
+func (m *CommunityControlSignatureRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommunityControlSignatureRequest.Unmarshal(m, b)
+}
+func (m *CommunityControlSignatureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommunityControlSignatureRequest.Marshal(b, m, deterministic)
+}
+func (m *CommunityControlSignatureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityControlSignatureRequest.Merge(m, src)
+}
+func (m *CommunityControlSignatureRequest) XXX_Size() int {
+	return xxx_messageInfo_CommunityControlSignatureRequest.Size(m)
+}
+func (m *CommunityControlSignatureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityControlSignatureRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityControlSignatureRequest proto.InternalMessageInfo
+
+func (m *CommunityControlSignatureRequest) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *CommunityControlSignatureRequest) GetCommunityId() []byte {
+	if m != nil {
+		return m.CommunityId
+	}
+	return nil
+}
+
+func (m *CommunityControlSignatureRequest) GetDescription() []byte {
+	if m != nil {
+		return m.Description
+	}
+	return nil
+}
+
+type CommunityControlSignature struct {
+	Clock                uint64   `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	CommunityId          []byte   `protobuf:"bytes,2,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	StateHash            []byte   `protobuf:"bytes,3,opt,name=state_hash,json=stateHash,proto3" json:"state_hash,omitempty"`
+	Signature            []byte   `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommunityControlSignature) Reset()         { *m = CommunityControlSignature{} }
+func (m *CommunityControlSignature) String() string { return proto.CompactTextString(m) }
+func (*CommunityControlSignature) ProtoMessage()    {}
+func (*CommunityControlSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{19}
+}
+
+func (m *CommunityControlSignature) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommunityControlSignature.Unmarshal(m, b)
+}
+func (m *CommunityControlSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommunityControlSignature.Marshal(b, m, deterministic)
+}
+func (m *CommunityControlSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityControlSignature.Merge(m, src)
+}
+func (m *CommunityControlSignature) XXX_Size() int {
+	return xxx_messageInfo_CommunityControlSignature.Size(m)
+}
+func (m *CommunityControlSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityControlSignature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityControlSignature proto.InternalMessageInfo
+
+func (m *CommunityControlSignature) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *CommunityControlSignature) GetCommunityId() []byte {
+	if m != nil {
+		return m.CommunityId
+	}
+	return nil
+}
+
+func (m *CommunityControlSignature) GetStateHash() []byte {
+	if m != nil {
+		return m.StateHash
+	}
+	return nil
+}
+
+func (m *CommunityControlSignature) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// CommunityDirectoryListing advertises a community on the discovery topic,
+// it's signed by the community owner
+type CommunityDirectoryListing struct {
+	Clock                uint64                      `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	CommunityId          []byte                      `protobuf:"bytes,2,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	Name                 string                      `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string                      `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Tags                 []string                    `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	MembersCount         uint32                      `protobuf:"varint,6,opt,name=members_count,json=membersCount,proto3" json:"members_count,omitempty"`
+	Image                []byte                      `protobuf:"bytes,7,opt,name=image,proto3" json:"image,omitempty"`
+	Access               CommunityPermissions_Access `protobuf:"varint,8,opt,name=access,proto3,enum=protobuf.CommunityPermissions_Access" json:"access,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *CommunityDirectoryListing) Reset()         { *m = CommunityDirectoryListing{} }
+func (m *CommunityDirectoryListing) String() string { return proto.CompactTextString(m) }
+func (*CommunityDirectoryListing) ProtoMessage()    {}
+func (*CommunityDirectoryListing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f937943d74c1cd8b, []int{20}
+}
+
+func (m *CommunityDirectoryListing) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommunityDirectoryListing.Unmarshal(m, b)
+}
+func (m *CommunityDirectoryListing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommunityDirectoryListing.Marshal(b, m, deterministic)
+}
+func (m *CommunityDirectoryListing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityDirectoryListing.Merge(m, src)
+}
+func (m *CommunityDirectoryListing) XXX_Size() int {
+	return xxx_messageInfo_CommunityDirectoryListing.Size(m)
+}
+func (m *CommunityDirectoryListing) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityDirectoryListing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityDirectoryListing proto.InternalMessageInfo
+
+func (m *CommunityDirectoryListing) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *CommunityDirectoryListing) GetCommunityId() []byte {
+	if m != nil {
+		return m.CommunityId
+	}
+	return nil
+}
+
+func (m *CommunityDirectoryListing) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CommunityDirectoryListing) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *CommunityDirectoryListing) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *CommunityDirectoryListing) GetMembersCount() uint32 {
+	if m != nil {
+		return m.MembersCount
+	}
+	return 0
+}
+
+func (m *CommunityDirectoryListing) GetImage() []byte {
+	if m != nil {
+		return m.Image
+	}
+	return nil
+}
+
+func (m *CommunityDirectoryListing) GetAccess() CommunityPermissions_Access {
+	if m != nil {
+		return m.Access
+	}
+	return CommunityPermissions_UNKNOWN_ACCESS
+}
+
 func init() {
 	proto.RegisterEnum("protobuf.CommunityMember_Roles", CommunityMember_Roles_name, CommunityMember_Roles_value)
 	proto.RegisterEnum("protobuf.CommunityPermissions_Access", CommunityPermissions_Access_name, CommunityPermissions_Access_value)
@@ -648,13 +1517,26 @@ func init() {
 	proto.RegisterType((*CommunityDescription)(nil), "protobuf.CommunityDescription")
 	proto.RegisterMapType((map[string]*CommunityCategory)(nil), "protobuf.CommunityDescription.CategoriesEntry")
 	proto.RegisterMapType((map[string]*CommunityChat)(nil), "protobuf.CommunityDescription.ChatsEntry")
+	proto.RegisterMapType((map[string]*CommunityEmoji)(nil), "protobuf.CommunityDescription.EmojisEntry")
 	proto.RegisterMapType((map[string]*CommunityMember)(nil), "protobuf.CommunityDescription.MembersEntry")
 	proto.RegisterType((*CommunityChat)(nil), "protobuf.CommunityChat")
 	proto.RegisterMapType((map[string]*CommunityMember)(nil), "protobuf.CommunityChat.MembersEntry")
 	proto.RegisterType((*CommunityCategory)(nil), "protobuf.CommunityCategory")
+	proto.RegisterType((*CommunityEmoji)(nil), "protobuf.CommunityEmoji")
 	proto.RegisterType((*CommunityInvitation)(nil), "protobuf.CommunityInvitation")
 	proto.RegisterType((*CommunityRequestToJoin)(nil), "protobuf.CommunityRequestToJoin")
 	proto.RegisterType((*CommunityRequestToJoinResponse)(nil), "protobuf.CommunityRequestToJoinResponse")
+	proto.RegisterType((*CommunityMessageArchiveIndexEntry)(nil), "protobuf.CommunityMessageArchiveIndexEntry")
+	proto.RegisterType((*WakuMessage)(nil), "protobuf.WakuMessage")
+	proto.RegisterType((*CommunityMessageArchive)(nil), "protobuf.CommunityMessageArchive")
+	proto.RegisterType((*CommunityMessageArchiveRequest)(nil), "protobuf.CommunityMessageArchiveRequest")
+	proto.RegisterType((*CommunityMessageArchiveResponse)(nil), "protobuf.CommunityMessageArchiveResponse")
+	proto.RegisterType((*CommunityOwnershipTransfer)(nil), "protobuf.CommunityOwnershipTransfer")
+	proto.RegisterType((*CommunityControl)(nil), "protobuf.CommunityControl")
+	proto.RegisterType((*CommunityControlState)(nil), "protobuf.CommunityControlState")
+	proto.RegisterType((*CommunityControlSignatureRequest)(nil), "protobuf.CommunityControlSignatureRequest")
+	proto.RegisterType((*CommunityControlSignature)(nil), "protobuf.CommunityControlSignature")
+	proto.RegisterType((*CommunityDirectoryListing)(nil), "protobuf.CommunityDirectoryListing")
 }
 
 func init() {
@@ -662,59 +1544,103 @@ func init() {
 }
 
 var fileDescriptor_f937943d74c1cd8b = []byte{
-	// 850 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xef, 0x8e, 0xdb, 0x44,
-	0x10, 0xef, 0xc6, 0x71, 0xe2, 0x4c, 0xd2, 0x3b, 0xdf, 0xde, 0xb5, 0x75, 0xaf, 0xa2, 0x0d, 0x16,
-	0x48, 0x41, 0x88, 0x20, 0x52, 0x21, 0x21, 0x04, 0x85, 0x70, 0x58, 0xc5, 0x34, 0xe7, 0xb4, 0x9b,
-	0x1c, 0x08, 0xbe, 0x58, 0x8e, 0xb3, 0x94, 0x55, 0x13, 0xdb, 0x78, 0x9d, 0x93, 0xf2, 0x00, 0x48,
-	0x3c, 0x02, 0x12, 0xdf, 0x79, 0x27, 0xbe, 0xf1, 0x28, 0x68, 0x77, 0xe3, 0x3f, 0xb9, 0x4b, 0xda,
-	0x93, 0x50, 0x3f, 0xc5, 0xb3, 0xbb, 0xf3, 0x9b, 0xdf, 0xfc, 0x66, 0x32, 0x03, 0x47, 0x61, 0xbc,
-	0x5c, 0xae, 0x22, 0x96, 0x31, 0xca, 0xfb, 0x49, 0x1a, 0x67, 0x31, 0x36, 0xe4, 0xcf, 0x6c, 0xf5,
-	0xcb, 0xe9, 0x71, 0xf8, 0x6b, 0x90, 0xf9, 0x6c, 0x4e, 0xa3, 0x8c, 0x65, 0x6b, 0x75, 0x6d, 0x5f,
-	0x82, 0xfe, 0x34, 0x0d, 0xa2, 0x0c, 0xbf, 0x0b, 0x9d, 0xdc, 0x79, 0xed, 0xb3, 0xb9, 0x85, 0xba,
-	0xa8, 0xd7, 0x21, 0xed, 0xe2, 0xcc, 0x9d, 0xe3, 0x07, 0xd0, 0x5a, 0xd2, 0xe5, 0x8c, 0xa6, 0xe2,
-	0xbe, 0x26, 0xef, 0x0d, 0x75, 0xe0, 0xce, 0xf1, 0x3d, 0x68, 0x6e, 0xf0, 0x2d, 0xad, 0x8b, 0x7a,
-	0x2d, 0xd2, 0x10, 0xa6, 0x3b, 0xc7, 0x27, 0xa0, 0x87, 0x8b, 0x38, 0x7c, 0x65, 0xd5, 0xbb, 0xa8,
-	0x57, 0x27, 0xca, 0xb0, 0xff, 0x40, 0x70, 0x78, 0x96, 0x63, 0x9f, 0x4b, 0x10, 0xfc, 0x29, 0xe8,
-	0x69, 0xbc, 0xa0, 0xdc, 0x42, 0x5d, 0xad, 0x77, 0x30, 0x78, 0xd4, 0xcf, 0xa9, 0xf7, 0xaf, 0xbc,
-	0xec, 0x13, 0xf1, 0x8c, 0xa8, 0xd7, 0xf6, 0x13, 0xd0, 0xa5, 0x8d, 0x4d, 0xe8, 0x5c, 0x78, 0xcf,
-	0xbc, 0xf1, 0x8f, 0x9e, 0x4f, 0xc6, 0x23, 0xc7, 0xbc, 0x85, 0x3b, 0x60, 0x88, 0x2f, 0x7f, 0x38,
-	0x1a, 0x99, 0x08, 0xdf, 0x81, 0x23, 0x69, 0x9d, 0x0f, 0xbd, 0xe1, 0x53, 0xc7, 0xbf, 0x98, 0x38,
-	0x64, 0x62, 0xd6, 0xec, 0x7f, 0x11, 0x9c, 0x14, 0x01, 0x9e, 0xd3, 0x74, 0xc9, 0x38, 0x67, 0x71,
-	0xc4, 0xf1, 0x7d, 0x30, 0x68, 0xc4, 0xfd, 0x38, 0x5a, 0xac, 0xa5, 0x1c, 0x06, 0x69, 0xd2, 0x88,
-	0x8f, 0xa3, 0xc5, 0x1a, 0x5b, 0xd0, 0x4c, 0x52, 0x76, 0x19, 0x64, 0x54, 0x0a, 0x61, 0x90, 0xdc,
-	0xc4, 0x5f, 0x42, 0x23, 0x08, 0x43, 0xca, 0xb9, 0x94, 0xe1, 0x60, 0xf0, 0xfe, 0x8e, 0x2c, 0x2a,
-	0x41, 0xfa, 0x43, 0xf9, 0x98, 0x6c, 0x9c, 0xec, 0x29, 0x34, 0xd4, 0x09, 0xc6, 0x70, 0x90, 0x67,
-	0x33, 0x3c, 0x3b, 0x73, 0x26, 0x13, 0xf3, 0x16, 0x3e, 0x82, 0xdb, 0xde, 0xd8, 0x3f, 0x77, 0xce,
-	0xbf, 0x71, 0xc8, 0xe4, 0x3b, 0xf7, 0xb9, 0x89, 0xf0, 0x31, 0x1c, 0xba, 0xde, 0x0f, 0xee, 0x74,
-	0x38, 0x75, 0xc7, 0x9e, 0x3f, 0xf6, 0x46, 0x3f, 0x99, 0x35, 0x7c, 0x00, 0x30, 0xf6, 0x7c, 0xe2,
-	0xbc, 0xb8, 0x70, 0x26, 0x53, 0x53, 0xb3, 0xff, 0xd2, 0x2b, 0x29, 0x7e, 0x4b, 0x79, 0x98, 0xb2,
-	0x24, 0x63, 0x71, 0x54, 0x16, 0x07, 0x55, 0x8a, 0x83, 0x1d, 0x68, 0xaa, 0xba, 0x72, 0xab, 0xd6,
-	0xd5, 0x7a, 0xed, 0xc1, 0x87, 0x3b, 0x92, 0xa8, 0xc0, 0xf4, 0x55, 0x59, 0xb8, 0x13, 0x65, 0xe9,
-	0x9a, 0xe4, 0xbe, 0xf8, 0x6b, 0x68, 0x27, 0x65, 0xa6, 0x52, 0x8f, 0xf6, 0xe0, 0xe1, 0xeb, 0xf5,
-	0x20, 0x55, 0x17, 0x3c, 0x00, 0x23, 0xef, 0x57, 0x4b, 0x97, 0xee, 0x77, 0x2b, 0xee, 0xb2, 0xbf,
-	0xd4, 0x2d, 0x29, 0xde, 0xe1, 0xaf, 0x40, 0x17, 0x9d, 0xc7, 0xad, 0x86, 0xa4, 0xfe, 0xc1, 0x1b,
-	0xa8, 0x0b, 0x94, 0x0d, 0x71, 0xe5, 0x27, 0xca, 0x3e, 0x0b, 0x22, 0x7f, 0xc1, 0x78, 0x66, 0x35,
-	0xbb, 0x5a, 0xaf, 0x45, 0x9a, 0xb3, 0x20, 0x1a, 0x31, 0x9e, 0x61, 0x0f, 0x20, 0x0c, 0x32, 0xfa,
-	0x32, 0x4e, 0x19, 0xe5, 0x96, 0x21, 0x03, 0xf4, 0xdf, 0x14, 0xa0, 0x70, 0x50, 0x51, 0x2a, 0x08,
-	0xa7, 0x17, 0xd0, 0xa9, 0x4a, 0x87, 0x4d, 0xd0, 0x5e, 0x51, 0xd5, 0x6c, 0x2d, 0x22, 0x3e, 0xf1,
-	0xc7, 0xa0, 0x5f, 0x06, 0x8b, 0x95, 0x6a, 0xb3, 0xf6, 0xe0, 0xfe, 0xde, 0xff, 0x04, 0x51, 0xef,
-	0x3e, 0xaf, 0x7d, 0x86, 0x4e, 0x5f, 0x00, 0x94, 0x69, 0xed, 0x00, 0xfd, 0x68, 0x1b, 0xf4, 0xde,
-	0x0e, 0x50, 0xe1, 0x5f, 0x85, 0xfc, 0x19, 0x0e, 0xaf, 0x24, 0xb2, 0x03, 0xf7, 0x93, 0x6d, 0xdc,
-	0x07, 0xbb, 0x70, 0x15, 0xc8, 0xba, 0x82, 0x6d, 0xff, 0x53, 0x83, 0xdb, 0x5b, 0x81, 0xf1, 0x93,
-	0xb2, 0x01, 0x91, 0x14, 0xf9, 0xbd, 0x3d, 0x14, 0x6f, 0xd6, 0x79, 0xb5, 0xff, 0xd7, 0x79, 0xda,
-	0x0d, 0x3b, 0xef, 0x11, 0xb4, 0x37, 0xb5, 0x95, 0x13, 0xb4, 0x2e, 0x85, 0xc9, 0xcb, 0x2d, 0x06,
-	0xe8, 0x29, 0x18, 0x49, 0xcc, 0x99, 0x68, 0x0b, 0xd9, 0xce, 0x3a, 0x29, 0xec, 0xb7, 0xd4, 0x0a,
-	0xf6, 0x1c, 0x8e, 0xae, 0x69, 0x7f, 0x95, 0x28, 0xba, 0x46, 0x14, 0x43, 0x3d, 0x0a, 0x96, 0x2a,
-	0x52, 0x8b, 0xc8, 0xef, 0x2d, 0xf2, 0xda, 0x36, 0x79, 0xfb, 0x4f, 0x04, 0xc7, 0x45, 0x18, 0x37,
-	0xba, 0x64, 0x59, 0x20, 0xc7, 0xcb, 0x63, 0xb8, 0x53, 0x2e, 0x95, 0x79, 0xf9, 0xa7, 0xd8, 0x6c,
-	0x97, 0x93, 0x70, 0xcf, 0x4c, 0x7a, 0x29, 0x56, 0xd2, 0x66, 0xc5, 0x28, 0x63, 0xff, 0x7e, 0x79,
-	0x07, 0x20, 0x59, 0xcd, 0x16, 0x2c, 0xf4, 0x85, 0x5e, 0x75, 0xe9, 0xd3, 0x52, 0x27, 0xcf, 0xe8,
-	0xda, 0xfe, 0x1d, 0xc1, 0xdd, 0x82, 0x1a, 0xa1, 0xbf, 0xad, 0x28, 0xcf, 0xa6, 0xf1, 0xf7, 0x31,
-	0xdb, 0x37, 0xfc, 0x36, 0x53, 0xbf, 0x92, 0xbf, 0x98, 0xfa, 0x9e, 0x90, 0x60, 0x2f, 0x87, 0xab,
-	0xcb, 0xb3, 0x7e, 0x6d, 0x79, 0xda, 0x7f, 0x23, 0x78, 0xb8, 0x9b, 0x07, 0xa1, 0x3c, 0x89, 0x23,
-	0x4e, 0xf7, 0xf0, 0xf9, 0x02, 0x5a, 0x05, 0xce, 0x6b, 0x3a, 0xb9, 0xa2, 0x20, 0x29, 0x1d, 0x44,
-	0xd5, 0xc4, 0x66, 0x49, 0x32, 0xaa, 0x38, 0x1b, 0xa4, 0xb0, 0x4b, 0xa1, 0xeb, 0x15, 0xa1, 0x67,
-	0x0d, 0x89, 0xfd, 0xf8, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xfc, 0x77, 0xbe, 0x23, 0x4c, 0x08,
-	0x00, 0x00,
+	// 1556 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4b, 0x6f, 0x1b, 0x47,
+	0x12, 0xf6, 0x0c, 0xdf, 0xc5, 0x87, 0xa8, 0x96, 0x64, 0x8f, 0xe5, 0x17, 0x3d, 0xbb, 0x06, 0xb4,
+	0xbb, 0x30, 0x0d, 0xcb, 0xbb, 0xc0, 0x62, 0xb1, 0xeb, 0x5d, 0x59, 0x26, 0x6c, 0xda, 0x12, 0x69,
+	0x37, 0xa5, 0x35, 0xe2, 0xcb, 0x60, 0x34, 0x6c, 0x93, 0x1d, 0x93, 0x33, 0xf4, 0x74, 0x53, 0x0e,
+	0x03, 0xe4, 0x18, 0x20, 0x39, 0xe6, 0x66, 0x20, 0x97, 0x9c, 0x72, 0xcd, 0xef, 0xc8, 0x4f, 0xc8,
+	0x2d, 0xff, 0x22, 0xd7, 0xa0, 0xbb, 0x67, 0xc8, 0xe6, 0x63, 0x2c, 0xc5, 0x4e, 0x4e, 0xd3, 0x55,
+	0x5d, 0xfd, 0x55, 0x4d, 0xd5, 0xd7, 0xdd, 0xd5, 0xb0, 0xee, 0x05, 0xc3, 0xe1, 0xd8, 0xa7, 0x9c,
+	0x12, 0x56, 0x1f, 0x85, 0x01, 0x0f, 0x50, 0x5e, 0x7e, 0x4e, 0xc6, 0xaf, 0xb6, 0x37, 0xbc, 0xbe,
+	0xcb, 0x1d, 0xda, 0x25, 0x3e, 0xa7, 0x7c, 0xa2, 0xa6, 0xed, 0x53, 0xc8, 0x3c, 0x0a, 0x5d, 0x9f,
+	0xa3, 0x9b, 0x50, 0x8a, 0x17, 0x4f, 0x1c, 0xda, 0xb5, 0x8c, 0x9a, 0xb1, 0x53, 0xc2, 0xc5, 0xa9,
+	0xae, 0xd9, 0x45, 0x57, 0xa0, 0x30, 0x24, 0xc3, 0x13, 0x12, 0x8a, 0x79, 0x53, 0xce, 0xe7, 0x95,
+	0xa2, 0xd9, 0x45, 0x97, 0x20, 0x17, 0xe1, 0x5b, 0xa9, 0x9a, 0xb1, 0x53, 0xc0, 0x59, 0x21, 0x36,
+	0xbb, 0x68, 0x13, 0x32, 0xde, 0x20, 0xf0, 0x5e, 0x5b, 0xe9, 0x9a, 0xb1, 0x93, 0xc6, 0x4a, 0xb0,
+	0xbf, 0x32, 0x60, 0x6d, 0x3f, 0xc6, 0x3e, 0x94, 0x20, 0xe8, 0x1f, 0x90, 0x09, 0x83, 0x01, 0x61,
+	0x96, 0x51, 0x4b, 0xed, 0x54, 0x76, 0x6f, 0xd4, 0xe3, 0xd0, 0xeb, 0x0b, 0x96, 0x75, 0x2c, 0xcc,
+	0xb0, 0xb2, 0xb6, 0xef, 0x43, 0x46, 0xca, 0xa8, 0x0a, 0xa5, 0xe3, 0xd6, 0xd3, 0x56, 0xfb, 0x45,
+	0xcb, 0xc1, 0xed, 0x83, 0x46, 0xf5, 0x02, 0x2a, 0x41, 0x5e, 0x8c, 0x9c, 0xbd, 0x83, 0x83, 0xaa,
+	0x81, 0xb6, 0x60, 0x5d, 0x4a, 0x87, 0x7b, 0xad, 0xbd, 0x47, 0x0d, 0xe7, 0xb8, 0xd3, 0xc0, 0x9d,
+	0xaa, 0x69, 0xff, 0x6c, 0xc0, 0xe6, 0xd4, 0xc1, 0x33, 0x12, 0x0e, 0x29, 0x63, 0x34, 0xf0, 0x19,
+	0xba, 0x0c, 0x79, 0xe2, 0x33, 0x27, 0xf0, 0x07, 0x13, 0x99, 0x8e, 0x3c, 0xce, 0x11, 0x9f, 0xb5,
+	0xfd, 0xc1, 0x04, 0x59, 0x90, 0x1b, 0x85, 0xf4, 0xd4, 0xe5, 0x44, 0x26, 0x22, 0x8f, 0x63, 0x11,
+	0xfd, 0x07, 0xb2, 0xae, 0xe7, 0x11, 0xc6, 0x64, 0x1a, 0x2a, 0xbb, 0xb7, 0x56, 0xfc, 0x85, 0xe6,
+	0xa4, 0xbe, 0x27, 0x8d, 0x71, 0xb4, 0xc8, 0x3e, 0x82, 0xac, 0xd2, 0x20, 0x04, 0x95, 0xf8, 0x6f,
+	0xf6, 0xf6, 0xf7, 0x1b, 0x9d, 0x4e, 0xf5, 0x02, 0x5a, 0x87, 0x72, 0xab, 0xed, 0x1c, 0x36, 0x0e,
+	0x1f, 0x34, 0x70, 0xe7, 0x71, 0xf3, 0x59, 0xd5, 0x40, 0x1b, 0xb0, 0xd6, 0x6c, 0xfd, 0xbf, 0x79,
+	0xb4, 0x77, 0xd4, 0x6c, 0xb7, 0x9c, 0x76, 0xeb, 0xe0, 0x93, 0xaa, 0x89, 0x2a, 0x00, 0xed, 0x96,
+	0x83, 0x1b, 0xcf, 0x8f, 0x1b, 0x9d, 0xa3, 0x6a, 0xca, 0xfe, 0x25, 0xaf, 0xfd, 0xe2, 0x43, 0xc2,
+	0xbc, 0x90, 0x8e, 0x38, 0x0d, 0xfc, 0x59, 0x71, 0x0c, 0xad, 0x38, 0xa8, 0x01, 0x39, 0x55, 0x57,
+	0x66, 0x99, 0xb5, 0xd4, 0x4e, 0x71, 0xf7, 0x6f, 0x2b, 0x7e, 0x42, 0x83, 0xa9, 0xab, 0xb2, 0xb0,
+	0x86, 0xcf, 0xc3, 0x09, 0x8e, 0xd7, 0xa2, 0xff, 0x41, 0x71, 0x34, 0xfb, 0x53, 0x99, 0x8f, 0xe2,
+	0xee, 0xf5, 0xf7, 0xe7, 0x03, 0xeb, 0x4b, 0xd0, 0x2e, 0xe4, 0x63, 0xbe, 0x5a, 0x19, 0xb9, 0xfc,
+	0xa2, 0xb6, 0x5c, 0xf2, 0x4b, 0xcd, 0xe2, 0xa9, 0x1d, 0xfa, 0x2f, 0x64, 0x04, 0xf3, 0x98, 0x95,
+	0x95, 0xa1, 0xff, 0xe5, 0x8c, 0xd0, 0x05, 0x4a, 0x14, 0xb8, 0x5a, 0x27, 0xca, 0x7e, 0xe2, 0xfa,
+	0xce, 0x80, 0x32, 0x6e, 0xe5, 0x6a, 0xa9, 0x9d, 0x02, 0xce, 0x9d, 0xb8, 0xfe, 0x01, 0x65, 0x1c,
+	0xb5, 0x00, 0x3c, 0x97, 0x93, 0x5e, 0x10, 0x52, 0xc2, 0xac, 0xbc, 0x74, 0x50, 0x3f, 0xcb, 0xc1,
+	0x74, 0x81, 0xf2, 0xa2, 0x21, 0xa0, 0x07, 0x90, 0x25, 0xc3, 0xe0, 0x53, 0xca, 0xac, 0x82, 0xc4,
+	0xfa, 0xeb, 0x19, 0x58, 0x0d, 0x69, 0xac, 0x70, 0xa2, 0x95, 0xe8, 0x19, 0x94, 0xdd, 0xd0, 0xeb,
+	0xd3, 0x53, 0xe2, 0x50, 0xbf, 0x4b, 0x3e, 0xb3, 0x20, 0xb1, 0x64, 0x87, 0x84, 0x31, 0xb7, 0x47,
+	0xf6, 0x94, 0x79, 0x53, 0x58, 0x2b, 0xac, 0x92, 0xab, 0xa9, 0x50, 0x05, 0x4c, 0xda, 0xb5, 0x8a,
+	0x72, 0x83, 0x9b, 0xb4, 0x8b, 0x8e, 0x61, 0x23, 0x78, 0xeb, 0x93, 0x90, 0xf5, 0xe9, 0xc8, 0xe1,
+	0xa1, 0xeb, 0xb3, 0x57, 0x82, 0x1a, 0x25, 0xe9, 0xe7, 0xcf, 0x2b, 0xfc, 0xb4, 0x63, 0xeb, 0xa3,
+	0xc8, 0x18, 0xa3, 0x60, 0x51, 0xc5, 0xd0, 0xdf, 0x21, 0xe7, 0x05, 0x3e, 0x0f, 0x83, 0x81, 0x55,
+	0x96, 0xb5, 0xdd, 0x5e, 0x01, 0xb5, 0xaf, 0x2c, 0x70, 0x6c, 0x8a, 0x6e, 0x03, 0x8a, 0x86, 0x0e,
+	0xa3, 0x3d, 0xdf, 0xe5, 0xe3, 0x90, 0x30, 0xab, 0x52, 0x4b, 0xed, 0x94, 0xf0, 0x7a, 0x34, 0xd3,
+	0x99, 0x4e, 0xa0, 0x3f, 0x41, 0x39, 0x24, 0xc3, 0xe0, 0x94, 0x74, 0x1d, 0xc5, 0x8a, 0x35, 0x59,
+	0xd1, 0x52, 0xa4, 0x94, 0xd5, 0xdf, 0x3e, 0x86, 0x92, 0xce, 0x60, 0x54, 0x85, 0xd4, 0x6b, 0xa2,
+	0xf6, 0x7c, 0x01, 0x8b, 0x21, 0xba, 0x03, 0x99, 0x53, 0x77, 0x30, 0x56, 0xbb, 0xbd, 0xb8, 0x7b,
+	0x39, 0xf1, 0x68, 0xc2, 0xca, 0xee, 0x5f, 0xe6, 0x3f, 0x8d, 0xed, 0xe7, 0x00, 0x33, 0x76, 0xad,
+	0x00, 0xbd, 0x3d, 0x0f, 0x7a, 0x69, 0xd5, 0xef, 0xf7, 0x5d, 0xae, 0x43, 0xbe, 0x84, 0xb5, 0x05,
+	0x3e, 0xad, 0xc0, 0xbd, 0x3b, 0x8f, 0x7b, 0x65, 0x15, 0xae, 0x02, 0x99, 0xe8, 0xd8, 0x1d, 0x28,
+	0x6a, 0xfc, 0x5a, 0x81, 0x5b, 0x9f, 0xc7, 0xb5, 0x56, 0xe0, 0x4a, 0x00, 0x0d, 0xd4, 0xfe, 0xc9,
+	0x84, 0xf2, 0xdc, 0xdf, 0xa0, 0xfb, 0xb3, 0xc3, 0xc5, 0x48, 0x64, 0x90, 0xb0, 0x3c, 0xdf, 0xa9,
+	0x62, 0x7e, 0xdc, 0xa9, 0x92, 0x3a, 0xe7, 0xa9, 0x72, 0x03, 0x8a, 0xd1, 0xbe, 0x95, 0xb7, 0x63,
+	0x5a, 0x66, 0x25, 0xde, 0xca, 0xe2, 0x72, 0xdc, 0x86, 0xfc, 0x28, 0x60, 0x54, 0x6c, 0x53, 0x79,
+	0x54, 0x65, 0xf0, 0x54, 0xfe, 0x83, 0xf8, 0x65, 0x77, 0x61, 0x7d, 0xa9, 0xa0, 0x8b, 0x81, 0x1a,
+	0x4b, 0x81, 0x22, 0x48, 0xfb, 0xee, 0x50, 0x79, 0x2a, 0x60, 0x39, 0x9e, 0x0b, 0x3e, 0x35, 0x1f,
+	0xbc, 0xed, 0x43, 0x65, 0xbe, 0xbc, 0xf2, 0x5e, 0x14, 0x83, 0x19, 0x7e, 0x4e, 0xca, 0x09, 0xe0,
+	0xb7, 0x21, 0x43, 0x87, 0x6e, 0x8f, 0x58, 0xa9, 0x45, 0x9a, 0xc7, 0x79, 0x6e, 0x8a, 0x69, 0xac,
+	0xac, 0xec, 0x77, 0x06, 0x6c, 0x4c, 0x1d, 0x36, 0xfd, 0x53, 0xca, 0x5d, 0x79, 0x55, 0xdd, 0x83,
+	0xad, 0x59, 0x83, 0xd2, 0x9d, 0x1d, 0x8a, 0x51, 0xa7, 0xb2, 0xe9, 0x25, 0xdc, 0x6f, 0x3d, 0xd1,
+	0xde, 0x44, 0xed, 0x8a, 0x12, 0x92, 0x7b, 0x95, 0x6b, 0x00, 0xa3, 0xf1, 0xc9, 0x80, 0x7a, 0x8e,
+	0xa8, 0x4f, 0x5a, 0xae, 0x29, 0x28, 0xcd, 0x53, 0x32, 0xb1, 0xbf, 0x34, 0xe0, 0xe2, 0x34, 0x34,
+	0x4c, 0xde, 0x8c, 0x09, 0xe3, 0x47, 0xc1, 0x93, 0x80, 0x26, 0x5d, 0xa4, 0x51, 0x07, 0xa1, 0xa5,
+	0x44, 0x74, 0x10, 0x2d, 0x91, 0x95, 0xc4, 0x18, 0x16, 0x1b, 0xb1, 0xf4, 0x52, 0x23, 0x66, 0x7f,
+	0x6f, 0xc0, 0xf5, 0xd5, 0x71, 0x60, 0xc2, 0x46, 0x81, 0xcf, 0x48, 0x42, 0x3c, 0xff, 0x86, 0xc2,
+	0x14, 0xe7, 0x3d, 0x3b, 0x47, 0xcb, 0x20, 0x9e, 0x2d, 0x10, 0x2c, 0x11, 0x5d, 0xca, 0x88, 0x13,
+	0x15, 0x73, 0x1e, 0x4f, 0xe5, 0x59, 0xa2, 0xd3, 0x5a, 0xa2, 0xed, 0x1f, 0x0c, 0xb8, 0x79, 0xe6,
+	0xed, 0x23, 0x48, 0xd3, 0x77, 0x59, 0x3f, 0x2a, 0xa4, 0x1c, 0xeb, 0xe9, 0x31, 0xe7, 0xd2, 0x83,
+	0x20, 0xfd, 0x2a, 0x0c, 0x86, 0x32, 0x80, 0x34, 0x96, 0x63, 0x71, 0x61, 0xf1, 0x20, 0xea, 0x2f,
+	0x4d, 0x1e, 0xa0, 0x5b, 0x50, 0x19, 0x2a, 0x67, 0xcc, 0xf1, 0x82, 0xb1, 0xcf, 0xe5, 0x8e, 0x2c,
+	0xe3, 0x72, 0xac, 0xdd, 0x17, 0x4a, 0x01, 0xc5, 0xe8, 0xe7, 0xc4, 0xca, 0x2a, 0x28, 0x31, 0xb6,
+	0xbf, 0x35, 0xa0, 0xf8, 0xc2, 0x7d, 0x3d, 0x8e, 0x82, 0x15, 0x5b, 0x95, 0xd1, 0x5e, 0x14, 0x9a,
+	0x18, 0xa2, 0xab, 0x50, 0xe0, 0x74, 0x48, 0x18, 0x77, 0x87, 0x23, 0x19, 0x5b, 0x19, 0xcf, 0x14,
+	0x22, 0x0f, 0x3c, 0x18, 0x51, 0x4f, 0xc6, 0x57, 0xc2, 0x4a, 0x90, 0xed, 0xa2, 0x3b, 0x19, 0x04,
+	0x6e, 0x5c, 0xce, 0x58, 0x54, 0x33, 0xdd, 0x2e, 0xf5, 0x7b, 0x56, 0x26, 0x9e, 0x91, 0xe2, 0x34,
+	0x2b, 0xd9, 0x59, 0x56, 0xec, 0xaf, 0x0d, 0xb8, 0x94, 0x90, 0x4f, 0x3d, 0x63, 0xc6, 0xca, 0x8c,
+	0x99, 0x4b, 0x19, 0x4b, 0x4d, 0x33, 0x76, 0x17, 0xf2, 0x71, 0x6e, 0xac, 0xb4, 0x3c, 0x95, 0xb7,
+	0x66, 0xbc, 0xd0, 0xf2, 0x81, 0xa7, 0x66, 0xf6, 0x1b, 0x8d, 0x83, 0xf3, 0xa1, 0x44, 0x8c, 0x4c,
+	0xe0, 0xe0, 0x22, 0xbf, 0xcd, 0xe5, 0x87, 0xc6, 0x45, 0xc8, 0x8a, 0xdf, 0x25, 0xa2, 0x67, 0x14,
+	0xf7, 0x7a, 0x24, 0xd9, 0xdf, 0x19, 0x70, 0x23, 0xd1, 0xe7, 0x7b, 0x89, 0x7f, 0x0e, 0xa7, 0x71,
+	0xbe, 0x53, 0x1a, 0x0b, 0x11, 0xa4, 0xbb, 0x2e, 0x77, 0xa3, 0xa2, 0xc9, 0xb1, 0xa8, 0xff, 0xb4,
+	0xf1, 0x88, 0x6a, 0x36, 0x53, 0xd8, 0x43, 0xd8, 0x4e, 0x6e, 0x83, 0xc4, 0x0b, 0xca, 0x27, 0x6f,
+	0x1d, 0xd9, 0x0c, 0x45, 0x9c, 0xca, 0xfb, 0xe4, 0xad, 0x34, 0x9c, 0x45, 0x6e, 0xea, 0x91, 0xcf,
+	0xb9, 0x4b, 0x2d, 0xba, 0x7b, 0x02, 0xd5, 0xc5, 0x56, 0x49, 0x50, 0x4a, 0x18, 0xc4, 0x17, 0x6c,
+	0x09, 0xc7, 0xa2, 0xa4, 0x6e, 0x3f, 0x24, 0xac, 0x1f, 0x0c, 0xba, 0x53, 0xea, 0xc6, 0x0a, 0xfb,
+	0x47, 0x13, 0xb6, 0x16, 0xc1, 0x3a, 0x5c, 0xbc, 0x69, 0xce, 0xf1, 0x36, 0x4c, 0xe8, 0x11, 0xcd,
+	0xdf, 0xaf, 0x47, 0x4c, 0x9d, 0xbf, 0x47, 0x5c, 0x68, 0x11, 0xd2, 0xbf, 0xbd, 0x45, 0xd0, 0xdf,
+	0x00, 0x99, 0xf9, 0x37, 0xc0, 0x52, 0x47, 0x99, 0x5d, 0xee, 0x28, 0xed, 0x2f, 0xa0, 0xb6, 0x94,
+	0xca, 0xb8, 0x68, 0x1f, 0xbd, 0x3d, 0x6a, 0x50, 0xd4, 0xef, 0x3f, 0x45, 0x0a, 0x5d, 0x65, 0x7f,
+	0x63, 0xc0, 0xe5, 0x44, 0xff, 0x1f, 0xee, 0xf8, 0x1a, 0x00, 0x13, 0x84, 0x70, 0xb4, 0x8d, 0x52,
+	0x90, 0x9a, 0xc7, 0x62, 0xb7, 0xcc, 0x51, 0x35, 0xbd, 0x48, 0xd5, 0x77, 0xa6, 0x16, 0xd3, 0x43,
+	0x1a, 0x12, 0x8f, 0x07, 0xe1, 0x44, 0xa4, 0x54, 0x9c, 0x76, 0x1f, 0xb3, 0x6d, 0xe5, 0xf5, 0x9a,
+	0xd2, 0x3a, 0x8e, 0x85, 0x04, 0xa9, 0x66, 0x4d, 0x57, 0x89, 0x55, 0xdc, 0xed, 0xb1, 0xa8, 0xb6,
+	0x72, 0x2c, 0x0a, 0x1b, 0xf5, 0x98, 0xd1, 0xa5, 0x91, 0x95, 0x3b, 0xa4, 0x14, 0x29, 0xd5, 0x9d,
+	0xb1, 0x19, 0x37, 0x33, 0x39, 0x75, 0xbe, 0x4b, 0x41, 0x7b, 0xf4, 0xe7, 0x3f, 0xe0, 0xd1, 0xff,
+	0xe0, 0xfa, 0xcb, 0xab, 0x3d, 0xca, 0xfb, 0xe3, 0x93, 0xba, 0x17, 0x0c, 0xef, 0xc8, 0xa5, 0x5e,
+	0x30, 0xb8, 0x13, 0x63, 0x9c, 0x64, 0xe5, 0xe8, 0xde, 0xaf, 0x03, 0x00, 0x4e, 0x2d, 0xff, 0xec,
+	0xdf, 0x11, 0x00, 0x00,
 }
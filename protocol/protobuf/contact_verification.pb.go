@@ -0,0 +1,331 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: contact_verification.proto
+
+package protobuf
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type VerificationStatus int32
+
+const (
+	VerificationStatus_VERIFICATION_PENDING       VerificationStatus = 0
+	VerificationStatus_VERIFICATION_ACCEPTED      VerificationStatus = 1
+	VerificationStatus_VERIFICATION_TRUSTED       VerificationStatus = 2
+	VerificationStatus_VERIFICATION_UNTRUSTWORTHY VerificationStatus = 3
+)
+
+var VerificationStatus_name = map[int32]string{
+	0: "VERIFICATION_PENDING",
+	1: "VERIFICATION_ACCEPTED",
+	2: "VERIFICATION_TRUSTED",
+	3: "VERIFICATION_UNTRUSTWORTHY",
+}
+
+var VerificationStatus_value = map[string]int32{
+	"VERIFICATION_PENDING":       0,
+	"VERIFICATION_ACCEPTED":      1,
+	"VERIFICATION_TRUSTED":       2,
+	"VERIFICATION_UNTRUSTWORTHY": 3,
+}
+
+func (x VerificationStatus) String() string {
+	return proto.EnumName(VerificationStatus_name, int32(x))
+}
+
+func (VerificationStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d6997df64de39454, []int{0}
+}
+
+type TrustStatus int32
+
+const (
+	TrustStatus_UNKNOWN_TRUST_STATUS TrustStatus = 0
+	TrustStatus_TRUSTED              TrustStatus = 1
+	TrustStatus_UNTRUSTWORTHY        TrustStatus = 2
+)
+
+var TrustStatus_name = map[int32]string{
+	0: "UNKNOWN_TRUST_STATUS",
+	1: "TRUSTED",
+	2: "UNTRUSTWORTHY",
+}
+
+var TrustStatus_value = map[string]int32{
+	"UNKNOWN_TRUST_STATUS": 0,
+	"TRUSTED":              1,
+	"UNTRUSTWORTHY":        2,
+}
+
+func (x TrustStatus) String() string {
+	return proto.EnumName(TrustStatus_name, int32(x))
+}
+
+func (TrustStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d6997df64de39454, []int{1}
+}
+
+// RequestContactVerification asks a contact to answer a question that
+// only the person we know would be able to answer
+type RequestContactVerification struct {
+	Clock                uint64   `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	Challenge            string   `protobuf:"bytes,2,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RequestContactVerification) Reset()         { *m = RequestContactVerification{} }
+func (m *RequestContactVerification) String() string { return proto.CompactTextString(m) }
+func (*RequestContactVerification) ProtoMessage()    {}
+func (*RequestContactVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6997df64de39454, []int{0}
+}
+
+func (m *RequestContactVerification) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestContactVerification.Unmarshal(m, b)
+}
+func (m *RequestContactVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestContactVerification.Marshal(b, m, deterministic)
+}
+func (m *RequestContactVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestContactVerification.Merge(m, src)
+}
+func (m *RequestContactVerification) XXX_Size() int {
+	return xxx_messageInfo_RequestContactVerification.Size(m)
+}
+func (m *RequestContactVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestContactVerification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestContactVerification proto.InternalMessageInfo
+
+func (m *RequestContactVerification) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *RequestContactVerification) GetChallenge() string {
+	if m != nil {
+		return m.Challenge
+	}
+	return ""
+}
+
+type AcceptContactVerification struct {
+	Clock uint64 `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	// The id of the RequestContactVerification message
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Response             string   `protobuf:"bytes,3,opt,name=response,proto3" json:"response,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AcceptContactVerification) Reset()         { *m = AcceptContactVerification{} }
+func (m *AcceptContactVerification) String() string { return proto.CompactTextString(m) }
+func (*AcceptContactVerification) ProtoMessage()    {}
+func (*AcceptContactVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6997df64de39454, []int{1}
+}
+
+func (m *AcceptContactVerification) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AcceptContactVerification.Unmarshal(m, b)
+}
+func (m *AcceptContactVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AcceptContactVerification.Marshal(b, m, deterministic)
+}
+func (m *AcceptContactVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcceptContactVerification.Merge(m, src)
+}
+func (m *AcceptContactVerification) XXX_Size() int {
+	return xxx_messageInfo_AcceptContactVerification.Size(m)
+}
+func (m *AcceptContactVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcceptContactVerification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcceptContactVerification proto.InternalMessageInfo
+
+func (m *AcceptContactVerification) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *AcceptContactVerification) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *AcceptContactVerification) GetResponse() string {
+	if m != nil {
+		return m.Response
+	}
+	return ""
+}
+
+// SyncVerificationRequest keeps the verification requests of paired devices in sync
+type SyncVerificationRequest struct {
+	Clock                uint64             `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	Id                   string             `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	From                 string             `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string             `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Challenge            string             `protobuf:"bytes,5,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	Response             string             `protobuf:"bytes,6,opt,name=response,proto3" json:"response,omitempty"`
+	RequestedAt          uint64             `protobuf:"varint,7,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	RepliedAt            uint64             `protobuf:"varint,8,opt,name=replied_at,json=repliedAt,proto3" json:"replied_at,omitempty"`
+	VerificationStatus   VerificationStatus `protobuf:"varint,9,opt,name=verification_status,json=verificationStatus,proto3,enum=protobuf.VerificationStatus" json:"verification_status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *SyncVerificationRequest) Reset()         { *m = SyncVerificationRequest{} }
+func (m *SyncVerificationRequest) String() string { return proto.CompactTextString(m) }
+func (*SyncVerificationRequest) ProtoMessage()    {}
+func (*SyncVerificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6997df64de39454, []int{2}
+}
+
+func (m *SyncVerificationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncVerificationRequest.Unmarshal(m, b)
+}
+func (m *SyncVerificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncVerificationRequest.Marshal(b, m, deterministic)
+}
+func (m *SyncVerificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncVerificationRequest.Merge(m, src)
+}
+func (m *SyncVerificationRequest) XXX_Size() int {
+	return xxx_messageInfo_SyncVerificationRequest.Size(m)
+}
+func (m *SyncVerificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncVerificationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncVerificationRequest proto.InternalMessageInfo
+
+func (m *SyncVerificationRequest) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *SyncVerificationRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *SyncVerificationRequest) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *SyncVerificationRequest) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *SyncVerificationRequest) GetChallenge() string {
+	if m != nil {
+		return m.Challenge
+	}
+	return ""
+}
+
+func (m *SyncVerificationRequest) GetResponse() string {
+	if m != nil {
+		return m.Response
+	}
+	return ""
+}
+
+func (m *SyncVerificationRequest) GetRequestedAt() uint64 {
+	if m != nil {
+		return m.RequestedAt
+	}
+	return 0
+}
+
+func (m *SyncVerificationRequest) GetRepliedAt() uint64 {
+	if m != nil {
+		return m.RepliedAt
+	}
+	return 0
+}
+
+func (m *SyncVerificationRequest) GetVerificationStatus() VerificationStatus {
+	if m != nil {
+		return m.VerificationStatus
+	}
+	return VerificationStatus_VERIFICATION_PENDING
+}
+
+func init() {
+	proto.RegisterEnum("protobuf.VerificationStatus", VerificationStatus_name, VerificationStatus_value)
+	proto.RegisterEnum("protobuf.TrustStatus", TrustStatus_name, TrustStatus_value)
+	proto.RegisterType((*RequestContactVerification)(nil), "protobuf.RequestContactVerification")
+	proto.RegisterType((*AcceptContactVerification)(nil), "protobuf.AcceptContactVerification")
+	proto.RegisterType((*SyncVerificationRequest)(nil), "protobuf.SyncVerificationRequest")
+}
+
+func init() {
+	proto.RegisterFile("contact_verification.proto", fileDescriptor_d6997df64de39454)
+}
+
+var fileDescriptor_d6997df64de39454 = []byte{
+	// 407 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xdf, 0x8e, 0x93, 0x40,
+	0x14, 0xc6, 0x17, 0xb6, 0xbb, 0x5b, 0x4e, 0x75, 0x83, 0xe3, 0x1a, 0x59, 0x52, 0x37, 0xb5, 0x57,
+	0xcd, 0x5e, 0xd0, 0x44, 0x9f, 0x00, 0x29, 0x56, 0x62, 0xa4, 0x0d, 0x7f, 0xda, 0x68, 0x62, 0x08,
+	0x9d, 0x4e, 0x5b, 0x22, 0x65, 0x10, 0x86, 0x26, 0x5e, 0xfb, 0x3e, 0x3e, 0xa3, 0xe9, 0x40, 0x5b,
+	0xb0, 0x37, 0xee, 0x15, 0x33, 0xdf, 0xf9, 0xf8, 0xcd, 0x77, 0xce, 0x01, 0x15, 0xd3, 0x84, 0x85,
+	0x98, 0x05, 0x3b, 0x92, 0x45, 0xab, 0x08, 0x87, 0x2c, 0xa2, 0x89, 0x96, 0x66, 0x94, 0x51, 0xd4,
+	0xe6, 0x9f, 0x45, 0xb1, 0xea, 0x4f, 0x41, 0x75, 0xc8, 0xcf, 0x82, 0xe4, 0xcc, 0x28, 0xed, 0xb3,
+	0x9a, 0x1b, 0xdd, 0xc1, 0x15, 0x8e, 0x29, 0xfe, 0xa1, 0x08, 0x3d, 0x61, 0xd0, 0x72, 0xca, 0x0b,
+	0xea, 0x82, 0x84, 0x37, 0x61, 0x1c, 0x93, 0x64, 0x4d, 0x14, 0xb1, 0x27, 0x0c, 0x24, 0xe7, 0x24,
+	0xf4, 0xbf, 0xc3, 0xbd, 0x8e, 0x31, 0x49, 0x9f, 0x00, 0xbc, 0x05, 0x31, 0x5a, 0x56, 0x24, 0x31,
+	0x5a, 0x22, 0x15, 0xda, 0x19, 0xc9, 0x53, 0x9a, 0xe4, 0x44, 0xb9, 0xe4, 0xea, 0xf1, 0xde, 0xff,
+	0x23, 0xc2, 0x6b, 0xf7, 0x57, 0x82, 0xeb, 0xd8, 0xaa, 0x83, 0xff, 0xa4, 0x23, 0x68, 0xad, 0x32,
+	0xba, 0xad, 0xc8, 0xfc, 0xbc, 0xf7, 0x30, 0xaa, 0xb4, 0x4a, 0x0f, 0xa3, 0xcd, 0x16, 0xaf, 0xfe,
+	0x69, 0xb1, 0x91, 0xef, 0xba, 0x99, 0x0f, 0xbd, 0x85, 0x67, 0x59, 0x19, 0x87, 0x2c, 0x83, 0x90,
+	0x29, 0x37, 0x3c, 0x4a, 0xe7, 0xa8, 0xe9, 0x0c, 0xbd, 0x01, 0xc8, 0x48, 0x1a, 0x47, 0xa5, 0xa1,
+	0xcd, 0x0d, 0x52, 0xa5, 0xe8, 0x0c, 0x7d, 0x81, 0x97, 0xf5, 0x95, 0x05, 0x39, 0x0b, 0x59, 0x91,
+	0x2b, 0x52, 0x4f, 0x18, 0xdc, 0xbe, 0xeb, 0x6a, 0x87, 0xd5, 0x69, 0xf5, 0x09, 0xb8, 0xdc, 0xe3,
+	0xa0, 0xdd, 0x99, 0xf6, 0xf8, 0x5b, 0x00, 0x74, 0x6e, 0x45, 0x0a, 0xdc, 0xcd, 0x4c, 0xc7, 0xfa,
+	0x68, 0x19, 0xba, 0x67, 0x4d, 0xec, 0x60, 0x6a, 0xda, 0x23, 0xcb, 0x1e, 0xcb, 0x17, 0xe8, 0x1e,
+	0x5e, 0x35, 0x2a, 0xba, 0x61, 0x98, 0x53, 0xcf, 0x1c, 0xc9, 0xc2, 0xd9, 0x4f, 0x9e, 0xe3, 0xbb,
+	0xfb, 0x8a, 0x88, 0x1e, 0x40, 0x6d, 0x54, 0x7c, 0x9b, 0xd7, 0xe6, 0x13, 0xc7, 0xfb, 0xf4, 0x55,
+	0xbe, 0x7c, 0x1c, 0x43, 0xc7, 0xcb, 0x8a, 0x9c, 0x9d, 0x5e, 0xf7, 0xed, 0xcf, 0xf6, 0x64, 0x5e,
+	0x31, 0x02, 0xd7, 0xd3, 0x3d, 0xdf, 0x95, 0x2f, 0x50, 0x07, 0x6e, 0x0e, 0x54, 0x01, 0xbd, 0x80,
+	0xe7, 0x4d, 0x90, 0xf8, 0xe1, 0xe1, 0x5b, 0x77, 0x1d, 0xb1, 0x4d, 0xb1, 0xd0, 0x30, 0xdd, 0x0e,
+	0xf9, 0x30, 0x30, 0x8d, 0x87, 0x87, 0xa9, 0x2c, 0xae, 0xf9, 0xe9, 0xfd, 0xdf, 0x01, 0x00, 0x22,
+	0xc6, 0xc8, 0xba, 0xff, 0x02, 0x00, 0x00,
+}
@@ -75,7 +75,12 @@ type EmojiReaction struct {
 	// whether this is a rectraction of a previously sent emoji
 	Retracted bool `protobuf:"varint,6,opt,name=retracted,proto3" json:"retracted,omitempty"`
 	// Grant for organisation chat messages
-	Grant                []byte   `protobuf:"bytes,7,opt,name=grant,proto3" json:"grant,omitempty"`
+	Grant []byte `protobuf:"bytes,7,opt,name=grant,proto3" json:"grant,omitempty"`
+	// emoji the unicode emoji sequence the user wishes to react with,
+	// type is also set if the emoji matches one of the legacy types
+	Emoji string `protobuf:"bytes,8,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	// community_emoji_id the ID of a custom emoji defined in the community description
+	CommunityEmojiId     string   `protobuf:"bytes,9,opt,name=community_emoji_id,json=communityEmojiId,proto3" json:"community_emoji_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -155,6 +160,20 @@ func (m *EmojiReaction) GetGrant() []byte {
 	return nil
 }
 
+func (m *EmojiReaction) GetEmoji() string {
+	if m != nil {
+		return m.Emoji
+	}
+	return ""
+}
+
+func (m *EmojiReaction) GetCommunityEmojiId() string {
+	if m != nil {
+		return m.CommunityEmojiId
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("protobuf.EmojiReaction_Type", EmojiReaction_Type_name, EmojiReaction_Type_value)
 	proto.RegisterType((*EmojiReaction)(nil), "protobuf.EmojiReaction")
@@ -165,25 +184,29 @@ func init() {
 }
 
 var fileDescriptor_0a088c907bbc7ed6 = []byte{
-	// 318 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x8f, 0x5f, 0x4f, 0x82, 0x50,
-	0x18, 0xc6, 0x3b, 0x0a, 0x28, 0xaf, 0x5a, 0x67, 0x67, 0xb6, 0x58, 0xd9, 0x62, 0x5e, 0x71, 0xc5,
-	0x5a, 0xdd, 0x74, 0x4b, 0xc9, 0x94, 0x52, 0x70, 0x47, 0xc8, 0x79, 0xc5, 0x10, 0x4e, 0x66, 0x85,
-	0x30, 0x3c, 0x5e, 0xf8, 0xa9, 0xfb, 0x0a, 0x8d, 0x83, 0xce, 0x75, 0x75, 0xf6, 0x7b, 0xfe, 0xec,
-	0xbc, 0x0f, 0x74, 0x59, 0x9a, 0x7d, 0xad, 0xc3, 0x82, 0x45, 0x31, 0x5f, 0x67, 0x1b, 0x33, 0x2f,
-	0x32, 0x9e, 0x91, 0xa6, 0x78, 0x96, 0xbb, 0x8f, 0xeb, 0x16, 0xdb, 0xec, 0xd2, 0x6d, 0x25, 0xf7,
-	0x7f, 0x6b, 0xd0, 0xb1, 0xcb, 0x3c, 0x3d, 0xc4, 0x49, 0x17, 0xe4, 0xf8, 0x27, 0x8b, 0xbf, 0x35,
-	0xa4, 0x23, 0x43, 0xa2, 0x15, 0x90, 0x2b, 0x68, 0xc4, 0x9f, 0x11, 0x0f, 0xd7, 0x89, 0x56, 0xd3,
-	0x91, 0xa1, 0x52, 0xa5, 0x44, 0x27, 0x21, 0xb7, 0x00, 0x29, 0xdb, 0x6e, 0xa3, 0x15, 0x2b, 0xbd,
-	0xba, 0xf0, 0xd4, 0x83, 0xe2, 0x24, 0xe4, 0x09, 0xda, 0x47, 0x9b, 0xef, 0x73, 0xa6, 0x49, 0x3a,
-	0x32, 0xce, 0x1f, 0x2e, 0xcd, 0xe3, 0x35, 0xe6, 0xa4, 0x72, 0xfd, 0x7d, 0xce, 0x68, 0x2b, 0x3d,
-	0x01, 0xb9, 0x07, 0x49, 0x34, 0x64, 0xd1, 0xe8, 0x9d, 0x1a, 0xff, 0xce, 0x35, 0x45, 0x51, 0x24,
-	0x49, 0x0f, 0xd4, 0x82, 0xf1, 0x22, 0x8a, 0x39, 0x4b, 0x34, 0x45, 0x47, 0x46, 0x93, 0x9e, 0x84,
-	0x72, 0xd7, 0xaa, 0x88, 0x36, 0x5c, 0x6b, 0xe8, 0xc8, 0x68, 0xd3, 0x0a, 0xfa, 0x39, 0x48, 0xe2,
-	0xb7, 0x3b, 0xb8, 0x09, 0xdc, 0x37, 0xd7, 0x9b, 0xbb, 0xa1, 0x3d, 0xf1, 0x5e, 0x9d, 0x90, 0xda,
-	0xd6, 0x8b, 0xef, 0x78, 0x6e, 0xe8, 0x2f, 0xa6, 0x36, 0x3e, 0x23, 0x4d, 0x90, 0xc6, 0xde, 0xbb,
-	0x8d, 0x11, 0xe9, 0x80, 0xea, 0x8f, 0x82, 0xc9, 0xf3, 0x2c, 0x0c, 0xa6, 0xb8, 0x46, 0x2e, 0xa0,
-	0x75, 0xc0, 0x81, 0x37, 0x77, 0x71, 0x9d, 0xa8, 0x20, 0x8f, 0xad, 0x60, 0x38, 0xc2, 0x12, 0x69,
-	0x40, 0x7d, 0x66, 0x0d, 0xb0, 0x5c, 0x6a, 0x96, 0x3b, 0xa4, 0x0b, 0xac, 0x2c, 0x15, 0x31, 0xe4,
-	0xf1, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x65, 0xad, 0x5a, 0x02, 0xa7, 0x01, 0x00, 0x00,
+	// 371 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x50, 0x4d, 0x8f, 0x93, 0x40,
+	0x18, 0x96, 0xe5, 0xa3, 0xf0, 0x76, 0x57, 0x27, 0x93, 0x35, 0x12, 0xad, 0x4a, 0xf6, 0xc4, 0xc1,
+	0xb0, 0x46, 0x2f, 0x5e, 0x59, 0x97, 0xec, 0xa2, 0x2d, 0x34, 0x53, 0xb0, 0xa9, 0x17, 0x42, 0x87,
+	0xb1, 0x45, 0x0b, 0x43, 0xe8, 0x70, 0xe8, 0xef, 0xf2, 0x0f, 0x1a, 0x86, 0xd6, 0x66, 0x4f, 0x33,
+	0xcf, 0x57, 0xf2, 0xbc, 0x0f, 0x5c, 0xb3, 0x8a, 0xff, 0x2e, 0xb3, 0x96, 0xe5, 0x54, 0x94, 0xbc,
+	0xf6, 0x9a, 0x96, 0x0b, 0x8e, 0x4d, 0xf9, 0xac, 0xbb, 0x5f, 0xaf, 0xc7, 0xac, 0xee, 0xaa, 0xfd,
+	0x40, 0xdf, 0xfc, 0x55, 0xe1, 0x2a, 0xe8, 0xfd, 0xe4, 0x68, 0xc7, 0xd7, 0xa0, 0xd3, 0x1d, 0xa7,
+	0x7f, 0x6c, 0xc5, 0x51, 0x5c, 0x8d, 0x0c, 0x00, 0xbf, 0x82, 0x11, 0xdd, 0xe6, 0x22, 0x2b, 0x0b,
+	0xfb, 0xc2, 0x51, 0x5c, 0x8b, 0x18, 0x3d, 0x0c, 0x0b, 0xfc, 0x16, 0xa0, 0x62, 0xfb, 0x7d, 0xbe,
+	0x61, 0xbd, 0xa6, 0x4a, 0xcd, 0x3a, 0x32, 0x61, 0x81, 0xbf, 0xc0, 0xe5, 0x49, 0x16, 0x87, 0x86,
+	0xd9, 0x9a, 0xa3, 0xb8, 0xcf, 0x3f, 0xbd, 0xf4, 0x4e, 0x6d, 0xbc, 0xd9, 0xa0, 0x26, 0x87, 0x86,
+	0x91, 0x71, 0x75, 0x06, 0xf8, 0x23, 0x68, 0x32, 0xa1, 0xcb, 0xc4, 0xe4, 0x9c, 0x78, 0x52, 0xd7,
+	0x93, 0x41, 0xe9, 0xc4, 0x13, 0xb0, 0x5a, 0x26, 0xda, 0x9c, 0x0a, 0x56, 0xd8, 0x86, 0xa3, 0xb8,
+	0x26, 0x39, 0x13, 0xfd, 0x5d, 0x9b, 0x36, 0xaf, 0x85, 0x3d, 0x72, 0x14, 0xf7, 0x92, 0x0c, 0xa0,
+	0x67, 0xe5, 0x5c, 0xb6, 0x29, 0x9b, 0x0f, 0x00, 0x7f, 0x00, 0x4c, 0x79, 0x55, 0x75, 0x75, 0x29,
+	0x0e, 0xd9, 0x30, 0x67, 0x59, 0xd8, 0x96, 0xb4, 0xa0, 0xff, 0x8a, 0x2c, 0x12, 0x16, 0x37, 0x0d,
+	0x68, 0xb2, 0xf1, 0x7b, 0x78, 0x93, 0x46, 0xdf, 0xa3, 0x78, 0x19, 0x65, 0xc1, 0x2c, 0xfe, 0x16,
+	0x66, 0x24, 0xf0, 0xbf, 0x26, 0x61, 0x1c, 0x65, 0xc9, 0x6a, 0x1e, 0xa0, 0x67, 0xd8, 0x04, 0x6d,
+	0x1a, 0xff, 0x08, 0x90, 0x82, 0xaf, 0xc0, 0x4a, 0x1e, 0xd3, 0xd9, 0xdd, 0x22, 0x4b, 0xe7, 0xe8,
+	0x02, 0xbf, 0x80, 0xf1, 0x11, 0xde, 0xc7, 0xcb, 0x08, 0xa9, 0xd8, 0x02, 0x7d, 0xea, 0xa7, 0x0f,
+	0x8f, 0x48, 0xc3, 0x23, 0x50, 0x17, 0xfe, 0x3d, 0xd2, 0x7b, 0xce, 0x8f, 0x1e, 0xc8, 0x0a, 0x19,
+	0x77, 0xef, 0x7e, 0x4e, 0x36, 0xa5, 0xd8, 0x76, 0x6b, 0x8f, 0xf2, 0xea, 0x56, 0x2e, 0x43, 0xf9,
+	0xee, 0xf6, 0x34, 0xd1, 0xda, 0x90, 0xbf, 0xcf, 0xff, 0x06, 0x00, 0x6c, 0x9f, 0xa4, 0x58, 0x0b,
+	0x02, 0x00, 0x00,
 }
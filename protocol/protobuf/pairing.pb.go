@@ -84,16 +84,17 @@ func (m *PairInstallation) GetName() string {
 }
 
 type SyncInstallationContact struct {
-	Clock                uint64   `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
-	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	ProfileImage         string   `protobuf:"bytes,3,opt,name=profile_image,json=profileImage,proto3" json:"profile_image,omitempty"`
-	EnsName              string   `protobuf:"bytes,4,opt,name=ens_name,json=ensName,proto3" json:"ens_name,omitempty"`
-	LastUpdated          uint64   `protobuf:"varint,5,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
-	SystemTags           []string `protobuf:"bytes,6,rep,name=system_tags,json=systemTags,proto3" json:"system_tags,omitempty"`
-	LocalNickname        string   `protobuf:"bytes,7,opt,name=local_nickname,json=localNickname,proto3" json:"local_nickname,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Clock                uint64      `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	Id                   string      `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	ProfileImage         string      `protobuf:"bytes,3,opt,name=profile_image,json=profileImage,proto3" json:"profile_image,omitempty"`
+	EnsName              string      `protobuf:"bytes,4,opt,name=ens_name,json=ensName,proto3" json:"ens_name,omitempty"`
+	LastUpdated          uint64      `protobuf:"varint,5,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	SystemTags           []string    `protobuf:"bytes,6,rep,name=system_tags,json=systemTags,proto3" json:"system_tags,omitempty"`
+	LocalNickname        string      `protobuf:"bytes,7,opt,name=local_nickname,json=localNickname,proto3" json:"local_nickname,omitempty"`
+	TrustStatus          TrustStatus `protobuf:"varint,8,opt,name=trust_status,json=trustStatus,proto3,enum=protobuf.TrustStatus" json:"trust_status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *SyncInstallationContact) Reset()         { *m = SyncInstallationContact{} }
@@ -170,6 +171,13 @@ func (m *SyncInstallationContact) GetLocalNickname() string {
 	return ""
 }
 
+func (m *SyncInstallationContact) GetTrustStatus() TrustStatus {
+	if m != nil {
+		return m.TrustStatus
+	}
+	return TrustStatus_UNKNOWN_TRUST_STATUS
+}
+
 type SyncInstallationAccount struct {
 	Clock                uint64   `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
 	ProfileImage         string   `protobuf:"bytes,2,opt,name=profile_image,json=profileImage,proto3" json:"profile_image,omitempty"`
@@ -340,30 +348,34 @@ func init() {
 }
 
 var fileDescriptor_d61ab7221f0b5518 = []byte{
-	// 397 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0x8e, 0xd3, 0x30,
-	0x10, 0xc6, 0x95, 0xb4, 0xbb, 0x2d, 0xd3, 0x3f, 0xac, 0x2c, 0x24, 0x0c, 0x17, 0xb2, 0x01, 0x44,
-	0x4f, 0x3d, 0xc0, 0x11, 0x71, 0x80, 0x3d, 0xa0, 0x5e, 0x56, 0xab, 0xb0, 0x9c, 0xad, 0xa9, 0xe3,
-	0xcd, 0x5a, 0xeb, 0xd8, 0x56, 0xec, 0x80, 0xf2, 0x02, 0xbc, 0x22, 0xef, 0xc0, 0x53, 0xa0, 0x38,
-	0x6d, 0x89, 0x5a, 0x82, 0x38, 0xc5, 0xf9, 0x32, 0x9e, 0xef, 0xfb, 0xcd, 0x04, 0x16, 0x16, 0x65,
-	0x25, 0x75, 0xb1, 0xb6, 0x95, 0xf1, 0x86, 0x4c, 0xc3, 0x63, 0x5b, 0xdf, 0xa5, 0x3f, 0x22, 0xb8,
-	0xb8, 0x41, 0x59, 0x6d, 0xb4, 0xf3, 0xa8, 0x14, 0x7a, 0x69, 0x34, 0x79, 0x02, 0x67, 0x5c, 0x19,
-	0xfe, 0x40, 0xa3, 0x24, 0x5a, 0x8d, 0xb3, 0xee, 0x85, 0xbc, 0x81, 0xc7, 0xb2, 0x57, 0xc5, 0x64,
-	0x4e, 0xe3, 0x24, 0x5a, 0x3d, 0xca, 0x96, 0x7d, 0x79, 0x93, 0x93, 0x17, 0x30, 0xcb, 0xc5, 0x37,
-	0xc9, 0x05, 0xf3, 0x8d, 0x15, 0x74, 0x14, 0x8a, 0xa0, 0x93, 0x6e, 0x1b, 0x2b, 0x08, 0x81, 0xb1,
-	0xc6, 0x52, 0xd0, 0x71, 0xf8, 0x12, 0xce, 0xe9, 0xaf, 0x08, 0x9e, 0x7e, 0x69, 0x34, 0xef, 0x07,
-	0xb9, 0x32, 0xda, 0x23, 0xf7, 0x03, 0x79, 0x96, 0x10, 0x1f, 0x22, 0xc4, 0x32, 0x27, 0x2f, 0x61,
-	0x61, 0x2b, 0x73, 0x27, 0x95, 0x60, 0xb2, 0xc4, 0x62, 0x6f, 0x3c, 0xdf, 0x89, 0x9b, 0x56, 0x23,
-	0xcf, 0x60, 0x2a, 0xb4, 0x63, 0x3d, 0xfb, 0x89, 0xd0, 0xee, 0x1a, 0x4b, 0x41, 0x2e, 0x61, 0xae,
-	0xd0, 0x79, 0x56, 0xdb, 0x1c, 0xbd, 0xc8, 0xe9, 0x59, 0x30, 0x9b, 0xb5, 0xda, 0xd7, 0x4e, 0x6a,
-	0xc9, 0x5c, 0xe3, 0xbc, 0x28, 0x99, 0xc7, 0xc2, 0xd1, 0xf3, 0x64, 0xd4, 0x92, 0x75, 0xd2, 0x2d,
-	0x16, 0x8e, 0xbc, 0x86, 0xa5, 0x32, 0x1c, 0x15, 0xd3, 0x92, 0x3f, 0x04, 0x93, 0x49, 0x30, 0x59,
-	0x04, 0xf5, 0x7a, 0x27, 0xa6, 0xdf, 0x4f, 0x59, 0x3f, 0x72, 0x6e, 0x6a, 0x3d, 0xc4, 0x7a, 0xc2,
-	0x16, 0xff, 0x85, 0xed, 0x18, 0x60, 0x74, 0x02, 0x90, 0x7e, 0x82, 0xe7, 0xc7, 0xc6, 0x37, 0xf5,
-	0x56, 0x49, 0x7e, 0x75, 0x8f, 0xff, 0x39, 0xe7, 0xf4, 0x67, 0x04, 0x17, 0xc7, 0x4d, 0xc8, 0x07,
-	0x98, 0xf2, 0x6e, 0x5b, 0x8e, 0x46, 0xc9, 0x68, 0x35, 0x7b, 0x7b, 0xb9, 0xde, 0xff, 0x64, 0xeb,
-	0x81, 0xbd, 0x66, 0x87, 0x2b, 0xe4, 0x33, 0xcc, 0x6d, 0xc8, 0xc1, 0xf8, 0x3d, 0x7a, 0x47, 0xe3,
-	0xd0, 0xe2, 0xd5, 0x70, 0x8b, 0x3f, 0xa9, 0xb3, 0x99, 0x3d, 0x9c, 0x1d, 0x79, 0x0f, 0x13, 0xec,
-	0x26, 0x19, 0xf0, 0xff, 0x19, 0x63, 0x37, 0xf2, 0x6c, 0x7f, 0x63, 0x7b, 0x1e, 0x4a, 0xdf, 0xfd,
-	0x0e, 0x00, 0x00, 0xff, 0xff, 0xb5, 0x5c, 0xf1, 0xac, 0x2e, 0x03, 0x00, 0x00,
+	// 459 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xdd, 0x6e, 0xd3, 0x30,
+	0x14, 0x56, 0xd2, 0x6e, 0x2d, 0x27, 0x6d, 0x99, 0x2c, 0x10, 0xa1, 0x42, 0x90, 0x05, 0x10, 0xbd,
+	0xea, 0xa4, 0x71, 0x83, 0x84, 0xb8, 0x60, 0xbb, 0x40, 0xbd, 0x99, 0xa6, 0xac, 0xdc, 0x70, 0x63,
+	0xb9, 0x8e, 0x9b, 0x5a, 0x73, 0xec, 0x28, 0x3e, 0x19, 0xea, 0x0b, 0xf0, 0x24, 0xbc, 0x13, 0xaf,
+	0x83, 0xe2, 0xf4, 0x4f, 0x2d, 0x45, 0xbb, 0xf2, 0xf1, 0x77, 0x7e, 0xbe, 0xef, 0x7c, 0x36, 0xf4,
+	0x0b, 0x26, 0x4b, 0xa9, 0xb3, 0x71, 0x51, 0x1a, 0x34, 0xa4, 0xeb, 0x8e, 0x59, 0x35, 0x1f, 0x0e,
+	0xb9, 0xd1, 0xc8, 0x38, 0xd2, 0x07, 0x51, 0xca, 0xb9, 0xe4, 0x0c, 0xa5, 0xd1, 0x4d, 0x55, 0xfc,
+	0xcb, 0x83, 0xb3, 0x5b, 0x26, 0xcb, 0x89, 0xb6, 0xc8, 0x94, 0x72, 0x29, 0xf2, 0x0c, 0x4e, 0xb8,
+	0x32, 0xfc, 0x3e, 0xf4, 0x22, 0x6f, 0xd4, 0x4e, 0x9a, 0x0b, 0xf9, 0x00, 0x4f, 0xe5, 0x4e, 0x15,
+	0x95, 0x69, 0xe8, 0x47, 0xde, 0xe8, 0x49, 0x32, 0xd8, 0x85, 0x27, 0x29, 0x79, 0x03, 0x41, 0x2a,
+	0x1e, 0x24, 0x17, 0x14, 0x97, 0x85, 0x08, 0x5b, 0xae, 0x08, 0x1a, 0x68, 0xba, 0x2c, 0x04, 0x21,
+	0xd0, 0xd6, 0x2c, 0x17, 0x61, 0xdb, 0x65, 0x5c, 0x1c, 0xff, 0xf6, 0xe1, 0xc5, 0xdd, 0x52, 0xf3,
+	0x5d, 0x21, 0xd7, 0x8d, 0xee, 0x23, 0x7a, 0x06, 0xe0, 0x6f, 0x24, 0xf8, 0x32, 0x25, 0x6f, 0xa1,
+	0x5f, 0x94, 0x66, 0x2e, 0x95, 0xa0, 0x32, 0x67, 0xd9, 0x9a, 0xb8, 0xb7, 0x02, 0x27, 0x35, 0x46,
+	0x5e, 0x42, 0x57, 0x68, 0x4b, 0x77, 0xe8, 0x3b, 0x42, 0xdb, 0x1b, 0x96, 0x0b, 0x72, 0x0e, 0x3d,
+	0xc5, 0x2c, 0xd2, 0xaa, 0x48, 0x19, 0x8a, 0x34, 0x3c, 0x71, 0x64, 0x41, 0x8d, 0x7d, 0x6f, 0xa0,
+	0x7a, 0x33, 0xbb, 0xb4, 0x28, 0x72, 0x8a, 0x2c, 0xb3, 0xe1, 0x69, 0xd4, 0xaa, 0x37, 0x6b, 0xa0,
+	0x29, 0xcb, 0x2c, 0x79, 0x0f, 0x03, 0x65, 0x38, 0x53, 0x54, 0x4b, 0x7e, 0xef, 0x48, 0x3a, 0x8e,
+	0xa4, 0xef, 0xd0, 0x9b, 0x15, 0x48, 0x3e, 0x41, 0x0f, 0xcb, 0xca, 0x22, 0xb5, 0xc8, 0xb0, 0xb2,
+	0x61, 0x37, 0xf2, 0x46, 0x83, 0xcb, 0xe7, 0xe3, 0xf5, 0x93, 0x8d, 0xa7, 0x75, 0xf6, 0xce, 0x25,
+	0x93, 0x00, 0xb7, 0x97, 0xf8, 0xe7, 0xa1, 0x4b, 0x5f, 0x39, 0x37, 0x95, 0x3e, 0xe6, 0xd2, 0x81,
+	0x2b, 0xfe, 0x3f, 0x5c, 0xd9, 0x5f, 0xbd, 0x75, 0xb0, 0x7a, 0x7c, 0x05, 0xc3, 0x7d, 0xe2, 0xdb,
+	0x6a, 0xa6, 0x24, 0xbf, 0x5e, 0xb0, 0x47, 0xbe, 0x50, 0xfc, 0xc7, 0x83, 0xb3, 0xfd, 0x21, 0xe4,
+	0x0b, 0x74, 0x57, 0xff, 0xd3, 0x86, 0x5e, 0xd4, 0x1a, 0x05, 0x97, 0xe7, 0x5b, 0x1f, 0x8e, 0xfc,
+	0x88, 0x64, 0xd3, 0x42, 0xbe, 0x41, 0xaf, 0x70, 0x3a, 0x28, 0x5f, 0x30, 0xb4, 0xa1, 0xef, 0x46,
+	0xbc, 0x3b, 0x3e, 0x62, 0xab, 0x3a, 0x09, 0x8a, 0x4d, 0x6c, 0xc9, 0x67, 0xe8, 0xb0, 0xc6, 0x49,
+	0xb7, 0xfe, 0x7f, 0x65, 0xac, 0x2c, 0x4f, 0xd6, 0x1d, 0x57, 0xaf, 0x7f, 0xbc, 0xca, 0x24, 0x2e,
+	0xaa, 0xd9, 0x98, 0x9b, 0xfc, 0xc2, 0xf5, 0x71, 0xa3, 0x2e, 0xd6, 0x03, 0x66, 0xa7, 0x2e, 0xfa,
+	0xf8, 0x77, 0x00, 0xb2, 0x30, 0x20, 0x36, 0xa4, 0x03, 0x00, 0x00,
 }
@@ -0,0 +1,90 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: spam_blocklist.proto
+
+package protobuf
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// SpamBlocklist is a list of senders published by a user or a community,
+// it's signed by the publisher and replaces the previous ones
+type SpamBlocklist struct {
+	Clock uint64 `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	// Compressed public keys of the senders, so that a full list fits in a message
+	PublicKeys           [][]byte `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SpamBlocklist) Reset()         { *m = SpamBlocklist{} }
+func (m *SpamBlocklist) String() string { return proto.CompactTextString(m) }
+func (*SpamBlocklist) ProtoMessage()    {}
+func (*SpamBlocklist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86f11b32ba6ec42a, []int{0}
+}
+
+func (m *SpamBlocklist) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SpamBlocklist.Unmarshal(m, b)
+}
+func (m *SpamBlocklist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SpamBlocklist.Marshal(b, m, deterministic)
+}
+func (m *SpamBlocklist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpamBlocklist.Merge(m, src)
+}
+func (m *SpamBlocklist) XXX_Size() int {
+	return xxx_messageInfo_SpamBlocklist.Size(m)
+}
+func (m *SpamBlocklist) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpamBlocklist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpamBlocklist proto.InternalMessageInfo
+
+func (m *SpamBlocklist) GetClock() uint64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *SpamBlocklist) GetPublicKeys() [][]byte {
+	if m != nil {
+		return m.PublicKeys
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SpamBlocklist)(nil), "protobuf.SpamBlocklist")
+}
+
+func init() {
+	proto.RegisterFile("spam_blocklist.proto", fileDescriptor_86f11b32ba6ec42a)
+}
+
+var fileDescriptor_86f11b32ba6ec42a = []byte{
+	// 110 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x29, 0x2e, 0x48, 0xcc,
+	0x8d, 0x4f, 0xca, 0xc9, 0x4f, 0xce, 0xce, 0xc9, 0x2c, 0x2e, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
+	0x17, 0xe2, 0x00, 0x53, 0x49, 0xa5, 0x69, 0x4a, 0x6e, 0x5c, 0xbc, 0xc1, 0x05, 0x89, 0xb9, 0x4e,
+	0x30, 0x05, 0x42, 0x22, 0x5c, 0xac, 0xc9, 0x20, 0x8e, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x4b, 0x10,
+	0x84, 0x23, 0x24, 0xcf, 0xc5, 0x5d, 0x50, 0x9a, 0x94, 0x93, 0x99, 0x1c, 0x9f, 0x9d, 0x5a, 0x59,
+	0x2c, 0xc1, 0xa4, 0xc0, 0xac, 0xc1, 0x13, 0xc4, 0x05, 0x11, 0xf2, 0x4e, 0xad, 0x2c, 0x4e, 0x62,
+	0x03, 0x9b, 0x68, 0x0c, 0x18, 0x00, 0x24, 0xef, 0xd9, 0xef, 0x70, 0x00, 0x00, 0x00,
+}
@@ -0,0 +1,55 @@
+package requests
+
+import (
+	"errors"
+	"regexp"
+
+	"github.com/status-im/status-go/eth-node/types"
+	userimages "github.com/status-im/status-go/images"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+var ErrAddCommunityEmojiInvalidCommunityID = errors.New("add-community-emoji: invalid community id")
+var ErrAddCommunityEmojiInvalidName = errors.New("add-community-emoji: invalid name")
+var ErrAddCommunityEmojiInvalidImage = errors.New("add-community-emoji: invalid image")
+
+// emojiNameRegexp matches the shortcodes, without the surrounding colons
+var emojiNameRegexp = regexp.MustCompile(`^[a-z0-9_]{2,32}$`)
+
+type AddCommunityEmoji struct {
+	CommunityID types.HexBytes `json:"communityId"`
+	Name        string         `json:"name"`
+	Image       string         `json:"image"`
+	ImageAx     int            `json:"imageAx"`
+	ImageAy     int            `json:"imageAy"`
+	ImageBx     int            `json:"imageBx"`
+	ImageBy     int            `json:"imageBy"`
+}
+
+func (a *AddCommunityEmoji) Validate() error {
+	if len(a.CommunityID) == 0 {
+		return ErrAddCommunityEmojiInvalidCommunityID
+	}
+
+	if !emojiNameRegexp.MatchString(a.Name) {
+		return ErrAddCommunityEmojiInvalidName
+	}
+
+	if a.Image == "" {
+		return ErrAddCommunityEmojiInvalidImage
+	}
+
+	return nil
+}
+
+func (a *AddCommunityEmoji) ToCommunityEmoji() (*protobuf.CommunityEmoji, error) {
+	img, err := userimages.GenerateEmojiImage(a.Image, a.ImageAx, a.ImageAy, a.ImageBx, a.ImageBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protobuf.CommunityEmoji{
+		Name:  a.Name,
+		Image: adaptIdentityImageToProtobuf(img),
+	}, nil
+}
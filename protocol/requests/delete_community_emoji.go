@@ -0,0 +1,27 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+var ErrDeleteCommunityEmojiInvalidCommunityID = errors.New("delete-community-emoji: invalid community id")
+var ErrDeleteCommunityEmojiInvalidEmojiID = errors.New("delete-community-emoji: invalid emoji id")
+
+type DeleteCommunityEmoji struct {
+	CommunityID types.HexBytes `json:"communityId"`
+	EmojiID     string         `json:"emojiId"`
+}
+
+func (d *DeleteCommunityEmoji) Validate() error {
+	if len(d.CommunityID) == 0 {
+		return ErrDeleteCommunityEmojiInvalidCommunityID
+	}
+
+	if len(d.EmojiID) == 0 {
+		return ErrDeleteCommunityEmojiInvalidEmojiID
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package requests
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+var ErrListCommunityInDirectoryInvalidCommunityID = errors.New("list-community-in-directory: invalid community id")
+var ErrListCommunityInDirectoryInvalidTag = errors.New("list-community-in-directory: invalid tag")
+
+type ListCommunityInDirectory struct {
+	CommunityID types.HexBytes `json:"communityId"`
+	Tags        []string       `json:"tags"`
+}
+
+func (l *ListCommunityInDirectory) Validate() error {
+	if len(l.CommunityID) == 0 {
+		return ErrListCommunityInDirectoryInvalidCommunityID
+	}
+
+	for _, tag := range l.Tags {
+		if len(strings.TrimSpace(tag)) == 0 {
+			return ErrListCommunityInDirectoryInvalidTag
+		}
+	}
+
+	return nil
+}
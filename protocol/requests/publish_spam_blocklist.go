@@ -0,0 +1,25 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+var ErrPublishSpamBlocklistInvalidPublicKey = errors.New("publish-spam-blocklist: invalid public key")
+
+type PublishSpamBlocklist struct {
+	// CommunityID is set to publish the list on behalf of a community we own
+	CommunityID types.HexBytes   `json:"communityId"`
+	PublicKeys  []types.HexBytes `json:"publicKeys"`
+}
+
+func (p *PublishSpamBlocklist) Validate() error {
+	for _, pk := range p.PublicKeys {
+		if len(pk) == 0 {
+			return ErrPublishSpamBlocklistInvalidPublicKey
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,7 @@
+package requests
+
+type SearchCommunityDirectory struct {
+	Name           string   `json:"name"`
+	Tags           []string `json:"tags"`
+	IncludeFlagged bool     `json:"includeFlagged"`
+}
@@ -0,0 +1,59 @@
+package requests
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+var ErrSendPollInvalidChatID = errors.New("send-poll: invalid chat id")
+var ErrSendPollInvalidQuestion = errors.New("send-poll: invalid question")
+var ErrSendPollInvalidOptions = errors.New("send-poll: at least two options are required")
+var ErrSendPollInvalidOption = errors.New("send-poll: invalid option")
+
+type SendPoll struct {
+	ChatID         string   `json:"chatId"`
+	Question       string   `json:"question"`
+	Options        []string `json:"options"`
+	MultipleChoice bool     `json:"multipleChoice"`
+	// EndTime is the unix timestamp in milliseconds after which
+	// votes are not accepted, 0 if the poll never closes
+	EndTime uint64 `json:"endTime"`
+}
+
+func (s *SendPoll) Validate() error {
+	if len(s.ChatID) == 0 {
+		return ErrSendPollInvalidChatID
+	}
+
+	if len(strings.TrimSpace(s.Question)) == 0 {
+		return ErrSendPollInvalidQuestion
+	}
+
+	if len(s.Options) < 2 {
+		return ErrSendPollInvalidOptions
+	}
+
+	for _, option := range s.Options {
+		if len(strings.TrimSpace(option)) == 0 {
+			return ErrSendPollInvalidOption
+		}
+	}
+
+	return nil
+}
+
+func (s *SendPoll) ToPollMessage() *protobuf.PollMessage {
+	options := make([]string, len(s.Options))
+	for i, option := range s.Options {
+		options[i] = strings.TrimSpace(option)
+	}
+
+	return &protobuf.PollMessage{
+		Question:       strings.TrimSpace(s.Question),
+		Options:        options,
+		MultipleChoice: s.MultipleChoice,
+		EndTime:        s.EndTime,
+	}
+}
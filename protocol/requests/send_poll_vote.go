@@ -0,0 +1,23 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+var ErrSendPollVoteInvalidMessageID = errors.New("send-poll-vote: invalid message id")
+
+type SendPollVote struct {
+	MessageID types.HexBytes `json:"messageId"`
+	// Options are the indexes of the chosen options, empty to retract the vote
+	Options []uint32 `json:"options"`
+}
+
+func (s *SendPollVote) Validate() error {
+	if len(s.MessageID) == 0 {
+		return ErrSendPollVoteInvalidMessageID
+	}
+
+	return nil
+}
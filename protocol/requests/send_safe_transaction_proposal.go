@@ -0,0 +1,35 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/safe"
+)
+
+var ErrSendSafeTransactionProposalInvalidChatID = errors.New("send-safe-transaction-proposal: invalid chat id")
+var ErrSendSafeTransactionProposalInvalidTransaction = errors.New("send-safe-transaction-proposal: invalid transaction")
+var ErrSendSafeTransactionProposalInvalidSignature = errors.New("send-safe-transaction-proposal: invalid signature")
+
+type SendSafeTransactionProposal struct {
+	ChatID      string            `json:"chatId"`
+	Transaction *safe.Transaction `json:"transaction"`
+	// Signature of the proposer over the hash of the transaction
+	Signature types.HexBytes `json:"signature"`
+}
+
+func (s *SendSafeTransactionProposal) Validate() error {
+	if len(s.ChatID) == 0 {
+		return ErrSendSafeTransactionProposalInvalidChatID
+	}
+
+	if s.Transaction == nil {
+		return ErrSendSafeTransactionProposalInvalidTransaction
+	}
+
+	if len(s.Signature) == 0 {
+		return ErrSendSafeTransactionProposalInvalidSignature
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+var ErrSendSafeTransactionSignatureInvalidMessageID = errors.New("send-safe-transaction-signature: invalid message id")
+var ErrSendSafeTransactionSignatureInvalidSignature = errors.New("send-safe-transaction-signature: invalid signature")
+
+type SendSafeTransactionSignature struct {
+	// MessageID is the id of the proposal message
+	MessageID types.HexBytes `json:"messageId"`
+	// Signature of an owner over the hash of the transaction
+	Signature types.HexBytes `json:"signature"`
+}
+
+func (s *SendSafeTransactionSignature) Validate() error {
+	if len(s.MessageID) == 0 {
+		return ErrSendSafeTransactionSignatureInvalidMessageID
+	}
+
+	if len(s.Signature) == 0 {
+		return ErrSendSafeTransactionSignatureInvalidSignature
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+var ErrSetCommunityControlInvalidCommunityID = errors.New("set-community-control: invalid community id")
+var ErrSetCommunityControlInvalidThreshold = errors.New("set-community-control: threshold can't be greater than the number of signers")
+
+type SetCommunityControl struct {
+	CommunityID types.HexBytes   `json:"communityId"`
+	Signers     []types.HexBytes `json:"signers"`
+	Threshold   uint32           `json:"threshold"`
+}
+
+func (s *SetCommunityControl) Validate() error {
+	if len(s.CommunityID) == 0 {
+		return ErrSetCommunityControlInvalidCommunityID
+	}
+
+	if int(s.Threshold) > len(s.Signers) {
+		return ErrSetCommunityControlInvalidThreshold
+	}
+
+	return nil
+}
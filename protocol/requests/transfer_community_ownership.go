@@ -0,0 +1,27 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+var ErrTransferCommunityOwnershipInvalidCommunityID = errors.New("transfer-community-ownership: invalid community id")
+var ErrTransferCommunityOwnershipInvalidNewOwner = errors.New("transfer-community-ownership: invalid new owner")
+
+type TransferCommunityOwnership struct {
+	CommunityID types.HexBytes `json:"communityId"`
+	NewOwner    types.HexBytes `json:"newOwner"`
+}
+
+func (t *TransferCommunityOwnership) Validate() error {
+	if len(t.CommunityID) == 0 {
+		return ErrTransferCommunityOwnershipInvalidCommunityID
+	}
+
+	if len(t.NewOwner) == 0 {
+		return ErrTransferCommunityOwnershipInvalidNewOwner
+	}
+
+	return nil
+}
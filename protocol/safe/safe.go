@@ -0,0 +1,261 @@
+package safe
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	signercore "github.com/ethereum/go-ethereum/signer/core"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/services/typeddata"
+)
+
+var ErrInvalidTransaction = errors.New("invalid safe transaction")
+var ErrInvalidOperation = errors.New("invalid safe operation")
+var ErrInvalidSignature = errors.New("invalid safe transaction signature")
+
+const (
+	// OperationCall is a regular call from the Safe
+	OperationCall uint8 = 0
+	// OperationDelegateCall runs the code of the target in the context of the Safe
+	OperationDelegateCall uint8 = 1
+)
+
+const signatureLength = 65
+
+// Transaction is a transaction executed by a Safe once it has been signed by
+// enough of its owners, as defined by the Safe contracts since v1.3.0
+type Transaction struct {
+	ChainID        *hexutil.Big   `json:"chainId"`
+	Safe           common.Address `json:"safe"`
+	To             common.Address `json:"to"`
+	Value          *hexutil.Big   `json:"value"`
+	Data           hexutil.Bytes  `json:"data"`
+	Operation      uint8          `json:"operation"`
+	SafeTxGas      *hexutil.Big   `json:"safeTxGas"`
+	BaseGas        *hexutil.Big   `json:"baseGas"`
+	GasPrice       *hexutil.Big   `json:"gasPrice"`
+	GasToken       common.Address `json:"gasToken"`
+	RefundReceiver common.Address `json:"refundReceiver"`
+	Nonce          *hexutil.Big   `json:"nonce"`
+}
+
+// Validate checks that all the fields needed to hash the transaction are set
+func (t *Transaction) Validate() error {
+	if t.ChainID == nil || t.Nonce == nil {
+		return ErrInvalidTransaction
+	}
+	if t.Operation != OperationCall && t.Operation != OperationDelegateCall {
+		return ErrInvalidOperation
+	}
+	for _, n := range []*hexutil.Big{t.ChainID, t.Value, t.SafeTxGas, t.BaseGas, t.GasPrice, t.Nonce} {
+		if n != nil && n.ToInt().Sign() < 0 {
+			return ErrInvalidTransaction
+		}
+	}
+	return nil
+}
+
+// TypedData returns the EIP-712 typed data of the transaction signed by the owners
+func (t *Transaction) TypedData() signercore.TypedData {
+	return signercore.TypedData{
+		Types: signercore.Types{
+			"EIP712Domain": {
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			"SafeTx": {
+				{Name: "to", Type: "address"},
+				{Name: "value", Type: "uint256"},
+				{Name: "data", Type: "bytes"},
+				{Name: "operation", Type: "uint8"},
+				{Name: "safeTxGas", Type: "uint256"},
+				{Name: "baseGas", Type: "uint256"},
+				{Name: "gasPrice", Type: "uint256"},
+				{Name: "gasToken", Type: "address"},
+				{Name: "refundReceiver", Type: "address"},
+				{Name: "nonce", Type: "uint256"},
+			},
+		},
+		PrimaryType: "SafeTx",
+		Domain: signercore.TypedDataDomain{
+			ChainId:           decimal(t.ChainID),
+			VerifyingContract: t.Safe.Hex(),
+		},
+		Message: signercore.TypedDataMessage{
+			"to":             t.To.Hex(),
+			"value":          decimal(t.Value),
+			"data":           hexutil.Encode(t.Data),
+			"operation":      decimal((*hexutil.Big)(big.NewInt(int64(t.Operation)))),
+			"safeTxGas":      decimal(t.SafeTxGas),
+			"baseGas":        decimal(t.BaseGas),
+			"gasPrice":       decimal(t.GasPrice),
+			"gasToken":       t.GasToken.Hex(),
+			"refundReceiver": t.RefundReceiver.Hex(),
+			"nonce":          decimal(t.Nonce),
+		},
+	}
+}
+
+// Hash returns the EIP-712 hash of the transaction, which the owners sign
+func (t *Transaction) Hash() (common.Hash, error) {
+	if err := t.Validate(); err != nil {
+		return common.Hash{}, err
+	}
+	return typeddata.HashTypedDataV4(t.TypedData(), t.ChainID.ToInt())
+}
+
+// ToProtobuf converts the transaction to the proposal sent in chats
+func (t *Transaction) ToProtobuf() *protobuf.SafeTransactionProposal {
+	return &protobuf.SafeTransactionProposal{
+		ChainId:        t.ChainID.ToInt().Uint64(),
+		Safe:           t.Safe.Hex(),
+		To:             t.To.Hex(),
+		Value:          bigString(t.Value),
+		Data:           t.Data,
+		Operation:      uint32(t.Operation),
+		SafeTxGas:      bigString(t.SafeTxGas),
+		BaseGas:        bigString(t.BaseGas),
+		GasPrice:       bigString(t.GasPrice),
+		GasToken:       t.GasToken.Hex(),
+		RefundReceiver: t.RefundReceiver.Hex(),
+		Nonce:          bigString(t.Nonce),
+	}
+}
+
+// FromProtobuf converts a proposal received in a chat to a transaction
+func FromProtobuf(p *protobuf.SafeTransactionProposal) (*Transaction, error) {
+	if p == nil || p.Operation > uint32(OperationDelegateCall) {
+		return nil, ErrInvalidTransaction
+	}
+
+	t := &Transaction{
+		ChainID:   (*hexutil.Big)(new(big.Int).SetUint64(p.ChainId)),
+		Data:      p.Data,
+		Operation: uint8(p.Operation),
+	}
+
+	addresses := []struct {
+		value string
+		dst   *common.Address
+	}{
+		{p.Safe, &t.Safe},
+		{p.To, &t.To},
+		{p.GasToken, &t.GasToken},
+		{p.RefundReceiver, &t.RefundReceiver},
+	}
+	for _, a := range addresses {
+		if !common.IsHexAddress(a.value) {
+			return nil, ErrInvalidTransaction
+		}
+		*a.dst = common.HexToAddress(a.value)
+	}
+
+	numbers := []struct {
+		value string
+		dst   **hexutil.Big
+	}{
+		{p.Value, &t.Value},
+		{p.SafeTxGas, &t.SafeTxGas},
+		{p.BaseGas, &t.BaseGas},
+		{p.GasPrice, &t.GasPrice},
+		{p.Nonce, &t.Nonce},
+	}
+	for _, n := range numbers {
+		value, ok := new(big.Int).SetString(n.value, 10)
+		if !ok {
+			return nil, ErrInvalidTransaction
+		}
+		*n.dst = (*hexutil.Big)(value)
+	}
+
+	return t, t.Validate()
+}
+
+// Sign signs the hash of a transaction in the format expected by the Safe
+// contract, with a recovery id of 27 or 28
+func Sign(hash common.Hash, key *ecdsa.PrivateKey) ([]byte, error) {
+	signature, err := crypto.Sign(hash.Bytes(), key)
+	if err != nil {
+		return nil, err
+	}
+	signature[64] += 27
+	return signature, nil
+}
+
+// NormalizeSignature returns a copy of the signature with a recovery id of
+// 27 or 28, as wallets sign with a recovery id of either 0 and 1 or 27 and 28,
+// and the Safe contract reads 0 and 1 as other kinds of signatures
+func NormalizeSignature(signature []byte) ([]byte, error) {
+	if len(signature) != signatureLength {
+		return nil, ErrInvalidSignature
+	}
+
+	sig := make([]byte, signatureLength)
+	copy(sig, signature)
+	if sig[64] < 27 {
+		sig[64] += 27
+	}
+	if sig[64] != 27 && sig[64] != 28 {
+		return nil, ErrInvalidSignature
+	}
+	return sig, nil
+}
+
+// RecoverSigner returns the address of the owner who signed the hash of a transaction
+func RecoverSigner(hash common.Hash, signature []byte) (common.Address, error) {
+	sig, err := NormalizeSignature(signature)
+	if err != nil {
+		return common.Address{}, err
+	}
+	sig[64] -= 27
+
+	pubKey, err := crypto.SigToPub(hash.Bytes(), sig)
+	if err != nil {
+		return common.Address{}, ErrInvalidSignature
+	}
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
+
+// PackSignatures concatenates the signatures of the owners sorted by address,
+// with a recovery id of 27 or 28, as execTransaction requires
+func PackSignatures(signatures map[common.Address][]byte) []byte {
+	owners := make([]common.Address, 0, len(signatures))
+	for owner := range signatures {
+		owners = append(owners, owner)
+	}
+	sort.Slice(owners, func(i, j int) bool {
+		return bytes.Compare(owners[i].Bytes(), owners[j].Bytes()) < 0
+	})
+
+	packed := make([]byte, 0, len(owners)*signatureLength)
+	for _, owner := range owners {
+		signature := signatures[owner]
+		if normalized, err := NormalizeSignature(signature); err == nil {
+			signature = normalized
+		}
+		packed = append(packed, signature...)
+	}
+	return packed
+}
+
+func decimal(n *hexutil.Big) *math.HexOrDecimal256 {
+	if n == nil {
+		return (*math.HexOrDecimal256)(big.NewInt(0))
+	}
+	return (*math.HexOrDecimal256)(n.ToInt())
+}
+
+func bigString(n *hexutil.Big) string {
+	if n == nil {
+		return "0"
+	}
+	return n.ToInt().String()
+}
@@ -0,0 +1,164 @@
+package safe
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Type hashes hardcoded in the Safe contracts
+var (
+	domainSeparatorTypehash = common.HexToHash("0x47e79534a245952e8b16893a336b85a3d9ea9fa8c573f3d803afb92a79469218")
+	safeTxTypehash          = common.HexToHash("0xbb8310d486368db6bd6f849402fdd73ad53d316b5a4b2644ad6efe0f941286d8")
+)
+
+func testTransaction() *Transaction {
+	return &Transaction{
+		ChainID:        (*hexutil.Big)(big.NewInt(1)),
+		Safe:           common.HexToAddress("0x1111111111111111111111111111111111111111"),
+		To:             common.HexToAddress("0x2222222222222222222222222222222222222222"),
+		Value:          (*hexutil.Big)(big.NewInt(1000000000000000000)),
+		Data:           hexutil.Bytes{0xa9, 0x05, 0x9c, 0xbb},
+		Operation:      OperationCall,
+		SafeTxGas:      (*hexutil.Big)(big.NewInt(50000)),
+		BaseGas:        (*hexutil.Big)(big.NewInt(0)),
+		GasPrice:       (*hexutil.Big)(big.NewInt(0)),
+		GasToken:       common.Address{},
+		RefundReceiver: common.Address{},
+		Nonce:          (*hexutil.Big)(big.NewInt(7)),
+	}
+}
+
+func word(n *big.Int) []byte {
+	return math.PaddedBigBytes(n, 32)
+}
+
+func TestTypeHashes(t *testing.T) {
+	require.Equal(t, domainSeparatorTypehash, crypto.Keccak256Hash([]byte("EIP712Domain(uint256 chainId,address verifyingContract)")))
+	require.Equal(t, safeTxTypehash, crypto.Keccak256Hash([]byte("SafeTx(address to,uint256 value,bytes data,uint8 operation,uint256 safeTxGas,uint256 baseGas,uint256 gasPrice,address gasToken,address refundReceiver,uint256 nonce)")))
+}
+
+func TestHashMatchesContractEncoding(t *testing.T) {
+	tx := testTransaction()
+
+	// Encoding done by getTransactionHash in the Safe contract
+	domainSeparator := crypto.Keccak256(
+		domainSeparatorTypehash.Bytes(),
+		word(tx.ChainID.ToInt()),
+		common.LeftPadBytes(tx.Safe.Bytes(), 32),
+	)
+	safeTxHash := crypto.Keccak256(
+		safeTxTypehash.Bytes(),
+		common.LeftPadBytes(tx.To.Bytes(), 32),
+		word(tx.Value.ToInt()),
+		crypto.Keccak256(tx.Data),
+		word(big.NewInt(int64(tx.Operation))),
+		word(tx.SafeTxGas.ToInt()),
+		word(tx.BaseGas.ToInt()),
+		word(tx.GasPrice.ToInt()),
+		common.LeftPadBytes(tx.GasToken.Bytes(), 32),
+		common.LeftPadBytes(tx.RefundReceiver.Bytes(), 32),
+		word(tx.Nonce.ToInt()),
+	)
+	expected := crypto.Keccak256Hash([]byte{0x19, 0x01}, domainSeparator, safeTxHash)
+
+	hash, err := tx.Hash()
+	require.NoError(t, err)
+	require.Equal(t, expected, hash)
+}
+
+func TestHashRequiresNonce(t *testing.T) {
+	tx := testTransaction()
+	tx.Nonce = nil
+	_, err := tx.Hash()
+	require.Equal(t, ErrInvalidTransaction, err)
+
+	tx = testTransaction()
+	tx.Operation = 2
+	_, err = tx.Hash()
+	require.Equal(t, ErrInvalidOperation, err)
+}
+
+func TestProtobufRoundTrip(t *testing.T) {
+	tx := testTransaction()
+
+	decoded, err := FromProtobuf(tx.ToProtobuf())
+	require.NoError(t, err)
+	require.Equal(t, tx, decoded)
+
+	p := tx.ToProtobuf()
+	p.To = "not an address"
+	_, err = FromProtobuf(p)
+	require.Equal(t, ErrInvalidTransaction, err)
+}
+
+func TestSignAndRecover(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	hash, err := testTransaction().Hash()
+	require.NoError(t, err)
+
+	signature, err := Sign(hash, key)
+	require.NoError(t, err)
+	require.True(t, signature[64] == 27 || signature[64] == 28)
+
+	signer, err := RecoverSigner(hash, signature)
+	require.NoError(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(key.PublicKey), signer)
+
+	_, err = RecoverSigner(hash, signature[:64])
+	require.Equal(t, ErrInvalidSignature, err)
+}
+
+func TestNormalizeSignature(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	hash, err := testTransaction().Hash()
+	require.NoError(t, err)
+
+	signature, err := Sign(hash, key)
+	require.NoError(t, err)
+
+	// Wallets might sign with a recovery id of 0 or 1
+	walletSignature := append([]byte{}, signature...)
+	walletSignature[64] -= 27
+	normalized, err := NormalizeSignature(walletSignature)
+	require.NoError(t, err)
+	require.Equal(t, signature, normalized)
+	require.Equal(t, signature[64]-27, walletSignature[64])
+
+	signer, err := RecoverSigner(hash, walletSignature)
+	require.NoError(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(key.PublicKey), signer)
+
+	packed := PackSignatures(map[common.Address][]byte{signer: walletSignature})
+	require.Equal(t, signature, packed)
+
+	walletSignature[64] = 29
+	_, err = NormalizeSignature(walletSignature)
+	require.Equal(t, ErrInvalidSignature, err)
+	_, err = NormalizeSignature(signature[:64])
+	require.Equal(t, ErrInvalidSignature, err)
+}
+
+func TestPackSignaturesSortsByOwner(t *testing.T) {
+	low := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	high := common.HexToAddress("0xf000000000000000000000000000000000000000")
+	lowSignature := append(bytes.Repeat([]byte{1}, signatureLength-1), 27)
+	highSignature := append(bytes.Repeat([]byte{2}, signatureLength-1), 28)
+
+	packed := PackSignatures(map[common.Address][]byte{
+		high: highSignature,
+		low:  lowSignature,
+	})
+	require.Equal(t, append(lowSignature, highSignature...), packed)
+}
@@ -0,0 +1,90 @@
+package protocol
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/safe"
+)
+
+// SafeTransactionProposalStatus is the state of a Safe transaction proposed in a chat
+type SafeTransactionProposalStatus int
+
+const (
+	// SafeTransactionProposalPending is collecting the signatures of the owners
+	SafeTransactionProposalPending SafeTransactionProposalStatus = iota
+	// SafeTransactionProposalReady has been signed by enough owners to be executed
+	SafeTransactionProposalReady
+	// SafeTransactionProposalExecuted has been sent to the Safe by the user
+	SafeTransactionProposalExecuted
+)
+
+// SafeTransactionSignature represents the signature of a Safe transaction proposal in the
+// application layer, used for persistence and querying
+type SafeTransactionSignature struct {
+	protobuf.SafeTransactionSignature
+
+	// From is a public key of the chat member who sent the signature
+	From string `json:"from,omitempty"`
+
+	// SigPubKey is the ecdsa encoded public key of the sender
+	SigPubKey *ecdsa.PublicKey `json:"-"`
+
+	// LocalChatID is the chatID of the local chat (one-to-one are not symmetric)
+	LocalChatID string `json:"localChatId"`
+}
+
+// GetSigPubKey returns an ecdsa encoded public key
+// this function is required to implement the ChatEntity interface
+func (s SafeTransactionSignature) GetSigPubKey() *ecdsa.PublicKey {
+	return s.SigPubKey
+}
+
+// GetProtoBuf returns the struct's embedded protobuf struct
+// this function is required to implement the ChatEntity interface
+func (s *SafeTransactionSignature) GetProtobuf() proto.Message {
+	return &s.SafeTransactionSignature
+}
+
+// SetMessageType a setter for the MessageType field
+// this function is required to implement the ChatEntity interface
+func (s *SafeTransactionSignature) SetMessageType(messageType protobuf.MessageType) {
+	s.MessageType = messageType
+}
+
+// WrapGroupMessage indicates whether we should wrap this in membership information
+func (s SafeTransactionSignature) WrapGroupMessage() bool {
+	return false
+}
+
+// SafeTransactionProposal is a Safe transaction proposed in a chat, along with the
+// signatures collected so far
+type SafeTransactionProposal struct {
+	// MessageID is the id of the proposal message
+	MessageID string `json:"messageId"`
+
+	// LocalChatID is the chatID of the local chat the proposal was sent to
+	LocalChatID string `json:"localChatId"`
+
+	// Proposer is the public key of the chat member who proposed the transaction
+	Proposer string `json:"proposer"`
+
+	Transaction *safe.Transaction `json:"transaction"`
+
+	// Hash is the EIP-712 hash of the transaction signed by the owners
+	Hash common.Hash `json:"hash"`
+
+	// Signatures are the valid signatures received, by the address of the signer.
+	// Whether the signers are owners of the Safe is checked against the chain.
+	Signatures map[common.Address]types.HexBytes `json:"signatures"`
+
+	Status SafeTransactionProposalStatus `json:"status"`
+
+	// TransactionHash is the hash of the transaction executing the proposal
+	TransactionHash string `json:"transactionHash,omitempty"`
+}
@@ -0,0 +1,55 @@
+package protocol
+
+import (
+	"database/sql"
+)
+
+// SaveSafeTransactionSignature stores a signature received for a proposal, signatures
+// are only checked against the proposal when it's read
+func (db sqlitePersistence) SaveSafeTransactionSignature(signature *SafeTransactionSignature) error {
+	_, err := db.db.Exec(`INSERT INTO safe_transaction_signatures (message_id, source, signature, clock) VALUES (?, ?, ?, ?)`,
+		signature.MessageId,
+		signature.From,
+		signature.Signature,
+		signature.Clock,
+	)
+	return err
+}
+
+// SafeTransactionSignatures returns the signatures received for a proposal
+func (db sqlitePersistence) SafeTransactionSignatures(messageID string) ([][]byte, error) {
+	rows, err := db.db.Query(`SELECT signature FROM safe_transaction_signatures WHERE message_id = ? ORDER BY clock`, messageID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var signatures [][]byte
+	for rows.Next() {
+		var signature []byte
+		if err := rows.Scan(&signature); err != nil {
+			return nil, err
+		}
+		signatures = append(signatures, signature)
+	}
+
+	return signatures, rows.Err()
+}
+
+// SetSafeTransactionProposalStatus updates the status of a proposal
+func (db sqlitePersistence) SetSafeTransactionProposalStatus(messageID string, status SafeTransactionProposalStatus, transactionHash string) error {
+	_, err := db.db.Exec(`INSERT INTO safe_transaction_proposals (message_id, status, transaction_hash) VALUES (?, ?, ?)`, messageID, status, transactionHash)
+	return err
+}
+
+// SafeTransactionProposalStatus returns the status of a proposal and the hash of the
+// transaction executing it, proposals are pending until their status is set
+func (db sqlitePersistence) SafeTransactionProposalStatus(messageID string) (SafeTransactionProposalStatus, string, error) {
+	var status SafeTransactionProposalStatus
+	var transactionHash string
+	err := db.db.QueryRow(`SELECT status, transaction_hash FROM safe_transaction_proposals WHERE message_id = ?`, messageID).Scan(&status, &transactionHash)
+	if err == sql.ErrNoRows {
+		return SafeTransactionProposalPending, "", nil
+	}
+	return status, transactionHash, err
+}
@@ -0,0 +1,40 @@
+package protocol
+
+// SocialRecoveryRequestStatus is the status of a request to release a share
+// of a seed phrase
+type SocialRecoveryRequestStatus int
+
+const (
+	// SocialRecoveryRequestPending is set while the holder of the share hasn't
+	// confirmed the identity of the requester
+	SocialRecoveryRequestPending SocialRecoveryRequestStatus = iota
+	// SocialRecoveryRequestReleased is set once the share has been sent to the requester
+	SocialRecoveryRequestReleased
+	// SocialRecoveryRequestDeclined is set when the holder refused to release the share
+	SocialRecoveryRequestDeclined
+)
+
+// SocialRecoveryShare is a share of the seed phrase of Owner. Shares we hold
+// for contacts have an empty Holder, shares collected to recover an account
+// have the contact who released them as Holder
+type SocialRecoveryShare struct {
+	ID        string `json:"id"`
+	Owner     string `json:"owner"`
+	Holder    string `json:"holder,omitempty"`
+	Index     uint32 `json:"index"`
+	Value     []byte `json:"-"`
+	Threshold uint32 `json:"threshold"`
+	Total     uint32 `json:"total"`
+	Checksum  []byte `json:"-"`
+	Clock     uint64 `json:"clock"`
+}
+
+// SocialRecoveryRequest is a request received from Requester to release the
+// share of Owner we hold
+type SocialRecoveryRequest struct {
+	ID        string                      `json:"id"`
+	Owner     string                      `json:"owner"`
+	Requester string                      `json:"requester"`
+	Clock     uint64                      `json:"clock"`
+	Status    SocialRecoveryRequestStatus `json:"status"`
+}
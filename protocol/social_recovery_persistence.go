@@ -0,0 +1,180 @@
+package protocol
+
+import (
+	"database/sql"
+)
+
+const selectSocialRecoveryRequestsQuery = `SELECT id, owner, requester, clock, status FROM social_recovery_requests`
+
+// SaveSocialRecoveryShare stores the share held for its owner, replacing
+// the one of a previous set
+func (db sqlitePersistence) SaveSocialRecoveryShare(share *SocialRecoveryShare) error {
+	_, err := db.db.Exec(`INSERT INTO social_recovery_shares (owner, id, share_index, value, threshold, total, checksum, clock) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		share.Owner,
+		share.ID,
+		share.Index,
+		share.Value,
+		share.Threshold,
+		share.Total,
+		share.Checksum,
+		share.Clock,
+	)
+	return err
+}
+
+// SocialRecoveryShareByOwner returns the share held for the owner, or nil if there is none
+func (db sqlitePersistence) SocialRecoveryShareByOwner(owner string) (*SocialRecoveryShare, error) {
+	share := &SocialRecoveryShare{Owner: owner}
+	err := db.db.QueryRow(`SELECT id, share_index, value, threshold, total, checksum, clock FROM social_recovery_shares WHERE owner = ?`, owner).Scan(
+		&share.ID,
+		&share.Index,
+		&share.Value,
+		&share.Threshold,
+		&share.Total,
+		&share.Checksum,
+		&share.Clock,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return share, nil
+}
+
+func (db sqlitePersistence) SaveSocialRecoveryRequest(request *SocialRecoveryRequest) error {
+	_, err := db.db.Exec(`INSERT INTO social_recovery_requests (id, owner, requester, clock, status) VALUES (?, ?, ?, ?, ?)`,
+		request.ID,
+		request.Owner,
+		request.Requester,
+		request.Clock,
+		request.Status,
+	)
+	return err
+}
+
+func scanSocialRecoveryRequest(row interface{ Scan(...interface{}) error }) (*SocialRecoveryRequest, error) {
+	request := &SocialRecoveryRequest{}
+	err := row.Scan(
+		&request.ID,
+		&request.Owner,
+		&request.Requester,
+		&request.Clock,
+		&request.Status,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+// SocialRecoveryRequestByID returns the request with the given id, or nil if it doesn't exist
+func (db sqlitePersistence) SocialRecoveryRequestByID(id string) (*SocialRecoveryRequest, error) {
+	request, err := scanSocialRecoveryRequest(db.db.QueryRow(selectSocialRecoveryRequestsQuery+` WHERE id = ?`, id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return request, err
+}
+
+// PendingSocialRecoveryRequests returns the requests waiting for a confirmation, most recent first
+func (db sqlitePersistence) PendingSocialRecoveryRequests() ([]*SocialRecoveryRequest, error) {
+	rows, err := db.db.Query(selectSocialRecoveryRequestsQuery+` WHERE status = ? ORDER BY clock DESC`, SocialRecoveryRequestPending)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var requests []*SocialRecoveryRequest
+	for rows.Next() {
+		request, err := scanSocialRecoveryRequest(rows)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, request)
+	}
+	return requests, nil
+}
+
+// SaveSocialRecoverySentRequest records that the holder has been asked to
+// release the share of the owner to us
+func (db sqlitePersistence) SaveSocialRecoverySentRequest(owner, holder string, clock uint64) error {
+	_, err := db.db.Exec(`INSERT INTO social_recovery_sent_requests (owner, holder, clock) VALUES (?, ?, ?)`, owner, holder, clock)
+	return err
+}
+
+func (db sqlitePersistence) HasSocialRecoverySentRequest(owner, holder string) (bool, error) {
+	var count int
+	err := db.db.QueryRow(`SELECT COUNT(*) FROM social_recovery_sent_requests WHERE owner = ? AND holder = ?`, owner, holder).Scan(&count)
+	return count > 0, err
+}
+
+// SaveCollectedSocialRecoveryShare stores a share released to us by its holder
+func (db sqlitePersistence) SaveCollectedSocialRecoveryShare(share *SocialRecoveryShare) error {
+	_, err := db.db.Exec(`INSERT INTO social_recovery_collected_shares (owner, holder, id, share_index, value, threshold, total, checksum, clock) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		share.Owner,
+		share.Holder,
+		share.ID,
+		share.Index,
+		share.Value,
+		share.Threshold,
+		share.Total,
+		share.Checksum,
+		share.Clock,
+	)
+	return err
+}
+
+// CollectedSocialRecoveryShares returns the shares of the owner released to us
+func (db sqlitePersistence) CollectedSocialRecoveryShares(owner string) ([]*SocialRecoveryShare, error) {
+	rows, err := db.db.Query(`SELECT holder, id, share_index, value, threshold, total, checksum, clock FROM social_recovery_collected_shares WHERE owner = ? ORDER BY share_index`, owner)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var shares []*SocialRecoveryShare
+	for rows.Next() {
+		share := &SocialRecoveryShare{Owner: owner}
+		err := rows.Scan(
+			&share.Holder,
+			&share.ID,
+			&share.Index,
+			&share.Value,
+			&share.Threshold,
+			&share.Total,
+			&share.Checksum,
+			&share.Clock,
+		)
+		if err != nil {
+			return nil, err
+		}
+		shares = append(shares, share)
+	}
+	return shares, nil
+}
+
+// DeleteCollectedSocialRecoveryShares removes the shares of the owner and
+// the requests sent for them, once the account has been recovered
+func (db sqlitePersistence) DeleteCollectedSocialRecoveryShares(owner string) (err error) {
+	tx, err := db.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.Exec(`DELETE FROM social_recovery_collected_shares WHERE owner = ?`, owner)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(`DELETE FROM social_recovery_sent_requests WHERE owner = ?`, owner)
+	return err
+}
@@ -0,0 +1,264 @@
+package spam
+
+import (
+	"crypto/sha256"
+	"errors"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+var ErrInvalidPattern = errors.New("invalid spam filter pattern")
+var ErrInvalidRules = errors.New("invalid spam filter rules")
+
+// Reason is why a message has been filtered
+type Reason string
+
+const (
+	ReasonNone       Reason = ""
+	ReasonBlocklist  Reason = "blocklist"
+	ReasonRate       Reason = "rate"
+	ReasonDuplicate  Reason = "duplicate"
+	ReasonKeyword    Reason = "keyword"
+	ReasonQuarantine Reason = "quarantine"
+)
+
+// maxTrackedKeys is the number of senders or contents tracked before we
+// drop the ones which are out of their window
+const maxTrackedKeys = 10000
+
+// Rules configures the filter, a zero value disables the corresponding rule.
+// Periods are in milliseconds.
+type Rules struct {
+	// RateLimit is the number of messages a sender can post in a chat during RatePeriod
+	RateLimit  int    `json:"rateLimit"`
+	RatePeriod uint64 `json:"ratePeriod"`
+	// DuplicateLimit is the number of times the same text can be posted in a chat
+	// during DuplicatePeriod, by any sender
+	DuplicateLimit  int    `json:"duplicateLimit"`
+	DuplicatePeriod uint64 `json:"duplicatePeriod"`
+	// Keywords filters messages containing any of them, case insensitive
+	Keywords []string `json:"keywords"`
+	// Patterns filters messages matching any of these regular expressions
+	Patterns []string `json:"patterns"`
+	// QuarantinePeriod filters the messages of a sender for this long after
+	// we have first seen them
+	QuarantinePeriod uint64 `json:"quarantinePeriod"`
+}
+
+func (r *Rules) Validate() error {
+	if r.RateLimit < 0 || (r.RateLimit != 0 && r.RatePeriod == 0) {
+		return ErrInvalidRules
+	}
+	if r.DuplicateLimit < 0 || (r.DuplicateLimit != 0 && r.DuplicatePeriod == 0) {
+		return ErrInvalidRules
+	}
+	_, err := compilePatterns(r.Patterns)
+	return err
+}
+
+func compilePatterns(patterns []string) ([]*regexp.Regexp, error) {
+	var compiled []*regexp.Regexp
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, ErrInvalidPattern
+		}
+		compiled = append(compiled, re)
+	}
+	return compiled, nil
+}
+
+// Message is what the filter looks at
+type Message struct {
+	ChatID string
+	From   string
+	Text   string
+	// Timestamp is when the message was sent, in milliseconds
+	Timestamp uint64
+	// FirstSeen is when we have first seen the sender, in milliseconds
+	FirstSeen uint64
+}
+
+// Filter applies the rules to incoming messages. It keeps track of the
+// recent messages in memory.
+type Filter struct {
+	mutex    sync.Mutex
+	rules    Rules
+	keywords []string
+	patterns []*regexp.Regexp
+	blocked  map[string]bool
+
+	// timestamps of the recent messages by chat and sender
+	senders map[string][]uint64
+	// timestamps of the recent messages by chat and content
+	contents map[string][]uint64
+}
+
+func NewFilter(rules Rules) (*Filter, error) {
+	f := &Filter{
+		blocked:  make(map[string]bool),
+		senders:  make(map[string][]uint64),
+		contents: make(map[string][]uint64),
+	}
+	if err := f.SetRules(rules); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (f *Filter) SetRules(rules Rules) error {
+	if err := rules.Validate(); err != nil {
+		return err
+	}
+
+	patterns, err := compilePatterns(rules.Patterns)
+	if err != nil {
+		return err
+	}
+
+	var keywords []string
+	for _, keyword := range rules.Keywords {
+		keyword = strings.ToLower(strings.TrimSpace(keyword))
+		if len(keyword) != 0 {
+			keywords = append(keywords, keyword)
+		}
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.rules = rules
+	f.keywords = keywords
+	f.patterns = patterns
+	return nil
+}
+
+func (f *Filter) Rules() Rules {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.rules
+}
+
+// SetBlocked replaces the senders whose messages are always filtered
+func (f *Filter) SetBlocked(publicKeys []string) {
+	blocked := make(map[string]bool)
+	for _, pk := range publicKeys {
+		blocked[pk] = true
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.blocked = blocked
+}
+
+// Check returns whether message should be filtered, and why. Messages which
+// are filtered still count towards the rate and duplicate limits.
+func (f *Filter) Check(message *Message) (bool, Reason) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if f.blocked[message.From] {
+		return true, ReasonBlocklist
+	}
+
+	if f.rules.RateLimit != 0 {
+		key := message.ChatID + "/" + message.From
+		count := track(f.senders, key, message.Timestamp, f.rules.RatePeriod)
+		if count > f.rules.RateLimit {
+			return true, ReasonRate
+		}
+	}
+
+	text := strings.ToLower(strings.TrimSpace(message.Text))
+
+	if f.rules.DuplicateLimit != 0 && len(text) != 0 {
+		hash := sha256.Sum256([]byte(text))
+		key := message.ChatID + "/" + string(hash[:])
+		count := track(f.contents, key, message.Timestamp, f.rules.DuplicatePeriod)
+		if count > f.rules.DuplicateLimit {
+			return true, ReasonDuplicate
+		}
+	}
+
+	for _, keyword := range f.keywords {
+		if strings.Contains(text, keyword) {
+			return true, ReasonKeyword
+		}
+	}
+
+	for _, pattern := range f.patterns {
+		if pattern.MatchString(message.Text) {
+			return true, ReasonKeyword
+		}
+	}
+
+	if f.rules.QuarantinePeriod != 0 && message.Timestamp < message.FirstSeen+f.rules.QuarantinePeriod {
+		return true, ReasonQuarantine
+	}
+
+	return false, ReasonNone
+}
+
+// track records timestamp for key and returns the number of timestamps
+// in the period preceding it
+func track(tracked map[string][]uint64, key string, timestamp uint64, period uint64) int {
+	if len(tracked) > maxTrackedKeys {
+		prune(tracked, timestamp, period)
+	}
+
+	var recent []uint64
+	for _, t := range tracked[key] {
+		if inPeriod(t, timestamp, period) {
+			recent = append(recent, t)
+		}
+	}
+	recent = append(recent, timestamp)
+	tracked[key] = recent
+
+	return len(recent)
+}
+
+func prune(tracked map[string][]uint64, now uint64, period uint64) {
+	for key, timestamps := range tracked {
+		if len(timestamps) == 0 || !inPeriod(timestamps[len(timestamps)-1], now, period) {
+			delete(tracked, key)
+		}
+	}
+}
+
+// inPeriod returns whether t is within period of now. Messages might be
+// received out of order, so t can be after now.
+func inPeriod(t, now, period uint64) bool {
+	if t > now {
+		return t-now < period
+	}
+	return now-t < period
+}
+
+const blocklistChatIDPrefix = "spam-blocklist-"
+
+// BlocklistChatID returns the public chat the blocklist of publisher is published on
+func BlocklistChatID(publisher string) string {
+	return blocklistChatIDPrefix + publisher
+}
+
+// Blocklist is a list of senders published by a user or a community.
+// Publisher is the key signing it.
+type Blocklist struct {
+	Publisher  string   `json:"publisher"`
+	Clock      uint64   `json:"clock"`
+	PublicKeys []string `json:"publicKeys"`
+	// Own is set for the lists we publish, the others are followed
+	Own bool `json:"own"`
+	// CommunityID is set if we publish the list for a community
+	CommunityID string `json:"communityId,omitempty"`
+}
+
+// Blocked returns the senders blocked by any of the lists
+func Blocked(blocklists []*Blocklist) []string {
+	var blocked []string
+	for _, blocklist := range blocklists {
+		blocked = append(blocked, blocklist.PublicKeys...)
+	}
+	return blocked
+}
@@ -0,0 +1,100 @@
+package spam
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRulesValidate(t *testing.T) {
+	require.NoError(t, (&Rules{}).Validate())
+	require.Equal(t, ErrInvalidRules, (&Rules{RateLimit: 3}).Validate())
+	require.Equal(t, ErrInvalidRules, (&Rules{DuplicateLimit: -1, DuplicatePeriod: 10}).Validate())
+	require.Equal(t, ErrInvalidPattern, (&Rules{Patterns: []string{"("}}).Validate())
+}
+
+func TestRateLimit(t *testing.T) {
+	filter, err := NewFilter(Rules{RateLimit: 2, RatePeriod: 100})
+	require.NoError(t, err)
+
+	message := &Message{ChatID: "status", From: "a"}
+
+	for _, timestamp := range []uint64{10, 20} {
+		message.Timestamp = timestamp
+		filtered, _ := filter.Check(message)
+		require.False(t, filtered)
+	}
+
+	message.Timestamp = 30
+	filtered, reason := filter.Check(message)
+	require.True(t, filtered)
+	require.Equal(t, ReasonRate, reason)
+
+	// Other chats have their own limit
+	filtered, _ = filter.Check(&Message{ChatID: "other", From: "a", Timestamp: 30})
+	require.False(t, filtered)
+
+	// The messages of the first period are forgotten
+	message.Timestamp = 200
+	filtered, _ = filter.Check(message)
+	require.False(t, filtered)
+}
+
+func TestDuplicateLimit(t *testing.T) {
+	filter, err := NewFilter(Rules{DuplicateLimit: 1, DuplicatePeriod: 100})
+	require.NoError(t, err)
+
+	filtered, _ := filter.Check(&Message{ChatID: "status", From: "a", Text: "Buy now", Timestamp: 10})
+	require.False(t, filtered)
+
+	filtered, reason := filter.Check(&Message{ChatID: "status", From: "b", Text: " buy NOW", Timestamp: 20})
+	require.True(t, filtered)
+	require.Equal(t, ReasonDuplicate, reason)
+}
+
+func TestKeywords(t *testing.T) {
+	filter, err := NewFilter(Rules{Keywords: []string{" Airdrop "}, Patterns: []string{`0x[0-9a-f]{40}`}})
+	require.NoError(t, err)
+
+	filtered, reason := filter.Check(&Message{ChatID: "status", From: "a", Text: "free AIRDROP here"})
+	require.True(t, filtered)
+	require.Equal(t, ReasonKeyword, reason)
+
+	filtered, reason = filter.Check(&Message{ChatID: "status", From: "a", Text: "send to 0x0123456789abcdef0123456789abcdef01234567"})
+	require.True(t, filtered)
+	require.Equal(t, ReasonKeyword, reason)
+
+	filtered, _ = filter.Check(&Message{ChatID: "status", From: "a", Text: "hello"})
+	require.False(t, filtered)
+
+	require.Equal(t, ErrInvalidPattern, filter.SetRules(Rules{Patterns: []string{"["}}))
+}
+
+func TestQuarantine(t *testing.T) {
+	filter, err := NewFilter(Rules{QuarantinePeriod: 100})
+	require.NoError(t, err)
+
+	filtered, reason := filter.Check(&Message{ChatID: "status", From: "a", FirstSeen: 10, Timestamp: 50})
+	require.True(t, filtered)
+	require.Equal(t, ReasonQuarantine, reason)
+
+	filtered, _ = filter.Check(&Message{ChatID: "status", From: "a", FirstSeen: 10, Timestamp: 110})
+	require.False(t, filtered)
+}
+
+func TestBlocklists(t *testing.T) {
+	filter, err := NewFilter(Rules{})
+	require.NoError(t, err)
+
+	filter.SetBlocked(Blocked([]*Blocklist{
+		{Publisher: "p1", PublicKeys: []string{"a"}},
+		{Publisher: "p2", PublicKeys: []string{"b"}},
+	}))
+
+	filtered, reason := filter.Check(&Message{ChatID: "status", From: "b"})
+	require.True(t, filtered)
+	require.Equal(t, ReasonBlocklist, reason)
+
+	filtered, _ = filter.Check(&Message{ChatID: "status", From: "c"})
+	require.False(t, filtered)
+}
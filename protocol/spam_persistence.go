@@ -0,0 +1,123 @@
+package protocol
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	"github.com/status-im/status-go/protocol/spam"
+)
+
+// SpamFilterRules returns the stored rules, or nil if they haven't been set
+func (db sqlitePersistence) SpamFilterRules() (*spam.Rules, error) {
+	var encoded []byte
+	err := db.db.QueryRow(`SELECT rules FROM spam_filter_rules WHERE id = 1`).Scan(&encoded)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rules := &spam.Rules{}
+	err = json.Unmarshal(encoded, rules)
+	if err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+func (db sqlitePersistence) SaveSpamFilterRules(rules spam.Rules) error {
+	encoded, err := json.Marshal(rules)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.db.Exec(`INSERT INTO spam_filter_rules (id, rules) VALUES (1, ?)`, encoded)
+	return err
+}
+
+// SpamSenderFirstSeen records a message of the sender sent at timestamp, and
+// returns the timestamp of the earliest one we have seen
+func (db sqlitePersistence) SpamSenderFirstSeen(publicKey string, timestamp uint64) (uint64, error) {
+	_, err := db.db.Exec(`INSERT INTO spam_senders (public_key, first_seen) VALUES (?, ?)`, publicKey, timestamp)
+	if err != nil {
+		return 0, err
+	}
+
+	// Messages from the history are not received in order
+	_, err = db.db.Exec(`UPDATE spam_senders SET first_seen = ? WHERE public_key = ? AND first_seen > ?`, timestamp, publicKey, timestamp)
+	if err != nil {
+		return 0, err
+	}
+
+	var firstSeen uint64
+	err = db.db.QueryRow(`SELECT first_seen FROM spam_senders WHERE public_key = ?`, publicKey).Scan(&firstSeen)
+	return firstSeen, err
+}
+
+func (db sqlitePersistence) SaveSpamBlocklist(blocklist *spam.Blocklist) error {
+	publicKeys, err := json.Marshal(blocklist.PublicKeys)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.db.Exec(`INSERT INTO spam_blocklists (publisher, clock, public_keys, own, community_id) VALUES (?, ?, ?, ?, ?)`,
+		blocklist.Publisher,
+		blocklist.Clock,
+		string(publicKeys),
+		blocklist.Own,
+		blocklist.CommunityID,
+	)
+	return err
+}
+
+func (db sqlitePersistence) querySpamBlocklists(query string, args ...interface{}) ([]*spam.Blocklist, error) {
+	rows, err := db.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var blocklists []*spam.Blocklist
+	for rows.Next() {
+		blocklist := &spam.Blocklist{}
+		var publicKeys string
+		err := rows.Scan(&blocklist.Publisher, &blocklist.Clock, &publicKeys, &blocklist.Own, &blocklist.CommunityID)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal([]byte(publicKeys), &blocklist.PublicKeys)
+		if err != nil {
+			return nil, err
+		}
+		blocklists = append(blocklists, blocklist)
+	}
+	return blocklists, nil
+}
+
+func (db sqlitePersistence) SpamBlocklists() ([]*spam.Blocklist, error) {
+	return db.querySpamBlocklists(`SELECT publisher, clock, public_keys, own, community_id FROM spam_blocklists`)
+}
+
+// SpamBlocklist returns the list of publisher, or nil if we don't have it
+func (db sqlitePersistence) SpamBlocklist(publisher string) (*spam.Blocklist, error) {
+	blocklists, err := db.querySpamBlocklists(`SELECT publisher, clock, public_keys, own, community_id FROM spam_blocklists WHERE publisher = ?`, publisher)
+	if err != nil {
+		return nil, err
+	}
+	if len(blocklists) == 0 {
+		return nil, nil
+	}
+	return blocklists[0], nil
+}
+
+func (db sqlitePersistence) DeleteSpamBlocklist(publisher string) error {
+	_, err := db.db.Exec(`DELETE FROM spam_blocklists WHERE publisher = ?`, publisher)
+	return err
+}
+
+func (db sqlitePersistence) UnhideMessage(id string) error {
+	_, err := db.db.Exec(`UPDATE user_messages SET hide = 0 WHERE id = ?`, id)
+	return err
+}
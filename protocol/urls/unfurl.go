@@ -0,0 +1,135 @@
+package urls
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/keighl/metabolize"
+)
+
+// maxPageSize is the largest amount of html we read to find the meta tags of a page
+const maxPageSize = 1024 * 1024
+
+// maxThumbnailSize is the largest image we download to generate the thumbnail of a link
+const maxThumbnailSize = 5 * 1024 * 1024
+
+var unfurlClient = http.Client{
+	Timeout: 10 * time.Second,
+}
+
+// UnfurledLink is the preview of a link as fetched by the sender of a message
+type UnfurledLink struct {
+	LinkPreviewData
+	URL string `json:"url"`
+	// Thumbnail is the image found at ThumbnailURL, if any
+	Thumbnail []byte `json:"-"`
+}
+
+// hasOembedProvider returns whether the previews of the site are fetched
+// through its oembed service rather than from the meta tags of the page
+func hasOembedProvider(hostname string) bool {
+	switch hostname {
+	case "youtube.com", "youtu.be", "www.youtube.com", "giphy.com", "media.giphy.com", "gph.is", "tenor.com":
+		return true
+	}
+	return false
+}
+
+// UnfurlLink fetches the preview data of a link along with its thumbnail.
+// Unlike GetLinkPreviewData it isn't restricted to whitelisted sites, as it
+// is only called by the sender of a message, receivers don't make any request.
+func UnfurlLink(link string) (*UnfurledLink, error) {
+	u, err := url.Parse(link)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse link %s", link)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("can't unfurl link %s, unsupported scheme", link)
+	}
+
+	hostname := strings.ToLower(u.Hostname())
+
+	var previewData LinkPreviewData
+	if hasOembedProvider(hostname) {
+		previewData, err = GetLinkPreviewData(link)
+	} else {
+		previewData, err = getPagePreviewData(link)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if previewData.Title == "" {
+		return nil, fmt.Errorf("no preview data found for link %s", link)
+	}
+	if previewData.Site == "" {
+		previewData.Site = hostname
+	}
+
+	unfurled := &UnfurledLink{
+		LinkPreviewData: previewData,
+		URL:             link,
+	}
+
+	// The link is unfurled without a thumbnail if the image can't be fetched
+	if previewData.ThumbnailURL != "" {
+		// Thumbnails are allowed to be relative to the page
+		thumbnailURL, err := u.Parse(previewData.ThumbnailURL)
+		if err == nil {
+			unfurled.ThumbnailURL = thumbnailURL.String()
+			unfurled.Thumbnail, _ = getLimitedContent(unfurled.ThumbnailURL, maxThumbnailSize)
+		} else {
+			unfurled.ThumbnailURL = ""
+		}
+	}
+
+	return unfurled, nil
+}
+
+func getPagePreviewData(link string) (previewData LinkPreviewData, err error) {
+	// nolint: gosec
+	res, err := unfurlClient.Get(link)
+	if err != nil {
+		return previewData, fmt.Errorf("can't get content from link %s", link)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return previewData, fmt.Errorf("can't get content from link %s, status %d", link, res.StatusCode)
+	}
+
+	err = metabolize.Metabolize(io.LimitReader(res.Body, maxPageSize), &previewData)
+	if err != nil {
+		return previewData, fmt.Errorf("can't get meta info from link %s", link)
+	}
+
+	return previewData, nil
+}
+
+func getLimitedContent(link string, limit int64) ([]byte, error) {
+	// nolint: gosec
+	res, err := unfurlClient.Get(link)
+	if err != nil {
+		return nil, fmt.Errorf("can't get content from link %s", link)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("can't get content from link %s, status %d", link, res.StatusCode)
+	}
+
+	data, err := ioutil.ReadAll(io.LimitReader(res.Body, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > limit {
+		return nil, fmt.Errorf("content of link %s is too large", link)
+	}
+
+	return data, nil
+}
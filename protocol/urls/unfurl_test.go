@@ -0,0 +1,76 @@
+package urls
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var thumbnail = []byte{0xff, 0xd8, 0xff, 0xe0, 0x00, 0x10, 0x4a, 0x46, 0x49, 0x46}
+
+func newUnfurlTestServer() *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/article", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `<html><head>
+<meta property="og:site_name" content="Test site" />
+<meta property="og:title" content="Test article" />
+<meta property="og:description" content="An article about tests" />
+<meta property="og:image" content="/thumbnail.jpg" />
+</head><body></body></html>`)
+	})
+	mux.HandleFunc("/missing-thumbnail", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `<html><head>
+<meta property="og:title" content="Test article" />
+<meta property="og:image" content="/not-found.jpg" />
+</head><body></body></html>`)
+	})
+	mux.HandleFunc("/no-meta", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `<html><head></head><body></body></html>`)
+	})
+	mux.HandleFunc("/thumbnail.jpg", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(thumbnail)
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestUnfurlLink(t *testing.T) {
+	server := newUnfurlTestServer()
+	defer server.Close()
+
+	unfurled, err := UnfurlLink(server.URL + "/article")
+	require.NoError(t, err)
+	require.Equal(t, server.URL+"/article", unfurled.URL)
+	require.Equal(t, "Test site", unfurled.Site)
+	require.Equal(t, "Test article", unfurled.Title)
+	require.Equal(t, "An article about tests", unfurled.Description)
+	require.Equal(t, server.URL+"/thumbnail.jpg", unfurled.ThumbnailURL)
+	require.Equal(t, thumbnail, unfurled.Thumbnail)
+}
+
+func TestUnfurlLinkWithoutThumbnail(t *testing.T) {
+	server := newUnfurlTestServer()
+	defer server.Close()
+
+	unfurled, err := UnfurlLink(server.URL + "/missing-thumbnail")
+	require.NoError(t, err)
+	require.Equal(t, "Test article", unfurled.Title)
+	require.Equal(t, "127.0.0.1", unfurled.Site)
+	require.Empty(t, unfurled.Thumbnail)
+}
+
+func TestUnfurlLinkErrors(t *testing.T) {
+	server := newUnfurlTestServer()
+	defer server.Close()
+
+	_, err := UnfurlLink(server.URL + "/no-meta")
+	require.Error(t, err)
+
+	_, err = UnfurlLink(server.URL + "/not-found")
+	require.Error(t, err)
+
+	_, err = UnfurlLink("ftp://example.com/file")
+	require.Error(t, err)
+}
@@ -37,6 +37,7 @@ type TenorOembedData struct {
 type LinkPreviewData struct {
 	Site         string `json:"site" meta:"og:site_name"`
 	Title        string `json:"title" meta:"og:title"`
+	Description  string `json:"description" meta:"og:description"`
 	ThumbnailURL string `json:"thumbnailUrl" meta:"og:image"`
 	ContentType  string `json:"contentType"`
 	Height       int    `json:"height"`
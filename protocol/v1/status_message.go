@@ -230,12 +230,42 @@ func (m *StatusMessage) HandleApplication() error {
 		return m.unmarshalProtobufData(new(protobuf.CommunityInvitation))
 	case protobuf.ApplicationMetadataMessage_COMMUNITY_REQUEST_TO_JOIN:
 		return m.unmarshalProtobufData(new(protobuf.CommunityRequestToJoin))
+	case protobuf.ApplicationMetadataMessage_COMMUNITY_MESSAGE_ARCHIVE_REQUEST:
+		return m.unmarshalProtobufData(new(protobuf.CommunityMessageArchiveRequest))
+	case protobuf.ApplicationMetadataMessage_COMMUNITY_MESSAGE_ARCHIVE_RESPONSE:
+		return m.unmarshalProtobufData(new(protobuf.CommunityMessageArchiveResponse))
+	case protobuf.ApplicationMetadataMessage_COMMUNITY_CONTROL_SIGNATURE_REQUEST:
+		return m.unmarshalProtobufData(new(protobuf.CommunityControlSignatureRequest))
+	case protobuf.ApplicationMetadataMessage_COMMUNITY_CONTROL_SIGNATURE:
+		return m.unmarshalProtobufData(new(protobuf.CommunityControlSignature))
+	case protobuf.ApplicationMetadataMessage_COMMUNITY_DIRECTORY_LISTING:
+		return m.unmarshalProtobufData(new(protobuf.CommunityDirectoryListing))
+	case protobuf.ApplicationMetadataMessage_SPAM_BLOCKLIST:
+		return m.unmarshalProtobufData(new(protobuf.SpamBlocklist))
+	case protobuf.ApplicationMetadataMessage_ANONYMOUS_METRIC_BATCH:
+		return m.unmarshalProtobufData(new(protobuf.AnonymousMetricBatch))
+	case protobuf.ApplicationMetadataMessage_SOCIAL_RECOVERY_SHARE:
+		return m.unmarshalProtobufData(new(protobuf.SocialRecoveryShare))
+	case protobuf.ApplicationMetadataMessage_SOCIAL_RECOVERY_REQUEST:
+		return m.unmarshalProtobufData(new(protobuf.SocialRecoveryRequest))
+	case protobuf.ApplicationMetadataMessage_SAFE_TRANSACTION_SIGNATURE:
+		return m.unmarshalProtobufData(new(protobuf.SafeTransactionSignature))
 	case protobuf.ApplicationMetadataMessage_EDIT_MESSAGE:
 		return m.unmarshalProtobufData(new(protobuf.EditMessage))
 	case protobuf.ApplicationMetadataMessage_DELETE_MESSAGE:
 		return m.unmarshalProtobufData(new(protobuf.DeleteMessage))
 	case protobuf.ApplicationMetadataMessage_STATUS_UPDATE:
 		return m.unmarshalProtobufData(new(protobuf.StatusUpdate))
+	case protobuf.ApplicationMetadataMessage_REQUEST_CONTACT_VERIFICATION:
+		return m.unmarshalProtobufData(new(protobuf.RequestContactVerification))
+	case protobuf.ApplicationMetadataMessage_ACCEPT_CONTACT_VERIFICATION:
+		return m.unmarshalProtobufData(new(protobuf.AcceptContactVerification))
+	case protobuf.ApplicationMetadataMessage_SYNC_VERIFICATION_REQUEST:
+		return m.unmarshalProtobufData(new(protobuf.SyncVerificationRequest))
+	case protobuf.ApplicationMetadataMessage_POLL_VOTE:
+		return m.unmarshalProtobufData(new(protobuf.PollVote))
+	case protobuf.ApplicationMetadataMessage_FILE_CHUNK:
+		return m.unmarshalProtobufData(new(protobuf.FileChunk))
 	case protobuf.ApplicationMetadataMessage_PUSH_NOTIFICATION_REGISTRATION:
 		// This message is a bit different as it's encrypted, so we pass it straight through
 		v := reflect.ValueOf(m.UnwrappedPayload)
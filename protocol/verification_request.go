@@ -0,0 +1,28 @@
+package protocol
+
+// VerificationStatus is the status of a contact verification request
+type VerificationStatus int
+
+const (
+	// VerificationStatusPending is set while waiting for the contact to answer the challenge
+	VerificationStatusPending VerificationStatus = iota
+	// VerificationStatusAccepted is set once the contact replied to the challenge
+	VerificationStatusAccepted
+	// VerificationStatusTrusted is set when the user confirmed the response
+	VerificationStatusTrusted
+	// VerificationStatusUntrustworthy is set when the user rejected the response
+	VerificationStatusUntrustworthy
+)
+
+// VerificationRequest is a question asked to a contact to confirm their
+// identity out-of-band
+type VerificationRequest struct {
+	ID          string             `json:"id"`
+	From        string             `json:"from"`
+	To          string             `json:"to"`
+	Challenge   string             `json:"challenge"`
+	Response    string             `json:"response"`
+	RequestedAt uint64             `json:"requestedAt"`
+	RepliedAt   uint64             `json:"repliedAt"`
+	Status      VerificationStatus `json:"status"`
+}
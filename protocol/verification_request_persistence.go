@@ -0,0 +1,68 @@
+package protocol
+
+import (
+	"database/sql"
+)
+
+const selectVerificationRequestsQuery = `SELECT id, from_user, to_user, challenge, response, requested_at, replied_at, status FROM verification_requests`
+
+func (db sqlitePersistence) SaveVerificationRequest(request *VerificationRequest) error {
+	_, err := db.db.Exec(`INSERT INTO verification_requests (id, from_user, to_user, challenge, response, requested_at, replied_at, status) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		request.ID,
+		request.From,
+		request.To,
+		request.Challenge,
+		request.Response,
+		request.RequestedAt,
+		request.RepliedAt,
+		request.Status,
+	)
+	return err
+}
+
+func scanVerificationRequest(row interface{ Scan(...interface{}) error }) (*VerificationRequest, error) {
+	request := &VerificationRequest{}
+	err := row.Scan(
+		&request.ID,
+		&request.From,
+		&request.To,
+		&request.Challenge,
+		&request.Response,
+		&request.RequestedAt,
+		&request.RepliedAt,
+		&request.Status,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+// VerificationRequestByID returns the request with the given id, or nil if it doesn't exist
+func (db sqlitePersistence) VerificationRequestByID(id string) (*VerificationRequest, error) {
+	request, err := scanVerificationRequest(db.db.QueryRow(selectVerificationRequestsQuery+` WHERE id = ?`, id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return request, err
+}
+
+// VerificationRequestsWithContact returns the requests sent to or received from
+// the given contact, most recent first
+func (db sqlitePersistence) VerificationRequestsWithContact(contactID string) ([]*VerificationRequest, error) {
+	rows, err := db.db.Query(selectVerificationRequestsQuery+` WHERE from_user = ? OR to_user = ? ORDER BY requested_at DESC`, contactID, contactID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var requests []*VerificationRequest
+	for rows.Next() {
+		request, err := scanVerificationRequest(rows)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, request)
+	}
+	return requests, nil
+}
@@ -104,6 +104,7 @@ var remoteMethods = [...]string{
 	//"eth_compileSerpent",  // goes to the local because there's no need to send it anywhere
 
 	"eth_getLogs",
+	"debug_traceCall", // used by the wallet to simulate transactions, when the upstream supports tracing
 	"eth_getWork",
 	"eth_submitWork",
 	"eth_submitHashrate",
@@ -0,0 +1,265 @@
+package bridge
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/status-im/go-waku/waku/v2/protocol/pb"
+
+	"github.com/status-im/status-go/waku"
+	wakucommon "github.com/status-im/status-go/waku/common"
+	"github.com/status-im/status-go/wakuv2"
+)
+
+const (
+	// pipeBufferSize is the number of messages waiting to be bridged in each direction
+	pipeBufferSize = 1024
+	// seenCacheTTL is how long a bridged message is remembered, it must
+	// be longer than the time envelopes are kept by the networks.
+	seenCacheTTL = 10 * time.Minute
+	// sealWorkTime is the maximum time in seconds spent on the PoW of an envelope
+	sealWorkTime = 1
+	// payloadVersion is the version of the wakuv2 messages using waku v1 encryption
+	payloadVersion = 1
+)
+
+// WakuV1 is the waku v1 node the bridge is attached to.
+type WakuV1 interface {
+	RegisterBridge(waku.Bridge)
+	MinPow() float64
+}
+
+// WakuV2 is the wakuv2 node the bridge is attached to.
+type WakuV2 interface {
+	RegisterBridge(wakuv2.Bridge)
+}
+
+// Bridge moves messages between the devp2p waku v1 network and
+// the libp2p wakuv2 network, so that clients of both versions can talk
+// to each other during the migration.
+type Bridge struct {
+	wakuV1     WakuV1
+	wakuV2     WakuV2
+	topics     map[wakucommon.TopicType]bool
+	timeSource func() time.Time
+	logger     *zap.Logger
+
+	fromV1 chan *wakucommon.Envelope
+	toV1   chan *wakucommon.Envelope
+	fromV2 chan *pb.WakuMessage
+	toV2   chan *pb.WakuMessage
+
+	// seen contains the messages already bridged, in either direction,
+	// as each one comes back from the network it was sent to.
+	seen   map[gethcommon.Hash]time.Time
+	seenMu sync.Mutex
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New returns a bridge between the two nodes. If topics is not empty, only
+// the messages on those topics are bridged.
+func New(wakuV1 WakuV1, wakuV2 WakuV2, topics []wakucommon.TopicType, logger *zap.Logger) *Bridge {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+
+	var allowed map[wakucommon.TopicType]bool
+	if len(topics) != 0 {
+		allowed = make(map[wakucommon.TopicType]bool)
+		for _, topic := range topics {
+			allowed[topic] = true
+		}
+	}
+
+	return &Bridge{
+		wakuV1:     wakuV1,
+		wakuV2:     wakuV2,
+		topics:     allowed,
+		timeSource: time.Now,
+		logger:     logger.With(zap.String("site", "bridge")),
+		fromV1:     make(chan *wakucommon.Envelope, pipeBufferSize),
+		toV1:       make(chan *wakucommon.Envelope, pipeBufferSize),
+		fromV2:     make(chan *pb.WakuMessage, pipeBufferSize),
+		toV2:       make(chan *pb.WakuMessage, pipeBufferSize),
+		seen:       make(map[gethcommon.Hash]time.Time),
+	}
+}
+
+type wakuV1Pipe struct {
+	b *Bridge
+}
+
+func (p wakuV1Pipe) Pipe() (<-chan *wakucommon.Envelope, chan<- *wakucommon.Envelope) {
+	return p.b.toV1, p.b.fromV1
+}
+
+type wakuV2Pipe struct {
+	b *Bridge
+}
+
+func (p wakuV2Pipe) Pipe() (<-chan *pb.WakuMessage, chan<- *pb.WakuMessage) {
+	return p.b.toV2, p.b.fromV2
+}
+
+// Start registers the bridge in both nodes and starts moving messages.
+func (b *Bridge) Start() error {
+	b.quit = make(chan struct{})
+	b.wakuV1.RegisterBridge(wakuV1Pipe{b})
+	b.wakuV2.RegisterBridge(wakuV2Pipe{b})
+
+	b.wg.Add(1)
+	go b.loop()
+	return nil
+}
+
+// Stop stops moving messages between the nodes.
+func (b *Bridge) Stop() error {
+	if b.quit == nil {
+		return nil
+	}
+	close(b.quit)
+	b.wg.Wait()
+	b.quit = nil
+	return nil
+}
+
+// APIs returns list of available RPC APIs.
+func (b *Bridge) APIs() []rpc.API {
+	return nil
+}
+
+// Protocols returns list of p2p protocols.
+func (b *Bridge) Protocols() []p2p.Protocol {
+	return nil
+}
+
+func (b *Bridge) loop() {
+	defer b.wg.Done()
+	ticker := time.NewTicker(seenCacheTTL / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-b.quit:
+			return
+		case <-ticker.C:
+			b.expireSeen()
+		case envelope := <-b.fromV1:
+			msg := b.envelopeToMessage(envelope)
+			if msg == nil {
+				continue
+			}
+			select {
+			case b.toV2 <- msg:
+			case <-b.quit:
+				return
+			}
+		case msg := <-b.fromV2:
+			envelope := b.messageToEnvelope(msg)
+			if envelope == nil {
+				continue
+			}
+			select {
+			case b.toV1 <- envelope:
+			case <-b.quit:
+				return
+			}
+		}
+	}
+}
+
+// markSeen returns false if the message has already been bridged.
+// Messages are identified by topic and payload, as the envelope
+// fields differ between the two networks.
+func (b *Bridge) markSeen(topic wakucommon.TopicType, payload []byte) bool {
+	key := crypto.Keccak256Hash(topic[:], payload)
+
+	b.seenMu.Lock()
+	defer b.seenMu.Unlock()
+	if _, ok := b.seen[key]; ok {
+		return false
+	}
+	b.seen[key] = b.timeSource()
+	return true
+}
+
+func (b *Bridge) expireSeen() {
+	now := b.timeSource()
+
+	b.seenMu.Lock()
+	defer b.seenMu.Unlock()
+	for key, seenAt := range b.seen {
+		if now.Sub(seenAt) > seenCacheTTL {
+			delete(b.seen, key)
+		}
+	}
+}
+
+func (b *Bridge) allowed(topic wakucommon.TopicType) bool {
+	return b.topics == nil || b.topics[topic]
+}
+
+// envelopeToMessage translates a waku v1 envelope, it returns nil if
+// the envelope must not be bridged.
+func (b *Bridge) envelopeToMessage(envelope *wakucommon.Envelope) *pb.WakuMessage {
+	if !b.allowed(envelope.Topic) || !b.markSeen(envelope.Topic, envelope.Data) {
+		return nil
+	}
+
+	b.logger.Debug("bridging envelope to wakuv2", zap.Binary("hash", envelope.Hash().Bytes()))
+	return &pb.WakuMessage{
+		Payload:      envelope.Data,
+		ContentTopic: envelope.Topic.String(),
+		Version:      payloadVersion,
+		Timestamp:    float64(envelope.Expiry - envelope.TTL),
+	}
+}
+
+// messageToEnvelope translates a wakuv2 message, it returns nil if
+// the message must not or can't be bridged.
+func (b *Bridge) messageToEnvelope(msg *pb.WakuMessage) *wakucommon.Envelope {
+	// Other versions can't be decrypted by waku v1 clients
+	if msg.Version != payloadVersion {
+		return nil
+	}
+
+	topicBytes, err := hexutil.Decode(msg.ContentTopic)
+	if err != nil || len(topicBytes) != wakucommon.TopicLength {
+		return nil
+	}
+	topic := wakucommon.BytesToTopic(topicBytes)
+
+	if !b.allowed(topic) || !b.markSeen(topic, msg.Payload) {
+		return nil
+	}
+
+	sent := uint32(msg.Timestamp)
+	if sent == 0 {
+		sent = uint32(b.timeSource().Unix())
+	}
+	envelope := &wakucommon.Envelope{
+		Expiry: sent + wakucommon.DefaultTTL,
+		TTL:    wakucommon.DefaultTTL,
+		Topic:  topic,
+		Data:   msg.Payload,
+	}
+
+	err = envelope.Seal(&wakucommon.MessageParams{PoW: b.wakuV1.MinPow(), WorkTime: sealWorkTime})
+	if err != nil {
+		b.logger.Warn("failed to seal bridged envelope", zap.Error(err))
+		return nil
+	}
+
+	b.logger.Debug("bridging message to waku v1", zap.Binary("hash", envelope.Hash().Bytes()))
+	return envelope
+}
@@ -0,0 +1,141 @@
+package bridge
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/go-waku/waku/v2/protocol/pb"
+
+	"github.com/status-im/status-go/waku"
+	wakucommon "github.com/status-im/status-go/waku/common"
+	"github.com/status-im/status-go/wakuv2"
+)
+
+type fakeWakuV1 struct {
+	bridge waku.Bridge
+}
+
+func (w *fakeWakuV1) RegisterBridge(b waku.Bridge) { w.bridge = b }
+func (w *fakeWakuV1) MinPow() float64              { return 0 }
+
+type fakeWakuV2 struct {
+	bridge wakuv2.Bridge
+}
+
+func (w *fakeWakuV2) RegisterBridge(b wakuv2.Bridge) { w.bridge = b }
+
+func startBridge(t *testing.T, topics []wakucommon.TopicType) (*Bridge, *fakeWakuV1, *fakeWakuV2) {
+	v1, v2 := &fakeWakuV1{}, &fakeWakuV2{}
+	b := New(v1, v2, topics, nil)
+	require.NoError(t, b.Start())
+	require.NotNil(t, v1.bridge)
+	require.NotNil(t, v2.bridge)
+	return b, v1, v2
+}
+
+func receiveMessage(t *testing.T, ch <-chan *pb.WakuMessage) *pb.WakuMessage {
+	select {
+	case msg := <-ch:
+		return msg
+	case <-time.After(time.Second):
+		require.FailNow(t, "timed out waiting for wakuv2 message")
+	}
+	return nil
+}
+
+func receiveEnvelope(t *testing.T, ch <-chan *wakucommon.Envelope) *wakucommon.Envelope {
+	select {
+	case env := <-ch:
+		return env
+	case <-time.After(time.Second):
+		require.FailNow(t, "timed out waiting for waku v1 envelope")
+	}
+	return nil
+}
+
+func TestBridgeV1ToV2(t *testing.T) {
+	b, v1, v2 := startBridge(t, nil)
+	defer func() { require.NoError(t, b.Stop()) }()
+
+	_, fromV1 := v1.bridge.Pipe()
+	toV2, fromV2 := v2.bridge.Pipe()
+	toV1, _ := v1.bridge.Pipe()
+
+	envelope := &wakucommon.Envelope{Expiry: 1050, TTL: 50, Topic: wakucommon.TopicType{1, 2, 3, 4}, Data: []byte("payload")}
+	fromV1 <- envelope
+
+	msg := receiveMessage(t, toV2)
+	require.Equal(t, "0x01020304", msg.ContentTopic)
+	require.Equal(t, []byte("payload"), msg.Payload)
+	require.Equal(t, uint32(payloadVersion), msg.Version)
+	require.Equal(t, float64(1000), msg.Timestamp)
+
+	// The message coming back from wakuv2 must not be bridged again
+	fromV2 <- msg
+	fromV1 <- envelope
+	select {
+	case <-toV1:
+		require.FailNow(t, "message bridged back to waku v1")
+	case <-toV2:
+		require.FailNow(t, "message bridged twice to wakuv2")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestBridgeV2ToV1(t *testing.T) {
+	b, v1, v2 := startBridge(t, nil)
+	defer func() { require.NoError(t, b.Stop()) }()
+
+	toV1, fromV1 := v1.bridge.Pipe()
+	toV2, fromV2 := v2.bridge.Pipe()
+
+	// Messages that waku v1 clients can't read are ignored
+	fromV2 <- &pb.WakuMessage{ContentTopic: "0x01020304", Payload: []byte("v0"), Version: 0}
+	fromV2 <- &pb.WakuMessage{ContentTopic: "/waku/2/default", Payload: []byte("other"), Version: 1}
+	fromV2 <- &pb.WakuMessage{ContentTopic: "0x01020304", Payload: []byte("payload"), Version: 1, Timestamp: 1000}
+
+	envelope := receiveEnvelope(t, toV1)
+	require.Equal(t, wakucommon.TopicType{1, 2, 3, 4}, envelope.Topic)
+	require.Equal(t, []byte("payload"), envelope.Data)
+	require.Equal(t, uint32(1000), envelope.Expiry-envelope.TTL)
+
+	fromV1 <- envelope
+	select {
+	case <-toV2:
+		require.FailNow(t, "envelope bridged back to wakuv2")
+	case <-toV1:
+		require.FailNow(t, "unexpected envelope")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestBridgeTopicAllowList(t *testing.T) {
+	allowed := wakucommon.TopicType{1, 1, 1, 1}
+	b, v1, v2 := startBridge(t, []wakucommon.TopicType{allowed})
+	defer func() { require.NoError(t, b.Stop()) }()
+
+	_, fromV1 := v1.bridge.Pipe()
+	toV2, _ := v2.bridge.Pipe()
+
+	fromV1 <- &wakucommon.Envelope{Expiry: 1050, TTL: 50, Topic: wakucommon.TopicType{2, 2, 2, 2}, Data: []byte("ignored")}
+	fromV1 <- &wakucommon.Envelope{Expiry: 1050, TTL: 50, Topic: allowed, Data: []byte("bridged")}
+
+	msg := receiveMessage(t, toV2)
+	require.Equal(t, []byte("bridged"), msg.Payload)
+}
+
+func TestBridgeExpireSeen(t *testing.T) {
+	b := New(&fakeWakuV1{}, &fakeWakuV2{}, nil, nil)
+	now := time.Now()
+	b.timeSource = func() time.Time { return now }
+
+	topic := wakucommon.TopicType{1}
+	require.True(t, b.markSeen(topic, []byte("payload")))
+	require.False(t, b.markSeen(topic, []byte("payload")))
+
+	now = now.Add(seenCacheTTL + time.Second)
+	b.expireSeen()
+	require.True(t, b.markSeen(topic, []byte("payload")))
+}
@@ -0,0 +1,366 @@
+package ens
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+
+	ens "github.com/wealdtech/go-ens/v3"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/transactions"
+)
+
+var (
+	// ErrServiceNotInitialized is returned when the RPC client hasn't been set.
+	ErrServiceNotInitialized = errors.New("ens service is not initialized")
+	// ErrNoResolver is returned when the name has no resolver set.
+	ErrNoResolver = errors.New("no resolver set for the name")
+	// ErrInvalidUsername is returned when the username is not a valid label of the registrar domain.
+	ErrInvalidUsername = errors.New("invalid username")
+	// ErrInvalidPublicKey is returned when the public key is not an uncompressed secp256k1 key.
+	ErrInvalidPublicKey = errors.New("invalid public key")
+)
+
+func NewAPI(s *Service) *API {
+	return &API{s}
+}
+
+// API is class with methods available over RPC.
+type API struct {
+	s *Service
+}
+
+func (api *API) contracts() (*Contracts, error) {
+	if api.s.client == nil {
+		return nil, ErrServiceNotInitialized
+	}
+	if api.s.contracts == nil {
+		return nil, ErrUnsupportedChain
+	}
+	return api.s.contracts, nil
+}
+
+// registry returns the ENS registry, deployed at the same address on every network.
+func (api *API) registry() (*registryCaller, error) {
+	if api.s.client == nil {
+		return nil, ErrServiceNotInitialized
+	}
+	return newRegistryCaller(api.s.registry, api.s.client), nil
+}
+
+// resolver returns the node of the name along with its resolver.
+func (api *API) resolver(ctx context.Context, name string) ([32]byte, *resolverCaller, error) {
+	registry, err := api.registry()
+	if err != nil {
+		return [32]byte{}, nil, err
+	}
+	node, err := ens.NameHash(name)
+	if err != nil {
+		return [32]byte{}, nil, err
+	}
+
+	address, err := registry.Resolver(&bind.CallOpts{Context: ctx}, node)
+	if err != nil {
+		return [32]byte{}, nil, err
+	}
+	if address == (common.Address{}) {
+		return [32]byte{}, nil, ErrNoResolver
+	}
+	return node, newResolverCaller(address, api.s.client), nil
+}
+
+// AddressOf returns the address the name resolves to.
+func (api *API) AddressOf(ctx context.Context, name string) (common.Address, error) {
+	node, resolver, err := api.resolver(ctx, name)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return resolver.Addr(&bind.CallOpts{Context: ctx}, node)
+}
+
+// PublicKeyOf returns the uncompressed chat public key set for the name, or
+// an empty string when none is set.
+func (api *API) PublicKeyOf(ctx context.Context, name string) (string, error) {
+	node, resolver, err := api.resolver(ctx, name)
+	if err != nil {
+		return "", err
+	}
+	x, y, err := resolver.Pubkey(&bind.CallOpts{Context: ctx}, node)
+	if err != nil {
+		return "", err
+	}
+	if x == [32]byte{} && y == [32]byte{} {
+		return "", nil
+	}
+	return types.EncodeHex(append(append([]byte{0x04}, x[:]...), y[:]...)), nil
+}
+
+// Text returns the text record of the name for the key, e.g. "url" or "avatar".
+func (api *API) Text(ctx context.Context, name string, key string) (string, error) {
+	node, resolver, err := api.resolver(ctx, name)
+	if err != nil {
+		return "", err
+	}
+	return resolver.Text(&bind.CallOpts{Context: ctx}, node, key)
+}
+
+// ContentHash returns the EIP-1577 contenthash of the name.
+func (api *API) ContentHash(ctx context.Context, name string) (types.HexBytes, error) {
+	node, resolver, err := api.resolver(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return resolver.Contenthash(&bind.CallOpts{Context: ctx}, node)
+}
+
+// ReverseResolve returns the primary name of the address. The name is only
+// returned if it resolves back to the address, as anyone can claim any name
+// in their reverse record.
+func (api *API) ReverseResolve(ctx context.Context, address common.Address) (string, error) {
+	reverseName := strings.ToLower(address.Hex()[2:]) + ".addr.reverse"
+	node, resolver, err := api.resolver(ctx, reverseName)
+	if err == ErrNoResolver {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	name, err := resolver.Name(&bind.CallOpts{Context: ctx}, node)
+	if err != nil || name == "" {
+		return "", err
+	}
+
+	resolved, err := api.AddressOf(ctx, name)
+	if err == ErrNoResolver {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if resolved != address {
+		return "", nil
+	}
+	return name, nil
+}
+
+// OwnerOf returns the owner of the name in the registry.
+func (api *API) OwnerOf(ctx context.Context, name string) (common.Address, error) {
+	registry, err := api.registry()
+	if err != nil {
+		return common.Address{}, err
+	}
+	node, err := ens.NameHash(name)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return registry.Owner(&bind.CallOpts{Context: ctx}, node)
+}
+
+// Price returns the SNT price of a username.
+func (api *API) Price(ctx context.Context) (*hexutil.Big, error) {
+	contracts, err := api.contracts()
+	if err != nil {
+		return nil, err
+	}
+	price, err := newRegistrarCaller(contracts.Registrar, api.s.client).GetPrice(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(price), nil
+}
+
+// Usernames returns the usernames owned by the user.
+func (api *API) Usernames() ([]string, error) {
+	return api.s.Usernames()
+}
+
+// PendingTransactions returns the transactions on usernames which haven't been mined yet.
+func (api *API) PendingTransactions() ([]Transaction, error) {
+	return api.s.db.GetTransactions(TransactionStatusPending)
+}
+
+// RegisterPrepareTx builds the transaction registering the username under the
+// registrar domain for the address, with the chat public key set. The SNT price
+// is approved and the username registered in a single call through SNT's approveAndCall.
+func (api *API) RegisterPrepareTx(ctx context.Context, address common.Address, username string, pubkey string) (*transactions.SendTxArgs, error) {
+	contracts, err := api.contracts()
+	if err != nil {
+		return nil, err
+	}
+	label, err := usernameLabel(username)
+	if err != nil {
+		return nil, err
+	}
+	x, y, err := splitPublicKey(pubkey)
+	if err != nil {
+		return nil, err
+	}
+	price, err := api.Price(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	register, err := registrarABI.Pack("register", label, address, x, y)
+	if err != nil {
+		return nil, err
+	}
+	input, err := sntABI.Pack("approveAndCall", contracts.Registrar, price.ToInt(), register)
+	if err != nil {
+		return nil, err
+	}
+	return txArgs(address, contracts.SNT, input), nil
+}
+
+// RegisterEstimate returns the gas needed to register the username.
+func (api *API) RegisterEstimate(ctx context.Context, address common.Address, username string, pubkey string) (uint64, error) {
+	txArgs, err := api.RegisterPrepareTx(ctx, address, username, pubkey)
+	if err != nil {
+		return 0, err
+	}
+	return api.estimate(ctx, txArgs)
+}
+
+// Register sends the transaction registering the username, signed with the
+// account of the address, and returns its hash.
+func (api *API) Register(ctx context.Context, address common.Address, password string, username string, pubkey string) (types.Hash, error) {
+	txArgs, err := api.RegisterPrepareTx(ctx, address, username, pubkey)
+	if err != nil {
+		return types.Hash{}, err
+	}
+	return api.s.sendTransaction(TransactionTypeRegister, api.fullName(username), txArgs, password)
+}
+
+// ReleasePrepareTx builds the transaction releasing the username, which
+// refunds its price to the owner.
+func (api *API) ReleasePrepareTx(ctx context.Context, address common.Address, username string) (*transactions.SendTxArgs, error) {
+	contracts, err := api.contracts()
+	if err != nil {
+		return nil, err
+	}
+	label, err := usernameLabel(username)
+	if err != nil {
+		return nil, err
+	}
+
+	input, err := registrarABI.Pack("release", label)
+	if err != nil {
+		return nil, err
+	}
+	return txArgs(address, contracts.Registrar, input), nil
+}
+
+// ReleaseEstimate returns the gas needed to release the username.
+func (api *API) ReleaseEstimate(ctx context.Context, address common.Address, username string) (uint64, error) {
+	txArgs, err := api.ReleasePrepareTx(ctx, address, username)
+	if err != nil {
+		return 0, err
+	}
+	return api.estimate(ctx, txArgs)
+}
+
+// Release sends the transaction releasing the username and returns its hash.
+func (api *API) Release(ctx context.Context, address common.Address, password string, username string) (types.Hash, error) {
+	txArgs, err := api.ReleasePrepareTx(ctx, address, username)
+	if err != nil {
+		return types.Hash{}, err
+	}
+	return api.s.sendTransaction(TransactionTypeRelease, api.fullName(username), txArgs, password)
+}
+
+// SetPubKeyPrepareTx builds the transaction setting the chat public key of
+// the name in its resolver.
+func (api *API) SetPubKeyPrepareTx(ctx context.Context, address common.Address, name string, pubkey string) (*transactions.SendTxArgs, error) {
+	x, y, err := splitPublicKey(pubkey)
+	if err != nil {
+		return nil, err
+	}
+	node, resolver, err := api.resolver(ctx, api.fullName(name))
+	if err != nil {
+		return nil, err
+	}
+
+	input, err := resolverABI.Pack("setPubkey", node, x, y)
+	if err != nil {
+		return nil, err
+	}
+	return txArgs(address, resolver.address, input), nil
+}
+
+// SetPubKeyEstimate returns the gas needed to set the public key of the name.
+func (api *API) SetPubKeyEstimate(ctx context.Context, address common.Address, name string, pubkey string) (uint64, error) {
+	txArgs, err := api.SetPubKeyPrepareTx(ctx, address, name, pubkey)
+	if err != nil {
+		return 0, err
+	}
+	return api.estimate(ctx, txArgs)
+}
+
+// SetPubKey sends the transaction setting the public key of the name and returns its hash.
+func (api *API) SetPubKey(ctx context.Context, address common.Address, password string, name string, pubkey string) (types.Hash, error) {
+	txArgs, err := api.SetPubKeyPrepareTx(ctx, address, name, pubkey)
+	if err != nil {
+		return types.Hash{}, err
+	}
+	return api.s.sendTransaction(TransactionTypeSetPubKey, api.fullName(name), txArgs, password)
+}
+
+func (api *API) estimate(ctx context.Context, txArgs *transactions.SendTxArgs) (uint64, error) {
+	return api.s.client.EstimateGas(ctx, ethereum.CallMsg{
+		From:  common.Address(txArgs.From),
+		To:    (*common.Address)(txArgs.To),
+		Value: txArgs.Value.ToInt(),
+		Data:  txArgs.Input,
+	})
+}
+
+// fullName appends the registrar domain to the names without one.
+func (api *API) fullName(name string) string {
+	if strings.Contains(name, ".") || api.s.contracts == nil {
+		return name
+	}
+	return name + "." + api.s.contracts.Domain
+}
+
+func txArgs(from common.Address, to common.Address, input []byte) *transactions.SendTxArgs {
+	toAddress := types.Address(to)
+	return &transactions.SendTxArgs{
+		From:  types.Address(from),
+		To:    &toAddress,
+		Value: (*hexutil.Big)(big.NewInt(0)),
+		Input: types.HexBytes(input),
+	}
+}
+
+// usernameLabel returns the label hash of a username, which must be a single
+// label of the registrar domain.
+func usernameLabel(username string) ([32]byte, error) {
+	normalized, err := ens.Normalize(username)
+	if err != nil || normalized == "" || normalized != username || strings.Contains(username, ".") {
+		return [32]byte{}, ErrInvalidUsername
+	}
+	return ens.LabelHash(username)
+}
+
+// splitPublicKey returns the coordinates of an uncompressed public key.
+func splitPublicKey(pubkey string) ([32]byte, [32]byte, error) {
+	var x, y [32]byte
+	bytes, err := types.DecodeHex(pubkey)
+	if err != nil {
+		return x, y, ErrInvalidPublicKey
+	}
+	if _, err := crypto.UnmarshalPubkey(bytes); err != nil {
+		return x, y, ErrInvalidPublicKey
+	}
+	copy(x[:], bytes[1:33])
+	copy(y[:], bytes[33:])
+	return x, y, nil
+}
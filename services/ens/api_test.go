@@ -0,0 +1,243 @@
+package ens
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	ens "github.com/wealdtech/go-ens/v3"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/status-im/status-go/account"
+	"github.com/status-im/status-go/appdatabase"
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/multiaccounts/accounts"
+	"github.com/status-im/status-go/params"
+	"github.com/status-im/status-go/t/helpers"
+	"github.com/status-im/status-go/transactions"
+)
+
+var (
+	testWallet = common.HexToAddress("0xdC540f3745Ff2964AFC1171a5A0DD726d1F6B472")
+	testName   = "alice.stateofus.eth"
+	testPubkey = "0x04261c55675e55ff25edb50b345cfb3a3f35f60712d251cbaaab97bd50054c6ebc3cd4e22200c68daf7493e1f8da6a190a68a671e2d3977809612424c7c3888bc6"
+)
+
+func reverseName(address common.Address) string {
+	return strings.ToLower(address.Hex()[2:]) + ".addr.reverse"
+}
+
+// stubResolver programs the registry to return the resolver of the name.
+func stubResolver(t *testing.T, chain *helpers.SimulatedChain, name string, resolver common.Address) {
+	node, err := ens.NameHash(name)
+	require.NoError(t, err)
+	require.NoError(t, chain.StubMethod(registryAddress, registryABI, "resolver", []interface{}{node}, resolver))
+}
+
+// deployTestRegistrar deploys the username registrar contracts on the chain,
+// along with a resolver holding the records of testName, which is the primary
+// name of testWallet. The ENS registry is expected at its fixed address.
+func deployTestRegistrar(t *testing.T, chain *helpers.SimulatedChain) (Contracts, common.Address) {
+	var addresses [3]common.Address
+	for i := range addresses {
+		address, err := chain.DeployStub()
+		require.NoError(t, err)
+		addresses[i] = address
+	}
+	contracts := Contracts{
+		Registrar: addresses[0],
+		SNT:       addresses[1],
+		Domain:    "stateofus.eth",
+	}
+	resolver := addresses[2]
+
+	stubResolver(t, chain, testName, resolver)
+	stubResolver(t, chain, reverseName(testWallet), resolver)
+	stubResolver(t, chain, "bob.stateofus.eth", common.Address{})
+	stubResolver(t, chain, reverseName(common.Address{9}), common.Address{})
+
+	nameNode, err := ens.NameHash(testName)
+	require.NoError(t, err)
+	reverseNode, err := ens.NameHash(reverseName(testWallet))
+	require.NoError(t, err)
+	pubkey, err := types.DecodeHex(testPubkey)
+	require.NoError(t, err)
+	var x, y [32]byte
+	copy(x[:], pubkey[1:33])
+	copy(y[:], pubkey[33:])
+
+	require.NoError(t, chain.StubMethod(resolver, resolverABI, "addr", []interface{}{nameNode}, testWallet))
+	require.NoError(t, chain.StubMethod(resolver, resolverABI, "pubkey", []interface{}{nameNode}, x, y))
+	require.NoError(t, chain.StubMethod(resolver, resolverABI, "text", []interface{}{nameNode, "url"}, "https://status.im"))
+	require.NoError(t, chain.StubMethod(resolver, resolverABI, "contenthash", []interface{}{nameNode}, []byte{0xe3, 0x01}))
+	require.NoError(t, chain.StubMethod(resolver, resolverABI, "name", []interface{}{reverseNode}, testName))
+	require.NoError(t, chain.StubMethod(contracts.Registrar, registrarABI, "getPrice", nil, big.NewInt(10)))
+	return contracts, resolver
+}
+
+// setupTestService creates a service with the settings of an account.
+func setupTestService(t *testing.T, accountsManager *account.GethManager, transactor *transactions.Transactor, keyStoreDir string) (*Service, func()) {
+	tmpfile, err := ioutil.TempFile("", "ens-tests-")
+	require.NoError(t, err)
+	db, err := appdatabase.InitializeDB(tmpfile.Name(), "ens-tests")
+	require.NoError(t, err)
+
+	networks := json.RawMessage("{}")
+	require.NoError(t, accounts.NewDB(db).CreateSettings(accounts.Settings{
+		Address:        types.HexToAddress("0x1"),
+		CurrentNetwork: "mainnet_rpc",
+		InstallationID: "d3efcff6-cffa-560e-a547-21d3858cbc51",
+		KeyUID:         "0x4e8129f3edfc004875be17bf468a784098a9f69b53c095be1f52deff286935ab",
+		Name:           "Jittery Cornflowerblue Kingbird",
+		Networks:       &networks,
+	}, params.NodeConfig{NetworkID: 1}))
+
+	return NewService(db, accountsManager, transactor, keyStoreDir, 1), func() {
+		require.NoError(t, db.Close())
+		require.NoError(t, os.Remove(tmpfile.Name()))
+	}
+}
+
+func setupTestAPI(t *testing.T) (*API, *helpers.SimulatedChain, common.Address, func()) {
+	chain, err := helpers.NewSimulatedChain(registryAddress)
+	require.NoError(t, err)
+	contracts, resolver := deployTestRegistrar(t, chain)
+
+	service, cancel := setupTestService(t, nil, nil, "")
+	service.contracts = &contracts
+	service.SetClient(chain)
+
+	return NewAPI(service), chain, resolver, func() {
+		require.NoError(t, chain.Close())
+		cancel()
+	}
+}
+
+func TestResolve(t *testing.T) {
+	api, _, _, cancel := setupTestAPI(t)
+	defer cancel()
+	ctx := context.Background()
+
+	address, err := api.AddressOf(ctx, testName)
+	require.NoError(t, err)
+	require.Equal(t, testWallet, address)
+
+	pubkey, err := api.PublicKeyOf(ctx, testName)
+	require.NoError(t, err)
+	require.Equal(t, testPubkey, pubkey)
+
+	text, err := api.Text(ctx, testName, "url")
+	require.NoError(t, err)
+	require.Equal(t, "https://status.im", text)
+
+	contenthash, err := api.ContentHash(ctx, testName)
+	require.NoError(t, err)
+	require.Equal(t, types.HexBytes{0xe3, 0x01}, contenthash)
+
+	name, err := api.ReverseResolve(ctx, testWallet)
+	require.NoError(t, err)
+	require.Equal(t, testName, name)
+
+	name, err = api.ReverseResolve(ctx, common.Address{9})
+	require.NoError(t, err)
+	require.Empty(t, name)
+
+	_, err = api.AddressOf(ctx, "bob.stateofus.eth")
+	require.Equal(t, ErrNoResolver, err)
+}
+
+func TestRegisterPrepareTx(t *testing.T) {
+	api, _, _, cancel := setupTestAPI(t)
+	defer cancel()
+	contracts := api.s.contracts
+
+	txArgs, err := api.RegisterPrepareTx(context.Background(), testWallet, "alice", testPubkey)
+	require.NoError(t, err)
+	require.Equal(t, types.Address(testWallet), txArgs.From)
+	require.Equal(t, types.Address(contracts.SNT), *txArgs.To)
+
+	method, err := sntABI.MethodById(txArgs.Input[:4])
+	require.NoError(t, err)
+	require.Equal(t, "approveAndCall", method.Name)
+	args, err := method.Inputs.Unpack(txArgs.Input[4:])
+	require.NoError(t, err)
+	require.Equal(t, contracts.Registrar, args[0].(common.Address))
+	require.Equal(t, big.NewInt(10), args[1].(*big.Int))
+
+	register := args[2].([]byte)
+	method, err = registrarABI.MethodById(register[:4])
+	require.NoError(t, err)
+	require.Equal(t, "register", method.Name)
+	args, err = method.Inputs.Unpack(register[4:])
+	require.NoError(t, err)
+	label, _ := ens.LabelHash("alice")
+	require.Equal(t, label, args[0].([32]byte))
+	require.Equal(t, testWallet, args[1].(common.Address))
+
+	_, err = api.RegisterPrepareTx(context.Background(), testWallet, "alice.eth", testPubkey)
+	require.Equal(t, ErrInvalidUsername, err)
+	_, err = api.RegisterPrepareTx(context.Background(), testWallet, "alice", "0x02")
+	require.Equal(t, ErrInvalidPublicKey, err)
+}
+
+func TestSetPubKeyPrepareTx(t *testing.T) {
+	api, _, resolver, cancel := setupTestAPI(t)
+	defer cancel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	pubkey := types.EncodeHex(crypto.FromECDSAPub(&key.PublicKey))
+
+	txArgs, err := api.SetPubKeyPrepareTx(context.Background(), testWallet, "alice", pubkey)
+	require.NoError(t, err)
+	require.Equal(t, types.Address(resolver), *txArgs.To)
+
+	method, err := resolverABI.MethodById(txArgs.Input[:4])
+	require.NoError(t, err)
+	require.Equal(t, "setPubkey", method.Name)
+}
+
+func TestPendingTransactions(t *testing.T) {
+	api, chain, resolver, cancel := setupTestAPI(t)
+	defer cancel()
+
+	// The transactions are mined on the chain, except the pending one
+	var mined []types.Hash
+	for i := 0; i < 2; i++ {
+		receipt, err := chain.Transact(resolver, []byte{byte(i)})
+		require.NoError(t, err)
+		mined = append(mined, types.Hash(receipt.TxHash))
+	}
+	registered := mined[0]
+	released := mined[1]
+	pending := types.Hash{3}
+	for i, tx := range []Transaction{
+		{Hash: registered, Type: TransactionTypeRegister, Username: "bob.stateofus.eth"},
+		{Hash: released, Type: TransactionTypeRelease, Username: testName},
+		{Hash: pending, Type: TransactionTypeRegister, Username: "carol.stateofus.eth"},
+	} {
+		tx.Address = types.Address(testWallet)
+		tx.Status = TransactionStatusPending
+		tx.CreatedAt = int64(i)
+		require.NoError(t, api.s.db.AddTransaction(tx))
+	}
+	require.NoError(t, api.s.updateUsernames(testName, true))
+
+	require.NoError(t, api.s.checkPending(context.Background()))
+
+	transactions, err := api.PendingTransactions()
+	require.NoError(t, err)
+	require.Len(t, transactions, 1)
+	require.Equal(t, pending, transactions[0].Hash)
+
+	usernames, err := api.Usernames()
+	require.NoError(t, err)
+	require.Equal(t, []string{"bob.stateofus.eth"}, usernames)
+}
@@ -0,0 +1,175 @@
+package ens
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ErrUnsupportedChain is returned when the username registrar is not deployed on the network
+var ErrUnsupportedChain = errors.New("ens registrar is not deployed on this network")
+
+// RegistryABI is the subset of the ENS registry ABI used to find the owner and resolver of a node.
+const RegistryABI = `[{"constant":true,"inputs":[{"name":"node","type":"bytes32"}],"name":"owner","outputs":[{"name":"","type":"address"}],"payable":false,"stateMutability":"view","type":"function"},{"constant":true,"inputs":[{"name":"node","type":"bytes32"}],"name":"resolver","outputs":[{"name":"","type":"address"}],"payable":false,"stateMutability":"view","type":"function"}]`
+
+// ResolverABI is the subset of the public resolver ABI used to read the records of a node and set its public key.
+const ResolverABI = `[{"constant":true,"inputs":[{"name":"node","type":"bytes32"}],"name":"addr","outputs":[{"name":"","type":"address"}],"payable":false,"stateMutability":"view","type":"function"},{"constant":true,"inputs":[{"name":"node","type":"bytes32"}],"name":"pubkey","outputs":[{"name":"x","type":"bytes32"},{"name":"y","type":"bytes32"}],"payable":false,"stateMutability":"view","type":"function"},{"constant":true,"inputs":[{"name":"node","type":"bytes32"},{"name":"key","type":"string"}],"name":"text","outputs":[{"name":"","type":"string"}],"payable":false,"stateMutability":"view","type":"function"},{"constant":true,"inputs":[{"name":"node","type":"bytes32"}],"name":"contenthash","outputs":[{"name":"","type":"bytes"}],"payable":false,"stateMutability":"view","type":"function"},{"constant":true,"inputs":[{"name":"node","type":"bytes32"}],"name":"name","outputs":[{"name":"","type":"string"}],"payable":false,"stateMutability":"view","type":"function"},{"constant":false,"inputs":[{"name":"node","type":"bytes32"},{"name":"x","type":"bytes32"},{"name":"y","type":"bytes32"}],"name":"setPubkey","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"}]`
+
+// UsernameRegistrarABI is the subset of the Status username registrar ABI used to register and release subdomains.
+const UsernameRegistrarABI = `[{"constant":true,"inputs":[],"name":"getPrice","outputs":[{"name":"registryPrice","type":"uint256"}],"payable":false,"stateMutability":"view","type":"function"},{"constant":false,"inputs":[{"name":"_label","type":"bytes32"},{"name":"_account","type":"address"},{"name":"_pubkeyA","type":"bytes32"},{"name":"_pubkeyB","type":"bytes32"}],"name":"register","outputs":[{"name":"namehash","type":"bytes32"}],"payable":false,"stateMutability":"nonpayable","type":"function"},{"constant":false,"inputs":[{"name":"_label","type":"bytes32"}],"name":"release","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"}]`
+
+// SNTABI is the subset of the SNT MiniMe token ABI used to pay for usernames in a single transaction.
+const SNTABI = `[{"constant":false,"inputs":[{"name":"_spender","type":"address"},{"name":"_amount","type":"uint256"},{"name":"_extraData","type":"bytes"}],"name":"approveAndCall","outputs":[{"name":"success","type":"bool"}],"payable":false,"stateMutability":"nonpayable","type":"function"}]`
+
+// registryAddress is the address of the ENS registry, the same on every network.
+var registryAddress = common.HexToAddress("0x00000000000C2E074eC69A0dFb2997BA6C7d2e1e")
+
+// Contracts are the addresses of the username registrar contracts on a given network.
+type Contracts struct {
+	Registrar common.Address
+	SNT       common.Address
+	// Domain is the domain the registrar issues subdomains of
+	Domain string
+}
+
+var contractsByChainID = map[uint64]Contracts{
+	1: {
+		Registrar: common.HexToAddress("0xDB5ac1a559b02E12F29fC0eC0e37Be8E046DEF49"),
+		SNT:       common.HexToAddress("0x744d70fdbe2ba4cf95131626614a1763df805b9e"),
+		Domain:    "stateofus.eth",
+	},
+	3: {
+		Registrar: common.HexToAddress("0x028F3Df706c5295Ba283c326F4692c375D14cb68"),
+		SNT:       common.HexToAddress("0xc55cf4b03948d7ebc8b9e8bad92643703811d162"),
+		Domain:    "stateofus.eth",
+	},
+}
+
+// ContractsByChainID returns the addresses of the ENS contracts deployed on the network.
+func ContractsByChainID(chainID uint64) (Contracts, error) {
+	contracts, ok := contractsByChainID[chainID]
+	if !ok {
+		return Contracts{}, ErrUnsupportedChain
+	}
+	return contracts, nil
+}
+
+var (
+	registryABI  = mustParseABI(RegistryABI)
+	resolverABI  = mustParseABI(ResolverABI)
+	registrarABI = mustParseABI(UsernameRegistrarABI)
+	sntABI       = mustParseABI(SNTABI)
+)
+
+func mustParseABI(definition string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(definition))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+// registryCaller reads the owner and resolver of the nodes in the registry.
+type registryCaller struct {
+	contract *bind.BoundContract
+}
+
+func newRegistryCaller(address common.Address, caller bind.ContractCaller) *registryCaller {
+	return &registryCaller{contract: bind.NewBoundContract(address, registryABI, caller, nil, nil)}
+}
+
+func (c *registryCaller) call(opts *bind.CallOpts, method string, node [32]byte) (common.Address, error) {
+	var out []interface{}
+	err := c.contract.Call(opts, &out, method, node)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return *abi.ConvertType(out[0], new(common.Address)).(*common.Address), nil
+}
+
+func (c *registryCaller) Owner(opts *bind.CallOpts, node [32]byte) (common.Address, error) {
+	return c.call(opts, "owner", node)
+}
+
+func (c *registryCaller) Resolver(opts *bind.CallOpts, node [32]byte) (common.Address, error) {
+	return c.call(opts, "resolver", node)
+}
+
+// resolverCaller reads the records of the nodes set in a resolver.
+type resolverCaller struct {
+	address  common.Address
+	contract *bind.BoundContract
+}
+
+func newResolverCaller(address common.Address, caller bind.ContractCaller) *resolverCaller {
+	return &resolverCaller{address: address, contract: bind.NewBoundContract(address, resolverABI, caller, nil, nil)}
+}
+
+func (c *resolverCaller) Addr(opts *bind.CallOpts, node [32]byte) (common.Address, error) {
+	var out []interface{}
+	err := c.contract.Call(opts, &out, "addr", node)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return *abi.ConvertType(out[0], new(common.Address)).(*common.Address), nil
+}
+
+func (c *resolverCaller) Pubkey(opts *bind.CallOpts, node [32]byte) ([32]byte, [32]byte, error) {
+	var out []interface{}
+	err := c.contract.Call(opts, &out, "pubkey", node)
+	if err != nil {
+		return [32]byte{}, [32]byte{}, err
+	}
+	x := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+	y := *abi.ConvertType(out[1], new([32]byte)).(*[32]byte)
+	return x, y, nil
+}
+
+func (c *resolverCaller) Text(opts *bind.CallOpts, node [32]byte, key string) (string, error) {
+	var out []interface{}
+	err := c.contract.Call(opts, &out, "text", node, key)
+	if err != nil {
+		return "", err
+	}
+	return *abi.ConvertType(out[0], new(string)).(*string), nil
+}
+
+func (c *resolverCaller) Contenthash(opts *bind.CallOpts, node [32]byte) ([]byte, error) {
+	var out []interface{}
+	err := c.contract.Call(opts, &out, "contenthash", node)
+	if err != nil {
+		return nil, err
+	}
+	return *abi.ConvertType(out[0], new([]byte)).(*[]byte), nil
+}
+
+func (c *resolverCaller) Name(opts *bind.CallOpts, node [32]byte) (string, error) {
+	var out []interface{}
+	err := c.contract.Call(opts, &out, "name", node)
+	if err != nil {
+		return "", err
+	}
+	return *abi.ConvertType(out[0], new(string)).(*string), nil
+}
+
+// registrarCaller reads the price of the usernames.
+type registrarCaller struct {
+	contract *bind.BoundContract
+}
+
+func newRegistrarCaller(address common.Address, caller bind.ContractCaller) *registrarCaller {
+	return &registrarCaller{contract: bind.NewBoundContract(address, registrarABI, caller, nil, nil)}
+}
+
+func (c *registrarCaller) GetPrice(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := c.contract.Call(opts, &out, "getPrice")
+	if err != nil {
+		return nil, err
+	}
+	return *abi.ConvertType(out[0], new(*big.Int)).(**big.Int), nil
+}
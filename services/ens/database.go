@@ -0,0 +1,78 @@
+package ens
+
+import (
+	"database/sql"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+// TransactionType is the operation on a username a transaction performs.
+type TransactionType string
+
+const (
+	TransactionTypeRegister  TransactionType = "register"
+	TransactionTypeRelease   TransactionType = "release"
+	TransactionTypeSetPubKey TransactionType = "setPubKey"
+)
+
+// TransactionStatus is the status of a transaction sent by the service.
+type TransactionStatus string
+
+const (
+	TransactionStatusPending TransactionStatus = "pending"
+	TransactionStatusMined   TransactionStatus = "mined"
+	TransactionStatusFailed  TransactionStatus = "failed"
+)
+
+// Transaction is a transaction on a username, tracked until it is mined.
+type Transaction struct {
+	Hash      types.Hash        `json:"hash"`
+	Type      TransactionType   `json:"type"`
+	Username  string            `json:"username"`
+	Address   types.Address     `json:"address"`
+	Status    TransactionStatus `json:"status"`
+	CreatedAt int64             `json:"createdAt"`
+}
+
+// Database sql wrapper for operations with ens transactions.
+type Database struct {
+	db *sql.DB
+}
+
+func NewDB(db *sql.DB) *Database {
+	return &Database{db: db}
+}
+
+func (db *Database) AddTransaction(tx Transaction) error {
+	_, err := db.db.Exec(`INSERT INTO ens_transactions (hash, type, username, address, status, created_at) VALUES (?, ?, ?, ?, ?, ?)`,
+		tx.Hash.Hex(), tx.Type, tx.Username, tx.Address.Hex(), tx.Status, tx.CreatedAt)
+	return err
+}
+
+func (db *Database) SetTransactionStatus(hash types.Hash, status TransactionStatus) error {
+	_, err := db.db.Exec(`UPDATE ens_transactions SET status = ? WHERE hash = ?`, status, hash.Hex())
+	return err
+}
+
+// GetTransactions returns the transactions with the given status, oldest first.
+func (db *Database) GetTransactions(status TransactionStatus) ([]Transaction, error) {
+	rows, err := db.db.Query(`SELECT hash, type, username, address, status, created_at FROM ens_transactions WHERE status = ? ORDER BY created_at`, status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []Transaction
+	for rows.Next() {
+		var tx Transaction
+		var hash, address string
+		err := rows.Scan(&hash, &tx.Type, &tx.Username, &address, &tx.Status, &tx.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		tx.Hash = types.HexToHash(hash)
+		tx.Address = types.HexToAddress(address)
+		result = append(result, tx)
+	}
+	return result, rows.Err()
+}
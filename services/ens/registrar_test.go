@@ -0,0 +1,351 @@
+package ens
+
+import (
+	"context"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ens "github.com/wealdtech/go-ens/v3"
+
+	gethkeystore "github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+	gethparams "github.com/ethereum/go-ethereum/params"
+
+	"github.com/status-im/status-go/account"
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/multiaccounts/accounts"
+	"github.com/status-im/status-go/params"
+	"github.com/status-im/status-go/rpc"
+	"github.com/status-im/status-go/t/helpers"
+	"github.com/status-im/status-go/transactions"
+)
+
+const testPassword = "password"
+
+var testPrice = big.NewInt(10)
+
+// The methods of the registry and of the resolver used to set up the names
+var (
+	registryAdminABI = mustParseABI(`[{"constant":false,"inputs":[{"name":"node","type":"bytes32"},{"name":"label","type":"bytes32"},{"name":"owner","type":"address"}],"name":"setSubnodeOwner","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"},{"constant":false,"inputs":[{"name":"node","type":"bytes32"},{"name":"resolver","type":"address"}],"name":"setResolver","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"}]`)
+	resolverAdminABI = mustParseABI(`[{"inputs":[{"name":"ensAddr","type":"address"}],"payable":false,"stateMutability":"nonpayable","type":"constructor"},{"constant":false,"inputs":[{"name":"node","type":"bytes32"},{"name":"name","type":"string"}],"name":"setName","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"}]`)
+)
+
+func readBytecode(t *testing.T, name string) []byte {
+	data, err := ioutil.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	bytecode, err := hexutil.Decode(strings.TrimSpace(string(data)))
+	require.NoError(t, err)
+	return bytecode
+}
+
+func selector(method string) []byte {
+	return crypto.Keccak256([]byte(method))[:4]
+}
+
+// An argument pushes a word on the stack
+type argument func(a *helpers.Assembler)
+
+func word(data []byte) argument {
+	return func(a *helpers.Assembler) { a.PushBytes(data) }
+}
+
+func memory(offset uint64) argument {
+	return func(a *helpers.Assembler) { a.Push(offset).Op(vm.MLOAD) }
+}
+
+func opcode(op vm.OpCode) argument {
+	return func(a *helpers.Assembler) { a.Op(op) }
+}
+
+// call calls the method of the contract with the arguments, reverting if the call fails.
+func call(a *helpers.Assembler, contract common.Address, method string, args ...argument) {
+	a.PushBytes(common.RightPadBytes(selector(method), 32)).Push(0).Op(vm.MSTORE)
+	for i, arg := range args {
+		arg(a)
+		a.Push(uint64(4 + 32*i)).Op(vm.MSTORE)
+	}
+	a.Push(0).Push(0).Push(uint64(4 + 32*len(args))).Push(0).Push(0).PushBytes(contract.Bytes()).Op(vm.GAS).Op(vm.CALL)
+	a.Op(vm.ISZERO).PushLabel("revert").Op(vm.JUMPI)
+}
+
+// dispatch jumps to the label of the method called.
+func dispatch(a *helpers.Assembler, methods ...string) {
+	a.Push(0).Op(vm.CALLDATALOAD).Push(0xe0).Op(vm.SHR)
+	for _, method := range methods {
+		a.Op(vm.DUP1).PushBytes(selector(method)).Op(vm.EQ).PushLabel(method).Op(vm.JUMPI)
+	}
+	a.Label("revert").Push(0).Push(0).Op(vm.REVERT)
+}
+
+// Memory of the registrar holding the arguments of a registration
+const (
+	labelSlot    = 0x200
+	accountSlot  = 0x220
+	pubkeyXSlot  = 0x240
+	pubkeyYSlot  = 0x260
+	ownerSlot    = 0x280
+	namehashSlot = 0x2a0
+)
+
+// registrarRuntime is the code of a registrar implementing the part of the
+// Status UsernameRegistrar used by the service: receiveApproval, called by
+// SNT.approveAndCall, registers the label of the domain with the records of
+// the account in the resolver, and release gives the label up.
+func registrarRuntime(registry, resolver, snt common.Address, domain [32]byte) []byte {
+	var a helpers.Assembler
+	register := "register(bytes32,address,bytes32,bytes32)"
+	dispatch(&a, "getPrice()", "receiveApproval(address,uint256,address,bytes)", "release(bytes32)")
+
+	// The namehash of the label of the domain
+	namehash := func() {
+		a.PushBytes(domain[:]).Push(0x300).Op(vm.MSTORE)
+		a.Push(labelSlot).Op(vm.MLOAD).Push(0x320).Op(vm.MSTORE)
+		a.Push(64).Push(0x300).Op(vm.SHA3).Push(namehashSlot).Op(vm.MSTORE)
+	}
+
+	a.Label("getPrice()")
+	a.PushBytes(common.LeftPadBytes(testPrice.Bytes(), 32)).Push(0).Op(vm.MSTORE)
+	a.Push(32).Push(0).Op(vm.RETURN)
+
+	// receiveApproval(from, amount, token, data) with data = register(label, account, x, y)
+	a.Label("receiveApproval(address,uint256,address,bytes)")
+	a.Op(vm.CALLER).PushBytes(snt.Bytes()).Op(vm.EQ).Op(vm.ISZERO).PushLabel("revert").Op(vm.JUMPI)
+	a.PushBytes(testPrice.Bytes()).Push(36).Op(vm.CALLDATALOAD).Op(vm.LT).PushLabel("revert").Op(vm.JUMPI)
+	a.Push(100).Op(vm.CALLDATALOAD).Push(36).Op(vm.ADD).Op(vm.CALLDATALOAD).Push(0xe0).Op(vm.SHR)
+	a.PushBytes(selector(register)).Op(vm.EQ).Op(vm.ISZERO).PushLabel("revert").Op(vm.JUMPI)
+	for i, slot := range []uint64{labelSlot, accountSlot, pubkeyXSlot, pubkeyYSlot} {
+		a.Push(100).Op(vm.CALLDATALOAD).Push(uint64(40 + 32*i)).Op(vm.ADD).Op(vm.CALLDATALOAD).Push(slot).Op(vm.MSTORE)
+	}
+	a.Push(4).Op(vm.CALLDATALOAD).Push(ownerSlot).Op(vm.MSTORE)
+	namehash()
+	call(&a, registry, "setSubnodeOwner(bytes32,bytes32,address)", word(domain[:]), memory(labelSlot), opcode(vm.ADDRESS))
+	call(&a, registry, "setResolver(bytes32,address)", memory(namehashSlot), word(resolver.Bytes()))
+	call(&a, resolver, "setAddr(bytes32,address)", memory(namehashSlot), memory(accountSlot))
+	call(&a, resolver, "setPubkey(bytes32,bytes32,bytes32)", memory(namehashSlot), memory(pubkeyXSlot), memory(pubkeyYSlot))
+	call(&a, registry, "setOwner(bytes32,address)", memory(namehashSlot), memory(ownerSlot))
+	a.Push(ownerSlot).Op(vm.MLOAD).Push(labelSlot).Op(vm.MLOAD).Op(vm.SSTORE)
+	a.Op(vm.STOP)
+
+	// release(label), by the owner of the username
+	a.Label("release(bytes32)")
+	a.Push(4).Op(vm.CALLDATALOAD).Op(vm.SLOAD).Op(vm.CALLER).Op(vm.EQ).Op(vm.ISZERO).PushLabel("revert").Op(vm.JUMPI)
+	a.Push(4).Op(vm.CALLDATALOAD).Push(labelSlot).Op(vm.MSTORE)
+	namehash()
+	call(&a, registry, "setSubnodeOwner(bytes32,bytes32,address)", word(domain[:]), memory(labelSlot), opcode(vm.ADDRESS))
+	call(&a, registry, "setResolver(bytes32,address)", memory(namehashSlot), word([]byte{0}))
+	call(&a, registry, "setOwner(bytes32,address)", memory(namehashSlot), word([]byte{0}))
+	a.Push(0).Push(labelSlot).Op(vm.MLOAD).Op(vm.SSTORE)
+	a.Op(vm.STOP)
+
+	return a.Assemble()
+}
+
+// sntRuntime is the code of a token implementing the approveAndCall of the
+// SNT MiniMe token, without balances: it calls receiveApproval on the spender.
+func sntRuntime() []byte {
+	var a helpers.Assembler
+	dispatch(&a, "approveAndCall(address,uint256,bytes)")
+
+	// receiveApproval(caller, amount, this, extraData)
+	a.Label("approveAndCall(address,uint256,bytes)")
+	a.PushBytes(common.RightPadBytes(selector("receiveApproval(address,uint256,address,bytes)"), 32)).Push(0).Op(vm.MSTORE)
+	a.Op(vm.CALLER).Push(4).Op(vm.MSTORE)
+	a.Push(36).Op(vm.CALLDATALOAD).Push(36).Op(vm.MSTORE)
+	a.Op(vm.ADDRESS).Push(68).Op(vm.MSTORE)
+	a.Push(0x80).Push(100).Op(vm.MSTORE)
+	// Copy the length and the data of extraData
+	a.Push(68).Op(vm.CALLDATALOAD).Push(4).Op(vm.ADD)
+	a.Op(vm.DUP1).Op(vm.CALLDATASIZE).Op(vm.SUB).Op(vm.SWAP1).Push(132).Op(vm.CALLDATACOPY)
+
+	a.Push(0).Push(0)
+	a.Push(68).Op(vm.CALLDATALOAD).Push(4).Op(vm.ADD).Op(vm.CALLDATASIZE).Op(vm.SUB).Push(132).Op(vm.ADD)
+	a.Push(0).Push(0).Push(4).Op(vm.CALLDATALOAD).Op(vm.GAS).Op(vm.CALL)
+	a.Op(vm.ISZERO).PushLabel("revert").Op(vm.JUMPI)
+	a.Push(1).Push(0).Op(vm.MSTORE).Push(32).Push(0).Op(vm.RETURN)
+
+	return a.Assemble()
+}
+
+// deployENS deploys the ENS registry and the public resolver, and a username
+// registrar owning stateofus.eth. The account of the chain owns the reverse
+// record of its address.
+func deployENS(t *testing.T, chain *helpers.SimulatedChain) (registry common.Address, resolver common.Address, contracts Contracts) {
+	registry, err := chain.Deploy(readBytecode(t, "ENS.bin"), registryAdminABI)
+	require.NoError(t, err)
+	resolver, err = chain.Deploy(readBytecode(t, "PublicResolver.bin"), resolverAdminABI, registry)
+	require.NoError(t, err)
+
+	contracts.Domain = "stateofus.eth"
+	domain, err := ens.NameHash(contracts.Domain)
+	require.NoError(t, err)
+	contracts.SNT, err = chain.DeployRuntime(sntRuntime())
+	require.NoError(t, err)
+	contracts.Registrar, err = chain.DeployRuntime(registrarRuntime(registry, resolver, contracts.SNT, domain))
+	require.NoError(t, err)
+
+	setSubnodeOwner := func(parent string, label string, owner common.Address) {
+		node, err := ens.NameHash(parent)
+		require.NoError(t, err)
+		labelHash, err := ens.LabelHash(label)
+		require.NoError(t, err)
+		input, err := registryAdminABI.Pack("setSubnodeOwner", node, labelHash, owner)
+		require.NoError(t, err)
+		_, err = chain.Transact(registry, input)
+		require.NoError(t, err)
+	}
+	setSubnodeOwner("", "eth", chain.Account)
+	setSubnodeOwner("eth", "stateofus", contracts.Registrar)
+	setSubnodeOwner("", "reverse", chain.Account)
+	setSubnodeOwner("reverse", "addr", chain.Account)
+	setSubnodeOwner("addr.reverse", strings.ToLower(chain.Account.Hex()[2:]), chain.Account)
+
+	reverseNode, err := ens.NameHash(reverseName(chain.Account))
+	require.NoError(t, err)
+	input, err := registryAdminABI.Pack("setResolver", reverseNode, resolver)
+	require.NoError(t, err)
+	_, err = chain.Transact(registry, input)
+	require.NoError(t, err)
+	return registry, resolver, contracts
+}
+
+// setupRegistrarAPI creates an API sending the transactions of the account of
+// the chain, which is a wallet account of the user, to the ENS contracts.
+func setupRegistrarAPI(t *testing.T) (*API, *helpers.SimulatedChain, func()) {
+	chain, err := helpers.NewSimulatedChain()
+	require.NoError(t, err)
+	registry, _, contracts := deployENS(t, chain)
+
+	keyStoreDir, err := ioutil.TempDir("", "ens-keystore-")
+	require.NoError(t, err)
+	_, err = gethkeystore.NewKeyStore(keyStoreDir, gethkeystore.LightScryptN, gethkeystore.LightScryptP).ImportECDSA(chain.Key, testPassword)
+	require.NoError(t, err)
+
+	client, err := chain.RPCClient()
+	require.NoError(t, err)
+	rpcClient, err := rpc.NewClient(client, params.UpstreamRPCConfig{})
+	require.NoError(t, err)
+	transactor := transactions.NewTransactor()
+	transactor.SetNetworkID(gethparams.AllEthashProtocolChanges.ChainID.Uint64())
+	transactor.SetRPC(rpcClient, time.Second)
+
+	service, cancel := setupTestService(t, account.NewGethManager(), transactor, keyStoreDir)
+	require.NoError(t, service.accountsDB.SaveAccounts([]accounts.Account{{
+		Address: types.Address(chain.Account),
+		Wallet:  true,
+		Type:    "key",
+	}}))
+	service.contracts = &contracts
+	service.registry = registry
+	service.SetClient(chain)
+
+	return NewAPI(service), chain, func() {
+		client.Close()
+		require.NoError(t, chain.Close())
+		require.NoError(t, os.RemoveAll(keyStoreDir))
+		cancel()
+	}
+}
+
+// mine mines the pending transactions and checks their receipts.
+func mine(t *testing.T, api *API, chain *helpers.SimulatedChain) {
+	chain.Commit()
+	require.NoError(t, api.s.checkPending(context.Background()))
+	transactions, err := api.PendingTransactions()
+	require.NoError(t, err)
+	require.Empty(t, transactions)
+}
+
+func TestRegisterAndRelease(t *testing.T) {
+	api, chain, cancel := setupRegistrarAPI(t)
+	defer cancel()
+	ctx := context.Background()
+	wallet := chain.Account
+
+	price, err := api.Price(ctx)
+	require.NoError(t, err)
+	require.Equal(t, testPrice, price.ToInt())
+
+	_, err = api.Register(ctx, wallet, "wrong", "alice", testPubkey)
+	require.Error(t, err)
+
+	hash, err := api.Register(ctx, wallet, testPassword, "alice", testPubkey)
+	require.NoError(t, err)
+
+	// The username is added once the transaction is mined
+	require.NoError(t, api.s.checkPending(ctx))
+	transactions, err := api.PendingTransactions()
+	require.NoError(t, err)
+	require.Len(t, transactions, 1)
+	require.Equal(t, hash, transactions[0].Hash)
+	require.Equal(t, testName, transactions[0].Username)
+	usernames, err := api.Usernames()
+	require.NoError(t, err)
+	require.Empty(t, usernames)
+
+	mine(t, api, chain)
+	usernames, err = api.Usernames()
+	require.NoError(t, err)
+	require.Equal(t, []string{testName}, usernames)
+
+	owner, err := api.OwnerOf(ctx, testName)
+	require.NoError(t, err)
+	require.Equal(t, wallet, owner)
+	address, err := api.AddressOf(ctx, testName)
+	require.NoError(t, err)
+	require.Equal(t, wallet, address)
+	pubkey, err := api.PublicKeyOf(ctx, testName)
+	require.NoError(t, err)
+	require.Equal(t, testPubkey, pubkey)
+
+	// The primary name of the wallet is set in its reverse record
+	name, err := api.ReverseResolve(ctx, wallet)
+	require.NoError(t, err)
+	require.Empty(t, name)
+	reverseNode, err := ens.NameHash(reverseName(wallet))
+	require.NoError(t, err)
+	_, resolver, err := api.resolver(ctx, testName)
+	require.NoError(t, err)
+	input, err := resolverAdminABI.Pack("setName", reverseNode, testName)
+	require.NoError(t, err)
+	_, err = chain.Transact(resolver.address, input)
+	require.NoError(t, err)
+	name, err = api.ReverseResolve(ctx, wallet)
+	require.NoError(t, err)
+	require.Equal(t, testName, name)
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	newPubkey := types.EncodeHex(crypto.FromECDSAPub(&key.PublicKey))
+	_, err = api.SetPubKey(ctx, wallet, testPassword, "alice", newPubkey)
+	require.NoError(t, err)
+	mine(t, api, chain)
+	pubkey, err = api.PublicKeyOf(ctx, testName)
+	require.NoError(t, err)
+	require.Equal(t, newPubkey, pubkey)
+
+	_, err = api.Release(ctx, wallet, testPassword, "alice")
+	require.NoError(t, err)
+	mine(t, api, chain)
+	usernames, err = api.Usernames()
+	require.NoError(t, err)
+	require.Empty(t, usernames)
+
+	owner, err = api.OwnerOf(ctx, testName)
+	require.NoError(t, err)
+	require.Equal(t, common.Address{}, owner)
+	_, err = api.AddressOf(ctx, testName)
+	require.Equal(t, ErrNoResolver, err)
+	// The reverse record is left, but the name doesn't resolve to the wallet anymore
+	name, err = api.ReverseResolve(ctx, wallet)
+	require.NoError(t, err)
+	require.Empty(t, name)
+}
@@ -0,0 +1,258 @@
+package ens
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/status-im/status-go/account"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/multiaccounts/accounts"
+	"github.com/status-im/status-go/transactions"
+)
+
+// How often the receipts of the pending transactions are checked
+const pendingCheckInterval = 15 * time.Second
+
+const requestTimeout = 10 * time.Second
+
+// Client is the backend used to call the ENS contracts and follow the
+// transactions sent to them, satisfied by ethclient and the simulated backend.
+type Client interface {
+	bind.ContractBackend
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*gethtypes.Receipt, error)
+}
+
+// NewService initializes service instance.
+func NewService(appDB *sql.DB, accountsManager *account.GethManager, transactor *transactions.Transactor, keyStoreDir string, chainID uint64) *Service {
+	s := &Service{
+		db:              NewDB(appDB),
+		accountsDB:      accounts.NewDB(appDB),
+		accountsManager: accountsManager,
+		transactor:      transactor,
+		keyStoreDir:     keyStoreDir,
+		registry:        registryAddress,
+	}
+	if contracts, err := ContractsByChainID(chainID); err == nil {
+		s.contracts = &contracts
+	}
+	return s
+}
+
+// Service resolves ENS names and manages the usernames of the user.
+type Service struct {
+	db              *Database
+	accountsDB      *accounts.Database
+	accountsManager *account.GethManager
+	transactor      *transactions.Transactor
+	keyStoreDir     string
+	client          Client
+	registry        common.Address
+	contracts       *Contracts
+	quit            chan struct{}
+
+	// mu serializes the updates of the usernames stored in the settings
+	mu sync.Mutex
+}
+
+// SetClient sets the backend used to call the ENS contracts.
+func (s *Service) SetClient(client Client) {
+	s.client = client
+}
+
+// Start a service.
+func (s *Service) Start() error {
+	s.quit = make(chan struct{})
+	go s.watchPending()
+	return nil
+}
+
+// Stop a service.
+func (s *Service) Stop() error {
+	if s.quit != nil {
+		close(s.quit)
+		s.quit = nil
+	}
+	return nil
+}
+
+// APIs returns list of available RPC APIs.
+func (s *Service) APIs() []rpc.API {
+	return []rpc.API{
+		{
+			Namespace: "ens",
+			Version:   "0.1.0",
+			Service:   NewAPI(s),
+		},
+	}
+}
+
+// Protocols returns list of p2p protocols.
+func (s *Service) Protocols() []p2p.Protocol {
+	return nil
+}
+
+func (s *Service) watchPending() {
+	quit := s.quit
+	ticker := time.NewTicker(pendingCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if s.client == nil {
+				continue
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+			if err := s.checkPending(ctx); err != nil {
+				log.Warn("failed to check pending ens transactions", "error", err)
+			}
+			cancel()
+		case <-quit:
+			return
+		}
+	}
+}
+
+// checkPending updates the status of the pending transactions which have
+// been mined, and the usernames of the user accordingly.
+func (s *Service) checkPending(ctx context.Context) error {
+	pending, err := s.db.GetTransactions(TransactionStatusPending)
+	if err != nil {
+		return err
+	}
+
+	for _, tx := range pending {
+		receipt, err := s.client.TransactionReceipt(ctx, common.Hash(tx.Hash))
+		if err == ethereum.NotFound {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		// Some clients return no receipt and no error for unknown transactions
+		if receipt == nil {
+			continue
+		}
+
+		status := TransactionStatusMined
+		if receipt.Status != gethtypes.ReceiptStatusSuccessful {
+			status = TransactionStatusFailed
+		}
+
+		if status == TransactionStatusMined {
+			switch tx.Type {
+			case TransactionTypeRegister:
+				err = s.updateUsernames(tx.Username, true)
+			case TransactionTypeRelease:
+				err = s.updateUsernames(tx.Username, false)
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		err = s.db.SetTransactionStatus(tx.Hash, status)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Usernames returns the usernames owned by the user.
+func (s *Service) Usernames() ([]string, error) {
+	settings, err := s.accountsDB.GetSettings()
+	if err != nil {
+		return nil, err
+	}
+
+	var usernames []string
+	if settings.Usernames == nil {
+		return usernames, nil
+	}
+	err = json.Unmarshal(*settings.Usernames, &usernames)
+	if err != nil {
+		return nil, err
+	}
+	return usernames, nil
+}
+
+func (s *Service) updateUsernames(username string, add bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	usernames, err := s.Usernames()
+	if err != nil {
+		return err
+	}
+
+	updated := []string{}
+	for _, u := range usernames {
+		if u != username {
+			updated = append(updated, u)
+		}
+	}
+	if add {
+		updated = append(updated, username)
+	}
+	return s.accountsDB.SaveSetting("usernames", updated)
+}
+
+// verifiedAccount checks the password of a wallet account of the user, which
+// is then used to sign a transaction.
+func (s *Service) verifiedAccount(address types.Address, password string) (*account.SelectedExtKey, error) {
+	exists, err := s.accountsDB.AddressExists(address)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, transactions.ErrAccountDoesntExist
+	}
+
+	key, err := s.accountsManager.VerifyAccountPassword(s.keyStoreDir, address.Hex(), password)
+	if err != nil {
+		return nil, err
+	}
+
+	return &account.SelectedExtKey{
+		Address:    key.Address,
+		AccountKey: key,
+	}, nil
+}
+
+// sendTransaction signs and sends the transaction, then tracks it until it is mined.
+func (s *Service) sendTransaction(txType TransactionType, username string, txArgs *transactions.SendTxArgs, password string) (types.Hash, error) {
+	verifiedAccount, err := s.verifiedAccount(txArgs.From, password)
+	if err != nil {
+		return types.Hash{}, err
+	}
+
+	hash, err := s.transactor.SendTransaction(*txArgs, verifiedAccount)
+	if err != nil {
+		return types.Hash{}, err
+	}
+
+	err = s.db.AddTransaction(Transaction{
+		Hash:      hash,
+		Type:      txType,
+		Username:  username,
+		Address:   txArgs.From,
+		Status:    TransactionStatusPending,
+		CreatedAt: time.Now().Unix(),
+	})
+	if err != nil {
+		return types.Hash{}, err
+	}
+	return hash, nil
+}
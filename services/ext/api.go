@@ -12,6 +12,7 @@ import (
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/rlp"
 
+	"github.com/status-im/status-go/account"
 	"github.com/status-im/status-go/eth-node/crypto"
 	"github.com/status-im/status-go/eth-node/types"
 	"github.com/status-im/status-go/mailserver"
@@ -22,6 +23,7 @@ import (
 	"github.com/status-im/status-go/protocol/protobuf"
 	"github.com/status-im/status-go/protocol/pushnotificationclient"
 	"github.com/status-im/status-go/protocol/requests"
+	"github.com/status-im/status-go/protocol/spam"
 	"github.com/status-im/status-go/protocol/transport"
 	"github.com/status-im/status-go/protocol/urls"
 	"github.com/status-im/status-go/services/ext/mailservers"
@@ -43,6 +45,9 @@ var (
 	// ErrPFSNotEnabled is returned when an endpoint PFS only is called but
 	// PFS is disabled
 	ErrPFSNotEnabled = errors.New("pfs not enabled")
+	// ErrAccountManagerNotSet is returned when an account needs to be
+	// recovered but no account manager has been set
+	ErrAccountManagerNotSet = errors.New("account manager not set")
 )
 
 // -----
@@ -414,6 +419,91 @@ func (api *PublicAPI) BanUserFromCommunity(request *requests.BanUserFromCommunit
 	return api.service.messenger.BanUserFromCommunity(request)
 }
 
+// TransferCommunityOwnership hands control of a community we own to another key
+func (api *PublicAPI) TransferCommunityOwnership(request *requests.TransferCommunityOwnership) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.TransferCommunityOwnership(request)
+}
+
+// SetCommunityControl sets the signers required to change the critical settings of a community
+func (api *PublicAPI) SetCommunityControl(request *requests.SetCommunityControl) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.SetCommunityControl(request)
+}
+
+// CommunityControlSignatureRequests returns the pending requests to approve changes to communities
+func (api *PublicAPI) CommunityControlSignatureRequests() ([]*communities.ControlSignatureRequest, error) {
+	return api.service.messenger.CommunityControlSignatureRequests()
+}
+
+// SignCommunityControl approves the pending changes to the community with the given ID
+func (api *PublicAPI) SignCommunityControl(communityID types.HexBytes) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.SignCommunityControl(communityID)
+}
+
+// DeclineCommunityControl declines the pending changes to the community with the given ID
+func (api *PublicAPI) DeclineCommunityControl(communityID types.HexBytes) error {
+	return api.service.messenger.DeclineCommunityControl(communityID)
+}
+
+// ListCommunityInDirectory publishes a listing of a community we own in the directory
+func (api *PublicAPI) ListCommunityInDirectory(request *requests.ListCommunityInDirectory) error {
+	return api.service.messenger.ListCommunityInDirectory(request)
+}
+
+// UnlistCommunityFromDirectory stops publishing the listing of the community with the given ID
+func (api *PublicAPI) UnlistCommunityFromDirectory(communityID types.HexBytes) error {
+	return api.service.messenger.UnlistCommunityFromDirectory(communityID)
+}
+
+// JoinCommunityDirectory starts collecting the listings of the community directory
+func (api *PublicAPI) JoinCommunityDirectory() error {
+	return api.service.messenger.JoinCommunityDirectory()
+}
+
+// LeaveCommunityDirectory stops collecting the listings of the community directory
+func (api *PublicAPI) LeaveCommunityDirectory() error {
+	return api.service.messenger.LeaveCommunityDirectory()
+}
+
+// CommunityDirectory returns the collected community listings matching the request
+func (api *PublicAPI) CommunityDirectory(request *requests.SearchCommunityDirectory) ([]*communities.DirectoryListing, error) {
+	return api.service.messenger.CommunityDirectory(request)
+}
+
+// FlagCommunityDirectoryListing flags the community with the given ID as spam in the directory
+func (api *PublicAPI) FlagCommunityDirectoryListing(communityID types.HexBytes, flagged bool) error {
+	return api.service.messenger.FlagCommunityDirectoryListing(communityID, flagged)
+}
+
+// SpamFilterRules returns the rules applied to messages received in public chats
+func (api *PublicAPI) SpamFilterRules() spam.Rules {
+	return api.service.messenger.SpamFilterRules()
+}
+
+// SetSpamFilterRules sets the rules applied to messages received in public chats
+func (api *PublicAPI) SetSpamFilterRules(rules spam.Rules) error {
+	return api.service.messenger.SetSpamFilterRules(rules)
+}
+
+// FollowSpamBlocklist filters the senders blocked by the given user or community
+func (api *PublicAPI) FollowSpamBlocklist(publisher string) error {
+	return api.service.messenger.FollowSpamBlocklist(publisher)
+}
+
+// UnfollowSpamBlocklist stops filtering the senders blocked by the given user or community
+func (api *PublicAPI) UnfollowSpamBlocklist(publisher string) error {
+	return api.service.messenger.UnfollowSpamBlocklist(publisher)
+}
+
+// SpamBlocklists returns the blocklists we follow or publish
+func (api *PublicAPI) SpamBlocklists() ([]*spam.Blocklist, error) {
+	return api.service.messenger.SpamBlocklists()
+}
+
+// PublishSpamBlocklist publishes a blocklist as ourselves or on behalf of a community we own
+func (api *PublicAPI) PublishSpamBlocklist(request *requests.PublishSpamBlocklist) error {
+	return api.service.messenger.PublishSpamBlocklist(request)
+}
+
 // MyPendingRequestsToJoin returns the pending requests for the logged in user
 func (api *PublicAPI) MyPendingRequestsToJoin() ([]*communities.RequestToJoin, error) {
 	return api.service.messenger.MyPendingRequestsToJoin()
@@ -464,6 +554,16 @@ func (api *PublicAPI) DeleteCommunityCategory(request *requests.DeleteCommunityC
 	return api.service.messenger.DeleteCommunityCategory(request)
 }
 
+// AddCommunityEmoji adds a custom emoji to a community
+func (api *PublicAPI) AddCommunityEmoji(request *requests.AddCommunityEmoji) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.AddCommunityEmoji(request)
+}
+
+// DeleteCommunityEmoji removes a custom emoji from a community
+func (api *PublicAPI) DeleteCommunityEmoji(request *requests.DeleteCommunityEmoji) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.DeleteCommunityEmoji(request)
+}
+
 type ApplicationMessagesResponse struct {
 	Messages []*common.Message `json:"messages"`
 	Cursor   string            `json:"cursor"`
@@ -545,6 +645,78 @@ func (api *PublicAPI) RemoveContact(ctx context.Context, pubKey string) (*protoc
 	return api.service.messenger.RemoveContact(ctx, pubKey)
 }
 
+func (api *PublicAPI) SendContactVerificationRequest(ctx context.Context, contactID string, challenge string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.SendContactVerificationRequest(ctx, contactID, challenge)
+}
+
+func (api *PublicAPI) AcceptContactVerificationRequest(ctx context.Context, id string, response string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.AcceptContactVerificationRequest(ctx, id, response)
+}
+
+func (api *PublicAPI) VerifiedTrusted(ctx context.Context, id string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.VerifiedTrusted(ctx, id)
+}
+
+func (api *PublicAPI) VerifiedUntrustworthy(ctx context.Context, id string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.VerifiedUntrustworthy(ctx, id)
+}
+
+func (api *PublicAPI) SetContactTrustStatus(ctx context.Context, contactID string, trustStatus protocol.TrustStatus) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.SetContactTrustStatus(ctx, contactID, trustStatus)
+}
+
+func (api *PublicAPI) GetSafetyNumber(contactID string) (string, error) {
+	return api.service.messenger.GetSafetyNumber(contactID)
+}
+
+func (api *PublicAPI) GetVerificationRequestsWithContact(contactID string) ([]*protocol.VerificationRequest, error) {
+	return api.service.messenger.GetVerificationRequestsWithContact(contactID)
+}
+
+func (api *PublicAPI) SetupSocialRecovery(ctx context.Context, mnemonic string, threshold int, contactIDs []string) (string, error) {
+	return api.service.messenger.SetupSocialRecovery(ctx, mnemonic, threshold, contactIDs)
+}
+
+func (api *PublicAPI) RequestSocialRecovery(ctx context.Context, owner string, holderIDs []string) error {
+	return api.service.messenger.RequestSocialRecovery(ctx, owner, holderIDs)
+}
+
+func (api *PublicAPI) PendingSocialRecoveryRequests() ([]*protocol.SocialRecoveryRequest, error) {
+	return api.service.messenger.PendingSocialRecoveryRequests()
+}
+
+func (api *PublicAPI) ReleaseSocialRecoveryShare(ctx context.Context, requestID string, safetyNumber string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.ReleaseSocialRecoveryShare(ctx, requestID, safetyNumber)
+}
+
+func (api *PublicAPI) DeclineSocialRecoveryRequest(requestID string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.DeclineSocialRecoveryRequest(requestID)
+}
+
+func (api *PublicAPI) CollectedSocialRecoveryShares(owner string) ([]*protocol.SocialRecoveryShare, error) {
+	return api.service.messenger.CollectedSocialRecoveryShares(owner)
+}
+
+// RecoverAccountFromSocialRecovery combines the shares of the seed phrase of
+// the owner released by their contacts and imports the account in the keystore
+func (api *PublicAPI) RecoverAccountFromSocialRecovery(owner string, password string) (account.Info, error) {
+	if api.service.accountManager == nil {
+		return account.Info{}, ErrAccountManagerNotSet
+	}
+
+	mnemonic, err := api.service.messenger.RecoverSocialRecoveryMnemonic(owner)
+	if err != nil {
+		return account.Info{}, err
+	}
+
+	info, err := api.service.accountManager.RecoverAccount(password, mnemonic)
+	if err != nil {
+		return account.Info{}, err
+	}
+
+	return info, api.service.messenger.DeleteCollectedSocialRecoveryShares(owner)
+}
+
 func (api *PublicAPI) ClearHistory(request *requests.ClearHistory) (*protocol.MessengerResponse, error) {
 	return api.service.messenger.ClearHistory(request)
 }
@@ -778,6 +950,14 @@ func (api *PublicAPI) SendEmojiReaction(ctx context.Context, chatID, messageID s
 	return api.service.messenger.SendEmojiReaction(ctx, chatID, messageID, emojiID)
 }
 
+func (api *PublicAPI) SendCustomEmojiReaction(ctx context.Context, chatID, messageID, emoji string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.SendCustomEmojiReaction(ctx, chatID, messageID, emoji)
+}
+
+func (api *PublicAPI) SendCommunityEmojiReaction(ctx context.Context, chatID, messageID, communityEmojiID string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.SendCommunityEmojiReaction(ctx, chatID, messageID, communityEmojiID)
+}
+
 func (api *PublicAPI) SendEmojiReactionRetraction(ctx context.Context, emojiReactionID string) (*protocol.MessengerResponse, error) {
 	return api.service.messenger.SendEmojiReactionRetraction(ctx, emojiReactionID)
 }
@@ -786,6 +966,34 @@ func (api *PublicAPI) EmojiReactionsByChatID(chatID string, cursor string, limit
 	return api.service.messenger.EmojiReactionsByChatID(chatID, cursor, limit)
 }
 
+func (api *PublicAPI) EmojiReactionsCountByMessageID(messageID string) ([]*protocol.EmojiReactionCount, error) {
+	return api.service.messenger.EmojiReactionsCountByMessageID(messageID)
+}
+
+// Files
+
+// GetFilePath returns where the file attached to a message is stored, once completely received
+func (api *PublicAPI) GetFilePath(messageID string) (string, error) {
+	return api.service.messenger.FilePath(messageID)
+}
+
+// Polls
+
+// SendPoll sends a poll to a chat
+func (api *PublicAPI) SendPoll(ctx context.Context, request *requests.SendPoll) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.SendPoll(ctx, request)
+}
+
+// SendPollVote votes on a poll, an empty list of options retracts the vote
+func (api *PublicAPI) SendPollVote(ctx context.Context, request *requests.SendPollVote) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.SendPollVote(ctx, request)
+}
+
+// PollResults returns the current tally of a poll
+func (api *PublicAPI) PollResults(messageID string) (*protocol.PollResults, error) {
+	return api.service.messenger.PollResults(messageID)
+}
+
 // Urls
 
 func (api *PublicAPI) GetLinkPreviewWhitelist() []urls.Site {
@@ -796,6 +1004,12 @@ func (api *PublicAPI) GetLinkPreviewData(link string) (previewData urls.LinkPrev
 	return urls.GetLinkPreviewData(link)
 }
 
+// UnfurlLinks returns the previews of the links, to be attached to
+// the message as `link_previews` before sending it
+func (api *PublicAPI) UnfurlLinks(links []string) ([]*protobuf.LinkPreview, error) {
+	return api.service.messenger.UnfurlLinks(links)
+}
+
 func (api *PublicAPI) EnsVerified(pk, ensName string) error {
 	return api.service.messenger.ENSVerified(pk, ensName)
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/p2p/enode"
 
 	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/peers"
 )
 
 const (
@@ -39,10 +40,12 @@ type p2pServer interface {
 }
 
 // NewConnectionManager creates an instance of ConnectionManager.
-func NewConnectionManager(server p2pServer, eventSub EnvelopeEventSubscriber, target, maxFailures int, timeout time.Duration) *ConnectionManager {
+// Reputation is optional, if provided it records requests outcomes and prioritizes mail servers by score.
+func NewConnectionManager(server p2pServer, eventSub EnvelopeEventSubscriber, reputation *peers.Reputation, target, maxFailures int, timeout time.Duration) *ConnectionManager {
 	return &ConnectionManager{
 		server:           server,
 		eventSub:         eventSub,
+		reputation:       reputation,
 		connectedTarget:  target,
 		maxFailures:      maxFailures,
 		notifications:    make(chan []*enode.Node),
@@ -55,8 +58,9 @@ type ConnectionManager struct {
 	wg   sync.WaitGroup
 	quit chan struct{}
 
-	server   p2pServer
-	eventSub EnvelopeEventSubscriber
+	server     p2pServer
+	eventSub   EnvelopeEventSubscriber
+	reputation *peers.Reputation
 
 	notifications    chan []*enode.Node
 	connectedTarget  int
@@ -82,12 +86,12 @@ func (ps *ConnectionManager) Start() {
 	ps.quit = make(chan struct{})
 	ps.wg.Add(1)
 	go func() {
-		state := newInternalState(ps.server, ps.connectedTarget, ps.timeoutWaitAdded)
+		state := newInternalState(ps.server, ps.reputation, ps.connectedTarget, ps.timeoutWaitAdded)
 		events := make(chan *p2p.PeerEvent, peerEventsBuffer)
 		sub := ps.server.SubscribeEvents(events)
 		whisperEvents := make(chan types.EnvelopeEvent, whisperEventsBuffer)
 		whisperSub := ps.eventSub.SubscribeEnvelopeEvents(whisperEvents)
-		requests := map[types.Hash]struct{}{}
+		requests := map[types.Hash]time.Time{}
 		failuresPerServer := map[types.EnodeID]int{}
 
 		defer sub.Unsubscribe()
@@ -111,19 +115,23 @@ func (ps *ConnectionManager) Start() {
 				// TODO treat failed requests the same way as expired
 				switch ev.Event {
 				case types.EventMailServerRequestSent:
-					requests[ev.Hash] = struct{}{}
+					requests[ev.Hash] = time.Now()
 				case types.EventMailServerRequestCompleted:
 					// reset failures count on first success
 					failuresPerServer[ev.Peer] = 0
+					if sentAt, exist := requests[ev.Hash]; exist {
+						ps.reputation.RecordRequest(enode.ID(ev.Peer), time.Since(sentAt), true)
+					}
 					delete(requests, ev.Hash)
 				case types.EventMailServerRequestExpired:
-					_, exist := requests[ev.Hash]
+					sentAt, exist := requests[ev.Hash]
 					if !exist {
 						continue
 					}
+					ps.reputation.RecordRequest(enode.ID(ev.Peer), time.Since(sentAt), false)
 					failuresPerServer[ev.Peer]++
 					log.Debug("request to a mail server expired, disconnect a peer", "address", ev.Peer)
-					if failuresPerServer[ev.Peer] >= ps.maxFailures {
+					if failuresPerServer[ev.Peer] >= ps.maxFailures || ps.reputation.Banned(enode.ID(ev.Peer)) {
 						state.nodeDisconnected(ev.Peer)
 					}
 				}
@@ -166,10 +174,11 @@ func (state *internalState) processReplacement(newNodes []*enode.Node, events <-
 	}
 }
 
-func newInternalState(srv PeerAdderRemover, target int, timeout time.Duration) *internalState {
+func newInternalState(srv PeerAdderRemover, reputation *peers.Reputation, target int, timeout time.Duration) *internalState {
 	return &internalState{
 		options:      options{target: target, timeout: timeout},
 		srv:          srv,
+		reputation:   reputation,
 		connected:    map[types.EnodeID]struct{}{},
 		currentNodes: map[types.EnodeID]*enode.Node{},
 	}
@@ -182,7 +191,8 @@ type options struct {
 
 type internalState struct {
 	options
-	srv PeerAdderRemover
+	srv        PeerAdderRemover
+	reputation *peers.Reputation
 
 	connected    map[types.EnodeID]struct{}
 	currentNodes map[types.EnodeID]*enode.Node
@@ -200,13 +210,23 @@ func (state *internalState) replaceNodes(new map[types.EnodeID]*enode.Node) {
 		}
 	}
 	if !state.ReachedTarget() {
-		for _, n := range new {
+		for _, n := range state.byScore(new) {
 			state.srv.AddPeer(n)
 		}
 	}
 	state.currentNodes = new
 }
 
+// byScore returns nodes ordered from the best to the worst reputation.
+func (state *internalState) byScore(nodes map[types.EnodeID]*enode.Node) []*enode.Node {
+	rst := make([]*enode.Node, 0, len(nodes))
+	for _, n := range nodes {
+		rst = append(rst, n)
+	}
+	state.reputation.SortByScore(rst)
+	return rst
+}
+
 func (state *internalState) nodeAdded(peer types.EnodeID) {
 	n, exist := state.currentNodes[peer]
 	if !exist {
@@ -234,7 +254,8 @@ func (state *internalState) nodeDisconnected(peer types.EnodeID) {
 	state.srv.RemovePeer(n) // remove peer permanently, otherwise p2p.Server will try to reconnect
 	delete(state.connected, peer)
 	if !state.ReachedTarget() { // try to connect with any other selected (but not connected) node
-		for nid, n := range state.currentNodes {
+		for _, n := range state.byScore(state.currentNodes) {
+			nid := types.EnodeID(n.ID())
 			_, exist := state.connected[nid]
 			if exist || peer == nid {
 				continue
@@ -150,7 +150,7 @@ func TestReplaceNodes(t *testing.T) {
 	} {
 		t.Run(tc.description, func(t *testing.T) {
 			peers := newFakePeerAdderRemover()
-			state := newInternalState(peers, tc.target, 0)
+			state := newInternalState(peers, nil, tc.target, 0)
 			state.replaceNodes(tc.old)
 			require.Len(t, peers.nodes, len(tc.old))
 			for n := range peers.nodes {
@@ -169,7 +169,7 @@ func TestPartialReplaceNodesBelowTarget(t *testing.T) {
 	peers := newFakePeerAdderRemover()
 	old := getMapWithRandomNodes(t, 1)
 	new := getMapWithRandomNodes(t, 2)
-	state := newInternalState(peers, 2, 0)
+	state := newInternalState(peers, nil, 2, 0)
 	state.replaceNodes(old)
 	mergeOldIntoNew(old, new)
 	state.replaceNodes(new)
@@ -182,7 +182,7 @@ func TestPartialReplaceNodesAboveTarget(t *testing.T) {
 	require.NoError(t, err)
 	old := nodesToMap([]*enode.Node{initial})
 	new := getMapWithRandomNodes(t, 2)
-	state := newInternalState(peers, 1, 0)
+	state := newInternalState(peers, nil, 1, 0)
 	state.replaceNodes(old)
 	state.nodeAdded(types.EnodeID(initial.ID()))
 	mergeOldIntoNew(old, new)
@@ -194,7 +194,7 @@ func TestConnectionManagerAddDrop(t *testing.T) {
 	server := newFakeServer()
 	whisper := newFakeEnvelopesEvents()
 	target := 1
-	connmanager := NewConnectionManager(server, whisper, target, 1, 0)
+	connmanager := NewConnectionManager(server, whisper, nil, target, 1, 0)
 	connmanager.Start()
 	defer connmanager.Stop()
 	nodes := []*enode.Node{}
@@ -236,7 +236,7 @@ func TestConnectionManagerReplace(t *testing.T) {
 	server := newFakeServer()
 	whisper := newFakeEnvelopesEvents()
 	target := 1
-	connmanager := NewConnectionManager(server, whisper, target, 1, 0)
+	connmanager := NewConnectionManager(server, whisper, nil, target, 1, 0)
 	connmanager.Start()
 	defer connmanager.Stop()
 	nodes := []*enode.Node{}
@@ -275,7 +275,7 @@ func TestConnectionManagerReplace(t *testing.T) {
 }
 
 func setupTestConnectionAfterExpiry(t *testing.T, server *fakePeerEvents, whisperMock *fakeEnvelopeEvents, target, maxFailures int, hash types.Hash) (*ConnectionManager, types.EnodeID) {
-	connmanager := NewConnectionManager(server, whisperMock, target, maxFailures, 0)
+	connmanager := NewConnectionManager(server, whisperMock, nil, target, maxFailures, 0)
 	connmanager.Start()
 	nodes := []*enode.Node{}
 	for _, n := range getMapWithRandomNodes(t, 2) {
@@ -386,7 +386,7 @@ func TestProcessReplacementWaitsForConnections(t *testing.T) {
 	nodes := make([]*enode.Node, 2)
 	fillWithRandomNodes(t, nodes)
 	events := make(chan *p2p.PeerEvent)
-	state := newInternalState(srv, target, timeout)
+	state := newInternalState(srv, nil, target, timeout)
 	state.currentNodes = nodesToMap(nodes)
 	go func() {
 		select {
@@ -54,6 +54,6 @@ func (f fakePeerProvider) Peers() []*p2p.Peer {
 func TestNoConnected(t *testing.T) {
 	provider := fakePeerProvider{}
 	store := NewPeerStore(newInMemCache(t))
-	_, err := GetFirstConnected(provider, store)
+	_, err := GetFirstConnected(provider, store, nil)
 	require.EqualError(t, ErrNoConnected, err.Error())
 }
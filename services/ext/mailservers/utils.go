@@ -6,18 +6,24 @@ import (
 	"github.com/ethereum/go-ethereum/p2p/enode"
 
 	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/peers"
 )
 
-// GetFirstConnected returns first connected peer that is also added to a peer store.
+// GetFirstConnected returns connected peer with the best reputation that is also added to a peer store.
+// Peers with equal scores are returned in the order of the provider.
 // Raises ErrNoConnected if no peers are added to a peer store.
-func GetFirstConnected(provider PeersProvider, store *PeerStore) (*enode.Node, error) {
-	peers := provider.Peers()
-	for _, p := range peers {
+func GetFirstConnected(provider PeersProvider, store *PeerStore, reputation *peers.Reputation) (*enode.Node, error) {
+	var connected []*enode.Node
+	for _, p := range provider.Peers() {
 		if store.Exist(types.EnodeID(p.ID())) {
-			return p.Node(), nil
+			connected = append(connected, p.Node())
 		}
 	}
-	return nil, ErrNoConnected
+	if len(connected) == 0 {
+		return nil, ErrNoConnected
+	}
+	reputation.SortByScore(connected)
+	return connected[0], nil
 }
 
 // NodesNotifee interface to be notified when new nodes are received.
@@ -9,7 +9,9 @@ import (
 	"github.com/ethereum/go-ethereum/p2p"
 	"github.com/ethereum/go-ethereum/p2p/enode"
 
+	"github.com/status-im/status-go/db"
 	"github.com/status-im/status-go/eth-node/types"
+	statuspeers "github.com/status-im/status-go/peers"
 )
 
 func TestGetFirstConnected(t *testing.T) {
@@ -25,14 +27,35 @@ func TestGetFirstConnected(t *testing.T) {
 	}
 	store := NewPeerStore(newInMemCache(t))
 	provider := fakePeerProvider{peers}
-	_, err := GetFirstConnected(provider, store)
+	_, err := GetFirstConnected(provider, store, nil)
 	require.EqualError(t, ErrNoConnected, err.Error())
 	require.NoError(t, store.Update(nodes))
-	node, err := GetFirstConnected(provider, store)
+	node, err := GetFirstConnected(provider, store, nil)
 	require.NoError(t, err)
 	require.Contains(t, nodesMap, types.EnodeID(node.ID()))
 }
 
+func TestGetFirstConnectedPrefersBestScore(t *testing.T) {
+	numPeers := 3
+	nodes := make([]*enode.Node, numPeers)
+	peers := make([]*p2p.Peer, numPeers)
+	fillWithRandomNodes(t, nodes)
+	for i := range nodes {
+		peers[i] = p2p.NewPeer(nodes[i].ID(), nodes[i].ID().String(), nil)
+	}
+	store := NewPeerStore(newInMemCache(t))
+	require.NoError(t, store.Update(nodes))
+	ldb, err := db.NewMemoryDB()
+	require.NoError(t, err)
+	reputation := statuspeers.NewReputation(ldb)
+	reputation.RecordRequest(nodes[0].ID(), time.Second, false)
+	reputation.RecordRequest(nodes[2].ID(), time.Second, true)
+
+	node, err := GetFirstConnected(fakePeerProvider{peers}, store, reputation)
+	require.NoError(t, err)
+	require.Equal(t, nodes[2].ID(), node.ID())
+}
+
 type trackingNodeNotifee struct {
 	calls [][]*enode.Node
 }
@@ -20,6 +20,7 @@ import (
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/rpc"
 
+	"github.com/status-im/status-go/account"
 	"github.com/status-im/status-go/connection"
 	"github.com/status-im/status-go/db"
 	coretypes "github.com/status-im/status-go/eth-node/core/types"
@@ -27,7 +28,10 @@ import (
 	"github.com/status-im/status-go/multiaccounts"
 	"github.com/status-im/status-go/multiaccounts/accounts"
 	"github.com/status-im/status-go/params"
+	"github.com/status-im/status-go/peers"
 	"github.com/status-im/status-go/protocol"
+	"github.com/status-im/status-go/protocol/anonmetrics"
+	"github.com/status-im/status-go/protocol/common"
 	"github.com/status-im/status-go/protocol/pushnotificationclient"
 	"github.com/status-im/status-go/protocol/pushnotificationserver"
 	"github.com/status-im/status-go/protocol/transport"
@@ -58,9 +62,12 @@ type Service struct {
 	mailMonitor     *MailRequestMonitor
 	server          *p2p.Server
 	peerStore       *mailservers.PeerStore
+	reputation      *peers.Reputation
+	reputationMon   *peers.ReputationMonitor
 	accountsDB      *accounts.Database
 	multiAccountsDB *multiaccounts.Database
 	account         *multiaccounts.Account
+	accountManager  *account.GethManager
 }
 
 // Make sure that Service implements node.Service interface.
@@ -70,17 +77,20 @@ func New(
 	config params.ShhextConfig,
 	n types.Node,
 	ldb *leveldb.DB,
+	reputation *peers.Reputation,
 	mailMonitor *MailRequestMonitor,
 	eventSub mailservers.EnvelopeEventSubscriber,
 ) *Service {
 	cache := mailservers.NewCache(ldb)
 	peerStore := mailservers.NewPeerStore(cache)
 	return &Service{
-		storage:     db.NewLevelDBStorage(ldb),
-		n:           n,
-		config:      config,
-		mailMonitor: mailMonitor,
-		peerStore:   peerStore,
+		storage:       db.NewLevelDBStorage(ldb),
+		n:             n,
+		config:        config,
+		mailMonitor:   mailMonitor,
+		peerStore:     peerStore,
+		reputation:    reputation,
+		reputationMon: peers.NewReputationMonitor(reputation, eventSub),
 	}
 }
 
@@ -93,7 +103,7 @@ func (s *Service) NodeID() *ecdsa.PrivateKey {
 
 func (s *Service) GetPeer(rawURL string) (*enode.Node, error) {
 	if len(rawURL) == 0 {
-		return mailservers.GetFirstConnected(s.server, s.peerStore)
+		return mailservers.GetFirstConnected(s.server, s.peerStore, s.reputation)
 	}
 	return enode.ParseV4(rawURL)
 }
@@ -151,6 +161,11 @@ func (s *Service) InitProtocol(identity *ecdsa.PrivateKey, db *sql.DB, multiAcco
 	return messenger.Init()
 }
 
+// Messenger returns the messenger of the user, nil until the protocol is initialized.
+func (s *Service) Messenger() *protocol.Messenger {
+	return s.messenger
+}
+
 func (s *Service) StartMessenger() (*protocol.MessengerResponse, error) {
 	// Start a loop that retrieves all messages and propagates them to status-react.
 	s.cancelMessenger = make(chan struct{})
@@ -336,15 +351,22 @@ func (s *Service) SetP2PServer(server *p2p.Server) {
 	s.server = server
 }
 
+// SetAccountManager sets the manager used to import recovered accounts
+func (s *Service) SetAccountManager(accountManager *account.GethManager) {
+	s.accountManager = accountManager
+}
+
 // Start is run when a service is started.
-// It does nothing in this case but is required by `node.Service` interface.
+// It starts recording envelope confirmations of peers.
 func (s *Service) Start() error {
+	s.reputationMon.Start()
 	return nil
 }
 
 // Stop is run when a service is stopped.
 func (s *Service) Stop() error {
 	log.Info("Stopping shhext service")
+	s.reputationMon.Stop()
 	if s.cancelMessenger != nil {
 		select {
 		case <-s.cancelMessenger:
@@ -387,6 +409,7 @@ func buildMessengerOptions(
 		protocol.WithEnvelopesMonitorConfig(envelopesMonitorConfig),
 		protocol.WithSignalsHandler(messengerSignalsHandler),
 		protocol.WithENSVerificationConfig(publishMessengerResponse, config.VerifyENSURL, config.VerifyENSContractAddress),
+		protocol.WithFiles(filepath.Join(config.BackupDisabledDataDir, "files"), config.MaxFileSize),
 	}
 
 	if config.DataSyncEnabled {
@@ -414,6 +437,16 @@ func buildMessengerOptions(
 		RemoteNotificationsEnabled: settings.RemotePushNotificationsEnabled,
 	}))
 
+	if config.AnonMetricsSendID != "" {
+		sendAddress, err := common.HexToPubkey(config.AnonMetricsSendID)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, protocol.WithAnonMetricsClientConfig(&anonmetrics.ClientConfig{
+			SendAddress: sendAddress,
+		}))
+	}
+
 	if config.VerifyTransactionURL != "" {
 		client := &verifyTransactionClient{
 			url:     config.VerifyTransactionURL,
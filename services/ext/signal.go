@@ -58,6 +58,16 @@ func (m MessengerSignalsHandler) CommunityInfoFound(community *communities.Commu
 	signal.SendCommunityInfoFound(community)
 }
 
+// MessageQueueStatusChanged passes information about a message in the outbound queue
+func (m MessengerSignalsHandler) MessageQueueStatusChanged(chatID string, messageID string, status string, attempts int) {
+	signal.SendMessageQueueStatusChanged(chatID, messageID, status, attempts)
+}
+
+// FileDownloadProgress passes information about the chunks received of a file attached to a message
+func (m MessengerSignalsHandler) FileDownloadProgress(chatID string, messageID string, received uint32, total uint32) {
+	signal.SendFileDownloadProgress(chatID, messageID, received, total)
+}
+
 func (m *MessengerSignalsHandler) MessengerResponse(response *protocol.MessengerResponse) {
 	PublisherSignalHandler{}.NewMessages(response)
 }
@@ -2,15 +2,19 @@ package permissions
 
 import (
 	"context"
+	"time"
+
+	"github.com/status-im/status-go/eth-node/types"
 )
 
-func NewAPI(db *Database) *API {
-	return &API{db}
+func NewAPI(db *Database, provider *Provider) *API {
+	return &API{db, provider}
 }
 
 // API is class with methods available over RPC.
 type API struct {
-	db *Database
+	db       *Database
+	provider *Provider
 }
 
 func (api *API) AddDappPermissions(ctx context.Context, perms DappPermissions) error {
@@ -24,3 +28,31 @@ func (api *API) GetDappPermissions(ctx context.Context) ([]DappPermissions, erro
 func (api *API) DeleteDappPermissions(ctx context.Context, name string) error {
 	return api.db.DeletePermission(name)
 }
+
+// Request handles an EIP-1193 request of the dapp loaded from origin. Requests
+// needing the approval of the user only return once it has been given.
+func (api *API) Request(ctx context.Context, origin string, request ProviderRequest) (interface{}, error) {
+	return api.provider.Request(ctx, origin, request)
+}
+
+// PendingRequests returns the dapp requests waiting for the approval of the user.
+func (api *API) PendingRequests(ctx context.Context) ([]*PendingRequest, error) {
+	return api.provider.PendingRequests(), nil
+}
+
+func (api *API) ApproveRequest(ctx context.Context, id string, approval Approval) error {
+	return api.provider.Approve(id, approval)
+}
+
+func (api *API) RejectRequest(ctx context.Context, id string) error {
+	return api.provider.Reject(id)
+}
+
+// GetDappAccountPermissions returns the accounts the dapp has been granted permissions on.
+func (api *API) GetDappAccountPermissions(ctx context.Context, origin string) ([]AccountPermission, error) {
+	return api.db.GetAccountPermissions(origin, time.Now().Unix())
+}
+
+func (api *API) RevokeDappAccountPermissions(ctx context.Context, origin string, address types.Address) error {
+	return api.db.DeleteAccountPermissions(origin, address)
+}
@@ -27,7 +27,7 @@ func setupTestDB(t *testing.T) (*Database, func()) {
 
 func setupTestAPI(t *testing.T) (*API, func()) {
 	db, cancel := setupTestDB(t)
-	return &API{db: db}, cancel
+	return &API{db: db, provider: NewProvider(db, 1)}, cancel
 }
 
 func TestDappPermissionsStored(t *testing.T) {
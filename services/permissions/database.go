@@ -2,6 +2,11 @@ package permissions
 
 import (
 	"database/sql"
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/status-im/status-go/eth-node/types"
 )
 
 // Database sql wrapper for operations with browser objects.
@@ -118,5 +123,133 @@ func (db *Database) GetPermissions() (rst []DappPermissions, err error) {
 
 func (db *Database) DeletePermission(name string) error {
 	_, err := db.db.Exec("DELETE FROM dapps WHERE name = ?", name)
+	if err != nil {
+		return err
+	}
+	_, err = db.db.Exec("DELETE FROM dapp_account_permissions WHERE origin = ?", name)
+	return err
+}
+
+// AccountPermission grants a dapp a permission on one of the accounts of the user.
+type AccountPermission struct {
+	Origin     string        `json:"origin"`
+	Address    types.Address `json:"address"`
+	Permission string        `json:"permission"`
+	// ExpiresAt is the unix time the permission expires at, 0 if it never does
+	ExpiresAt int64 `json:"expiresAt"`
+	CreatedAt int64 `json:"createdAt"`
+}
+
+func (db *Database) AddAccountPermissions(perms []AccountPermission) (err error) {
+	tx, err := db.db.Begin()
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		_ = tx.Rollback()
+	}()
+
+	insert, err := tx.Prepare("INSERT INTO dapp_account_permissions(origin, address, permission, expires_at, created_at) VALUES(?, ?, ?, ?, ?)")
+	if err != nil {
+		return
+	}
+	defer insert.Close()
+	for _, perm := range perms {
+		_, err = insert.Exec(perm.Origin, perm.Address.Hex(), perm.Permission, perm.ExpiresAt, perm.CreatedAt)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// GetAccountPermissions returns the permissions of the origin which haven't expired at now.
+func (db *Database) GetAccountPermissions(origin string, now int64) ([]AccountPermission, error) {
+	rows, err := db.db.Query("SELECT origin, address, permission, expires_at, created_at FROM dapp_account_permissions WHERE origin = ? AND (expires_at = 0 OR expires_at > ?) ORDER BY created_at, address", origin, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rst []AccountPermission
+	for rows.Next() {
+		var (
+			perm    AccountPermission
+			address string
+		)
+		err = rows.Scan(&perm.Origin, &address, &perm.Permission, &perm.ExpiresAt, &perm.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		perm.Address = types.HexToAddress(address)
+		rst = append(rst, perm)
+	}
+	return rst, rows.Err()
+}
+
+func (db *Database) DeleteAccountPermissions(origin string, address types.Address) error {
+	_, err := db.db.Exec("DELETE FROM dapp_account_permissions WHERE origin = ? AND address = ?", origin, address.Hex())
 	return err
 }
+
+// Chain is a chain added by a dapp, described with the EIP-3085 parameters.
+type Chain struct {
+	ChainID           hexutil.Uint64  `json:"chainId"`
+	ChainName         string          `json:"chainName"`
+	NativeCurrency    *NativeCurrency `json:"nativeCurrency,omitempty"`
+	RPCUrls           []string        `json:"rpcUrls"`
+	BlockExplorerURLs []string        `json:"blockExplorerUrls,omitempty"`
+	IconURLs          []string        `json:"iconUrls,omitempty"`
+}
+
+type NativeCurrency struct {
+	Name     string `json:"name"`
+	Symbol   string `json:"symbol"`
+	Decimals uint8  `json:"decimals"`
+}
+
+func (db *Database) AddChain(chain Chain) error {
+	params, err := json.Marshal(chain)
+	if err != nil {
+		return err
+	}
+	_, err = db.db.Exec("INSERT INTO dapp_chains(chain_id, params) VALUES(?, ?)", uint64(chain.ChainID), params)
+	return err
+}
+
+// GetChain returns the chain added with the id, nil if there is none.
+func (db *Database) GetChain(chainID uint64) (*Chain, error) {
+	var params []byte
+	err := db.db.QueryRow("SELECT params FROM dapp_chains WHERE chain_id = ?", chainID).Scan(&params)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	chain := &Chain{}
+	err = json.Unmarshal(params, chain)
+	if err != nil {
+		return nil, err
+	}
+	return chain, nil
+}
+
+func (db *Database) SetOriginChain(origin string, chainID uint64) error {
+	_, err := db.db.Exec("INSERT INTO dapp_origin_chains(origin, chain_id) VALUES(?, ?)", origin, chainID)
+	return err
+}
+
+// GetOriginChain returns the chain the origin switched to, 0 if it didn't.
+func (db *Database) GetOriginChain(origin string) (uint64, error) {
+	var chainID uint64
+	err := db.db.QueryRow("SELECT chain_id FROM dapp_origin_chains WHERE origin = ?", origin).Scan(&chainID)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	return chainID, err
+}
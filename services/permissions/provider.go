@@ -0,0 +1,490 @@
+package permissions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/signal"
+)
+
+// PermissionEthAccounts is the EIP-2255 permission to see accounts of the user.
+const PermissionEthAccounts = "eth_accounts"
+
+// requestTimeout is how long a request waits for the user before it is rejected
+const requestTimeout = 5 * time.Minute
+
+// EIP-1193, EIP-3085 and JSON-RPC error codes returned to the dapps
+const (
+	ErrCodeUserRejected      = 4001
+	ErrCodeUnauthorized      = 4100
+	ErrCodeUnsupportedMethod = 4200
+	ErrCodeChainDisconnected = 4901
+	ErrCodeUnrecognizedChain = 4902
+	ErrCodeInvalidParams     = -32602
+)
+
+// ProviderError is an EIP-1193 provider error, its code is returned in the
+// JSON-RPC error.
+type ProviderError struct {
+	Code    int
+	Message string
+}
+
+func (e *ProviderError) Error() string {
+	return e.Message
+}
+
+func (e *ProviderError) ErrorCode() int {
+	return e.Code
+}
+
+func newProviderError(code int, message string) *ProviderError {
+	return &ProviderError{Code: code, Message: message}
+}
+
+var (
+	errUserRejected    = newProviderError(ErrCodeUserRejected, "user rejected the request")
+	errRequestExpired  = newProviderError(ErrCodeUserRejected, "request expired")
+	errUnauthorized    = newProviderError(ErrCodeUnauthorized, "the account has not been authorized by the user")
+	errInvalidParams   = newProviderError(ErrCodeInvalidParams, "invalid params")
+	errNoAccounts      = newProviderError(ErrCodeInvalidParams, "no accounts approved")
+	errRequestNotFound = newProviderError(ErrCodeInvalidParams, "request not found")
+)
+
+// ProviderRequest is an EIP-1193 request made by a dapp.
+type ProviderRequest struct {
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params,omitempty"`
+}
+
+// RPCClient forwards the read-only requests of the dapps to the node.
+type RPCClient interface {
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+}
+
+// Permission is a permission of a dapp in the EIP-2255 format.
+type Permission struct {
+	Invoker          string   `json:"invoker"`
+	ParentCapability string   `json:"parentCapability"`
+	Caveats          []Caveat `json:"caveats"`
+	Date             int64    `json:"date"`
+}
+
+type Caveat struct {
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+// Approval is the answer of the user to a pending request.
+type Approval struct {
+	// Accounts are the accounts exposed to the dapp, for account requests
+	Accounts []types.Address `json:"accounts,omitempty"`
+	// ExpiresAt is the unix time the account permissions expire at, 0 if they never do
+	ExpiresAt int64 `json:"expiresAt,omitempty"`
+	// Result is returned to the dapp for signing requests, e.g. the signature or the transaction hash
+	Result json.RawMessage `json:"result,omitempty"`
+}
+
+// PendingRequest is a dapp request waiting for the approval of the user.
+type PendingRequest struct {
+	ID        string          `json:"id"`
+	Origin    string          `json:"origin"`
+	Method    string          `json:"method"`
+	Params    json.RawMessage `json:"params,omitempty"`
+	CreatedAt int64           `json:"createdAt"`
+
+	approval chan *Approval
+}
+
+// signingMethods are the methods going through the approval of the user,
+// along with the position of the signing account in their params.
+var signingMethods = map[string]int{
+	"eth_sendTransaction":  0,
+	"eth_signTransaction":  0,
+	"eth_sign":             0,
+	"personal_sign":        1,
+	"eth_signTypedData":    0,
+	"eth_signTypedData_v3": 0,
+	"eth_signTypedData_v4": 0,
+}
+
+// Provider handles the EIP-1193 requests of the dapps: accounts are only
+// exposed to the origins the user granted, and the signing requests wait in a
+// queue until the user approves or rejects them.
+type Provider struct {
+	db        *Database
+	rpcClient RPCClient
+	chainID   uint64
+
+	mu      sync.Mutex
+	pending map[string]*PendingRequest
+}
+
+func NewProvider(db *Database, chainID uint64) *Provider {
+	return &Provider{
+		db:      db,
+		chainID: chainID,
+		pending: make(map[string]*PendingRequest),
+	}
+}
+
+// Request handles a request made by the dapp of the origin.
+func (p *Provider) Request(ctx context.Context, origin string, request ProviderRequest) (interface{}, error) {
+	switch request.Method {
+	case "eth_accounts":
+		return p.accounts(origin)
+	case "eth_requestAccounts":
+		return p.requestAccounts(ctx, origin, request)
+	case "wallet_getPermissions":
+		return p.permissions(origin)
+	case "wallet_requestPermissions":
+		return p.requestPermissions(ctx, origin, request)
+	case "eth_chainId":
+		chainID, err := p.originChain(origin)
+		if err != nil {
+			return nil, err
+		}
+		return hexutil.Uint64(chainID), nil
+	case "wallet_switchEthereumChain":
+		return p.switchChain(ctx, origin, request)
+	case "wallet_addEthereumChain":
+		return p.addChain(ctx, origin, request)
+	}
+
+	if position, ok := signingMethods[request.Method]; ok {
+		return p.sign(ctx, origin, request, position)
+	}
+	return p.forward(ctx, origin, request)
+}
+
+func (p *Provider) accounts(origin string) ([]types.Address, error) {
+	perms, err := p.db.GetAccountPermissions(origin, time.Now().Unix())
+	if err != nil {
+		return nil, err
+	}
+	accounts := []types.Address{}
+	for _, perm := range perms {
+		if perm.Permission == PermissionEthAccounts {
+			accounts = append(accounts, perm.Address)
+		}
+	}
+	return accounts, nil
+}
+
+func (p *Provider) requestAccounts(ctx context.Context, origin string, request ProviderRequest) ([]types.Address, error) {
+	accounts, err := p.accounts(origin)
+	if err != nil || len(accounts) > 0 {
+		return accounts, err
+	}
+	if err := p.approveAccounts(ctx, origin, request); err != nil {
+		return nil, err
+	}
+	return p.accounts(origin)
+}
+
+// approveAccounts waits for the user to pick the accounts exposed to the origin.
+func (p *Provider) approveAccounts(ctx context.Context, origin string, request ProviderRequest) error {
+	approval, err := p.queue(ctx, origin, request)
+	if err != nil {
+		return err
+	}
+	if len(approval.Accounts) == 0 {
+		return errNoAccounts
+	}
+
+	now := time.Now().Unix()
+	var perms []AccountPermission
+	for _, address := range approval.Accounts {
+		perms = append(perms, AccountPermission{
+			Origin:     origin,
+			Address:    address,
+			Permission: PermissionEthAccounts,
+			ExpiresAt:  approval.ExpiresAt,
+			CreatedAt:  now,
+		})
+	}
+	return p.db.AddAccountPermissions(perms)
+}
+
+func (p *Provider) permissions(origin string) ([]Permission, error) {
+	perms, err := p.db.GetAccountPermissions(origin, time.Now().Unix())
+	if err != nil {
+		return nil, err
+	}
+
+	permissions := []Permission{}
+	if len(perms) == 0 {
+		return permissions, nil
+	}
+
+	var accounts []types.Address
+	var date, expiresAt int64
+	for _, perm := range perms {
+		accounts = append(accounts, perm.Address)
+		if perm.CreatedAt > date {
+			date = perm.CreatedAt
+		}
+		if perm.ExpiresAt != 0 && (expiresAt == 0 || perm.ExpiresAt < expiresAt) {
+			expiresAt = perm.ExpiresAt
+		}
+	}
+
+	caveats := []Caveat{{Type: "restrictReturnedAccounts", Value: accounts}}
+	if expiresAt != 0 {
+		caveats = append(caveats, Caveat{Type: "expiresAt", Value: expiresAt * 1000})
+	}
+	return append(permissions, Permission{
+		Invoker:          origin,
+		ParentCapability: PermissionEthAccounts,
+		Caveats:          caveats,
+		Date:             date * 1000,
+	}), nil
+}
+
+func (p *Provider) requestPermissions(ctx context.Context, origin string, request ProviderRequest) ([]Permission, error) {
+	var params []map[string]json.RawMessage
+	if err := json.Unmarshal(request.Params, &params); err != nil || len(params) != 1 || len(params[0]) == 0 {
+		return nil, errInvalidParams
+	}
+	for permission := range params[0] {
+		if permission != PermissionEthAccounts {
+			return nil, newProviderError(ErrCodeUnsupportedMethod, fmt.Sprintf("permission %s is not supported", permission))
+		}
+	}
+
+	if err := p.approveAccounts(ctx, origin, request); err != nil {
+		return nil, err
+	}
+	return p.permissions(origin)
+}
+
+// originChain returns the chain the origin is connected to, the one of the
+// node unless it switched to another.
+func (p *Provider) originChain(origin string) (uint64, error) {
+	chainID, err := p.db.GetOriginChain(origin)
+	if err != nil || chainID != 0 {
+		return chainID, err
+	}
+	return p.chainID, nil
+}
+
+type chainParams struct {
+	ChainID hexutil.Uint64 `json:"chainId"`
+}
+
+func (p *Provider) switchChain(ctx context.Context, origin string, request ProviderRequest) (interface{}, error) {
+	var params []chainParams
+	if err := json.Unmarshal(request.Params, &params); err != nil || len(params) != 1 {
+		return nil, errInvalidParams
+	}
+	chainID := uint64(params[0].ChainID)
+
+	current, err := p.originChain(origin)
+	if err != nil {
+		return nil, err
+	}
+	if chainID == current {
+		return nil, nil
+	}
+
+	if chainID != p.chainID {
+		chain, err := p.db.GetChain(chainID)
+		if err != nil {
+			return nil, err
+		}
+		if chain == nil {
+			return nil, newProviderError(ErrCodeUnrecognizedChain, fmt.Sprintf("unrecognized chain id %d", chainID))
+		}
+	}
+
+	if _, err := p.queue(ctx, origin, request); err != nil {
+		return nil, err
+	}
+	return nil, p.db.SetOriginChain(origin, chainID)
+}
+
+func (p *Provider) addChain(ctx context.Context, origin string, request ProviderRequest) (interface{}, error) {
+	var params []Chain
+	if err := json.Unmarshal(request.Params, &params); err != nil || len(params) != 1 {
+		return nil, errInvalidParams
+	}
+	chain := params[0]
+	if chain.ChainID == 0 || chain.ChainName == "" || len(chain.RPCUrls) == 0 {
+		return nil, errInvalidParams
+	}
+	if uint64(chain.ChainID) == p.chainID {
+		return nil, nil
+	}
+
+	if _, err := p.queue(ctx, origin, request); err != nil {
+		return nil, err
+	}
+	return nil, p.db.AddChain(chain)
+}
+
+// sign queues a signing request once the signing account has been checked
+// against the accounts exposed to the origin. The user signs it when
+// approving, and the result is returned to the dapp.
+func (p *Provider) sign(ctx context.Context, origin string, request ProviderRequest, position int) (interface{}, error) {
+	var params []json.RawMessage
+	if err := json.Unmarshal(request.Params, &params); err != nil || len(params) <= position {
+		return nil, errInvalidParams
+	}
+
+	var from types.Address
+	var tx struct {
+		From types.Address `json:"from"`
+	}
+	if err := json.Unmarshal(params[position], &tx); err == nil {
+		from = tx.From
+	} else if err := json.Unmarshal(params[position], &from); err != nil {
+		return nil, errInvalidParams
+	}
+
+	accounts, err := p.accounts(origin)
+	if err != nil {
+		return nil, err
+	}
+	authorized := false
+	for _, account := range accounts {
+		if account == from {
+			authorized = true
+			break
+		}
+	}
+	if !authorized {
+		return nil, errUnauthorized
+	}
+
+	approval, err := p.queue(ctx, origin, request)
+	if err != nil {
+		return nil, err
+	}
+	return approval.Result, nil
+}
+
+// forward sends the read-only requests to the node, as long as the origin is
+// connected to the chain of the node.
+func (p *Provider) forward(ctx context.Context, origin string, request ProviderRequest) (interface{}, error) {
+	if p.rpcClient == nil || !(strings.HasPrefix(request.Method, "eth_") || strings.HasPrefix(request.Method, "net_") || strings.HasPrefix(request.Method, "web3_")) {
+		return nil, newProviderError(ErrCodeUnsupportedMethod, fmt.Sprintf("method %s is not supported", request.Method))
+	}
+
+	chainID, err := p.originChain(origin)
+	if err != nil {
+		return nil, err
+	}
+	if chainID != p.chainID {
+		return nil, newProviderError(ErrCodeChainDisconnected, fmt.Sprintf("chain %d is not connected", chainID))
+	}
+
+	var params []json.RawMessage
+	if len(request.Params) != 0 {
+		if err := json.Unmarshal(request.Params, &params); err != nil {
+			return nil, errInvalidParams
+		}
+	}
+	args := make([]interface{}, len(params))
+	for i := range params {
+		args[i] = params[i]
+	}
+
+	var result json.RawMessage
+	err = p.rpcClient.CallContext(ctx, &result, request.Method, args...)
+	return result, err
+}
+
+// queue adds the request to the pending requests and waits for the user.
+func (p *Provider) queue(ctx context.Context, origin string, request ProviderRequest) (*Approval, error) {
+	pending := &PendingRequest{
+		ID:        uuid.New().String(),
+		Origin:    origin,
+		Method:    request.Method,
+		Params:    request.Params,
+		CreatedAt: time.Now().Unix(),
+		approval:  make(chan *Approval, 1),
+	}
+
+	p.mu.Lock()
+	p.pending[pending.ID] = pending
+	p.mu.Unlock()
+
+	signal.SendDappRequestQueued(signal.DappRequestEvent{
+		ID:     pending.ID,
+		Origin: origin,
+		Method: request.Method,
+		Params: request.Params,
+	})
+
+	timeout := time.NewTimer(requestTimeout)
+	defer timeout.Stop()
+
+	select {
+	case approval := <-pending.approval:
+		if approval == nil {
+			return nil, errUserRejected
+		}
+		return approval, nil
+	case <-timeout.C:
+		p.complete(pending.ID, nil)
+		return nil, errRequestExpired
+	case <-ctx.Done():
+		p.complete(pending.ID, nil)
+		return nil, errRequestExpired
+	}
+}
+
+// PendingRequests returns the requests waiting for the approval of the user, oldest first.
+func (p *Provider) PendingRequests() []*PendingRequest {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	requests := []*PendingRequest{}
+	for _, request := range p.pending {
+		requests = append(requests, request)
+	}
+	sort.Slice(requests, func(i, j int) bool {
+		return requests[i].CreatedAt < requests[j].CreatedAt
+	})
+	return requests
+}
+
+// Approve answers the pending request with the approval of the user.
+func (p *Provider) Approve(id string, approval Approval) error {
+	if !p.complete(id, &approval) {
+		return errRequestNotFound
+	}
+	return nil
+}
+
+// Reject rejects the pending request.
+func (p *Provider) Reject(id string) error {
+	if !p.complete(id, nil) {
+		return errRequestNotFound
+	}
+	return nil
+}
+
+func (p *Provider) complete(id string, approval *Approval) bool {
+	p.mu.Lock()
+	pending, ok := p.pending[id]
+	delete(p.pending, id)
+	p.mu.Unlock()
+
+	if !ok {
+		return false
+	}
+	pending.approval <- approval
+	signal.SendDappRequestCompleted(id, approval != nil)
+	return true
+}
@@ -0,0 +1,200 @@
+package permissions
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+const testOrigin = "https://dapp.example"
+
+var testAccount = types.HexToAddress("0xdC540f3745Ff2964AFC1171a5A0DD726d1F6B472")
+
+type fakeRPCClient struct{}
+
+func (c *fakeRPCClient) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	*result.(*json.RawMessage) = json.RawMessage(`"0x10"`)
+	return nil
+}
+
+func setupTestProvider(t *testing.T) (*Provider, func()) {
+	db, cancel := setupTestDB(t)
+	provider := NewProvider(db, 1)
+	provider.rpcClient = &fakeRPCClient{}
+	return provider, cancel
+}
+
+// answerNext answers the next request queued in the provider, rejecting it
+// when the approval is nil.
+func answerNext(t *testing.T, provider *Provider, approval *Approval) {
+	go func() {
+		for i := 0; i < 100; i++ {
+			requests := provider.PendingRequests()
+			if len(requests) != 0 {
+				if approval == nil {
+					require.NoError(t, provider.Reject(requests[0].ID))
+				} else {
+					require.NoError(t, provider.Approve(requests[0].ID, *approval))
+				}
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}()
+}
+
+func request(method string, params string) ProviderRequest {
+	return ProviderRequest{Method: method, Params: json.RawMessage(params)}
+}
+
+func requireProviderError(t *testing.T, code int, err error) {
+	require.Error(t, err)
+	providerErr, ok := err.(*ProviderError)
+	require.True(t, ok)
+	require.Equal(t, code, providerErr.Code)
+}
+
+func TestRequestAccounts(t *testing.T) {
+	provider, cancel := setupTestProvider(t)
+	defer cancel()
+	ctx := context.Background()
+
+	answerNext(t, provider, nil)
+	_, err := provider.Request(ctx, testOrigin, request("eth_requestAccounts", ""))
+	requireProviderError(t, ErrCodeUserRejected, err)
+
+	answerNext(t, provider, &Approval{Accounts: []types.Address{testAccount}})
+	accounts, err := provider.Request(ctx, testOrigin, request("eth_requestAccounts", ""))
+	require.NoError(t, err)
+	require.Equal(t, []types.Address{testAccount}, accounts)
+
+	// Already granted, no approval needed
+	accounts, err = provider.Request(ctx, testOrigin, request("eth_requestAccounts", ""))
+	require.NoError(t, err)
+	require.Equal(t, []types.Address{testAccount}, accounts)
+
+	accounts, err = provider.Request(ctx, "https://other.example", request("eth_accounts", ""))
+	require.NoError(t, err)
+	require.Empty(t, accounts)
+
+	result, err := provider.Request(ctx, testOrigin, request("wallet_getPermissions", ""))
+	require.NoError(t, err)
+	permissions := result.([]Permission)
+	require.Len(t, permissions, 1)
+	require.Equal(t, testOrigin, permissions[0].Invoker)
+	require.Equal(t, PermissionEthAccounts, permissions[0].ParentCapability)
+	require.Equal(t, []types.Address{testAccount}, permissions[0].Caveats[0].Value)
+}
+
+func TestRequestPermissions(t *testing.T) {
+	provider, cancel := setupTestProvider(t)
+	defer cancel()
+	ctx := context.Background()
+
+	_, err := provider.Request(ctx, testOrigin, request("wallet_requestPermissions", `[{"eth_sign":{}}]`))
+	requireProviderError(t, ErrCodeUnsupportedMethod, err)
+
+	expiresAt := time.Now().Add(time.Hour).Unix()
+	answerNext(t, provider, &Approval{Accounts: []types.Address{testAccount}, ExpiresAt: expiresAt})
+	result, err := provider.Request(ctx, testOrigin, request("wallet_requestPermissions", `[{"eth_accounts":{}}]`))
+	require.NoError(t, err)
+	permissions := result.([]Permission)
+	require.Len(t, permissions, 1)
+	require.Equal(t, "expiresAt", permissions[0].Caveats[1].Type)
+	require.Equal(t, expiresAt*1000, permissions[0].Caveats[1].Value)
+}
+
+func TestAccountPermissionsExpire(t *testing.T) {
+	provider, cancel := setupTestProvider(t)
+	defer cancel()
+
+	require.NoError(t, provider.db.AddAccountPermissions([]AccountPermission{{
+		Origin:     testOrigin,
+		Address:    testAccount,
+		Permission: PermissionEthAccounts,
+		ExpiresAt:  time.Now().Add(-time.Minute).Unix(),
+	}}))
+
+	accounts, err := provider.Request(context.Background(), testOrigin, request("eth_accounts", ""))
+	require.NoError(t, err)
+	require.Empty(t, accounts)
+}
+
+func TestSign(t *testing.T) {
+	provider, cancel := setupTestProvider(t)
+	defer cancel()
+	ctx := context.Background()
+	sign := request("personal_sign", `["0xdeadbeef", "0xdC540f3745Ff2964AFC1171a5A0DD726d1F6B472"]`)
+
+	_, err := provider.Request(ctx, testOrigin, sign)
+	requireProviderError(t, ErrCodeUnauthorized, err)
+
+	require.NoError(t, provider.db.AddAccountPermissions([]AccountPermission{{
+		Origin:     testOrigin,
+		Address:    testAccount,
+		Permission: PermissionEthAccounts,
+	}}))
+
+	answerNext(t, provider, nil)
+	_, err = provider.Request(ctx, testOrigin, sign)
+	requireProviderError(t, ErrCodeUserRejected, err)
+
+	answerNext(t, provider, &Approval{Result: json.RawMessage(`"0x01"`)})
+	result, err := provider.Request(ctx, testOrigin, sign)
+	require.NoError(t, err)
+	require.Equal(t, json.RawMessage(`"0x01"`), result)
+
+	answerNext(t, provider, &Approval{Result: json.RawMessage(`"0x02"`)})
+	result, err = provider.Request(ctx, testOrigin, request("eth_sendTransaction", `[{"from":"0xdC540f3745Ff2964AFC1171a5A0DD726d1F6B472","to":"0x0000000000000000000000000000000000000001"}]`))
+	require.NoError(t, err)
+	require.Equal(t, json.RawMessage(`"0x02"`), result)
+
+	require.Empty(t, provider.PendingRequests())
+	require.Equal(t, errRequestNotFound, provider.Reject("unknown"))
+}
+
+func TestSwitchChain(t *testing.T) {
+	provider, cancel := setupTestProvider(t)
+	defer cancel()
+	ctx := context.Background()
+
+	result, err := provider.Request(ctx, testOrigin, request("eth_blockNumber", ""))
+	require.NoError(t, err)
+	require.Equal(t, json.RawMessage(`"0x10"`), result)
+
+	_, err = provider.Request(ctx, testOrigin, request("wallet_switchEthereumChain", `[{"chainId":"0x64"}]`))
+	requireProviderError(t, ErrCodeUnrecognizedChain, err)
+
+	_, err = provider.Request(ctx, testOrigin, request("wallet_addEthereumChain", `[{"chainId":"0x64"}]`))
+	requireProviderError(t, ErrCodeInvalidParams, err)
+
+	answerNext(t, provider, &Approval{})
+	_, err = provider.Request(ctx, testOrigin, request("wallet_addEthereumChain", `[{"chainId":"0x64","chainName":"xDai","rpcUrls":["https://rpc.xdaichain.com"],"nativeCurrency":{"name":"xDai","symbol":"xDAI","decimals":18}}]`))
+	require.NoError(t, err)
+
+	answerNext(t, provider, &Approval{})
+	_, err = provider.Request(ctx, testOrigin, request("wallet_switchEthereumChain", `[{"chainId":"0x64"}]`))
+	require.NoError(t, err)
+
+	result, err = provider.Request(ctx, testOrigin, request("eth_chainId", ""))
+	require.NoError(t, err)
+	require.Equal(t, hexutil.Uint64(100), result)
+
+	// The other origins stay on the chain of the node
+	result, err = provider.Request(ctx, "https://other.example", request("eth_chainId", ""))
+	require.NoError(t, err)
+	require.Equal(t, hexutil.Uint64(1), result)
+
+	_, err = provider.Request(ctx, testOrigin, request("eth_blockNumber", ""))
+	requireProviderError(t, ErrCodeChainDisconnected, err)
+
+	_, err = provider.Request(ctx, testOrigin, request("personal_unlockAccount", ""))
+	requireProviderError(t, ErrCodeUnsupportedMethod, err)
+}
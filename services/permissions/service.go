@@ -6,12 +6,18 @@ import (
 )
 
 // NewService initializes service instance.
-func NewService(db *Database) *Service {
-	return &Service{db: db}
+func NewService(db *Database, chainID uint64) *Service {
+	return &Service{db: db, provider: NewProvider(db, chainID)}
 }
 
 type Service struct {
-	db *Database
+	db       *Database
+	provider *Provider
+}
+
+// SetRPCClient sets the client the read-only requests of the dapps are forwarded to.
+func (s *Service) SetRPCClient(client RPCClient) {
+	s.provider.rpcClient = client
 }
 
 // Start a service.
@@ -30,7 +36,7 @@ func (s *Service) APIs() []rpc.API {
 		{
 			Namespace: "permissions",
 			Version:   "0.1.0",
-			Service:   NewAPI(s.db),
+			Service:   NewAPI(s.db, s.provider),
 		},
 	}
 }
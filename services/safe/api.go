@@ -0,0 +1,322 @@
+package safe
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol"
+	"github.com/status-im/status-go/protocol/requests"
+	safetx "github.com/status-im/status-go/protocol/safe"
+	"github.com/status-im/status-go/transactions"
+)
+
+var (
+	// ErrServiceNotInitialized is returned when the RPC client hasn't been set.
+	ErrServiceNotInitialized = errors.New("safe service is not initialized")
+	// ErrMessengerNotAvailable is returned when the user is not logged in.
+	ErrMessengerNotAvailable = errors.New("messenger is not available")
+	// ErrNotAnOwner is returned when the account signing a transaction doesn't own the Safe.
+	ErrNotAnOwner = errors.New("account is not an owner of the safe")
+	// ErrWrongChain is returned when the transaction is for another network than the node's.
+	ErrWrongChain = errors.New("safe transaction is for another network")
+	// ErrThresholdNotReached is returned when executing a transaction signed by too few owners.
+	ErrThresholdNotReached = errors.New("safe transaction is not signed by enough owners")
+)
+
+func NewAPI(s *Service) *API {
+	return &API{s}
+}
+
+// API is class with methods available over RPC.
+type API struct {
+	s *Service
+}
+
+// Info is the configuration of a Safe read from the chain.
+type Info struct {
+	Address   common.Address   `json:"address"`
+	Owners    []common.Address `json:"owners"`
+	Threshold uint64           `json:"threshold"`
+	// Nonce is the nonce of the next transaction executed by the Safe
+	Nonce *hexutil.Big `json:"nonce"`
+}
+
+// IsOwner returns whether the address is one of the owners of the Safe.
+func (i *Info) IsOwner(address common.Address) bool {
+	for _, owner := range i.Owners {
+		if owner == address {
+			return true
+		}
+	}
+	return false
+}
+
+// Proposal is a Safe transaction proposed in a chat, with only the
+// signatures of the current owners of the Safe.
+type Proposal struct {
+	*protocol.SafeTransactionProposal
+	Threshold uint64 `json:"threshold"`
+}
+
+// Info returns the owners, threshold and nonce of a Safe.
+func (api *API) Info(ctx context.Context, address common.Address) (*Info, error) {
+	if api.s.client == nil {
+		return nil, ErrServiceNotInitialized
+	}
+
+	caller := newSafeCaller(address, api.s.client)
+	opts := &bind.CallOpts{Context: ctx}
+
+	owners, err := caller.GetOwners(opts)
+	if err != nil {
+		return nil, err
+	}
+	threshold, err := caller.GetThreshold(opts)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := caller.Nonce(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Info{
+		Address:   address,
+		Owners:    owners,
+		Threshold: threshold.Uint64(),
+		Nonce:     (*hexutil.Big)(nonce),
+	}, nil
+}
+
+// Hash returns the EIP-712 hash of a Safe transaction, which the owners sign.
+func (api *API) Hash(transaction safetx.Transaction) (common.Hash, error) {
+	return transaction.Hash()
+}
+
+// Propose sends a Safe transaction to a chat, signed with the account of the
+// address, which must own the Safe. The transaction defaults to the network of
+// the node and to the current nonce of the Safe.
+func (api *API) Propose(ctx context.Context, chatID string, transaction safetx.Transaction, address types.Address, password string) (*protocol.MessengerResponse, error) {
+	messenger, err := api.s.messenger()
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := api.Info(ctx, transaction.Safe)
+	if err != nil {
+		return nil, err
+	}
+	if transaction.ChainID == nil {
+		transaction.ChainID = (*hexutil.Big)(api.s.chainID)
+	}
+	if transaction.Nonce == nil {
+		transaction.Nonce = info.Nonce
+	}
+
+	signature, err := api.sign(info, &transaction, address, password)
+	if err != nil {
+		return nil, err
+	}
+
+	return messenger.SendSafeTransactionProposal(ctx, &requests.SendSafeTransactionProposal{
+		ChatID:      chatID,
+		Transaction: &transaction,
+		Signature:   signature,
+	})
+}
+
+// Sign signs a proposed Safe transaction with the account of the address, which
+// must own the Safe, and sends the signature to the chat it was proposed in.
+func (api *API) Sign(ctx context.Context, messageID string, address types.Address, password string) (*protocol.MessengerResponse, error) {
+	messenger, err := api.s.messenger()
+	if err != nil {
+		return nil, err
+	}
+
+	proposal, err := messenger.SafeTransactionProposal(messageID)
+	if err != nil {
+		return nil, err
+	}
+	info, err := api.Info(ctx, proposal.Transaction.Safe)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := api.sign(info, proposal.Transaction, address, password)
+	if err != nil {
+		return nil, err
+	}
+
+	return messenger.SendSafeTransactionSignature(ctx, &requests.SendSafeTransactionSignature{
+		MessageID: types.FromHex(messageID),
+		Signature: signature,
+	})
+}
+
+// Proposal returns a proposed Safe transaction with the signatures of its owners,
+// marking it as ready once they reach the threshold of the Safe.
+func (api *API) Proposal(ctx context.Context, messageID string) (*Proposal, error) {
+	messenger, err := api.s.messenger()
+	if err != nil {
+		return nil, err
+	}
+
+	proposal, err := messenger.SafeTransactionProposal(messageID)
+	if err != nil {
+		return nil, err
+	}
+	info, err := api.Info(ctx, proposal.Transaction.Safe)
+	if err != nil {
+		return nil, err
+	}
+
+	removeNonOwnerSignatures(proposal, info)
+
+	if proposal.Status == protocol.SafeTransactionProposalPending && uint64(len(proposal.Signatures)) >= info.Threshold {
+		proposal, err = messenger.SetSafeTransactionProposalStatus(messageID, protocol.SafeTransactionProposalReady, "")
+		if err != nil {
+			return nil, err
+		}
+		// The updated proposal is read again with the signatures of all the signers
+		removeNonOwnerSignatures(proposal, info)
+	}
+
+	return &Proposal{
+		SafeTransactionProposal: proposal,
+		Threshold:               info.Threshold,
+	}, nil
+}
+
+// ExecutePrepareTx builds the transaction executing a proposal signed by enough
+// owners, sent from the account of the address which pays for the gas.
+func (api *API) ExecutePrepareTx(ctx context.Context, messageID string, address types.Address) (*transactions.SendTxArgs, error) {
+	proposal, err := api.Proposal(ctx, messageID)
+	if err != nil {
+		return nil, err
+	}
+	if proposal.Status == protocol.SafeTransactionProposalExecuted {
+		return nil, protocol.ErrSafeTransactionProposalExecuted
+	}
+	if uint64(len(proposal.Signatures)) < proposal.Threshold {
+		return nil, ErrThresholdNotReached
+	}
+
+	signatures := make(map[common.Address][]byte)
+	for signer, signature := range proposal.Signatures {
+		signatures[signer] = signature
+	}
+
+	tx := proposal.Transaction
+	input, err := safeABI.Pack("execTransaction",
+		tx.To,
+		bigOrZero(tx.Value),
+		[]byte(tx.Data),
+		tx.Operation,
+		bigOrZero(tx.SafeTxGas),
+		bigOrZero(tx.BaseGas),
+		bigOrZero(tx.GasPrice),
+		tx.GasToken,
+		tx.RefundReceiver,
+		safetx.PackSignatures(signatures),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	to := types.Address(tx.Safe)
+	return &transactions.SendTxArgs{
+		From:  address,
+		To:    &to,
+		Value: (*hexutil.Big)(big.NewInt(0)),
+		Input: types.HexBytes(input),
+	}, nil
+}
+
+// ExecuteEstimate returns the gas needed to execute the proposal.
+func (api *API) ExecuteEstimate(ctx context.Context, messageID string, address types.Address) (uint64, error) {
+	txArgs, err := api.ExecutePrepareTx(ctx, messageID, address)
+	if err != nil {
+		return 0, err
+	}
+	return api.s.client.EstimateGas(ctx, ethereum.CallMsg{
+		From:  common.Address(txArgs.From),
+		To:    (*common.Address)(txArgs.To),
+		Value: txArgs.Value.ToInt(),
+		Data:  txArgs.Input,
+	})
+}
+
+// Execute sends the transaction executing the proposal, signed with the account
+// of the address, and returns its hash.
+func (api *API) Execute(ctx context.Context, messageID string, address types.Address, password string) (types.Hash, error) {
+	messenger, err := api.s.messenger()
+	if err != nil {
+		return types.Hash{}, err
+	}
+
+	txArgs, err := api.ExecutePrepareTx(ctx, messageID, address)
+	if err != nil {
+		return types.Hash{}, err
+	}
+
+	verifiedAccount, err := api.s.verifiedAccount(address, password)
+	if err != nil {
+		return types.Hash{}, err
+	}
+
+	hash, err := api.s.transactor.SendTransaction(*txArgs, verifiedAccount)
+	if err != nil {
+		return types.Hash{}, err
+	}
+
+	_, err = messenger.SetSafeTransactionProposalStatus(messageID, protocol.SafeTransactionProposalExecuted, hash.Hex())
+	if err != nil {
+		return types.Hash{}, err
+	}
+	return hash, nil
+}
+
+// sign checks that the account owns the Safe and signs the hash of the transaction.
+func (api *API) sign(info *Info, transaction *safetx.Transaction, address types.Address, password string) ([]byte, error) {
+	if transaction.ChainID.ToInt().Cmp(api.s.chainID) != 0 {
+		return nil, ErrWrongChain
+	}
+	if !info.IsOwner(common.Address(address)) {
+		return nil, ErrNotAnOwner
+	}
+
+	hash, err := transaction.Hash()
+	if err != nil {
+		return nil, err
+	}
+
+	verifiedAccount, err := api.s.verifiedAccount(address, password)
+	if err != nil {
+		return nil, err
+	}
+	return safetx.Sign(hash, verifiedAccount.AccountKey.PrivateKey)
+}
+
+// removeNonOwnerSignatures removes the signatures of accounts which don't own
+// the Safe, as the contract rejects them.
+func removeNonOwnerSignatures(proposal *protocol.SafeTransactionProposal, info *Info) {
+	for signer := range proposal.Signatures {
+		if !info.IsOwner(signer) {
+			delete(proposal.Signatures, signer)
+		}
+	}
+}
+
+func bigOrZero(n *hexutil.Big) *big.Int {
+	if n == nil {
+		return big.NewInt(0)
+	}
+	return n.ToInt()
+}
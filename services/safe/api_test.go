@@ -0,0 +1,274 @@
+package safe
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/status-im/status-go/account/generator"
+	gethbridge "github.com/status-im/status-go/eth-node/bridge/geth"
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	enstypes "github.com/status-im/status-go/eth-node/types/ens"
+	"github.com/status-im/status-go/multiaccounts"
+	"github.com/status-im/status-go/protocol"
+	"github.com/status-im/status-go/protocol/requests"
+	safetx "github.com/status-im/status-go/protocol/safe"
+	"github.com/status-im/status-go/t/helpers"
+	"github.com/status-im/status-go/waku"
+)
+
+var testOwners = []common.Address{{2}, {3}, {4}}
+
+// setupTestAPI deploys a Safe owned by testOwners with a threshold of 2 on
+// a simulated chain.
+func setupTestAPI(t *testing.T) (*API, common.Address, func()) {
+	chain, err := helpers.NewSimulatedChain()
+	require.NoError(t, err)
+
+	safe, err := chain.DeployStub()
+	require.NoError(t, err)
+	require.NoError(t, chain.StubMethod(safe, safeABI, "getOwners", nil, testOwners))
+	require.NoError(t, chain.StubMethod(safe, safeABI, "getThreshold", nil, big.NewInt(2)))
+	require.NoError(t, chain.StubMethod(safe, safeABI, "nonce", nil, big.NewInt(5)))
+
+	s := NewService(nil, nil, nil, "", 1, nil)
+	s.SetClient(chain)
+	return NewAPI(s), safe, func() {
+		require.NoError(t, chain.Close())
+	}
+}
+
+func TestInfo(t *testing.T) {
+	api, testSafe, stop := setupTestAPI(t)
+	defer stop()
+
+	info, err := api.Info(context.Background(), testSafe)
+	require.NoError(t, err)
+	require.Equal(t, testOwners, info.Owners)
+	require.Equal(t, uint64(2), info.Threshold)
+	require.Equal(t, big.NewInt(5), info.Nonce.ToInt())
+	require.True(t, info.IsOwner(testOwners[1]))
+	require.False(t, info.IsOwner(testSafe))
+}
+
+func TestInfoWithoutClient(t *testing.T) {
+	api := NewAPI(NewService(nil, nil, nil, "", 1, nil))
+	_, err := api.Info(context.Background(), common.Address{1})
+	require.Equal(t, ErrServiceNotInitialized, err)
+}
+
+func TestProposeWithoutMessenger(t *testing.T) {
+	api, testSafe, stop := setupTestAPI(t)
+	defer stop()
+	_, err := api.Propose(context.Background(), "chat", safetx.Transaction{Safe: testSafe}, types.Address(testOwners[0]), "password")
+	require.Equal(t, ErrMessengerNotAvailable, err)
+}
+
+func TestSignChecksOwnerAndChain(t *testing.T) {
+	api, testSafe, stop := setupTestAPI(t)
+	defer stop()
+	info, err := api.Info(context.Background(), testSafe)
+	require.NoError(t, err)
+
+	transaction := &safetx.Transaction{
+		ChainID: (*hexutil.Big)(big.NewInt(3)),
+		Safe:    testSafe,
+		Nonce:   info.Nonce,
+	}
+	_, err = api.sign(info, transaction, types.Address(testOwners[0]), "password")
+	require.Equal(t, ErrWrongChain, err)
+
+	transaction.ChainID = (*hexutil.Big)(big.NewInt(1))
+	_, err = api.sign(info, transaction, types.Address(testSafe), "password")
+	require.Equal(t, ErrNotAnOwner, err)
+}
+
+type timeSource struct{}
+
+func (timeSource) GetCurrentTime() uint64 {
+	return uint64(time.Now().UnixNano() / int64(time.Millisecond))
+}
+
+// testNode runs the messenger on a local waku node, without peers.
+type testNode struct {
+	waku types.Waku
+}
+
+func (n *testNode) NewENSVerifier(_ *zap.Logger) enstypes.ENSVerifier {
+	panic("not implemented")
+}
+
+func (n *testNode) GetWaku(_ interface{}) (types.Waku, error) {
+	return n.waku, nil
+}
+
+func (n *testNode) GetWakuV2(_ interface{}) (types.Waku, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (n *testNode) AddPeer(_ string) error {
+	panic("not implemented")
+}
+
+func (n *testNode) RemovePeer(_ string) error {
+	panic("not implemented")
+}
+
+func (n *testNode) PeersCount() int {
+	return 1
+}
+
+type messengerProvider struct {
+	messenger *protocol.Messenger
+}
+
+func (p *messengerProvider) Messenger() *protocol.Messenger {
+	return p.messenger
+}
+
+// newMessenger starts a messenger on a local waku node.
+func newMessenger(t *testing.T) *protocol.Messenger {
+	config := waku.DefaultConfig
+	config.MinimumAcceptedPoW = 0
+	w := waku.New(&config, zap.NewNop())
+	require.NoError(t, w.Start())
+
+	tmpfile, err := ioutil.TempFile("", "safe-tests-")
+	require.NoError(t, err)
+	madb, err := multiaccounts.InitializeDB(tmpfile.Name())
+	require.NoError(t, err)
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	account := generator.NewAccount(key, nil)
+	info := account.ToIdentifiedAccountInfo("")
+
+	messenger, err := protocol.NewMessenger(
+		key,
+		&testNode{waku: gethbridge.NewGethWakuWrapper(w)},
+		uuid.New().String(),
+		protocol.WithCustomLogger(zap.NewNop()),
+		protocol.WithDatabaseConfig(":memory:", "some-key"),
+		protocol.WithMultiAccounts(madb),
+		protocol.WithAccount(info.ToMultiAccount()),
+		protocol.WithDatasync(),
+	)
+	require.NoError(t, err)
+	require.NoError(t, messenger.Init())
+	_, err = messenger.Start()
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, messenger.Shutdown())
+		require.NoError(t, w.Stop())
+		require.NoError(t, os.Remove(tmpfile.Name()))
+	})
+	return messenger
+}
+
+func TestNonOwnerSignaturesAreNotExecuted(t *testing.T) {
+	var keys []*ecdsa.PrivateKey
+	for i := 0; i < 3; i++ {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		keys = append(keys, key)
+	}
+	// The signature of the non-owner comes first once sorted by address
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(crypto.PubkeyToAddress(keys[i].PublicKey).Bytes(), crypto.PubkeyToAddress(keys[j].PublicKey).Bytes()) < 0
+	})
+	nonOwner := keys[0]
+	owners := []common.Address{
+		common.Address(crypto.PubkeyToAddress(keys[1].PublicKey)),
+		common.Address(crypto.PubkeyToAddress(keys[2].PublicKey)),
+	}
+
+	chain, err := helpers.NewSimulatedChain()
+	require.NoError(t, err)
+	defer chain.Close() // nolint: errcheck
+	safe, err := chain.DeployStub()
+	require.NoError(t, err)
+	require.NoError(t, chain.StubMethod(safe, safeABI, "getOwners", nil, owners))
+	require.NoError(t, chain.StubMethod(safe, safeABI, "getThreshold", nil, big.NewInt(2)))
+	require.NoError(t, chain.StubMethod(safe, safeABI, "nonce", nil, big.NewInt(0)))
+
+	messenger := newMessenger(t)
+	s := NewService(nil, nil, nil, "", 1, &messengerProvider{messenger})
+	s.SetClient(chain)
+	api := NewAPI(s)
+
+	contactKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	chat := protocol.CreateOneToOneChat("contact", &contactKey.PublicKey, timeSource{})
+	require.NoError(t, messenger.SaveChat(chat))
+
+	transaction := safetx.Transaction{
+		ChainID: (*hexutil.Big)(big.NewInt(1)),
+		Safe:    safe,
+		To:      common.Address{1},
+		Nonce:   (*hexutil.Big)(big.NewInt(0)),
+	}
+	hash, err := transaction.Hash()
+	require.NoError(t, err)
+	sign := func(key *ecdsa.PrivateKey) []byte {
+		signature, err := safetx.Sign(hash, key)
+		require.NoError(t, err)
+		return signature
+	}
+
+	response, err := messenger.SendSafeTransactionProposal(context.Background(), &requests.SendSafeTransactionProposal{
+		ChatID:      chat.ID,
+		Transaction: &transaction,
+		Signature:   sign(keys[1]),
+	})
+	require.NoError(t, err)
+	require.Len(t, response.Messages(), 1)
+	messageID := response.Messages()[0].ID
+
+	for _, key := range []*ecdsa.PrivateKey{nonOwner, keys[2]} {
+		_, err = messenger.SendSafeTransactionSignature(context.Background(), &requests.SendSafeTransactionSignature{
+			MessageID: types.FromHex(messageID),
+			Signature: sign(key),
+		})
+		require.NoError(t, err)
+	}
+
+	// The threshold is reached with the signatures of the owners only
+	proposal, err := api.Proposal(context.Background(), messageID)
+	require.NoError(t, err)
+	require.Equal(t, protocol.SafeTransactionProposalReady, proposal.Status)
+	require.Len(t, proposal.Signatures, 2)
+	require.NotContains(t, proposal.Signatures, crypto.PubkeyToAddress(nonOwner.PublicKey))
+
+	txArgs, err := api.ExecutePrepareTx(context.Background(), messageID, types.Address(owners[0]))
+	require.NoError(t, err)
+	expected, err := safeABI.Pack("execTransaction",
+		transaction.To,
+		big.NewInt(0),
+		[]byte{},
+		uint8(0),
+		big.NewInt(0),
+		big.NewInt(0),
+		big.NewInt(0),
+		common.Address{},
+		common.Address{},
+		append(sign(keys[1]), sign(keys[2])...),
+	)
+	require.NoError(t, err)
+	require.Equal(t, types.HexBytes(expected), txArgs.Input)
+}
@@ -0,0 +1,58 @@
+package safe
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// SafeABI is the subset of the Safe contract ABI used to read its owners and execute transactions.
+const SafeABI = `[{"inputs":[],"name":"getOwners","outputs":[{"internalType":"address[]","name":"","type":"address[]"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"getThreshold","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"nonce","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"value","type":"uint256"},{"internalType":"bytes","name":"data","type":"bytes"},{"internalType":"uint8","name":"operation","type":"uint8"},{"internalType":"uint256","name":"safeTxGas","type":"uint256"},{"internalType":"uint256","name":"baseGas","type":"uint256"},{"internalType":"uint256","name":"gasPrice","type":"uint256"},{"internalType":"address","name":"gasToken","type":"address"},{"internalType":"address payable","name":"refundReceiver","type":"address"},{"internalType":"bytes","name":"signatures","type":"bytes"}],"name":"execTransaction","outputs":[{"internalType":"bool","name":"success","type":"bool"}],"stateMutability":"payable","type":"function"}]`
+
+var safeABI = mustParseABI(SafeABI)
+
+func mustParseABI(definition string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(definition))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+// safeCaller reads the owners, threshold and nonce of a Safe.
+type safeCaller struct {
+	contract *bind.BoundContract
+}
+
+func newSafeCaller(address common.Address, caller bind.ContractCaller) *safeCaller {
+	return &safeCaller{contract: bind.NewBoundContract(address, safeABI, caller, nil, nil)}
+}
+
+func (c *safeCaller) GetOwners(opts *bind.CallOpts) ([]common.Address, error) {
+	var out []interface{}
+	err := c.contract.Call(opts, &out, "getOwners")
+	if err != nil {
+		return nil, err
+	}
+	return *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address), nil
+}
+
+func (c *safeCaller) uint(opts *bind.CallOpts, method string) (*big.Int, error) {
+	var out []interface{}
+	err := c.contract.Call(opts, &out, method)
+	if err != nil {
+		return nil, err
+	}
+	return *abi.ConvertType(out[0], new(*big.Int)).(**big.Int), nil
+}
+
+func (c *safeCaller) GetThreshold(opts *bind.CallOpts) (*big.Int, error) {
+	return c.uint(opts, "getThreshold")
+}
+
+func (c *safeCaller) Nonce(opts *bind.CallOpts) (*big.Int, error) {
+	return c.uint(opts, "nonce")
+}
@@ -0,0 +1,110 @@
+package safe
+
+import (
+	"database/sql"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/status-im/status-go/account"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/multiaccounts/accounts"
+	"github.com/status-im/status-go/protocol"
+	"github.com/status-im/status-go/transactions"
+)
+
+// MessengerProvider gives access to the messenger of the user, which is only
+// available once logged in. It's satisfied by the waku extension services.
+type MessengerProvider interface {
+	Messenger() *protocol.Messenger
+}
+
+// NewService initializes service instance.
+func NewService(appDB *sql.DB, accountsManager *account.GethManager, transactor *transactions.Transactor, keyStoreDir string, chainID uint64, messengerProvider MessengerProvider) *Service {
+	return &Service{
+		accountsDB:        accounts.NewDB(appDB),
+		accountsManager:   accountsManager,
+		transactor:        transactor,
+		keyStoreDir:       keyStoreDir,
+		chainID:           new(big.Int).SetUint64(chainID),
+		messengerProvider: messengerProvider,
+	}
+}
+
+// Service coordinates the signatures of Safe transactions through chats and
+// executes them once signed by enough owners.
+type Service struct {
+	accountsDB        *accounts.Database
+	accountsManager   *account.GethManager
+	transactor        *transactions.Transactor
+	keyStoreDir       string
+	chainID           *big.Int
+	client            bind.ContractBackend
+	messengerProvider MessengerProvider
+}
+
+// SetClient sets the backend used to call the Safe contracts.
+func (s *Service) SetClient(client bind.ContractBackend) {
+	s.client = client
+}
+
+// Start a service.
+func (s *Service) Start() error {
+	return nil
+}
+
+// Stop a service.
+func (s *Service) Stop() error {
+	return nil
+}
+
+// APIs returns list of available RPC APIs.
+func (s *Service) APIs() []rpc.API {
+	return []rpc.API{
+		{
+			Namespace: "safe",
+			Version:   "0.1.0",
+			Service:   NewAPI(s),
+		},
+	}
+}
+
+// Protocols returns list of p2p protocols.
+func (s *Service) Protocols() []p2p.Protocol {
+	return nil
+}
+
+func (s *Service) messenger() (*protocol.Messenger, error) {
+	if s.messengerProvider == nil {
+		return nil, ErrMessengerNotAvailable
+	}
+	messenger := s.messengerProvider.Messenger()
+	if messenger == nil {
+		return nil, ErrMessengerNotAvailable
+	}
+	return messenger, nil
+}
+
+// verifiedAccount checks the password of a wallet account of the user, which
+// is then used to sign Safe transactions.
+func (s *Service) verifiedAccount(address types.Address, password string) (*account.SelectedExtKey, error) {
+	exists, err := s.accountsDB.AddressExists(address)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, transactions.ErrAccountDoesntExist
+	}
+
+	key, err := s.accountsManager.VerifyAccountPassword(s.keyStoreDir, address.Hex(), password)
+	if err != nil {
+		return nil, err
+	}
+
+	return &account.SelectedExtKey{
+		Address:    key.Address,
+		AccountKey: key,
+	}, nil
+}
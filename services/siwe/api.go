@@ -0,0 +1,90 @@
+package siwe
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+func NewAPI(s *Service) *API {
+	return &API{s}
+}
+
+// API is class with methods available over RPC.
+type API struct {
+	s *Service
+}
+
+// VerifyParams are the message signed to sign in, with the values it is validated against.
+type VerifyParams struct {
+	Message   string         `json:"message"`
+	Signature types.HexBytes `json:"signature"`
+	ValidateOptions
+}
+
+// GenerateNonce returns a random nonce to put in a message.
+func (api *API) GenerateNonce() (string, error) {
+	return GenerateNonce()
+}
+
+// PrepareMessage fills the version, chain id, nonce and issue time when
+// they're missing, and returns the message to sign.
+func (api *API) PrepareMessage(message Message) (string, error) {
+	if message.Version == "" {
+		message.Version = Version
+	}
+	if message.ChainID == 0 {
+		message.ChainID = api.s.chainID
+	}
+	if message.Nonce == "" {
+		nonce, err := GenerateNonce()
+		if err != nil {
+			return "", err
+		}
+		message.Nonce = nonce
+	}
+	if message.IssuedAt == "" {
+		message.IssuedAt = time.Now().UTC().Format(time.RFC3339)
+	}
+
+	if err := message.checkFormat(); err != nil {
+		return "", err
+	}
+	return message.String(), nil
+}
+
+// ParseMessage returns the fields of a message.
+func (api *API) ParseMessage(message string) (*Message, error) {
+	return ParseMessage(message)
+}
+
+// Verify parses and validates the message, and checks its signature by the
+// address it contains. It returns the message when it is valid.
+func (api *API) Verify(ctx context.Context, params VerifyParams) (*Message, error) {
+	message, err := ParseMessage(params.Message)
+	if err != nil {
+		return nil, err
+	}
+	err = message.Validate(params.ValidateOptions, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	valid, err := VerifySignature(ctx, api.s.client, message.Address, []byte(params.Message), params.Signature)
+	if err != nil {
+		return nil, err
+	}
+	if !valid {
+		return nil, ErrInvalidSignature
+	}
+	return message, nil
+}
+
+// VerifySignature checks that the personal message has been signed by the
+// address, falling back to EIP-1271 for contract accounts.
+func (api *API) VerifySignature(ctx context.Context, address common.Address, message types.HexBytes, signature types.HexBytes) (bool, error) {
+	return VerifySignature(ctx, api.s.client, address, message, signature)
+}
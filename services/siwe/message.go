@@ -0,0 +1,262 @@
+package siwe
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	headerSuffix = " wants you to sign in with your Ethereum account:"
+
+	// Version is the only version of EIP-4361 messages
+	Version = "1"
+
+	nonceAlphabet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	nonceLength   = 17
+	minNonceSize  = 8
+)
+
+var (
+	ErrInvalidMessage  = errors.New("invalid sign-in with ethereum message")
+	ErrDomainMismatch  = errors.New("message domain doesn't match")
+	ErrNonceMismatch   = errors.New("message nonce doesn't match")
+	ErrChainIDMismatch = errors.New("message chain id doesn't match")
+	ErrExpired         = errors.New("message has expired")
+	ErrNotYetValid     = errors.New("message is not valid yet")
+)
+
+// Message is an EIP-4361 Sign-In with Ethereum message. Times are kept in
+// their RFC 3339 form so the message is signed exactly as it was written.
+type Message struct {
+	Domain         string         `json:"domain"`
+	Address        common.Address `json:"address"`
+	Statement      string         `json:"statement,omitempty"`
+	URI            string         `json:"uri"`
+	Version        string         `json:"version"`
+	ChainID        uint64         `json:"chainId"`
+	Nonce          string         `json:"nonce"`
+	IssuedAt       string         `json:"issuedAt"`
+	ExpirationTime string         `json:"expirationTime,omitempty"`
+	NotBefore      string         `json:"notBefore,omitempty"`
+	RequestID      string         `json:"requestId,omitempty"`
+	Resources      []string       `json:"resources,omitempty"`
+}
+
+// ValidateOptions are the values the message is checked against, empty ones are not checked.
+type ValidateOptions struct {
+	Domain  string `json:"domain,omitempty"`
+	Nonce   string `json:"nonce,omitempty"`
+	ChainID uint64 `json:"chainId,omitempty"`
+}
+
+// GenerateNonce returns a random alphanumeric nonce.
+func GenerateNonce() (string, error) {
+	nonce := make([]byte, nonceLength)
+	max := big.NewInt(int64(len(nonceAlphabet)))
+	for i := range nonce {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		nonce[i] = nonceAlphabet[n.Int64()]
+	}
+	return string(nonce), nil
+}
+
+// String returns the message in the format it is signed in.
+func (m *Message) String() string {
+	var b strings.Builder
+	b.WriteString(m.Domain + headerSuffix + "\n")
+	b.WriteString(m.Address.Hex() + "\n\n")
+	if m.Statement != "" {
+		b.WriteString(m.Statement + "\n")
+	}
+	b.WriteString("\n")
+	b.WriteString("URI: " + m.URI + "\n")
+	b.WriteString("Version: " + m.Version + "\n")
+	b.WriteString("Chain ID: " + strconv.FormatUint(m.ChainID, 10) + "\n")
+	b.WriteString("Nonce: " + m.Nonce + "\n")
+	b.WriteString("Issued At: " + m.IssuedAt)
+	if m.ExpirationTime != "" {
+		b.WriteString("\nExpiration Time: " + m.ExpirationTime)
+	}
+	if m.NotBefore != "" {
+		b.WriteString("\nNot Before: " + m.NotBefore)
+	}
+	if m.RequestID != "" {
+		b.WriteString("\nRequest ID: " + m.RequestID)
+	}
+	if len(m.Resources) != 0 {
+		b.WriteString("\nResources:")
+		for _, resource := range m.Resources {
+			b.WriteString("\n- " + resource)
+		}
+	}
+	return b.String()
+}
+
+// checkFormat checks the fields which can be checked without context.
+func (m *Message) checkFormat() error {
+	if m.Domain == "" || strings.ContainsAny(m.Domain, " \n") {
+		return fmt.Errorf("%w: domain", ErrInvalidMessage)
+	}
+	if strings.Contains(m.Statement, "\n") {
+		return fmt.Errorf("%w: statement", ErrInvalidMessage)
+	}
+	if m.URI == "" || strings.ContainsAny(m.URI, " \n") {
+		return fmt.Errorf("%w: uri", ErrInvalidMessage)
+	}
+	if m.Version != Version {
+		return fmt.Errorf("%w: version", ErrInvalidMessage)
+	}
+	if len(m.Nonce) < minNonceSize {
+		return fmt.Errorf("%w: nonce", ErrInvalidMessage)
+	}
+	for _, c := range m.Nonce {
+		if !strings.ContainsRune(nonceAlphabet, c) {
+			return fmt.Errorf("%w: nonce", ErrInvalidMessage)
+		}
+	}
+	if _, err := time.Parse(time.RFC3339, m.IssuedAt); err != nil {
+		return fmt.Errorf("%w: issued at", ErrInvalidMessage)
+	}
+	for _, t := range []string{m.ExpirationTime, m.NotBefore} {
+		if t == "" {
+			continue
+		}
+		if _, err := time.Parse(time.RFC3339, t); err != nil {
+			return fmt.Errorf("%w: time %q", ErrInvalidMessage, t)
+		}
+	}
+	for _, resource := range m.Resources {
+		if resource == "" || strings.ContainsAny(resource, " \n") {
+			return fmt.Errorf("%w: resource", ErrInvalidMessage)
+		}
+	}
+	return nil
+}
+
+// Validate checks the message against the options and, when it has
+// ones, its validity period at now.
+func (m *Message) Validate(options ValidateOptions, now time.Time) error {
+	if err := m.checkFormat(); err != nil {
+		return err
+	}
+	if options.Domain != "" && options.Domain != m.Domain {
+		return ErrDomainMismatch
+	}
+	if options.Nonce != "" && options.Nonce != m.Nonce {
+		return ErrNonceMismatch
+	}
+	if options.ChainID != 0 && options.ChainID != m.ChainID {
+		return ErrChainIDMismatch
+	}
+	if m.ExpirationTime != "" {
+		expirationTime, _ := time.Parse(time.RFC3339, m.ExpirationTime)
+		if !now.Before(expirationTime) {
+			return ErrExpired
+		}
+	}
+	if m.NotBefore != "" {
+		notBefore, _ := time.Parse(time.RFC3339, m.NotBefore)
+		if now.Before(notBefore) {
+			return ErrNotYetValid
+		}
+	}
+	return nil
+}
+
+// ParseMessage parses a message in the format it is signed in.
+func ParseMessage(message string) (*Message, error) {
+	lines := strings.Split(message, "\n")
+	if len(lines) < 9 {
+		return nil, ErrInvalidMessage
+	}
+
+	m := &Message{}
+	if !strings.HasSuffix(lines[0], headerSuffix) {
+		return nil, fmt.Errorf("%w: header", ErrInvalidMessage)
+	}
+	m.Domain = strings.TrimSuffix(lines[0], headerSuffix)
+
+	// The address has to be checksummed
+	if !common.IsHexAddress(lines[1]) || common.HexToAddress(lines[1]).Hex() != lines[1] {
+		return nil, fmt.Errorf("%w: address", ErrInvalidMessage)
+	}
+	m.Address = common.HexToAddress(lines[1])
+
+	if lines[2] != "" {
+		return nil, ErrInvalidMessage
+	}
+	i := 4
+	if lines[3] != "" {
+		m.Statement = lines[3]
+		if lines[4] != "" {
+			return nil, ErrInvalidMessage
+		}
+		i = 5
+	}
+
+	field := func(prefix string, optional bool) (string, error) {
+		if i < len(lines) && strings.HasPrefix(lines[i], prefix) {
+			value := strings.TrimPrefix(lines[i], prefix)
+			i++
+			return value, nil
+		}
+		if optional {
+			return "", nil
+		}
+		return "", fmt.Errorf("%w: missing %q", ErrInvalidMessage, strings.TrimSuffix(prefix, ": "))
+	}
+
+	var err error
+	if m.URI, err = field("URI: ", false); err != nil {
+		return nil, err
+	}
+	if m.Version, err = field("Version: ", false); err != nil {
+		return nil, err
+	}
+	chainID, err := field("Chain ID: ", false)
+	if err != nil {
+		return nil, err
+	}
+	if m.ChainID, err = strconv.ParseUint(chainID, 10, 64); err != nil {
+		return nil, fmt.Errorf("%w: chain id", ErrInvalidMessage)
+	}
+	if m.Nonce, err = field("Nonce: ", false); err != nil {
+		return nil, err
+	}
+	if m.IssuedAt, err = field("Issued At: ", false); err != nil {
+		return nil, err
+	}
+	if m.ExpirationTime, err = field("Expiration Time: ", true); err != nil {
+		return nil, err
+	}
+	if m.NotBefore, err = field("Not Before: ", true); err != nil {
+		return nil, err
+	}
+	if m.RequestID, err = field("Request ID: ", true); err != nil {
+		return nil, err
+	}
+	if i < len(lines) && lines[i] == "Resources:" {
+		i++
+		for ; i < len(lines) && strings.HasPrefix(lines[i], "- "); i++ {
+			m.Resources = append(m.Resources, strings.TrimPrefix(lines[i], "- "))
+		}
+	}
+	if i != len(lines) {
+		return nil, fmt.Errorf("%w: unexpected line %q", ErrInvalidMessage, lines[i])
+	}
+
+	if err := m.checkFormat(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
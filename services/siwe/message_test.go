@@ -0,0 +1,83 @@
+package siwe
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testMessage = `service.org wants you to sign in with your Ethereum account:
+0xe5A12547fe4E872D192E3eCecb76F2Ce1aeA4946
+
+I accept the ServiceOrg Terms of Service: https://service.org/tos
+
+URI: https://service.org/login
+Version: 1
+Chain ID: 1
+Nonce: 32891757
+Issued At: 2021-09-30T16:25:24Z
+Expiration Time: 2021-10-01T16:25:24Z
+Resources:
+- ipfs://Qme7ss3ARVgxv6rXqVPiikMJ8u2NLgmgszg13pYrDKEoiu
+- https://example.com/my-web2-claim.json`
+
+func TestParseMessage(t *testing.T) {
+	message, err := ParseMessage(testMessage)
+	require.NoError(t, err)
+	require.Equal(t, "service.org", message.Domain)
+	require.Equal(t, "0xe5A12547fe4E872D192E3eCecb76F2Ce1aeA4946", message.Address.Hex())
+	require.Equal(t, "I accept the ServiceOrg Terms of Service: https://service.org/tos", message.Statement)
+	require.Equal(t, uint64(1), message.ChainID)
+	require.Equal(t, "32891757", message.Nonce)
+	require.Len(t, message.Resources, 2)
+	require.Equal(t, testMessage, message.String())
+
+	// Without statement
+	message.Statement = ""
+	message.Resources = nil
+	parsed, err := ParseMessage(message.String())
+	require.NoError(t, err)
+	require.Equal(t, message, parsed)
+
+	_, err = ParseMessage(testMessage + "\nextra")
+	require.True(t, errors.Is(err, ErrInvalidMessage))
+
+	// The address has to be checksummed
+	_, err = ParseMessage(`service.org wants you to sign in with your Ethereum account:
+0xe5a12547fe4e872d192e3ececb76f2ce1aea4946
+
+
+URI: https://service.org/login
+Version: 1
+Chain ID: 1
+Nonce: 32891757
+Issued At: 2021-09-30T16:25:24Z`)
+	require.True(t, errors.Is(err, ErrInvalidMessage))
+}
+
+func TestValidateMessage(t *testing.T) {
+	message, err := ParseMessage(testMessage)
+	require.NoError(t, err)
+	now := time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	require.NoError(t, message.Validate(ValidateOptions{Domain: "service.org", Nonce: "32891757", ChainID: 1}, now))
+	require.Equal(t, ErrDomainMismatch, message.Validate(ValidateOptions{Domain: "evil.org"}, now))
+	require.Equal(t, ErrNonceMismatch, message.Validate(ValidateOptions{Nonce: "00000000"}, now))
+	require.Equal(t, ErrChainIDMismatch, message.Validate(ValidateOptions{ChainID: 3}, now))
+	require.Equal(t, ErrExpired, message.Validate(ValidateOptions{}, now.Add(24*time.Hour)))
+
+	message.NotBefore = "2021-10-01T12:00:00Z"
+	require.Equal(t, ErrNotYetValid, message.Validate(ValidateOptions{}, now))
+}
+
+func TestGenerateNonce(t *testing.T) {
+	nonce, err := GenerateNonce()
+	require.NoError(t, err)
+	require.Len(t, nonce, nonceLength)
+
+	other, err := GenerateNonce()
+	require.NoError(t, err)
+	require.NotEqual(t, nonce, other)
+}
@@ -0,0 +1,49 @@
+package siwe
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// NewService initializes service instance.
+func NewService(chainID uint64) *Service {
+	return &Service{chainID: chainID}
+}
+
+// Service builds and verifies Sign-In with Ethereum messages.
+type Service struct {
+	chainID uint64
+	client  bind.ContractCaller
+}
+
+// SetClient sets the backend used to verify the signatures of contract accounts.
+func (s *Service) SetClient(client bind.ContractCaller) {
+	s.client = client
+}
+
+// Start a service.
+func (s *Service) Start() error {
+	return nil
+}
+
+// Stop a service.
+func (s *Service) Stop() error {
+	return nil
+}
+
+// APIs returns list of available RPC APIs.
+func (s *Service) APIs() []rpc.API {
+	return []rpc.API{
+		{
+			Namespace: "siwe",
+			Version:   "0.1.0",
+			Service:   NewAPI(s),
+		},
+	}
+}
+
+// Protocols returns list of p2p protocols.
+func (s *Service) Protocols() []p2p.Protocol {
+	return nil
+}
@@ -0,0 +1,87 @@
+package siwe
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+)
+
+// ERC1271ABI is the EIP-1271 interface of the contract accounts.
+const ERC1271ABI = `[{"constant":true,"inputs":[{"name":"_hash","type":"bytes32"},{"name":"_signature","type":"bytes"}],"name":"isValidSignature","outputs":[{"name":"magicValue","type":"bytes4"}],"payable":false,"stateMutability":"view","type":"function"}]`
+
+// erc1271MagicValue is returned by isValidSignature for valid signatures
+var erc1271MagicValue = [4]byte{0x16, 0x26, 0xba, 0x7e}
+
+var erc1271ABI = mustParseABI(ERC1271ABI)
+
+var ErrInvalidSignature = errors.New("invalid signature")
+
+func mustParseABI(definition string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(definition))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+// recoverAddress returns the address which signed the personal message
+// hash, accepting both 0/1 and 27/28 recovery ids.
+func recoverAddress(hash []byte, signature []byte) (common.Address, error) {
+	if len(signature) != 65 {
+		return common.Address{}, ErrInvalidSignature
+	}
+	sig := make([]byte, 65)
+	copy(sig, signature)
+	if sig[64] >= 27 {
+		sig[64] -= 27
+	}
+
+	publicKey, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		return common.Address{}, ErrInvalidSignature
+	}
+	return common.Address(crypto.PubkeyToAddress(*publicKey)), nil
+}
+
+// VerifySignature checks that the personal message has been signed by the
+// address. Signatures which don't recover to the address are checked with
+// EIP-1271 when the address is a contract, e.g. a multisig wallet.
+func VerifySignature(ctx context.Context, caller bind.ContractCaller, address common.Address, message []byte, signature []byte) (bool, error) {
+	hash := accounts.TextHash(message)
+
+	recovered, err := recoverAddress(hash, signature)
+	if err == nil && recovered == address {
+		return true, nil
+	}
+
+	if caller == nil {
+		return false, nil
+	}
+	code, err := caller.CodeAt(ctx, address, nil)
+	if err != nil {
+		return false, err
+	}
+	if len(code) == 0 {
+		return false, nil
+	}
+
+	var digest [32]byte
+	copy(digest[:], hash)
+	var out []interface{}
+	contract := bind.NewBoundContract(address, erc1271ABI, caller, nil, nil)
+	err = contract.Call(&bind.CallOpts{Context: ctx}, &out, "isValidSignature", digest, signature)
+	if err != nil {
+		// Contracts not implementing EIP-1271 revert
+		return false, nil
+	}
+	magicValue := *abi.ConvertType(out[0], new([4]byte)).(*[4]byte)
+	return bytes.Equal(magicValue[:], erc1271MagicValue[:]), nil
+}
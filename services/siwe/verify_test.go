@@ -0,0 +1,65 @@
+package siwe
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/t/helpers"
+)
+
+func sign(t *testing.T, message []byte) (common.Address, []byte) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signature, err := crypto.Sign(accounts.TextHash(message), key)
+	require.NoError(t, err)
+	signature[64] += 27
+	return common.Address(crypto.PubkeyToAddress(key.PublicKey)), signature
+}
+
+// deployTestWallet deploys a contract wallet which accepts the signature of
+// the message by its owner.
+func deployTestWallet(t *testing.T, chain *helpers.SimulatedChain, message []byte, signature []byte) common.Address {
+	wallet, err := chain.DeployStub()
+	require.NoError(t, err)
+
+	var digest [32]byte
+	copy(digest[:], accounts.TextHash(message))
+	require.NoError(t, chain.StubMethod(wallet, erc1271ABI, "isValidSignature",
+		[]interface{}{digest, signature}, erc1271MagicValue))
+	return wallet
+}
+
+func TestVerifySignature(t *testing.T) {
+	chain, err := helpers.NewSimulatedChain()
+	require.NoError(t, err)
+	defer chain.Close() // nolint: errcheck
+
+	ctx := context.Background()
+	message := []byte(testMessage)
+	signer, signature := sign(t, message)
+	wallet := deployTestWallet(t, chain, message, signature)
+
+	valid, err := VerifySignature(ctx, chain, signer, message, signature)
+	require.NoError(t, err)
+	require.True(t, valid)
+
+	valid, err = VerifySignature(ctx, chain, common.Address{2}, message, signature)
+	require.NoError(t, err)
+	require.False(t, valid)
+
+	// The contract wallet accepts the signature of its owner
+	valid, err = VerifySignature(ctx, chain, wallet, message, signature)
+	require.NoError(t, err)
+	require.True(t, valid)
+
+	_, other := sign(t, message)
+	valid, err = VerifySignature(ctx, chain, wallet, message, other)
+	require.NoError(t, err)
+	require.False(t, valid)
+}
@@ -0,0 +1,389 @@
+package stickers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/transactions"
+)
+
+// maxRecentStickers is the number of recently used stickers kept in the settings
+const maxRecentStickers = 24
+
+var (
+	// ErrServiceNotInitialized is returned when the RPC client hasn't been set.
+	ErrServiceNotInitialized = errors.New("stickers service is not initialized")
+	// ErrPackNotFound is returned when the pack isn't registered in the market.
+	ErrPackNotFound = errors.New("sticker pack not found")
+)
+
+func NewAPI(s *Service) *API {
+	return &API{s}
+}
+
+// API is class with methods available over RPC.
+type API struct {
+	s *Service
+}
+
+func (api *API) contracts() (*Contracts, error) {
+	if api.s.client == nil {
+		return nil, ErrServiceNotInitialized
+	}
+	if api.s.contracts == nil {
+		return nil, ErrUnsupportedChain
+	}
+	return api.s.contracts, nil
+}
+
+func (api *API) packData(ctx context.Context, packID *hexutil.Big) (*packData, error) {
+	contracts, err := api.contracts()
+	if err != nil {
+		return nil, err
+	}
+	stickerType := newStickerTypeCaller(contracts.StickerType, api.s.client)
+	callOpts := &bind.CallOpts{Context: ctx, Pending: false}
+
+	count, err := stickerType.PackCount(callOpts)
+	if err != nil {
+		return nil, err
+	}
+	if packID.ToInt().Sign() < 0 || packID.ToInt().Cmp(count) >= 0 {
+		return nil, ErrPackNotFound
+	}
+	return stickerType.GetPackData(callOpts, packID.ToInt())
+}
+
+// Market returns the packs that can be bought in the market, along with their status for the user.
+func (api *API) Market(ctx context.Context) ([]*StickerPack, error) {
+	contracts, err := api.contracts()
+	if err != nil {
+		return nil, err
+	}
+	stickerType := newStickerTypeCaller(contracts.StickerType, api.s.client)
+	callOpts := &bind.CallOpts{Context: ctx, Pending: false}
+
+	count, err := stickerType.PackCount(callOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	installed, err := api.Installed()
+	if err != nil {
+		return nil, err
+	}
+	pending, err := api.Pending()
+	if err != nil {
+		return nil, err
+	}
+	owned, err := api.ownedByWallet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var packs []*StickerPack
+	for i := int64(0); i < count.Int64(); i++ {
+		packID := (*hexutil.Big)(big.NewInt(i))
+		data, err := stickerType.GetPackData(callOpts, packID.ToInt())
+		if err != nil {
+			return nil, err
+		}
+		if !data.Mintable {
+			continue
+		}
+
+		pack, err := api.s.packFromData(ctx, packID, data)
+		if err != nil {
+			// A single unreachable pack shouldn't hide the whole market
+			log.Warn("failed to resolve sticker pack", "packID", packID.ToInt(), "error", err)
+			continue
+		}
+
+		key := packKey(packID)
+		if _, ok := installed[key]; ok {
+			pack.Status = PackStatusInstalled
+		} else if _, ok := pending[key]; ok {
+			pack.Status = PackStatusPending
+		} else if owned[key] {
+			pack.Status = PackStatusPurchased
+		}
+		packs = append(packs, pack)
+	}
+
+	return packs, nil
+}
+
+// Owned returns the ids of the packs owned by the address.
+func (api *API) Owned(ctx context.Context, address common.Address) ([]*hexutil.Big, error) {
+	contracts, err := api.contracts()
+	if err != nil {
+		return nil, err
+	}
+	stickerPack := newStickerPackCaller(contracts.StickerPack, api.s.client)
+	callOpts := &bind.CallOpts{Context: ctx, Pending: false}
+
+	balance, err := stickerPack.BalanceOf(callOpts, address)
+	if err != nil {
+		return nil, err
+	}
+
+	var packIDs []*hexutil.Big
+	for i := int64(0); i < balance.Int64(); i++ {
+		tokenID, err := stickerPack.TokenOfOwnerByIndex(callOpts, address, big.NewInt(i))
+		if err != nil {
+			return nil, err
+		}
+		packID, err := stickerPack.TokenPackID(callOpts, tokenID)
+		if err != nil {
+			return nil, err
+		}
+		packIDs = append(packIDs, (*hexutil.Big)(packID))
+	}
+	return packIDs, nil
+}
+
+func (api *API) ownedByWallet(ctx context.Context) (map[string]bool, error) {
+	addresses, err := api.s.accountsDB.GetWalletAddresses()
+	if err != nil {
+		return nil, err
+	}
+
+	owned := make(map[string]bool)
+	for _, address := range addresses {
+		packIDs, err := api.Owned(ctx, common.Address(address))
+		if err != nil {
+			return nil, err
+		}
+		for _, packID := range packIDs {
+			owned[packKey(packID)] = true
+		}
+	}
+	return owned, nil
+}
+
+// Installed returns the packs installed by the user, indexed by pack id.
+func (api *API) Installed() (map[string]*StickerPack, error) {
+	settings, err := api.s.accountsDB.GetSettings()
+	if err != nil {
+		return nil, err
+	}
+	return decodePacks(settings.StickerPacksInstalled)
+}
+
+// Install resolves the pack and adds it to the installed packs.
+func (api *API) Install(ctx context.Context, packID *hexutil.Big) error {
+	data, err := api.packData(ctx, packID)
+	if err != nil {
+		return err
+	}
+	pack, err := api.s.packFromData(ctx, packID, data)
+	if err != nil {
+		return err
+	}
+	pack.Status = PackStatusInstalled
+
+	api.s.mu.Lock()
+	defer api.s.mu.Unlock()
+
+	installed, err := api.Installed()
+	if err != nil {
+		return err
+	}
+	installed[packKey(packID)] = pack
+	err = api.s.accountsDB.SaveSetting("stickers/packs-installed", installed)
+	if err != nil {
+		return err
+	}
+	return api.removePending(packID)
+}
+
+// Uninstall removes the pack from the installed packs and its stickers from the recent ones.
+func (api *API) Uninstall(packID *hexutil.Big) error {
+	api.s.mu.Lock()
+	defer api.s.mu.Unlock()
+
+	installed, err := api.Installed()
+	if err != nil {
+		return err
+	}
+	delete(installed, packKey(packID))
+	err = api.s.accountsDB.SaveSetting("stickers/packs-installed", installed)
+	if err != nil {
+		return err
+	}
+
+	recent, err := api.Recent()
+	if err != nil {
+		return err
+	}
+	var kept []Sticker
+	for _, sticker := range recent {
+		if sticker.PackID == nil || packKey(sticker.PackID) != packKey(packID) {
+			kept = append(kept, sticker)
+		}
+	}
+	return api.s.accountsDB.SaveSetting("stickers/recent-stickers", kept)
+}
+
+// Pending returns the packs whose purchase transaction hasn't been mined yet.
+func (api *API) Pending() (map[string]*StickerPack, error) {
+	settings, err := api.s.accountsDB.GetSettings()
+	if err != nil {
+		return nil, err
+	}
+	return decodePacks(settings.StickerPacksPending)
+}
+
+// AddPending marks a pack as being purchased.
+func (api *API) AddPending(ctx context.Context, packID *hexutil.Big) error {
+	data, err := api.packData(ctx, packID)
+	if err != nil {
+		return err
+	}
+	pack, err := api.s.packFromData(ctx, packID, data)
+	if err != nil {
+		return err
+	}
+	pack.Status = PackStatusPending
+
+	api.s.mu.Lock()
+	defer api.s.mu.Unlock()
+
+	pending, err := api.Pending()
+	if err != nil {
+		return err
+	}
+	pending[packKey(packID)] = pack
+	return api.s.accountsDB.SaveSetting("stickers/packs-pending", pending)
+}
+
+// RemovePending removes a pack from the pending purchases, for example when the transaction failed.
+func (api *API) RemovePending(packID *hexutil.Big) error {
+	api.s.mu.Lock()
+	defer api.s.mu.Unlock()
+	return api.removePending(packID)
+}
+
+func (api *API) removePending(packID *hexutil.Big) error {
+	pending, err := api.Pending()
+	if err != nil {
+		return err
+	}
+	if _, ok := pending[packKey(packID)]; !ok {
+		return nil
+	}
+	delete(pending, packKey(packID))
+	return api.s.accountsDB.SaveSetting("stickers/packs-pending", pending)
+}
+
+// Recent returns the stickers recently sent by the user, most recent first.
+func (api *API) Recent() ([]Sticker, error) {
+	settings, err := api.s.accountsDB.GetSettings()
+	if err != nil {
+		return nil, err
+	}
+
+	var recent []Sticker
+	if settings.StickersRecentStickers == nil {
+		return recent, nil
+	}
+	err = json.Unmarshal(*settings.StickersRecentStickers, &recent)
+	if err != nil {
+		return nil, err
+	}
+	return recent, nil
+}
+
+// AddRecent moves the sticker at the top of the recent stickers.
+func (api *API) AddRecent(sticker Sticker) error {
+	api.s.mu.Lock()
+	defer api.s.mu.Unlock()
+
+	recent, err := api.Recent()
+	if err != nil {
+		return err
+	}
+
+	updated := []Sticker{sticker}
+	for _, s := range recent {
+		if s.Hash != sticker.Hash && len(updated) < maxRecentStickers {
+			updated = append(updated, s)
+		}
+	}
+	return api.s.accountsDB.SaveSetting("stickers/recent-stickers", updated)
+}
+
+// BuyPrepareTx builds the transaction buying a pack for the address. The SNT price
+// is approved and the pack bought in a single call through SNT's approveAndCall.
+func (api *API) BuyPrepareTx(ctx context.Context, address common.Address, packID *hexutil.Big) (*transactions.SendTxArgs, error) {
+	contracts, err := api.contracts()
+	if err != nil {
+		return nil, err
+	}
+	data, err := api.packData(ctx, packID)
+	if err != nil {
+		return nil, err
+	}
+	if !data.Mintable {
+		return nil, ErrPackNotFound
+	}
+
+	buyToken, err := stickerMarketABI.Pack("buyToken", packID.ToInt(), address, data.Price)
+	if err != nil {
+		return nil, err
+	}
+	input, err := sntABI.Pack("approveAndCall", contracts.StickerMarket, data.Price, buyToken)
+	if err != nil {
+		return nil, err
+	}
+
+	to := types.Address(contracts.SNT)
+	return &transactions.SendTxArgs{
+		From:  types.Address(address),
+		To:    &to,
+		Value: (*hexutil.Big)(big.NewInt(0)),
+		Input: types.HexBytes(input),
+	}, nil
+}
+
+// BuyEstimate returns the gas needed to buy the pack.
+func (api *API) BuyEstimate(ctx context.Context, address common.Address, packID *hexutil.Big) (uint64, error) {
+	txArgs, err := api.BuyPrepareTx(ctx, address, packID)
+	if err != nil {
+		return 0, err
+	}
+	return api.s.client.EstimateGas(ctx, ethereum.CallMsg{
+		From:  common.Address(txArgs.From),
+		To:    (*common.Address)(txArgs.To),
+		Value: txArgs.Value.ToInt(),
+		Data:  txArgs.Input,
+	})
+}
+
+func packKey(packID *hexutil.Big) string {
+	return packID.ToInt().String()
+}
+
+func decodePacks(raw *json.RawMessage) (map[string]*StickerPack, error) {
+	packs := make(map[string]*StickerPack)
+	if raw == nil {
+		return packs, nil
+	}
+	err := json.Unmarshal(*raw, &packs)
+	if err != nil {
+		return nil, err
+	}
+	if packs == nil {
+		packs = make(map[string]*StickerPack)
+	}
+	return packs, nil
+}
@@ -0,0 +1,209 @@
+package stickers
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/status-im/status-go/appdatabase"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/multiaccounts/accounts"
+	"github.com/status-im/status-go/params"
+	"github.com/status-im/status-go/t/helpers"
+)
+
+const testMetadata = `{meta {:name "Test pack" :author "status"
+  :preview "e30101701220aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+  :thumbnail "e30101701220bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+  :stickers [{:hash "e30101701220cccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccc"}
+             {:hash "e30101701220dddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddd"}]}}`
+
+const testPackCID = "e30101701220eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee"
+
+// deployTestMarket deploys the sticker contracts on the chain with a market
+// of two packs, the second one not mintable, and one pack owned by the
+// account of the chain.
+func deployTestMarket(t *testing.T, chain *helpers.SimulatedChain) Contracts {
+	var addresses [4]common.Address
+	for i := range addresses {
+		address, err := chain.DeployStub()
+		require.NoError(t, err)
+		addresses[i] = address
+	}
+	contracts := Contracts{
+		StickerType:   addresses[0],
+		StickerPack:   addresses[1],
+		StickerMarket: addresses[2],
+		SNT:           addresses[3],
+	}
+
+	contenthash, err := hex.DecodeString(testPackCID)
+	require.NoError(t, err)
+	require.NoError(t, chain.StubMethod(contracts.StickerType, stickerTypeABI, "packCount", nil, big.NewInt(2)))
+	for packID, mintable := range []bool{true, false} {
+		require.NoError(t, chain.StubMethod(contracts.StickerType, stickerTypeABI, "getPackData",
+			[]interface{}{big.NewInt(int64(packID))},
+			[][4]byte{}, common.Address{9}, mintable, big.NewInt(1), big.NewInt(1000), contenthash))
+	}
+	require.NoError(t, chain.StubMethod(contracts.StickerPack, stickerPackABI, "balanceOf",
+		[]interface{}{chain.Account}, big.NewInt(1)))
+	require.NoError(t, chain.StubMethod(contracts.StickerPack, stickerPackABI, "tokenOfOwnerByIndex",
+		[]interface{}{chain.Account, big.NewInt(0)}, big.NewInt(42)))
+	require.NoError(t, chain.StubMethod(contracts.StickerPack, stickerPackABI, "tokenPackId",
+		[]interface{}{big.NewInt(42)}, big.NewInt(0)))
+	return contracts
+}
+
+func setupTestAPI(t *testing.T) (*API, *helpers.SimulatedChain, func()) {
+	tmpfile, err := ioutil.TempFile("", "stickers-tests-")
+	require.NoError(t, err)
+	db, err := appdatabase.InitializeDB(tmpfile.Name(), "stickers-tests")
+	require.NoError(t, err)
+
+	chain, err := helpers.NewSimulatedChain()
+	require.NoError(t, err)
+	contracts := deployTestMarket(t, chain)
+
+	accountsDB := accounts.NewDB(db)
+	networks := json.RawMessage("{}")
+	require.NoError(t, accountsDB.CreateSettings(accounts.Settings{
+		Address:        types.HexToAddress("0x1"),
+		CurrentNetwork: "mainnet_rpc",
+		InstallationID: "d3efcff6-cffa-560e-a547-21d3858cbc51",
+		KeyUID:         "0x4e8129f3edfc004875be17bf468a784098a9f69b53c095be1f52deff286935ab",
+		Name:           "Jittery Cornflowerblue Kingbird",
+		Networks:       &networks,
+	}, params.NodeConfig{NetworkID: 1}))
+	require.NoError(t, accountsDB.SaveAccounts([]accounts.Account{{Address: types.Address(chain.Account), Wallet: true}}))
+
+	gateway := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testMetadata))
+	}))
+
+	service := NewService(accountsDB, 1)
+	service.contracts = &contracts
+	service.ipfsGateway = gateway.URL + "/ipfs/"
+	service.SetClient(chain)
+
+	return NewAPI(service), chain, func() {
+		gateway.Close()
+		require.NoError(t, chain.Close())
+		require.NoError(t, db.Close())
+		require.NoError(t, os.Remove(tmpfile.Name()))
+	}
+}
+
+func TestDecodePackMetadata(t *testing.T) {
+	metadata, err := decodePackMetadata([]byte(testMetadata))
+	require.NoError(t, err)
+	require.Equal(t, "Test pack", metadata.Name)
+	require.Equal(t, "status", metadata.Author)
+	require.Len(t, metadata.Stickers, 2)
+
+	_, err = decodePackMetadata([]byte(`{:meta [`))
+	require.Error(t, err)
+	_, err = decodePackMetadata([]byte(`[1 2]`))
+	require.Equal(t, ErrInvalidMetadata, err)
+}
+
+func TestMarket(t *testing.T) {
+	api, _, stop := setupTestAPI(t)
+	defer stop()
+
+	packs, err := api.Market(context.Background())
+	require.NoError(t, err)
+	// The second pack isn't mintable
+	require.Len(t, packs, 1)
+	require.Equal(t, "Test pack", packs[0].Name)
+	require.Equal(t, int64(1000), packs[0].Price.ToInt().Int64())
+	require.Equal(t, PackStatusPurchased, packs[0].Status)
+	require.Len(t, packs[0].Stickers, 2)
+	require.Contains(t, packs[0].Stickers[0].URL, "/ipfs/")
+}
+
+func TestInstallAndRecent(t *testing.T) {
+	api, _, stop := setupTestAPI(t)
+	defer stop()
+	packID := (*hexutil.Big)(big.NewInt(0))
+
+	require.NoError(t, api.AddPending(context.Background(), packID))
+	pending, err := api.Pending()
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+
+	require.NoError(t, api.Install(context.Background(), packID))
+	installed, err := api.Installed()
+	require.NoError(t, err)
+	require.Len(t, installed, 1)
+	require.Equal(t, PackStatusInstalled, installed["0"].Status)
+	pending, err = api.Pending()
+	require.NoError(t, err)
+	require.Len(t, pending, 0)
+
+	require.Equal(t, ErrPackNotFound, api.Install(context.Background(), (*hexutil.Big)(big.NewInt(5))))
+
+	first := installed["0"].Stickers[0]
+	second := installed["0"].Stickers[1]
+	require.NoError(t, api.AddRecent(first))
+	require.NoError(t, api.AddRecent(second))
+	require.NoError(t, api.AddRecent(first))
+	recent, err := api.Recent()
+	require.NoError(t, err)
+	require.Equal(t, []string{first.Hash, second.Hash}, []string{recent[0].Hash, recent[1].Hash})
+
+	require.NoError(t, api.Uninstall(packID))
+	installed, err = api.Installed()
+	require.NoError(t, err)
+	require.Len(t, installed, 0)
+	recent, err = api.Recent()
+	require.NoError(t, err)
+	require.Len(t, recent, 0)
+}
+
+func TestBuyPrepareTx(t *testing.T) {
+	api, chain, stop := setupTestAPI(t)
+	defer stop()
+	contracts := api.s.contracts
+
+	tx, err := api.BuyPrepareTx(context.Background(), chain.Account, (*hexutil.Big)(big.NewInt(0)))
+	require.NoError(t, err)
+	require.Equal(t, types.Address(contracts.SNT), *tx.To)
+	require.Equal(t, types.Address(chain.Account), tx.From)
+
+	method, err := sntABI.MethodById(tx.Input[:4])
+	require.NoError(t, err)
+	require.Equal(t, "approveAndCall", method.Name)
+	args, err := method.Inputs.Unpack(tx.Input[4:])
+	require.NoError(t, err)
+	require.Equal(t, contracts.StickerMarket, args[0].(common.Address))
+	require.Equal(t, int64(1000), args[1].(*big.Int).Int64())
+
+	buyToken, err := stickerMarketABI.MethodById(args[2].([]byte)[:4])
+	require.NoError(t, err)
+	require.Equal(t, "buyToken", buyToken.Name)
+
+	gas, err := api.BuyEstimate(context.Background(), chain.Account, (*hexutil.Big)(big.NewInt(0)))
+	require.NoError(t, err)
+	require.Greater(t, gas, uint64(21000))
+
+	// The prepared transaction can be sent as is
+	_, err = chain.Transact(common.Address(*tx.To), tx.Input)
+	require.NoError(t, err)
+
+	// Packs that aren't mintable can't be bought
+	_, err = api.BuyPrepareTx(context.Background(), chain.Account, (*hexutil.Big)(big.NewInt(1)))
+	require.Equal(t, ErrPackNotFound, err)
+	_, err = api.BuyEstimate(context.Background(), chain.Account, (*hexutil.Big)(big.NewInt(1)))
+	require.Equal(t, ErrPackNotFound, err)
+}
@@ -0,0 +1,141 @@
+package stickers
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ErrUnsupportedChain is returned when the sticker contracts are not deployed on the network
+var ErrUnsupportedChain = errors.New("sticker contracts are not deployed on this network")
+
+// StickerTypeABI is the subset of the StickerType contract ABI used to read the packs registered in the market.
+const StickerTypeABI = `[{"constant":true,"inputs":[],"name":"packCount","outputs":[{"name":"","type":"uint256"}],"payable":false,"stateMutability":"view","type":"function"},{"constant":true,"inputs":[{"name":"_packId","type":"uint256"}],"name":"getPackData","outputs":[{"name":"category","type":"bytes4[]"},{"name":"owner","type":"address"},{"name":"mintable","type":"bool"},{"name":"timestamp","type":"uint256"},{"name":"price","type":"uint256"},{"name":"contenthash","type":"bytes"}],"payable":false,"stateMutability":"view","type":"function"}]`
+
+// StickerPackABI is the subset of the StickerPack ERC-721 contract ABI used to list the packs owned by an account.
+const StickerPackABI = `[{"constant":true,"inputs":[{"name":"owner","type":"address"}],"name":"balanceOf","outputs":[{"name":"","type":"uint256"}],"payable":false,"stateMutability":"view","type":"function"},{"constant":true,"inputs":[{"name":"owner","type":"address"},{"name":"index","type":"uint256"}],"name":"tokenOfOwnerByIndex","outputs":[{"name":"","type":"uint256"}],"payable":false,"stateMutability":"view","type":"function"},{"constant":true,"inputs":[{"name":"","type":"uint256"}],"name":"tokenPackId","outputs":[{"name":"","type":"uint256"}],"payable":false,"stateMutability":"view","type":"function"}]`
+
+// StickerMarketABI is the subset of the StickerMarket contract ABI used to buy packs.
+const StickerMarketABI = `[{"constant":false,"inputs":[{"name":"_packId","type":"uint256"},{"name":"_destination","type":"address"},{"name":"_price","type":"uint256"}],"name":"buyToken","outputs":[{"name":"tokenId","type":"uint256"}],"payable":false,"stateMutability":"nonpayable","type":"function"}]`
+
+// SNTABI is the subset of the SNT MiniMe token ABI used to pay for packs in a single transaction.
+const SNTABI = `[{"constant":false,"inputs":[{"name":"_spender","type":"address"},{"name":"_amount","type":"uint256"},{"name":"_extraData","type":"bytes"}],"name":"approveAndCall","outputs":[{"name":"success","type":"bool"}],"payable":false,"stateMutability":"nonpayable","type":"function"}]`
+
+// Contracts are the addresses of the contracts of the sticker market on a given network.
+type Contracts struct {
+	StickerType   common.Address
+	StickerPack   common.Address
+	StickerMarket common.Address
+	SNT           common.Address
+}
+
+var contractsByChainID = map[uint64]Contracts{
+	1: {
+		StickerType:   common.HexToAddress("0x0577215622f43a39f4bc9640806dfea9b10d2a36"),
+		StickerPack:   common.HexToAddress("0x110101156e8F0743948B2A61aFcf3994A8Fb172e"),
+		StickerMarket: common.HexToAddress("0x12824271339304d3a9f7e096e62a2a7e73b4a7e7"),
+		SNT:           common.HexToAddress("0x744d70fdbe2ba4cf95131626614a1763df805b9e"),
+	},
+}
+
+// ContractsByChainID returns the addresses of the sticker contracts deployed on the network.
+func ContractsByChainID(chainID uint64) (Contracts, error) {
+	contracts, ok := contractsByChainID[chainID]
+	if !ok {
+		return Contracts{}, ErrUnsupportedChain
+	}
+	return contracts, nil
+}
+
+var (
+	stickerTypeABI   = mustParseABI(StickerTypeABI)
+	stickerPackABI   = mustParseABI(StickerPackABI)
+	stickerMarketABI = mustParseABI(StickerMarketABI)
+	sntABI           = mustParseABI(SNTABI)
+)
+
+func mustParseABI(definition string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(definition))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+// packData is the data stored in the StickerType contract for a pack.
+type packData struct {
+	Category    [][4]byte
+	Owner       common.Address
+	Mintable    bool
+	Timestamp   *big.Int
+	Price       *big.Int
+	Contenthash []byte
+}
+
+// stickerTypeCaller reads the packs registered in the market.
+type stickerTypeCaller struct {
+	contract *bind.BoundContract
+}
+
+func newStickerTypeCaller(address common.Address, caller bind.ContractCaller) *stickerTypeCaller {
+	return &stickerTypeCaller{contract: bind.NewBoundContract(address, stickerTypeABI, caller, nil, nil)}
+}
+
+func (c *stickerTypeCaller) PackCount(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := c.contract.Call(opts, &out, "packCount")
+	if err != nil {
+		return nil, err
+	}
+	return *abi.ConvertType(out[0], new(*big.Int)).(**big.Int), nil
+}
+
+func (c *stickerTypeCaller) GetPackData(opts *bind.CallOpts, packID *big.Int) (*packData, error) {
+	var out []interface{}
+	err := c.contract.Call(opts, &out, "getPackData", packID)
+	if err != nil {
+		return nil, err
+	}
+	return &packData{
+		Category:    *abi.ConvertType(out[0], new([][4]byte)).(*[][4]byte),
+		Owner:       *abi.ConvertType(out[1], new(common.Address)).(*common.Address),
+		Mintable:    *abi.ConvertType(out[2], new(bool)).(*bool),
+		Timestamp:   *abi.ConvertType(out[3], new(*big.Int)).(**big.Int),
+		Price:       *abi.ConvertType(out[4], new(*big.Int)).(**big.Int),
+		Contenthash: *abi.ConvertType(out[5], new([]byte)).(*[]byte),
+	}, nil
+}
+
+// stickerPackCaller reads the pack tokens owned by an account.
+type stickerPackCaller struct {
+	contract *bind.BoundContract
+}
+
+func newStickerPackCaller(address common.Address, caller bind.ContractCaller) *stickerPackCaller {
+	return &stickerPackCaller{contract: bind.NewBoundContract(address, stickerPackABI, caller, nil, nil)}
+}
+
+func (c *stickerPackCaller) call(opts *bind.CallOpts, method string, params ...interface{}) (*big.Int, error) {
+	var out []interface{}
+	err := c.contract.Call(opts, &out, method, params...)
+	if err != nil {
+		return nil, err
+	}
+	return *abi.ConvertType(out[0], new(*big.Int)).(**big.Int), nil
+}
+
+func (c *stickerPackCaller) BalanceOf(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	return c.call(opts, "balanceOf", owner)
+}
+
+func (c *stickerPackCaller) TokenOfOwnerByIndex(opts *bind.CallOpts, owner common.Address, index *big.Int) (*big.Int, error) {
+	return c.call(opts, "tokenOfOwnerByIndex", owner, index)
+}
+
+func (c *stickerPackCaller) TokenPackID(opts *bind.CallOpts, tokenID *big.Int) (*big.Int, error) {
+	return c.call(opts, "tokenPackId", tokenID)
+}
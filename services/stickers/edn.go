@@ -0,0 +1,188 @@
+package stickers
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var errUnexpectedEOF = errors.New("edn: unexpected end of input")
+
+// parseEDN parses the subset of EDN used by sticker pack metadata:
+// maps, vectors, lists, strings, keywords, symbols, numbers, booleans and nil.
+// Keywords and symbols used as map keys are returned without their colon.
+func parseEDN(data []byte) (interface{}, error) {
+	p := &ednParser{data: string(data)}
+	value, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+	p.skipWhitespace()
+	if p.pos != len(p.data) {
+		return nil, fmt.Errorf("edn: unexpected data at offset %d", p.pos)
+	}
+	return value, nil
+}
+
+type ednParser struct {
+	data string
+	pos  int
+}
+
+func (p *ednParser) skipWhitespace() {
+	for p.pos < len(p.data) {
+		c := p.data[p.pos]
+		switch {
+		case c == ',' || unicode.IsSpace(rune(c)):
+			p.pos++
+		case c == ';':
+			for p.pos < len(p.data) && p.data[p.pos] != '\n' {
+				p.pos++
+			}
+		default:
+			return
+		}
+	}
+}
+
+func (p *ednParser) parseValue() (interface{}, error) {
+	p.skipWhitespace()
+	if p.pos >= len(p.data) {
+		return nil, errUnexpectedEOF
+	}
+
+	switch c := p.data[p.pos]; c {
+	case '{':
+		p.pos++
+		return p.parseMap()
+	case '[', '(':
+		p.pos++
+		closing := byte(']')
+		if c == '(' {
+			closing = ')'
+		}
+		return p.parseSequence(closing)
+	case '"':
+		p.pos++
+		return p.parseString()
+	case ':':
+		p.pos++
+		return p.parseToken(), nil
+	default:
+		return p.parseAtom()
+	}
+}
+
+func (p *ednParser) parseMap() (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+	for {
+		p.skipWhitespace()
+		if p.pos >= len(p.data) {
+			return nil, errUnexpectedEOF
+		}
+		if p.data[p.pos] == '}' {
+			p.pos++
+			return result, nil
+		}
+
+		key, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		result[fmt.Sprint(key)] = value
+	}
+}
+
+func (p *ednParser) parseSequence(closing byte) ([]interface{}, error) {
+	var result []interface{}
+	for {
+		p.skipWhitespace()
+		if p.pos >= len(p.data) {
+			return nil, errUnexpectedEOF
+		}
+		if p.data[p.pos] == closing {
+			p.pos++
+			return result, nil
+		}
+
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, value)
+	}
+}
+
+func (p *ednParser) parseString() (string, error) {
+	var builder strings.Builder
+	for p.pos < len(p.data) {
+		c := p.data[p.pos]
+		p.pos++
+		switch c {
+		case '"':
+			return builder.String(), nil
+		case '\\':
+			if p.pos >= len(p.data) {
+				return "", errUnexpectedEOF
+			}
+			escaped := p.data[p.pos]
+			p.pos++
+			switch escaped {
+			case 'n':
+				builder.WriteByte('\n')
+			case 't':
+				builder.WriteByte('\t')
+			case 'r':
+				builder.WriteByte('\r')
+			default:
+				builder.WriteByte(escaped)
+			}
+		default:
+			builder.WriteByte(c)
+		}
+	}
+	return "", errUnexpectedEOF
+}
+
+func (p *ednParser) parseToken() string {
+	start := p.pos
+	for p.pos < len(p.data) {
+		c := p.data[p.pos]
+		if c == ',' || unicode.IsSpace(rune(c)) || strings.IndexByte("{}[]()\";", c) != -1 {
+			break
+		}
+		p.pos++
+	}
+	return p.data[start:p.pos]
+}
+
+func (p *ednParser) parseAtom() (interface{}, error) {
+	token := p.parseToken()
+	if token == "" {
+		return nil, fmt.Errorf("edn: unexpected character %q at offset %d", p.data[p.pos], p.pos)
+	}
+
+	switch token {
+	case "nil":
+		return nil, nil
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	}
+
+	if i, err := strconv.ParseInt(token, 10, 64); err == nil {
+		return i, nil
+	}
+	if f, err := strconv.ParseFloat(token, 64); err == nil {
+		return f, nil
+	}
+	// Symbols are returned as plain strings
+	return token, nil
+}
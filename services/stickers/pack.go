@@ -0,0 +1,190 @@
+package stickers
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	ens "github.com/wealdtech/go-ens/v3"
+)
+
+const maxMetadataSize = 1024 * 1024
+
+// ErrInvalidMetadata is returned when the metadata of a pack can't be decoded.
+var ErrInvalidMetadata = errors.New("invalid sticker pack metadata")
+
+// PackStatus is the state of a pack for the current user.
+type PackStatus int
+
+const (
+	// PackStatusAvailable packs can be bought in the market
+	PackStatusAvailable PackStatus = iota
+	// PackStatusPending packs have been bought but the transaction isn't mined yet
+	PackStatusPending
+	// PackStatusPurchased packs are owned by one of the user's accounts
+	PackStatusPurchased
+	// PackStatusInstalled packs are purchased or free packs the user installed
+	PackStatusInstalled
+)
+
+// Sticker is a single image of a pack.
+type Sticker struct {
+	PackID *hexutil.Big `json:"packID,omitempty"`
+	URL    string       `json:"url,omitempty"`
+	Hash   string       `json:"hash,omitempty"`
+}
+
+// StickerPack is a pack registered in the sticker market along with its metadata.
+type StickerPack struct {
+	ID        *hexutil.Big   `json:"id"`
+	Name      string         `json:"name"`
+	Author    string         `json:"author"`
+	Owner     common.Address `json:"owner,omitempty"`
+	Price     *hexutil.Big   `json:"price"`
+	Preview   string         `json:"preview"`
+	Thumbnail string         `json:"thumbnail"`
+	Stickers  []Sticker      `json:"stickers"`
+	Status    PackStatus     `json:"status"`
+}
+
+// packMetadata is the EDN document pointed to by the contenthash of a pack.
+type packMetadata struct {
+	Name      string
+	Author    string
+	Preview   string
+	Thumbnail string
+	Stickers  []string
+}
+
+// decodePackMetadata extracts the fields of the EDN metadata of a pack:
+// {meta {:name "" :author "" :thumbnail "" :preview "" :stickers [{:hash ""}]}}
+func decodePackMetadata(data []byte) (*packMetadata, error) {
+	value, err := parseEDN(data)
+	if err != nil {
+		return nil, err
+	}
+
+	root, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, ErrInvalidMetadata
+	}
+	meta, ok := root["meta"].(map[string]interface{})
+	if !ok {
+		return nil, ErrInvalidMetadata
+	}
+
+	metadata := &packMetadata{}
+	metadata.Name, _ = meta["name"].(string)
+	metadata.Author, _ = meta["author"].(string)
+	metadata.Preview, _ = meta["preview"].(string)
+	metadata.Thumbnail, _ = meta["thumbnail"].(string)
+
+	stickers, _ := meta["stickers"].([]interface{})
+	for _, s := range stickers {
+		sticker, ok := s.(map[string]interface{})
+		if !ok {
+			return nil, ErrInvalidMetadata
+		}
+		hash, ok := sticker["hash"].(string)
+		if !ok {
+			return nil, ErrInvalidMetadata
+		}
+		metadata.Stickers = append(metadata.Stickers, hash)
+	}
+
+	return metadata, nil
+}
+
+// contenthashToURL returns the URL of an IPFS EIP-1577 contenthash on the gateway.
+func contenthashToURL(gateway string, contenthash []byte) (string, error) {
+	resource, err := ens.ContenthashToString(contenthash)
+	if err != nil {
+		return "", err
+	}
+	if !strings.HasPrefix(resource, "ipfs://") {
+		return "", fmt.Errorf("unsupported contenthash %s", resource)
+	}
+	return gateway + strings.TrimPrefix(resource, "ipfs://"), nil
+}
+
+// hexContenthashToURL is contenthashToURL for the hex encoded hashes used in pack metadata.
+func hexContenthashToURL(gateway string, hash string) (string, error) {
+	contenthash, err := hex.DecodeString(strings.TrimPrefix(hash, "0x"))
+	if err != nil {
+		return "", err
+	}
+	return contenthashToURL(gateway, contenthash)
+}
+
+func (s *Service) fetchPackMetadata(ctx context.Context, contenthash []byte) (*packMetadata, error) {
+	url, err := contenthashToURL(s.ipfsGateway, contenthash)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch sticker pack metadata from %s: %s", url, resp.Status)
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(nil, resp.Body, maxMetadataSize))
+	if err != nil {
+		return nil, err
+	}
+	return decodePackMetadata(body)
+}
+
+// packFromData builds a pack from the data stored in the market and its metadata.
+func (s *Service) packFromData(ctx context.Context, packID *hexutil.Big, data *packData) (*StickerPack, error) {
+	metadata, err := s.fetchPackMetadata(ctx, data.Contenthash)
+	if err != nil {
+		return nil, err
+	}
+
+	pack := &StickerPack{
+		ID:     packID,
+		Name:   metadata.Name,
+		Author: metadata.Author,
+		Owner:  data.Owner,
+		Price:  (*hexutil.Big)(data.Price),
+	}
+
+	pack.Preview, err = hexContenthashToURL(s.ipfsGateway, metadata.Preview)
+	if err != nil {
+		return nil, err
+	}
+	pack.Thumbnail, err = hexContenthashToURL(s.ipfsGateway, metadata.Thumbnail)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, hash := range metadata.Stickers {
+		url, err := hexContenthashToURL(s.ipfsGateway, hash)
+		if err != nil {
+			return nil, err
+		}
+		pack.Stickers = append(pack.Stickers, Sticker{
+			PackID: packID,
+			URL:    url,
+			Hash:   hash,
+		})
+	}
+
+	return pack, nil
+}
@@ -0,0 +1,75 @@
+package stickers
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/status-im/status-go/multiaccounts/accounts"
+)
+
+// DefaultIPFSGateway is the gateway used to resolve the content of the packs.
+const DefaultIPFSGateway = "https://ipfs.infura.io/ipfs/"
+
+const requestTimeout = 10 * time.Second
+
+// NewService initializes service instance.
+func NewService(accountsDB *accounts.Database, chainID uint64) *Service {
+	s := &Service{
+		accountsDB:  accountsDB,
+		ipfsGateway: DefaultIPFSGateway,
+		httpClient:  &http.Client{Timeout: requestTimeout},
+	}
+	if contracts, err := ContractsByChainID(chainID); err == nil {
+		s.contracts = &contracts
+	}
+	return s
+}
+
+// Service reads the sticker market and keeps track of the packs of the user.
+type Service struct {
+	accountsDB  *accounts.Database
+	client      bind.ContractBackend
+	contracts   *Contracts
+	ipfsGateway string
+	httpClient  *http.Client
+
+	// mu serializes the updates of the packs stored in the settings
+	mu sync.Mutex
+}
+
+// SetClient sets the backend used to call the sticker contracts and estimate
+// the transactions sent to them.
+func (s *Service) SetClient(client bind.ContractBackend) {
+	s.client = client
+}
+
+// Start a service.
+func (s *Service) Start() error {
+	return nil
+}
+
+// Stop a service.
+func (s *Service) Stop() error {
+	return nil
+}
+
+// APIs returns list of available RPC APIs.
+func (s *Service) APIs() []rpc.API {
+	return []rpc.API{
+		{
+			Namespace: "stickers",
+			Version:   "0.1.0",
+			Service:   NewAPI(s),
+		},
+	}
+}
+
+// Protocols returns list of p2p protocols.
+func (s *Service) Protocols() []p2p.Protocol {
+	return nil
+}
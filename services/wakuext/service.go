@@ -7,6 +7,7 @@ import (
 
 	"github.com/status-im/status-go/eth-node/types"
 	"github.com/status-im/status-go/params"
+	"github.com/status-im/status-go/peers"
 	"github.com/status-im/status-go/services/ext"
 )
 
@@ -15,7 +16,7 @@ type Service struct {
 	w types.Waku
 }
 
-func New(config params.ShhextConfig, n types.Node, handler ext.EnvelopeEventsHandler, ldb *leveldb.DB) *Service {
+func New(config params.ShhextConfig, n types.Node, handler ext.EnvelopeEventsHandler, ldb *leveldb.DB, reputation *peers.Reputation) *Service {
 	w, err := n.GetWaku(nil)
 	if err != nil {
 		panic(err)
@@ -27,7 +28,7 @@ func New(config params.ShhextConfig, n types.Node, handler ext.EnvelopeEventsHan
 	requestsRegistry := ext.NewRequestsRegistry(delay)
 	mailMonitor := ext.NewMailRequestMonitor(w, handler, requestsRegistry)
 	return &Service{
-		Service: ext.New(config, n, ldb, mailMonitor, w),
+		Service: ext.New(config, n, ldb, reputation, mailMonitor, w),
 		w:       w,
 	}
 }
@@ -0,0 +1,221 @@
+package wallet
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	statustypes "github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/services/wallet/ierc20"
+	"github.com/status-im/status-go/transactions"
+)
+
+// Allowance is the amount of a token that a spender can transfer from the account of the owner.
+type Allowance struct {
+	Owner   common.Address `json:"owner"`
+	Token   common.Address `json:"token"`
+	Spender common.Address `json:"spender"`
+	Amount  *hexutil.Big   `json:"amount"`
+	// BlockNumber is the block at which the amount was last updated
+	BlockNumber *hexutil.Big `json:"blockNumber"`
+}
+
+// allowanceFromLog parses an ERC-20 Approval event. ERC-721 tokens emit an event
+// with the same signature but with the token ID indexed, which isn't an allowance.
+func allowanceFromLog(l types.Log) (*Allowance, bool) {
+	if len(l.Topics) != 3 || len(l.Data) != 32 {
+		return nil, false
+	}
+	return &Allowance{
+		Owner:       common.BytesToAddress(l.Topics[1].Bytes()),
+		Token:       l.Address,
+		Spender:     common.BytesToAddress(l.Topics[2].Bytes()),
+		Amount:      (*hexutil.Big)(new(big.Int).SetBytes(l.Data)),
+		BlockNumber: (*hexutil.Big)(new(big.Int).SetUint64(l.BlockNumber)),
+	}, true
+}
+
+// blocksFromApprovals returns the headers of the blocks with approvals given by the address.
+func blocksFromApprovals(logs []types.Log, address common.Address) []*DBHeader {
+	headers := []*DBHeader{}
+	for _, l := range logs {
+		if l.Removed {
+			continue
+		}
+		allowance, ok := allowanceFromLog(l)
+		if !ok || allowance.Owner != address {
+			continue
+		}
+		headers = append(headers, &DBHeader{
+			Number:     new(big.Int).SetUint64(l.BlockNumber),
+			Hash:       l.BlockHash,
+			Allowances: []*Allowance{allowance},
+		})
+	}
+	return headers
+}
+
+// refreshAllowances reads the current amounts of the allowances from the token contracts
+// at the block. Allowances which can't be read keep their last known amount.
+func refreshAllowances(parent context.Context, client bind.ContractCaller, blockNumber *big.Int, allowances []*Allowance) ([]*Allowance, error) {
+	var (
+		group = NewAtomicGroup(parent)
+		mu    sync.Mutex
+		rst   = make([]*Allowance, 0, len(allowances))
+	)
+	for _, allowance := range allowances {
+		allowance := allowance
+		caller, err := ierc20.NewIERC20Caller(allowance.Token, client)
+		if err != nil {
+			return nil, err
+		}
+		group.Add(func(parent context.Context) error {
+			ctx, cancel := context.WithTimeout(parent, requestTimeout)
+			amount, err := caller.Allowance(&bind.CallOpts{
+				Context:     ctx,
+				BlockNumber: blockNumber,
+			}, allowance.Owner, allowance.Spender)
+			cancel()
+			if err != nil {
+				log.Error("can't fetch erc20 allowance", "owner", allowance.Owner, "token", allowance.Token, "spender", allowance.Spender, "error", err)
+				return nil
+			}
+			mu.Lock()
+			rst = append(rst, &Allowance{
+				Owner:       allowance.Owner,
+				Token:       allowance.Token,
+				Spender:     allowance.Spender,
+				Amount:      (*hexutil.Big)(amount),
+				BlockNumber: (*hexutil.Big)(blockNumber),
+			})
+			mu.Unlock()
+			return nil
+		})
+	}
+	select {
+	case <-group.WaitAsync():
+	case <-parent.Done():
+		return nil, parent.Err()
+	}
+	return rst, group.Error()
+}
+
+// buildRevokeTransactions builds the transactions setting the allowances to zero,
+// with sequential nonces starting at the pending nonce of the owner so that they can
+// be sent in a batch.
+func buildRevokeTransactions(ctx context.Context, nonceProvider transactions.PendingNonceProvider, owner common.Address, allowances []Allowance) ([]transactions.SendTxArgs, error) {
+	if len(allowances) == 0 {
+		return nil, nil
+	}
+	erc20ABI, err := abi.JSON(strings.NewReader(ierc20.IERC20ABI))
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := nonceProvider.PendingNonceAt(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	rst := make([]transactions.SendTxArgs, len(allowances))
+	for i, allowance := range allowances {
+		input, err := erc20ABI.Pack("approve", allowance.Spender, big.NewInt(0))
+		if err != nil {
+			return nil, err
+		}
+		to := statustypes.Address(allowance.Token)
+		txNonce := hexutil.Uint64(nonce + uint64(i))
+		rst[i] = transactions.SendTxArgs{
+			From:  statustypes.Address(owner),
+			To:    &to,
+			Value: (*hexutil.Big)(big.NewInt(0)),
+			Nonce: &txNonce,
+			Input: statustypes.HexBytes(input),
+		}
+	}
+	return rst, nil
+}
+
+// GetAllowances returns the allowances given by the owner, including revoked ones.
+func (db *Database) GetAllowances(owner common.Address) ([]*Allowance, error) {
+	rows, err := db.db.Query("SELECT token, spender, amount, blk_number FROM allowances WHERE network_id = ? AND owner = ?", db.network, owner)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rst []*Allowance
+	for rows.Next() {
+		allowance := &Allowance{
+			Owner:       owner,
+			Amount:      (*hexutil.Big)(new(big.Int)),
+			BlockNumber: (*hexutil.Big)(new(big.Int)),
+		}
+		err := rows.Scan(&allowance.Token, &allowance.Spender, (*SQLBigIntBytes)(allowance.Amount), (*SQLBigInt)(allowance.BlockNumber))
+		if err != nil {
+			return nil, err
+		}
+		rst = append(rst, allowance)
+	}
+	return rst, rows.Err()
+}
+
+// SaveAllowances stores the amounts of the allowances, unless they were updated at a later block.
+func (db *Database) SaveAllowances(allowances []*Allowance) (err error) {
+	tx, err := db.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		_ = tx.Rollback()
+	}()
+	return saveAllowances(tx, db.network, allowances)
+}
+
+func saveAllowances(creator statementCreator, network uint64, allowances []*Allowance) error {
+	update, err := creator.Prepare(`UPDATE allowances
+	SET amount = ?, blk_number = ?
+	WHERE network_id = ? AND owner = ? AND token = ? AND spender = ? AND blk_number <= ?`)
+	if err != nil {
+		return err
+	}
+
+	insert, err := creator.Prepare(`INSERT OR IGNORE INTO allowances
+	(network_id, owner, token, spender, amount, blk_number)
+	VALUES
+	(?, ?, ?, ?, ?, ?)`)
+	if err != nil {
+		return err
+	}
+
+	for _, a := range allowances {
+		res, err := update.Exec((*SQLBigIntBytes)(a.Amount), (*SQLBigInt)(a.BlockNumber), network, a.Owner, a.Token, a.Spender, (*SQLBigInt)(a.BlockNumber))
+		if err != nil {
+			return err
+		}
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if affected > 0 {
+			continue
+		}
+
+		_, err = insert.Exec(network, a.Owner, a.Token, a.Spender, (*SQLBigIntBytes)(a.Amount), (*SQLBigInt)(a.BlockNumber))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/status-im/status-go/services/wallet/ierc20"
+)
+
+func approvalLog(token, owner, spender common.Address, amount int64, block uint64) types.Log {
+	return types.Log{
+		Address: token,
+		Topics: []common.Hash{
+			crypto.Keccak256Hash([]byte(erc20ApprovalEventSignature)),
+			common.BytesToHash(owner.Bytes()),
+			common.BytesToHash(spender.Bytes()),
+		},
+		Data:        common.LeftPadBytes(big.NewInt(amount).Bytes(), 32),
+		BlockNumber: block,
+		BlockHash:   common.Hash{byte(block)},
+	}
+}
+
+func TestBlocksFromApprovals(t *testing.T) {
+	owner, token, spender := common.Address{1}, common.Address{2}, common.Address{3}
+	nft := approvalLog(token, owner, spender, 0, 11)
+	nft.Topics = append(nft.Topics, common.Hash{7})
+	nft.Data = nil
+	removed := approvalLog(token, owner, spender, 5, 12)
+	removed.Removed = true
+
+	headers := blocksFromApprovals([]types.Log{
+		approvalLog(token, owner, spender, 10, 10),
+		nft,
+		removed,
+		approvalLog(token, spender, owner, 10, 13),
+	}, owner)
+	require.Len(t, headers, 1)
+	require.Equal(t, big.NewInt(10), headers[0].Number)
+	require.Equal(t, []*Allowance{{
+		Owner:       owner,
+		Token:       token,
+		Spender:     spender,
+		Amount:      (*hexutil.Big)(big.NewInt(10)),
+		BlockNumber: (*hexutil.Big)(big.NewInt(10)),
+	}}, headers[0].Allowances)
+}
+
+func TestDBAllowances(t *testing.T) {
+	db, stop := setupTestDB(t)
+	defer stop()
+
+	owner, token, spender := common.Address{1}, common.Address{2}, common.Address{3}
+	headers := append(
+		blocksFromApprovals([]types.Log{approvalLog(token, owner, spender, 100, 20)}, owner),
+		blocksFromApprovals([]types.Log{approvalLog(token, owner, common.Address{4}, 0, 21)}, owner)...,
+	)
+	require.NoError(t, db.SaveBlocks(owner, headers))
+
+	allowances, err := db.GetAllowances(owner)
+	require.NoError(t, err)
+	require.Len(t, allowances, 2)
+
+	// An approval found later in an older block doesn't override the amount
+	require.NoError(t, db.SaveBlocks(owner, blocksFromApprovals([]types.Log{approvalLog(token, owner, spender, 50, 15)}, owner)))
+	allowances, err = db.GetAllowances(owner)
+	require.NoError(t, err)
+	for _, allowance := range allowances {
+		if allowance.Spender == spender {
+			require.Equal(t, big.NewInt(100), allowance.Amount.ToInt())
+			require.Equal(t, big.NewInt(20), allowance.BlockNumber.ToInt())
+		} else {
+			require.Equal(t, 0, allowance.Amount.ToInt().Sign())
+		}
+	}
+
+	require.NoError(t, db.SaveAllowances([]*Allowance{{
+		Owner:       owner,
+		Token:       token,
+		Spender:     spender,
+		Amount:      (*hexutil.Big)(big.NewInt(30)),
+		BlockNumber: (*hexutil.Big)(big.NewInt(25)),
+	}}))
+	allowances, err = db.GetAllowances(owner)
+	require.NoError(t, err)
+	for _, allowance := range allowances {
+		if allowance.Spender == spender {
+			require.Equal(t, big.NewInt(30), allowance.Amount.ToInt())
+		}
+	}
+
+	allowances, err = db.GetAllowances(spender)
+	require.NoError(t, err)
+	require.Empty(t, allowances)
+}
+
+// allowanceCaller answers allowance() calls with the amounts of the tokens.
+type allowanceCaller struct {
+	amounts map[common.Address]*big.Int
+}
+
+func (c *allowanceCaller) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return []byte{1}, nil
+}
+
+func (c *allowanceCaller) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	amount, ok := c.amounts[*call.To]
+	if !ok {
+		return nil, errors.New("execution reverted")
+	}
+	parsed, err := abi.JSON(strings.NewReader(ierc20.IERC20ABI))
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Methods["allowance"].Outputs.Pack(amount)
+}
+
+func TestRefreshAllowances(t *testing.T) {
+	owner, spender := common.Address{1}, common.Address{3}
+	good, broken := common.Address{2}, common.Address{5}
+	caller := &allowanceCaller{amounts: map[common.Address]*big.Int{good: big.NewInt(7)}}
+
+	refreshed, err := refreshAllowances(context.Background(), caller, big.NewInt(40), []*Allowance{
+		{Owner: owner, Token: good, Spender: spender, Amount: (*hexutil.Big)(big.NewInt(100))},
+		{Owner: owner, Token: broken, Spender: spender, Amount: (*hexutil.Big)(big.NewInt(100))},
+	})
+	require.NoError(t, err)
+	require.Len(t, refreshed, 1)
+	require.Equal(t, good, refreshed[0].Token)
+	require.Equal(t, big.NewInt(7), refreshed[0].Amount.ToInt())
+	require.Equal(t, big.NewInt(40), refreshed[0].BlockNumber.ToInt())
+}
+
+type fixedNonceProvider uint64
+
+func (n fixedNonceProvider) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return uint64(n), nil
+}
+
+func TestBuildRevokeTransactions(t *testing.T) {
+	owner := common.Address{1}
+	allowances := []Allowance{
+		{Token: common.Address{2}, Spender: common.Address{3}},
+		{Token: common.Address{4}, Spender: common.Address{5}},
+	}
+
+	txs, err := buildRevokeTransactions(context.Background(), fixedNonceProvider(9), owner, allowances)
+	require.NoError(t, err)
+	require.Len(t, txs, 2)
+
+	parsed, err := abi.JSON(strings.NewReader(ierc20.IERC20ABI))
+	require.NoError(t, err)
+	for i, tx := range txs {
+		require.Equal(t, owner, common.Address(tx.From))
+		require.Equal(t, allowances[i].Token, common.Address(*tx.To))
+		require.Equal(t, uint64(9+i), uint64(*tx.Nonce))
+
+		method, err := parsed.MethodById(tx.Input[:4])
+		require.NoError(t, err)
+		require.Equal(t, "approve", method.Name)
+		args, err := method.Inputs.Unpack(tx.Input[4:])
+		require.NoError(t, err)
+		require.Equal(t, allowances[i].Spender, args[0])
+		require.Equal(t, 0, args[1].(*big.Int).Sign())
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/status-im/status-go/transactions"
 )
 
 var (
@@ -58,7 +60,7 @@ func (api *API) GetTransfersByAddress(ctx context.Context, address common.Addres
 
 		// if zero block was already checked there is nothing to find more
 		if block == nil || big.NewInt(0).Cmp(block) == 0 {
-			return castToTransferViews(rst), nil
+			return api.s.transferViews(rst), nil
 		}
 
 		from, err := findFirstRange(ctx, address, block, api.s.client)
@@ -119,7 +121,7 @@ func (api *API) GetTransfersByAddress(ctx context.Context, address common.Addres
 		}
 	}
 
-	return castToTransferViews(rst), nil
+	return api.s.transferViews(rst), nil
 }
 
 // GetTokensBalances return mapping of token balances for every account.
@@ -151,6 +153,97 @@ func (api *API) DeleteCustomToken(ctx context.Context, address common.Address) e
 	return err
 }
 
+// GetAllowances returns the tokens that contracts can spend from the account of the address,
+// with their current amounts read from the chain. Revoked allowances are left out.
+func (api *API) GetAllowances(ctx context.Context, address common.Address) ([]*Allowance, error) {
+	log.Debug("call to get allowances", "address", address)
+	if api.s.db == nil {
+		return nil, ErrServiceNotInitialized
+	}
+	allowances, err := api.s.db.GetAllowances(address)
+	if err != nil {
+		return nil, err
+	}
+
+	if api.s.client != nil && len(allowances) > 0 {
+		header, err := api.s.client.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		refreshed, err := refreshAllowances(ctx, api.s.client, header.Number, allowances)
+		if err != nil {
+			return nil, err
+		}
+		if err = api.s.db.SaveAllowances(refreshed); err != nil {
+			return nil, err
+		}
+		allowances, err = api.s.db.GetAllowances(address)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rst := []*Allowance{}
+	for _, allowance := range allowances {
+		if allowance.Amount.ToInt().Sign() > 0 {
+			rst = append(rst, allowance)
+		}
+	}
+	return rst, nil
+}
+
+// BuildRevokeTransactions returns the transactions setting the allowances of the tokens
+// and spenders to zero, with sequential nonces so that they can be sent one after the other.
+func (api *API) BuildRevokeTransactions(ctx context.Context, address common.Address, allowances []Allowance) ([]transactions.SendTxArgs, error) {
+	log.Debug("call to build revoke transactions", "address", address, "len", len(allowances))
+	if api.s.client == nil {
+		return nil, ErrServiceNotInitialized
+	}
+	return buildRevokeTransactions(ctx, api.s.client, address, allowances)
+}
+
+// SimulateTransaction runs the transaction on top of the pending block before it is signed.
+// It returns whether the transaction would succeed, the decoded revert reason when it
+// wouldn't, and the token balance changes of the sender if the node traces calls.
+func (api *API) SimulateTransaction(ctx context.Context, args transactions.SendTxArgs) (*Simulation, error) {
+	log.Debug("call to simulate transaction", "from", args.From, "to", args.To)
+	if api.s.rpcClient == nil {
+		return nil, ErrServiceNotInitialized
+	}
+	if !args.Valid() {
+		return nil, transactions.ErrInvalidSendTxArgs
+	}
+	return simulateTransaction(ctx, api.s.rpcClient, api.s.registry, args)
+}
+
+// AddContractABI stores the JSON ABI of a contract, used to decode the calls to it in the history.
+func (api *API) AddContractABI(ctx context.Context, address common.Address, abi string) error {
+	log.Debug("call to add contract abi", "address", address)
+	if api.s.registry == nil {
+		return ErrServiceNotInitialized
+	}
+	return api.s.registry.AddContractABI(address, abi)
+}
+
+// RemoveContractABI removes the JSON ABI of a contract.
+func (api *API) RemoveContractABI(ctx context.Context, address common.Address) error {
+	log.Debug("call to remove contract abi", "address", address)
+	if api.s.registry == nil {
+		return ErrServiceNotInitialized
+	}
+	return api.s.registry.RemoveContractABI(address)
+}
+
+// AddMethodSignatures adds text signatures such as `transfer(address,uint256)` to the
+// 4-byte selector database used to decode calls to contracts without an ABI.
+func (api *API) AddMethodSignatures(ctx context.Context, signatures []string) error {
+	log.Debug("call to add method signatures", "len", len(signatures))
+	if api.s.registry == nil {
+		return ErrServiceNotInitialized
+	}
+	return api.s.registry.AddMethodSignatures(signatures)
+}
+
 func (api *API) GetPendingTransactions(ctx context.Context) ([]*PendingTransaction, error) {
 	log.Debug("call to get pending transactions")
 	rst, err := api.s.db.getAllPendingTransactions()
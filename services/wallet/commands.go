@@ -640,6 +640,9 @@ func (c *findAndCheckBlockRangeCommand) Run(parent context.Context) (err error)
 				if len(header.Erc20Transfers) > 0 {
 					uniqHeader.Erc20Transfers = append(uniqHeader.Erc20Transfers, header.Erc20Transfers...)
 				}
+				if len(header.Allowances) > 0 {
+					uniqHeader.Allowances = append(uniqHeader.Allowances, header.Allowances...)
+				}
 				uniqHeadersByHash[header.Hash] = uniqHeader
 			} else {
 				uniqHeadersByHash[header.Hash] = header
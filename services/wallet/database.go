@@ -21,6 +21,7 @@ type DBHeader struct {
 	Hash           common.Hash
 	Timestamp      uint64
 	Erc20Transfers []*Transfer
+	Allowances     []*Allowance
 	Network        uint64
 	Address        common.Address
 	// Head is true if the block was a head at the time it was pulled from chain.
@@ -820,6 +821,11 @@ func insertBlocksWithTransactions(creator statementCreator, account common.Addre
 		if err != nil {
 			return err
 		}
+		if len(header.Allowances) > 0 {
+			if err = saveAllowances(creator, network, header.Allowances); err != nil {
+				return err
+			}
+		}
 		if len(header.Erc20Transfers) > 0 {
 			for _, transfer := range header.Erc20Transfers {
 				res, err := updateTx.Exec(&JSONBlob{transfer.Log}, network, account, transfer.ID)
@@ -0,0 +1,56 @@
+package decoder
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// bundledSignatures are the methods of the token standards and of the contracts
+// most used from the wallet, decoded without any lookup.
+var bundledSignatures = []string{
+	// ERC-20
+	"transfer(address to, uint256 value)",
+	"transferFrom(address from, address to, uint256 value)",
+	"approve(address spender, uint256 value)",
+	"increaseAllowance(address spender, uint256 addedValue)",
+	"decreaseAllowance(address spender, uint256 subtractedValue)",
+	// MiniMe tokens such as SNT
+	"approveAndCall(address spender, uint256 amount, bytes extraData)",
+	// WETH
+	"deposit()",
+	"withdraw(uint256 wad)",
+	// ERC-721
+	"safeTransferFrom(address from, address to, uint256 tokenId)",
+	"safeTransferFrom(address from, address to, uint256 tokenId, bytes data)",
+	"setApprovalForAll(address operator, bool approved)",
+	"mint(address to, uint256 tokenId)",
+	// ERC-1155
+	"safeTransferFrom(address from, address to, uint256 id, uint256 amount, bytes data)",
+	"safeBatchTransferFrom(address from, address to, uint256[] ids, uint256[] amounts, bytes data)",
+	// Uniswap V2 router
+	"swapExactTokensForTokens(uint256 amountIn, uint256 amountOutMin, address[] path, address to, uint256 deadline)",
+	"swapTokensForExactTokens(uint256 amountOut, uint256 amountInMax, address[] path, address to, uint256 deadline)",
+	"swapExactETHForTokens(uint256 amountOutMin, address[] path, address to, uint256 deadline)",
+	"swapTokensForExactETH(uint256 amountOut, uint256 amountInMax, address[] path, address to, uint256 deadline)",
+	"swapExactTokensForETH(uint256 amountIn, uint256 amountOutMin, address[] path, address to, uint256 deadline)",
+	"swapETHForExactTokens(uint256 amountOut, address[] path, address to, uint256 deadline)",
+	"addLiquidity(address tokenA, address tokenB, uint256 amountADesired, uint256 amountBDesired, uint256 amountAMin, uint256 amountBMin, address to, uint256 deadline)",
+	"addLiquidityETH(address token, uint256 amountTokenDesired, uint256 amountTokenMin, uint256 amountETHMin, address to, uint256 deadline)",
+	"removeLiquidity(address tokenA, address tokenB, uint256 liquidity, uint256 amountAMin, uint256 amountBMin, address to, uint256 deadline)",
+	"removeLiquidityETH(address token, uint256 liquidity, uint256 amountTokenMin, uint256 amountETHMin, address to, uint256 deadline)",
+}
+
+var bundledMethods = mustParseSignatures(bundledSignatures)
+
+func mustParseSignatures(signatures []string) map[string][]abi.Method {
+	methods := make(map[string][]abi.Method)
+	for _, signature := range signatures {
+		method, err := ParseSignature(signature)
+		if err != nil {
+			panic(err)
+		}
+		selector := hexutil.Encode(method.ID)
+		methods[selector] = append(methods[selector], method)
+	}
+	return methods
+}
@@ -0,0 +1,55 @@
+package decoder
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// contractABI is the JSON ABI of a contract with its custom errors, which the
+// abi package can't parse yet.
+type contractABI struct {
+	abi.ABI
+	errors map[string][]abi.Method
+}
+
+func parseContractABI(definition string) (*contractABI, error) {
+	var entries []json.RawMessage
+	if err := json.Unmarshal([]byte(definition), &entries); err != nil {
+		return nil, err
+	}
+
+	rst := &contractABI{errors: make(map[string][]abi.Method)}
+	var rest []json.RawMessage
+	for _, entry := range entries {
+		var field struct {
+			Type   string
+			Name   string
+			Inputs []abi.Argument
+		}
+		if err := json.Unmarshal(entry, &field); err != nil {
+			return nil, err
+		}
+		if field.Type != "error" {
+			rest = append(rest, entry)
+			continue
+		}
+		if !identifierRegexp.MatchString(field.Name) {
+			return nil, fmt.Errorf("abi: invalid error name %q", field.Name)
+		}
+		method := abi.NewMethod(field.Name, field.Name, abi.Function, "", false, false, field.Inputs, nil)
+		selector := hexutil.Encode(method.ID)
+		rst.errors[selector] = append(rst.errors[selector], method)
+	}
+
+	encoded, err := json.Marshal(rest)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(encoded, &rst.ABI); err != nil {
+		return nil, err
+	}
+	return rst, nil
+}
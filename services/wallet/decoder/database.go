@@ -0,0 +1,93 @@
+package decoder
+
+import (
+	"database/sql"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// NewDB creates a new database of the ABIs of the network.
+func NewDB(db *sql.DB, network uint64) *Database {
+	return &Database{db: db, network: network}
+}
+
+// Database stores the ABIs supplied by the user and the 4-byte selector signatures.
+type Database struct {
+	db      *sql.DB
+	network uint64
+}
+
+// SaveContractABI stores the JSON ABI of a contract, replacing the previous one.
+func (db *Database) SaveContractABI(contract common.Address, definition string) error {
+	_, err := db.db.Exec("INSERT OR REPLACE INTO wallet_contract_abis (network_id, address, abi) VALUES (?, ?, ?)", db.network, contract, definition)
+	return err
+}
+
+// DeleteContractABI removes the JSON ABI of a contract.
+func (db *Database) DeleteContractABI(contract common.Address) error {
+	_, err := db.db.Exec("DELETE FROM wallet_contract_abis WHERE network_id = ? AND address = ?", db.network, contract)
+	return err
+}
+
+// ContractABI returns the JSON ABI of a contract, or an empty string if there is none.
+func (db *Database) ContractABI(contract common.Address) (string, error) {
+	var definition string
+	err := db.db.QueryRow("SELECT abi FROM wallet_contract_abis WHERE network_id = ? AND address = ?", db.network, contract).Scan(&definition)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	return definition, err
+}
+
+// SaveMethodSignatures adds text signatures to the 4-byte selector database.
+func (db *Database) SaveMethodSignatures(signatures []string) (err error) {
+	tx, err := db.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		_ = tx.Rollback()
+	}()
+
+	insert, err := tx.Prepare("INSERT OR IGNORE INTO wallet_method_signatures (selector, signature) VALUES (?, ?)")
+	if err != nil {
+		return err
+	}
+	defer insert.Close()
+
+	for _, signature := range signatures {
+		method, err := ParseSignature(signature)
+		if err != nil {
+			return err
+		}
+		if _, err = insert.Exec(hexutil.Encode(method.ID), strings.TrimSpace(signature)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MethodSignatures returns the text signatures of a selector.
+func (db *Database) MethodSignatures(selector string) ([]string, error) {
+	rows, err := db.db.Query("SELECT signature FROM wallet_method_signatures WHERE selector = ? ORDER BY signature", selector)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var signatures []string
+	for rows.Next() {
+		var signature string
+		if err := rows.Scan(&signature); err != nil {
+			return nil, err
+		}
+		signatures = append(signatures, signature)
+	}
+	return signatures, rows.Err()
+}
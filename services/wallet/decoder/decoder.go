@@ -0,0 +1,214 @@
+package decoder
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// Argument is a decoded argument of a contract call. Numbers are formatted as
+// decimal strings, addresses and bytes as hex strings and tuples as objects.
+type Argument struct {
+	Name  string      `json:"name"`
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+// Method is a decoded contract call.
+type Method struct {
+	Name      string     `json:"name"`
+	Signature string     `json:"signature"`
+	Arguments []Argument `json:"arguments"`
+}
+
+// NewRegistry creates a registry decoding calls with the ABIs stored in the database,
+// falling back to the bundled signatures of common methods.
+func NewRegistry(db *sql.DB, network uint64) *Registry {
+	return &Registry{db: NewDB(db, network), bundled: bundledMethods}
+}
+
+// Registry finds the method called by a transaction, or the error it reverted with,
+// looking up in order the ABI supplied by the user for the contract, the bundled
+// signatures and the signatures of the local 4-byte selector database.
+type Registry struct {
+	db      *Database
+	bundled map[string][]abi.Method
+}
+
+// AddContractABI stores the JSON ABI of a contract, used to decode calls to it.
+func (r *Registry) AddContractABI(contract common.Address, definition string) error {
+	if _, err := parseContractABI(definition); err != nil {
+		return err
+	}
+	return r.db.SaveContractABI(contract, definition)
+}
+
+// RemoveContractABI removes the JSON ABI of a contract.
+func (r *Registry) RemoveContractABI(contract common.Address) error {
+	return r.db.DeleteContractABI(contract)
+}
+
+// AddMethodSignatures adds text signatures, such as `transfer(address,uint256)`,
+// to the 4-byte selector database.
+func (r *Registry) AddMethodSignatures(signatures []string) error {
+	for _, signature := range signatures {
+		if _, err := ParseSignature(signature); err != nil {
+			return fmt.Errorf("%s: %w", signature, err)
+		}
+	}
+	return r.db.SaveMethodSignatures(signatures)
+}
+
+// Decode returns the method called by a transaction to the contract, or nil if
+// the input doesn't match any known method.
+func (r *Registry) Decode(contract common.Address, input []byte) (*Method, error) {
+	if len(input) < 4 {
+		return nil, nil
+	}
+	selector, data := input[:4], input[4:]
+
+	contractABI, err := r.contractABI(contract)
+	if err != nil {
+		return nil, err
+	}
+	if contractABI != nil {
+		if method, err := contractABI.MethodById(selector); err == nil {
+			if decoded := decode(method, data); decoded != nil {
+				return decoded, nil
+			}
+		}
+	}
+
+	if decoded := decodeAny(r.bundled[hexutil.Encode(selector)], data); decoded != nil {
+		return decoded, nil
+	}
+
+	return r.decodeWithSignatures(selector, data)
+}
+
+// DecodeError returns the custom error in the revert data of a call to the contract,
+// or nil if the data doesn't match any known error. Errors are encoded as method calls,
+// so they are also looked up in the 4-byte selector database.
+func (r *Registry) DecodeError(contract common.Address, revertData []byte) (*Method, error) {
+	if len(revertData) < 4 {
+		return nil, nil
+	}
+	selector, data := revertData[:4], revertData[4:]
+
+	contractABI, err := r.contractABI(contract)
+	if err != nil {
+		return nil, err
+	}
+	if contractABI != nil {
+		if decoded := decodeAny(contractABI.errors[hexutil.Encode(selector)], data); decoded != nil {
+			return decoded, nil
+		}
+	}
+
+	if decoded := decodeAny(bundledErrors[hexutil.Encode(selector)], data); decoded != nil {
+		return decoded, nil
+	}
+
+	return r.decodeWithSignatures(selector, data)
+}
+
+func (r *Registry) contractABI(contract common.Address) (*contractABI, error) {
+	definition, err := r.db.ContractABI(contract)
+	if err != nil || definition == "" {
+		return nil, err
+	}
+	return parseContractABI(definition)
+}
+
+func (r *Registry) decodeWithSignatures(selector []byte, data []byte) (*Method, error) {
+	signatures, err := r.db.MethodSignatures(hexutil.Encode(selector))
+	if err != nil {
+		return nil, err
+	}
+	var candidates []abi.Method
+	for _, signature := range signatures {
+		method, err := ParseSignature(signature)
+		if err != nil {
+			log.Warn("invalid method signature in database", "signature", signature, "error", err)
+			continue
+		}
+		candidates = append(candidates, method)
+	}
+	return decodeAny(candidates, data), nil
+}
+
+// decodeAny decodes the data with the first method it matches. Several methods
+// can share a selector, so we only accept a method if encoding the arguments back
+// gives the data, ignoring bytes some dapps append to the calldata.
+func decodeAny(methods []abi.Method, data []byte) *Method {
+	for i := range methods {
+		if decoded := decode(&methods[i], data); decoded != nil {
+			return decoded
+		}
+	}
+	return nil
+}
+
+func decode(method *abi.Method, data []byte) *Method {
+	values, err := method.Inputs.Unpack(data)
+	if err != nil {
+		return nil
+	}
+	packed, err := method.Inputs.Pack(values...)
+	if err != nil || !bytes.HasPrefix(data, packed) {
+		return nil
+	}
+
+	arguments := make([]Argument, len(method.Inputs))
+	for i, input := range method.Inputs {
+		arguments[i] = Argument{
+			Name:  input.Name,
+			Type:  input.Type.String(),
+			Value: formatValue(input.Type, reflect.ValueOf(values[i])),
+		}
+	}
+	return &Method{
+		Name:      method.RawName,
+		Signature: method.Sig,
+		Arguments: arguments,
+	}
+}
+
+func formatValue(t abi.Type, v reflect.Value) interface{} {
+	switch t.T {
+	case abi.IntTy, abi.UintTy:
+		if n, ok := v.Interface().(*big.Int); ok {
+			return n.String()
+		}
+		return fmt.Sprint(v.Interface())
+	case abi.AddressTy:
+		return v.Interface().(common.Address).Hex()
+	case abi.BytesTy:
+		return hexutil.Encode(v.Bytes())
+	case abi.FixedBytesTy:
+		b := make([]byte, v.Len())
+		reflect.Copy(reflect.ValueOf(b), v)
+		return hexutil.Encode(b)
+	case abi.SliceTy, abi.ArrayTy:
+		elems := make([]interface{}, v.Len())
+		for i := range elems {
+			elems[i] = formatValue(*t.Elem, v.Index(i))
+		}
+		return elems
+	case abi.TupleTy:
+		fields := make(map[string]interface{}, len(t.TupleElems))
+		for i, elem := range t.TupleElems {
+			fields[t.TupleRawNames[i]] = formatValue(*elem, v.Field(i))
+		}
+		return fields
+	default:
+		return v.Interface()
+	}
+}
@@ -0,0 +1,172 @@
+package decoder
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/status-im/status-go/appdatabase"
+)
+
+// fixture is a transaction recorded from the chain with its expected decoding.
+type fixture struct {
+	Description string          `json:"description"`
+	To          common.Address  `json:"to"`
+	Input       hexutil.Bytes   `json:"input"`
+	Signatures  []string        `json:"signatures"`
+	ABI         string          `json:"abi"`
+	Method      json.RawMessage `json:"method"`
+}
+
+func setupTestRegistry(t *testing.T) (*Registry, func()) {
+	tmpfile, err := ioutil.TempFile("", "decoder-tests-")
+	require.NoError(t, err)
+	db, err := appdatabase.InitializeDB(tmpfile.Name(), "decoder-tests")
+	require.NoError(t, err)
+	return NewRegistry(db, 1), func() {
+		require.NoError(t, db.Close())
+		require.NoError(t, os.Remove(tmpfile.Name()))
+	}
+}
+
+func TestDecodeFixtures(t *testing.T) {
+	registry, stop := setupTestRegistry(t)
+	defer stop()
+
+	data, err := ioutil.ReadFile("testdata/transactions.json")
+	require.NoError(t, err)
+	var fixtures []fixture
+	require.NoError(t, json.Unmarshal(data, &fixtures))
+
+	for _, f := range fixtures {
+		t.Run(f.Description, func(t *testing.T) {
+			if len(f.Signatures) > 0 {
+				require.NoError(t, registry.AddMethodSignatures(f.Signatures))
+			}
+			if f.ABI != "" {
+				require.NoError(t, registry.AddContractABI(f.To, f.ABI))
+			}
+
+			method, err := registry.Decode(f.To, f.Input)
+			require.NoError(t, err)
+			decoded, err := json.Marshal(method)
+			require.NoError(t, err)
+			require.JSONEq(t, string(f.Method), string(decoded))
+		})
+	}
+}
+
+func TestContractABITakesPrecedence(t *testing.T) {
+	registry, stop := setupTestRegistry(t)
+	defer stop()
+
+	token := common.Address{1}
+	input := hexutil.MustDecode("0x095ea7b30000000000000000000000007a250d5630b4cf539739df2c5dacb4c659f2488d0000000000000000000000000000000000000000000000000000000000000001")
+
+	method, err := registry.Decode(token, input)
+	require.NoError(t, err)
+	require.Equal(t, "spender", method.Arguments[0].Name)
+
+	require.NoError(t, registry.AddContractABI(token, `[{"inputs":[{"name":"guy","type":"address"},{"name":"wad","type":"uint256"}],"name":"approve","outputs":[{"name":"","type":"bool"}],"type":"function"}]`))
+	method, err = registry.Decode(token, input)
+	require.NoError(t, err)
+	require.Equal(t, "guy", method.Arguments[0].Name)
+	require.Equal(t, "1", method.Arguments[1].Value)
+
+	require.NoError(t, registry.RemoveContractABI(token))
+	method, err = registry.Decode(token, input)
+	require.NoError(t, err)
+	require.Equal(t, "spender", method.Arguments[0].Name)
+}
+
+func TestAddInvalidABIAndSignatures(t *testing.T) {
+	registry, stop := setupTestRegistry(t)
+	defer stop()
+
+	require.Error(t, registry.AddContractABI(common.Address{1}, "not an abi"))
+	require.Error(t, registry.AddMethodSignatures([]string{"transfer(address,uint256)", "transfer(address"}))
+	signatures, err := registry.db.MethodSignatures("0xa9059cbb")
+	require.NoError(t, err)
+	require.Empty(t, signatures)
+}
+
+func TestParseSignature(t *testing.T) {
+	for signature, expected := range map[string]string{
+		"transfer(address,uint256)":                 "transfer(address,uint256)",
+		" transfer( address to , uint256 amount ) ": "transfer(address,uint256)",
+		"deposit()": "deposit()",
+		"exec((address,bytes)[] calls, bytes calldata data)":            "exec((address,bytes)[],bytes)",
+		"fill((address token,(uint8,bytes32)[2] sigs) order, bool all)": "fill((address,(uint8,bytes32)[2]),bool)",
+	} {
+		method, err := ParseSignature(signature)
+		require.NoError(t, err, signature)
+		require.Equal(t, expected, method.Sig)
+	}
+
+	for _, signature := range []string{"", "transfer", "(address)", "transfer(address", "transfer(address))", "transfer(addr)", "1transfer()"} {
+		_, err := ParseSignature(signature)
+		require.Error(t, err, signature)
+	}
+}
+
+func TestDecodeRevert(t *testing.T) {
+	registry, stop := setupTestRegistry(t)
+	defer stop()
+
+	contract := common.Address{1}
+	require.NoError(t, registry.AddContractABI(contract, `[
+		{"inputs":[{"name":"available","type":"uint256"},{"name":"required","type":"uint256"}],"name":"InsufficientLiquidity","type":"error"},
+		{"inputs":[],"name":"pause","outputs":[],"stateMutability":"nonpayable","type":"function"}
+	]`))
+
+	for _, tc := range []struct {
+		description string
+		data        string
+		expected    string
+	}{
+		{
+			"require with a reason",
+			"0x08c379a000000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000000025556e697377617056323a20494e53554646494349454e545f4f55545055545f414d4f554e54000000000000000000000000000000000000000000000000000000",
+			`{"kind":"error","reason":"UniswapV2: INSUFFICIENT_OUTPUT_AMOUNT","data":"0x08c379a000000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000000025556e697377617056323a20494e53554646494349454e545f4f55545055545f414d4f554e54000000000000000000000000000000000000000000000000000000"}`,
+		},
+		{
+			"arithmetic panic",
+			"0x4e487b710000000000000000000000000000000000000000000000000000000000000011",
+			`{"kind":"panic","reason":"arithmetic overflow or underflow","panicCode":"0x11","data":"0x4e487b710000000000000000000000000000000000000000000000000000000000000011"}`,
+		},
+		{
+			"custom error of the contract ABI",
+			"0xa17e11d500000000000000000000000000000000000000000000000000000000000000050000000000000000000000000000000000000000000000000000000000000007",
+			`{"kind":"custom","error":{"name":"InsufficientLiquidity","signature":"InsufficientLiquidity(uint256,uint256)","arguments":[{"name":"available","type":"uint256","value":"5"},{"name":"required","type":"uint256","value":"7"}]},"data":"0xa17e11d500000000000000000000000000000000000000000000000000000000000000050000000000000000000000000000000000000000000000000000000000000007"}`,
+		},
+		{
+			"bundled custom error",
+			"0xfb8f41b20000000000000000000000007a250d5630b4cf539739df2c5dacb4c659f2488d00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000064",
+			`{"kind":"custom","error":{"name":"ERC20InsufficientAllowance","signature":"ERC20InsufficientAllowance(address,uint256,uint256)","arguments":[{"name":"spender","type":"address","value":"0x7a250d5630B4cF539739dF2C5dAcb4c659F2488D"},{"name":"allowance","type":"uint256","value":"0"},{"name":"needed","type":"uint256","value":"100"}]},"data":"0xfb8f41b20000000000000000000000007a250d5630b4cf539739df2c5dacb4c659f2488d00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000064"}`,
+		},
+		{
+			"revert without data",
+			"0x",
+			`{"kind":"unknown","data":"0x"}`,
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			revert, err := registry.DecodeRevert(contract, hexutil.MustDecode(tc.data))
+			require.NoError(t, err)
+			decoded, err := json.Marshal(revert)
+			require.NoError(t, err)
+			require.JSONEq(t, tc.expected, string(decoded))
+		})
+	}
+
+	// Calls are still decoded with an ABI declaring errors
+	method, err := registry.Decode(contract, hexutil.MustDecode("0x8456cb59"))
+	require.NoError(t, err)
+	require.Equal(t, "pause", method.Name)
+}
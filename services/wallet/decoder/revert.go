@@ -0,0 +1,118 @@
+package decoder
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// RevertKind is the kind of data a call reverted with.
+type RevertKind string
+
+const (
+	// RevertError is a require or revert with a reason, encoded as `Error(string)`.
+	RevertError RevertKind = "error"
+	// RevertPanic is a failed assertion or arithmetic error, encoded as `Panic(uint256)`.
+	RevertPanic RevertKind = "panic"
+	// RevertCustom is a custom error declared by the contract.
+	RevertCustom RevertKind = "custom"
+	// RevertUnknown is revert data which can't be decoded, or no data at all.
+	RevertUnknown RevertKind = "unknown"
+)
+
+var (
+	errorMethod = mustParseSignature("Error(string reason)")
+	panicMethod = mustParseSignature("Panic(uint256 code)")
+)
+
+// panicReasons describes the codes of the panics raised by the Solidity compiler.
+var panicReasons = map[uint64]string{
+	0x00: "generic compiler panic",
+	0x01: "assertion failed",
+	0x11: "arithmetic overflow or underflow",
+	0x12: "division or modulo by zero",
+	0x21: "invalid enum value",
+	0x22: "invalid storage byte array",
+	0x31: "pop on an empty array",
+	0x32: "array index out of bounds",
+	0x41: "out of memory",
+	0x51: "call to an uninitialized function",
+}
+
+// bundledErrors are the custom errors of the token standard implementations most used.
+var bundledErrors = mustParseSignatures([]string{
+	"ERC20InsufficientBalance(address sender, uint256 balance, uint256 needed)",
+	"ERC20InsufficientAllowance(address spender, uint256 allowance, uint256 needed)",
+	"ERC20InvalidSender(address sender)",
+	"ERC20InvalidReceiver(address receiver)",
+	"ERC20InvalidApprover(address approver)",
+	"ERC20InvalidSpender(address spender)",
+	"ERC721NonexistentToken(uint256 tokenId)",
+	"ERC721IncorrectOwner(address sender, uint256 tokenId, address owner)",
+	"ERC721InsufficientApproval(address operator, uint256 tokenId)",
+	"OwnableUnauthorizedAccount(address account)",
+	"SafeERC20FailedOperation(address token)",
+})
+
+// Revert is the decoded data a call to a contract reverted with.
+type Revert struct {
+	Kind RevertKind `json:"kind"`
+	// Reason is the reason given to require or revert, or the description of a panic
+	Reason    string       `json:"reason,omitempty"`
+	PanicCode *hexutil.Big `json:"panicCode,omitempty"`
+	// Error is the decoded custom error
+	Error *Method       `json:"error,omitempty"`
+	Data  hexutil.Bytes `json:"data"`
+}
+
+// DecodeRevert decodes the data a call to the contract reverted with.
+func (r *Registry) DecodeRevert(contract common.Address, data []byte) (*Revert, error) {
+	revert := &Revert{Kind: RevertUnknown, Data: data}
+	if len(data) < 4 {
+		return revert, nil
+	}
+	selector, args := data[:4], data[4:]
+
+	switch {
+	case bytes.Equal(selector, errorMethod.ID):
+		if values, err := errorMethod.Inputs.Unpack(args); err == nil {
+			revert.Kind = RevertError
+			revert.Reason = values[0].(string)
+			return revert, nil
+		}
+	case bytes.Equal(selector, panicMethod.ID):
+		if values, err := panicMethod.Inputs.Unpack(args); err == nil {
+			code := values[0].(*big.Int)
+			revert.Kind = RevertPanic
+			revert.PanicCode = (*hexutil.Big)(code)
+			revert.Reason = "panic"
+			if code.IsUint64() {
+				if reason, ok := panicReasons[code.Uint64()]; ok {
+					revert.Reason = reason
+				}
+			}
+			return revert, nil
+		}
+	}
+
+	custom, err := r.DecodeError(contract, data)
+	if err != nil {
+		return nil, err
+	}
+	if custom != nil {
+		revert.Kind = RevertCustom
+		revert.Error = custom
+	}
+	return revert, nil
+}
+
+func mustParseSignature(signature string) abi.Method {
+	method, err := ParseSignature(signature)
+	if err != nil {
+		panic(err)
+	}
+	return method
+}
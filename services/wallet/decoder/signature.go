@@ -0,0 +1,160 @@
+package decoder
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// ErrInvalidSignature is returned when a text signature can't be parsed.
+var ErrInvalidSignature = errors.New("invalid method signature")
+
+var identifierRegexp = regexp.MustCompile(`^[a-zA-Z_$][a-zA-Z0-9_$]*$`)
+
+// ParseSignature parses the text signature of a method, such as
+// `transfer(address,uint256)` or `transfer(address to, uint256 amount)`.
+// Names of the parameters are optional, tuples are written in parentheses.
+func ParseSignature(signature string) (abi.Method, error) {
+	signature = strings.TrimSpace(signature)
+	open := strings.Index(signature, "(")
+	if open <= 0 || !strings.HasSuffix(signature, ")") {
+		return abi.Method{}, ErrInvalidSignature
+	}
+
+	name := strings.TrimSpace(signature[:open])
+	if !identifierRegexp.MatchString(name) {
+		return abi.Method{}, ErrInvalidSignature
+	}
+
+	params, err := parseParams(signature[open+1:len(signature)-1], false)
+	if err != nil {
+		return abi.Method{}, err
+	}
+
+	inputs := make(abi.Arguments, len(params))
+	for i, param := range params {
+		typ, err := abi.NewType(param.Type, "", param.Components)
+		if err != nil {
+			return abi.Method{}, err
+		}
+		inputs[i] = abi.Argument{Name: param.Name, Type: typ}
+	}
+
+	return abi.NewMethod(name, name, abi.Function, "", false, false, inputs, nil), nil
+}
+
+// parseParams parses a comma separated list of parameters. Components of
+// tuples need a name to be decoded, unnamed ones are named after their position.
+func parseParams(params string, component bool) ([]abi.ArgumentMarshaling, error) {
+	if strings.TrimSpace(params) == "" {
+		return nil, nil
+	}
+
+	parts, err := splitParams(params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]abi.ArgumentMarshaling, len(parts))
+	for i, part := range parts {
+		param, err := parseParam(strings.TrimSpace(part))
+		if err != nil {
+			return nil, err
+		}
+		if component && param.Name == "" {
+			param.Name = fmt.Sprintf("arg%d", i)
+		}
+		result[i] = param
+	}
+	return result, nil
+}
+
+func parseParam(param string) (abi.ArgumentMarshaling, error) {
+	var result abi.ArgumentMarshaling
+	rest := param
+
+	if strings.HasPrefix(param, "(") {
+		end := matchingParen(param)
+		if end < 0 {
+			return result, ErrInvalidSignature
+		}
+		components, err := parseParams(param[1:end], true)
+		if err != nil {
+			return result, err
+		}
+		result.Components = components
+
+		// Array suffixes of the tuple, followed by the name
+		rest = param[end+1:]
+		suffixEnd := strings.IndexFunc(rest, func(r rune) bool { return r == ' ' || r == '\t' })
+		if suffixEnd < 0 {
+			suffixEnd = len(rest)
+		}
+		result.Type = "tuple" + rest[:suffixEnd]
+		rest = rest[suffixEnd:]
+	} else {
+		fields := strings.Fields(param)
+		if len(fields) == 0 {
+			return result, ErrInvalidSignature
+		}
+		result.Type = fields[0]
+		rest = strings.Join(fields[1:], " ")
+	}
+
+	// The name is the last word, after data locations such as calldata
+	fields := strings.Fields(rest)
+	if len(fields) > 0 {
+		result.Name = fields[len(fields)-1]
+		if !identifierRegexp.MatchString(result.Name) {
+			return result, ErrInvalidSignature
+		}
+	}
+	return result, nil
+}
+
+// splitParams splits a list of parameters on the commas outside of tuples.
+func splitParams(params string) ([]string, error) {
+	var parts []string
+	depth := 0
+	start := 0
+	for i, c := range params {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, ErrInvalidSignature
+			}
+		case ',':
+			if depth == 0 {
+				parts = append(parts, params[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, ErrInvalidSignature
+	}
+	return append(parts, params[start:]), nil
+}
+
+// matchingParen returns the index of the parenthesis closing the one the string starts with.
+func matchingParen(s string) int {
+	depth := 0
+	for i, c := range s {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
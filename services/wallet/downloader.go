@@ -22,6 +22,7 @@ const (
 	erc20Transfer TransferType = "erc20"
 
 	erc20TransferEventSignature = "Transfer(address,address,uint256)"
+	erc20ApprovalEventSignature = "Approval(address,address,uint256)"
 )
 
 var (
@@ -122,9 +123,11 @@ func (d *ETHTransferDownloader) getTransfersInBlock(ctx context.Context, blk *ty
 					return nil, err
 				}
 
+				// Token transfers are recorded from their logs, but other contract calls sent
+				// from the account, such as swaps, are recorded even if they moved tokens.
 				transactionLog := getTokenLog(receipt.Logs)
 
-				if transactionLog == nil {
+				if transactionLog == nil || (from == address && !isTokenTransfer(tx, receipt)) {
 					rst = append(rst, Transfer{
 						Type:        ethTransfer,
 						ID:          tx.Hash(),
@@ -134,8 +137,7 @@ func (d *ETHTransferDownloader) getTransfersInBlock(ctx context.Context, blk *ty
 						Timestamp:   blk.Time(),
 						Transaction: tx,
 						From:        from,
-						Receipt:     receipt,
-						Log:         transactionLog})
+						Receipt:     receipt})
 				}
 			}
 		}
@@ -148,11 +150,13 @@ func (d *ETHTransferDownloader) getTransfersInBlock(ctx context.Context, blk *ty
 // NewERC20TransfersDownloader returns new instance.
 func NewERC20TransfersDownloader(client *walletClient, accounts []common.Address, signer types.Signer) *ERC20TransfersDownloader {
 	signature := crypto.Keccak256Hash([]byte(erc20TransferEventSignature))
+	approvalSignature := crypto.Keccak256Hash([]byte(erc20ApprovalEventSignature))
 	return &ERC20TransfersDownloader{
-		client:    client,
-		accounts:  accounts,
-		signature: signature,
-		signer:    signer,
+		client:            client,
+		accounts:          accounts,
+		signature:         signature,
+		approvalSignature: approvalSignature,
+		signer:            signer,
 	}
 }
 
@@ -163,6 +167,8 @@ type ERC20TransfersDownloader struct {
 
 	// hash of the Transfer event signature
 	signature common.Hash
+	// hash of the Approval event signature
+	approvalSignature common.Hash
 
 	// signer is used to derive tx sender from tx signature
 	signer types.Signer
@@ -182,6 +188,10 @@ func (d *ERC20TransfersDownloader) outboundTopics(address common.Address) [][]co
 	return [][]common.Hash{{d.signature}, {d.paddedAddress(address)}, {}}
 }
 
+func (d *ERC20TransfersDownloader) approvalTopics(address common.Address) [][]common.Hash {
+	return [][]common.Hash{{d.approvalSignature}, {d.paddedAddress(address)}}
+}
+
 func (d *ETHTransferDownloader) transferFromLog(parent context.Context, ethlog types.Log, address common.Address, id common.Hash) (Transfer, error) {
 	ctx, cancel := context.WithTimeout(parent, 3*time.Second)
 	tx, _, err := d.client.TransactionByHash(ctx, ethlog.TxHash)
@@ -381,6 +391,18 @@ func (d *ERC20TransfersDownloader) GetHeadersInRange(parent context.Context, fro
 		if err != nil {
 			return nil, err
 		}
+		ctx, cancel = context.WithTimeout(parent, 5*time.Second)
+		approvals, err := d.client.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: from,
+			ToBlock:   to,
+			Topics:    d.approvalTopics(address),
+		})
+		cancel()
+		if err != nil {
+			return nil, err
+		}
+		headers = append(headers, blocksFromApprovals(approvals, address)...)
+
 		logs := append(outbound, inbound...)
 		if len(logs) == 0 {
 			continue
@@ -405,6 +427,17 @@ func IsTokenTransfer(logs []*types.Log) bool {
 	return false
 }
 
+// isTokenTransfer returns whether the only effect of the transaction is an ERC-20
+// transfer of the token it was sent to, which is already recorded from its log.
+func isTokenTransfer(tx *types.Transaction, receipt *types.Receipt) bool {
+	if tx.To() == nil || len(receipt.Logs) != 1 {
+		return false
+	}
+	l := receipt.Logs[0]
+	// ERC-721 transfers have the same signature but the token ID is indexed
+	return l.Address == *tx.To() && len(l.Topics) == 3 && l.Topics[0] == crypto.Keccak256Hash([]byte(erc20TransferEventSignature))
+}
+
 func getTokenLog(logs []*types.Log) *types.Log {
 	signature := crypto.Keccak256Hash([]byte(erc20TransferEventSignature))
 	for _, l := range logs {
@@ -67,6 +67,11 @@ func (rc *walletClient) NonceAt(ctx context.Context, account common.Address, blo
 	return rc.client.NonceAt(ctx, account, blockNumber)
 }
 
+func (rc *walletClient) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	rpcstats.CountCall("eth_getTransactionCount")
+	return rc.client.PendingNonceAt(ctx, account)
+}
+
 func (rc *walletClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
 	rpcstats.CountCall("eth_getTransactionReceipt")
 	return rc.client.TransactionReceipt(ctx, txHash)
@@ -13,14 +13,20 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/status-im/status-go/multiaccounts/accounts"
+	"github.com/status-im/status-go/services/wallet/decoder"
 )
 
 // NewService initializes service instance.
 func NewService(db *Database, accountsFeed *event.Feed) *Service {
 	feed := &event.Feed{}
+	var registry *decoder.Registry
+	if db != nil {
+		registry = decoder.NewRegistry(db.db, db.network)
+	}
 	return &Service{
-		db:   db,
-		feed: feed,
+		db:       db,
+		registry: registry,
+		feed:     feed,
 		signals: &SignalsTransmitter{
 			publisher: feed,
 		},
@@ -32,9 +38,11 @@ func NewService(db *Database, accountsFeed *event.Feed) *Service {
 type Service struct {
 	feed                *event.Feed
 	db                  *Database
+	registry            *decoder.Registry
 	reactor             *Reactor
 	signals             *SignalsTransmitter
 	client              *walletClient
+	rpcClient           rpcCaller
 	cryptoOnRampManager *CryptoOnRampManager
 	started             bool
 
@@ -58,6 +66,11 @@ func (s *Service) SetClient(client *ethclient.Client) {
 	s.client = &walletClient{client: client}
 }
 
+// SetRPCClient sets the client used for the JSON-RPC calls which aren't wrapped by ethclient.
+func (s *Service) SetRPCClient(client rpcCaller) {
+	s.rpcClient = client
+}
+
 // MergeBlocksRanges merge old blocks ranges if possible
 func (s *Service) MergeBlocksRanges(accounts []common.Address, chain uint64) error {
 	for _, account := range accounts {
@@ -181,6 +194,29 @@ func mapToList(m map[common.Address]struct{}) []common.Address {
 	return rst
 }
 
+// transferViews casts transfers to views with their decoded contract calls. Calls are
+// decoded when read so that ABIs added later apply to the whole history.
+func (s *Service) transferViews(transfers []Transfer) []TransferView {
+	views := castToTransferViews(transfers)
+	if s.registry == nil {
+		return views
+	}
+	for i := range views {
+		view := &views[i]
+		// Contract deployments have no recipient and their input is the bytecode
+		if view.Type != ethTransfer || len(view.Input) == 0 || view.To == (common.Address{}) {
+			continue
+		}
+		method, err := s.registry.Decode(view.To, view.Input)
+		if err != nil {
+			log.Warn("can't decode contract call", "hash", view.TxHash, "error", err)
+			continue
+		}
+		view.Method = method
+	}
+	return views
+}
+
 func (s *Service) IsStarted() bool {
 	return s.started
 }
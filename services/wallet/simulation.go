@@ -0,0 +1,207 @@
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/status-im/status-go/services/wallet/decoder"
+	"github.com/status-im/status-go/transactions"
+)
+
+// rpcCaller performs the JSON-RPC calls which aren't wrapped by ethclient.
+type rpcCaller interface {
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+}
+
+// TokenBalanceChange is a change of the token balance of the sender predicted by a simulation.
+type TokenBalanceChange struct {
+	Token common.Address `json:"token"`
+	// Delta is negative when the balance decreases
+	Delta *hexutil.Big `json:"delta"`
+}
+
+// Simulation is the result of running a transaction on top of the pending block,
+// before it is signed.
+type Simulation struct {
+	Success    bool          `json:"success"`
+	ReturnData hexutil.Bytes `json:"returnData,omitempty"`
+	// GasEstimate is only set when the transaction succeeds
+	GasEstimate hexutil.Uint64 `json:"gasEstimate,omitempty"`
+	// Error is the error of the node when the transaction fails
+	Error  string          `json:"error,omitempty"`
+	Revert *decoder.Revert `json:"revert,omitempty"`
+	// BalanceChanges is nil when the node doesn't support call tracing
+	BalanceChanges []TokenBalanceChange `json:"balanceChanges"`
+}
+
+// callFrame is a call traced by the callTracer of debug_traceCall.
+type callFrame struct {
+	Output hexutil.Bytes `json:"output"`
+	Error  string        `json:"error"`
+	Calls  []callFrame   `json:"calls"`
+	Logs   []callLog     `json:"logs"`
+}
+
+type callLog struct {
+	Address common.Address `json:"address"`
+	Topics  []common.Hash  `json:"topics"`
+	Data    hexutil.Bytes  `json:"data"`
+}
+
+var callTracerConfig = map[string]interface{}{
+	"tracer":       "callTracer",
+	"tracerConfig": map[string]interface{}{"withLog": true},
+}
+
+// simulateTransaction runs the transaction with eth_call at the pending block and decodes
+// the data it reverts with. The token balance changes are read from the Transfer events
+// of a traced call, when the node supports debug_traceCall.
+func simulateTransaction(ctx context.Context, client rpcCaller, registry *decoder.Registry, args transactions.SendTxArgs) (*Simulation, error) {
+	call := toCallArg(args)
+	simulation := &Simulation{}
+
+	var revertData []byte
+	err := client.CallContext(ctx, &simulation.ReturnData, "eth_call", call, "pending")
+	if err == nil {
+		var gas hexutil.Uint64
+		err = client.CallContext(ctx, &gas, "eth_estimateGas", call)
+		simulation.GasEstimate = gas
+	}
+	if err != nil {
+		var rpcErr rpc.Error
+		if !errors.As(err, &rpcErr) {
+			return nil, err
+		}
+		simulation.Error = err.Error()
+		simulation.ReturnData = nil
+		revertData = errorData(err)
+	} else {
+		simulation.Success = true
+	}
+
+	var frame callFrame
+	err = client.CallContext(ctx, &frame, "debug_traceCall", call, "pending", callTracerConfig)
+	if err != nil {
+		log.Debug("can't trace the simulated transaction", "error", err)
+	} else {
+		simulation.BalanceChanges = tokenBalanceChanges(common.Address(args.From), &frame)
+		// Some nodes don't return the revert data with the error of eth_call
+		if !simulation.Success && len(revertData) == 0 {
+			revertData = frame.Output
+		}
+	}
+
+	if !simulation.Success && registry != nil {
+		var to common.Address
+		if args.To != nil {
+			to = common.Address(*args.To)
+		}
+		simulation.Revert, err = registry.DecodeRevert(to, revertData)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return simulation, nil
+}
+
+// errorData returns the data of an execution error, which is the revert data when
+// the transaction reverted.
+func errorData(err error) []byte {
+	var dataErr rpc.DataError
+	if !errors.As(err, &dataErr) {
+		return nil
+	}
+	encoded, ok := dataErr.ErrorData().(string)
+	if !ok {
+		return nil
+	}
+	data, err := hexutil.Decode(encoded)
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+// tokenBalanceChanges sums the ERC-20 transfers from and to the address in the
+// traced calls, leaving out the calls which reverted.
+func tokenBalanceChanges(address common.Address, root *callFrame) []TokenBalanceChange {
+	signature := crypto.Keccak256Hash([]byte(erc20TransferEventSignature))
+	deltas := map[common.Address]*big.Int{}
+
+	var walk func(frame *callFrame)
+	walk = func(frame *callFrame) {
+		if frame.Error != "" {
+			return
+		}
+		for _, l := range frame.Logs {
+			// ERC-721 transfers have the same signature but the token ID is indexed
+			if len(l.Topics) != 3 || l.Topics[0] != signature || len(l.Data) != 32 {
+				continue
+			}
+			from := common.BytesToAddress(l.Topics[1].Bytes())
+			to := common.BytesToAddress(l.Topics[2].Bytes())
+			if from == to || (from != address && to != address) {
+				continue
+			}
+			if _, ok := deltas[l.Address]; !ok {
+				deltas[l.Address] = new(big.Int)
+			}
+			amount := new(big.Int).SetBytes(l.Data)
+			if from == address {
+				deltas[l.Address].Sub(deltas[l.Address], amount)
+			} else {
+				deltas[l.Address].Add(deltas[l.Address], amount)
+			}
+		}
+		for i := range frame.Calls {
+			walk(&frame.Calls[i])
+		}
+	}
+	walk(root)
+
+	changes := []TokenBalanceChange{}
+	for token, delta := range deltas {
+		if delta.Sign() != 0 {
+			changes = append(changes, TokenBalanceChange{Token: token, Delta: (*hexutil.Big)(delta)})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return bytes.Compare(changes[i].Token.Bytes(), changes[j].Token.Bytes()) < 0
+	})
+	return changes
+}
+
+func toCallArg(args transactions.SendTxArgs) map[string]interface{} {
+	arg := map[string]interface{}{
+		"from": args.From,
+		"to":   args.To,
+	}
+	if input := args.GetInput(); len(input) > 0 {
+		arg["data"] = input
+	}
+	if args.Value != nil {
+		arg["value"] = args.Value
+	}
+	if args.Gas != nil {
+		arg["gas"] = args.Gas
+	}
+	if args.GasPrice != nil {
+		arg["gasPrice"] = args.GasPrice
+	}
+	if args.MaxFeePerGas != nil {
+		arg["maxFeePerGas"] = args.MaxFeePerGas
+	}
+	if args.MaxPriorityFeePerGas != nil {
+		arg["maxPriorityFeePerGas"] = args.MaxPriorityFeePerGas
+	}
+	return arg
+}
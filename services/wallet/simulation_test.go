@@ -0,0 +1,170 @@
+package wallet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/services/wallet/decoder"
+	"github.com/status-im/status-go/transactions"
+)
+
+// executionError is an error returned by the node for a transaction which fails.
+type executionError struct {
+	message string
+	data    interface{}
+}
+
+func (e *executionError) Error() string          { return e.message }
+func (e *executionError) ErrorCode() int         { return 3 }
+func (e *executionError) ErrorData() interface{} { return e.data }
+
+// fakeRPC answers the calls of a simulation with the recorded responses.
+type fakeRPC struct {
+	responses map[string]interface{}
+	errors    map[string]error
+}
+
+func (c *fakeRPC) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	if err, ok := c.errors[method]; ok {
+		return err
+	}
+	response, ok := c.responses[method]
+	if !ok {
+		return &executionError{message: "the method " + method + " does not exist/is not available"}
+	}
+	encoded, err := json.Marshal(response)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(encoded, result)
+}
+
+func transferLog(token, from, to common.Address, amount int64) callLog {
+	return callLog{
+		Address: token,
+		Topics: []common.Hash{
+			crypto.Keccak256Hash([]byte(erc20TransferEventSignature)),
+			common.BytesToHash(from.Bytes()),
+			common.BytesToHash(to.Bytes()),
+		},
+		Data: common.LeftPadBytes(big.NewInt(amount).Bytes(), 32),
+	}
+}
+
+var (
+	testSender = common.Address{1}
+	testRouter = common.Address{2}
+	testTokenA = common.Address{3}
+	testTokenB = common.Address{4}
+)
+
+func testSendTxArgs() transactions.SendTxArgs {
+	to := types.Address(testRouter)
+	return transactions.SendTxArgs{
+		From:  types.Address(testSender),
+		To:    &to,
+		Input: types.HexBytes{0x12, 0x34, 0x56, 0x78},
+	}
+}
+
+func setupTestRegistry(t *testing.T) (*decoder.Registry, func()) {
+	db, stop := setupTestDB(t)
+	return decoder.NewRegistry(db.db, db.network), stop
+}
+
+func TestSimulateSwap(t *testing.T) {
+	registry, stop := setupTestRegistry(t)
+	defer stop()
+
+	nft := transferLog(testTokenB, testRouter, testSender, 0)
+	nft.Topics = append(nft.Topics, common.Hash{9})
+	nft.Data = nil
+	trace := callFrame{
+		Calls: []callFrame{
+			{Logs: []callLog{transferLog(testTokenA, testSender, testRouter, 100)}},
+			{Logs: []callLog{transferLog(testTokenB, testRouter, testSender, 40)}},
+			// Logs of calls which reverted are discarded
+			{Error: "execution reverted", Logs: []callLog{transferLog(testTokenB, testRouter, testSender, 1000)}},
+			{Logs: []callLog{nft, transferLog(testTokenA, testRouter, common.Address{5}, 7)}},
+		},
+	}
+	client := &fakeRPC{responses: map[string]interface{}{
+		"eth_call":        hexutil.Bytes{1},
+		"eth_estimateGas": hexutil.Uint64(150000),
+		"debug_traceCall": trace,
+	}}
+
+	simulation, err := simulateTransaction(context.Background(), client, registry, testSendTxArgs())
+	require.NoError(t, err)
+	require.True(t, simulation.Success)
+	require.Equal(t, hexutil.Bytes{1}, simulation.ReturnData)
+	require.Equal(t, hexutil.Uint64(150000), simulation.GasEstimate)
+	require.Nil(t, simulation.Revert)
+	require.Equal(t, []TokenBalanceChange{
+		{Token: testTokenA, Delta: (*hexutil.Big)(big.NewInt(-100))},
+		{Token: testTokenB, Delta: (*hexutil.Big)(big.NewInt(40))},
+	}, simulation.BalanceChanges)
+}
+
+func TestSimulateRevertWithoutTracing(t *testing.T) {
+	registry, stop := setupTestRegistry(t)
+	defer stop()
+
+	client := &fakeRPC{errors: map[string]error{
+		"eth_call": &executionError{
+			message: "execution reverted: ERC20: transfer amount exceeds balance",
+			data:    "0x08c379a00000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000002645524332303a207472616e7366657220616d6f756e7420657863656564732062616c616e63650000000000000000000000000000000000000000000000000000",
+		},
+	}}
+
+	simulation, err := simulateTransaction(context.Background(), client, registry, testSendTxArgs())
+	require.NoError(t, err)
+	require.False(t, simulation.Success)
+	require.Equal(t, "execution reverted: ERC20: transfer amount exceeds balance", simulation.Error)
+	require.Equal(t, decoder.RevertError, simulation.Revert.Kind)
+	require.Equal(t, "ERC20: transfer amount exceeds balance", simulation.Revert.Reason)
+	require.Nil(t, simulation.BalanceChanges)
+}
+
+func TestSimulateRevertDataFromTrace(t *testing.T) {
+	registry, stop := setupTestRegistry(t)
+	defer stop()
+
+	client := &fakeRPC{
+		errors: map[string]error{
+			"eth_call": &executionError{message: "execution reverted"},
+		},
+		responses: map[string]interface{}{
+			"debug_traceCall": callFrame{
+				Error:  "execution reverted",
+				Output: hexutil.MustDecode("0x4e487b710000000000000000000000000000000000000000000000000000000000000012"),
+			},
+		},
+	}
+
+	simulation, err := simulateTransaction(context.Background(), client, registry, testSendTxArgs())
+	require.NoError(t, err)
+	require.False(t, simulation.Success)
+	require.Equal(t, decoder.RevertPanic, simulation.Revert.Kind)
+	require.Equal(t, "division or modulo by zero", simulation.Revert.Reason)
+	require.Equal(t, []TokenBalanceChange{}, simulation.BalanceChanges)
+}
+
+func TestSimulateConnectionError(t *testing.T) {
+	registry, stop := setupTestRegistry(t)
+	defer stop()
+
+	client := &fakeRPC{errors: map[string]error{"eth_call": errors.New("connection refused")}}
+	_, err := simulateTransaction(context.Background(), client, registry, testSendTxArgs())
+	require.EqualError(t, err, "connection refused")
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/status-im/status-go/services/wallet/decoder"
 )
 
 func castToTransferViews(transfers []Transfer) []TransferView {
@@ -98,4 +100,6 @@ type TransferView struct {
 	To          common.Address `json:"to"`
 	Contract    common.Address `json:"contract"`
 	NetworkID   uint64
+	// Method is the decoded contract call of the transaction, nil if unknown
+	Method *decoder.Method `json:"method,omitempty"`
 }
@@ -0,0 +1,34 @@
+package signal
+
+import "encoding/json"
+
+const (
+	// EventDappRequestQueued is triggered when a dapp request waits for the approval of the user
+	EventDappRequestQueued = "dapp-request.queued"
+	// EventDappRequestCompleted is triggered when a dapp request has been approved, rejected or has expired
+	EventDappRequestCompleted = "dapp-request.completed"
+)
+
+// DappRequestEvent is a signal sent when a dapp request is queued
+type DappRequestEvent struct {
+	ID     string          `json:"id"`
+	Origin string          `json:"origin"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params,omitempty"`
+}
+
+// DappRequestCompletedEvent is a signal sent when a dapp request leaves the queue
+type DappRequestCompletedEvent struct {
+	ID       string `json:"id"`
+	Approved bool   `json:"approved"`
+}
+
+// SendDappRequestQueued sends a signal when a dapp request is queued.
+func SendDappRequestQueued(event DappRequestEvent) {
+	send(EventDappRequestQueued, event)
+}
+
+// SendDappRequestCompleted sends a signal when a dapp request leaves the queue.
+func SendDappRequestCompleted(id string, approved bool) {
+	send(EventDappRequestCompleted, DappRequestCompletedEvent{ID: id, Approved: approved})
+}
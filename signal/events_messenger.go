@@ -10,6 +10,12 @@ const (
 	// EventCommunityFound triggered when user requested info about some community and messenger successfully
 	// retrieved it from mailserver
 	EventCommunityInfoFound = "community.found"
+
+	// EventMessageQueueStatusChanged triggered when a message is added, re-sent or removed from the outbound queue
+	EventMessageQueueStatusChanged = "message.queue.changed"
+
+	// EventFileDownloadProgress triggered when a chunk of a file attached to a message is received
+	EventFileDownloadProgress = "message.file.progress"
 )
 
 // MessageDeliveredSignal specifies chat and message that was delivered
@@ -26,6 +32,22 @@ type CommunityInfoFoundSignal struct {
 	Verified     bool   `json:"verified"`
 }
 
+// MessageQueueStatusSignal specifies the status of a message in the outbound queue
+type MessageQueueStatusSignal struct {
+	ChatID    string `json:"chatID"`
+	MessageID string `json:"messageID"`
+	Status    string `json:"status"`
+	Attempts  int    `json:"attempts"`
+}
+
+// FileDownloadProgressSignal specifies how many chunks of the file attached to a message were received
+type FileDownloadProgressSignal struct {
+	ChatID    string `json:"chatID"`
+	MessageID string `json:"messageID"`
+	Received  uint32 `json:"received"`
+	Total     uint32 `json:"total"`
+}
+
 // SendMessageDelivered notifies about delivered message
 func SendMessageDelivered(chatID string, messageID string) {
 	send(EventMesssageDelivered, MessageDeliveredSignal{ChatID: chatID, MessageID: messageID})
@@ -35,3 +57,13 @@ func SendMessageDelivered(chatID string, messageID string) {
 func SendCommunityInfoFound(community *communities.Community) {
 	send(EventCommunityInfoFound, community)
 }
+
+// SendMessageQueueStatusChanged notifies about a message moving through the outbound queue
+func SendMessageQueueStatusChanged(chatID string, messageID string, status string, attempts int) {
+	send(EventMessageQueueStatusChanged, MessageQueueStatusSignal{ChatID: chatID, MessageID: messageID, Status: status, Attempts: attempts})
+}
+
+// SendFileDownloadProgress notifies about the chunks received of a file attached to a message
+func SendFileDownloadProgress(chatID string, messageID string, received uint32, total uint32) {
+	send(EventFileDownloadProgress, FileDownloadProgressSignal{ChatID: chatID, MessageID: messageID, Received: received, Total: total})
+}
@@ -0,0 +1,321 @@
+package helpers
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const simulatedGasLimit = 10000000
+
+// stubProgramSelector prefixes the calls programming the answers of a stub contract
+var stubProgramSelector = []byte{0xff, 0xff, 0xff, 0xff}
+
+// ErrTransactionFailed is returned when a transaction sent to the simulated chain is reverted
+var ErrTransactionFailed = errors.New("transaction failed")
+
+// SimulatedChain is an in-memory blockchain with a funded account, on which
+// stub contracts answering the calls of the tests are deployed.
+type SimulatedChain struct {
+	*backends.SimulatedBackend
+
+	Key     *ecdsa.PrivateKey
+	Account common.Address
+}
+
+// NewSimulatedChain creates a chain where the given addresses hold a stub
+// contract from the genesis, for contracts which are expected at a fixed
+// address like the ENS registry.
+func NewSimulatedChain(stubs ...common.Address) (*SimulatedChain, error) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+	account := crypto.PubkeyToAddress(key.PublicKey)
+
+	alloc := core.GenesisAlloc{
+		account: {Balance: new(big.Int).Mul(big.NewInt(1000), big.NewInt(params.Ether))},
+	}
+	for _, address := range stubs {
+		alloc[address] = core.GenesisAccount{
+			Balance: new(big.Int),
+			Code:    stubRuntime(),
+			Storage: map[common.Hash]common.Hash{{}: common.BytesToHash(account.Bytes())},
+		}
+	}
+
+	return &SimulatedChain{
+		SimulatedBackend: backends.NewSimulatedBackend(alloc, simulatedGasLimit),
+		Key:              key,
+		Account:          account,
+	}, nil
+}
+
+func (c *SimulatedChain) transactOpts() (*bind.TransactOpts, error) {
+	return bind.NewKeyedTransactorWithChainID(c.Key, params.AllEthashProtocolChanges.ChainID)
+}
+
+// DeployStub deploys a stub contract owned by the account of the chain.
+func (c *SimulatedChain) DeployStub() (common.Address, error) {
+	return c.Deploy(stubInit(), abi.ABI{})
+}
+
+// Deploy deploys a contract from its creation code and the parameters of its
+// constructor, from the account of the chain.
+func (c *SimulatedChain) Deploy(bytecode []byte, contractABI abi.ABI, params ...interface{}) (common.Address, error) {
+	opts, err := c.transactOpts()
+	if err != nil {
+		return common.Address{}, err
+	}
+	address, tx, _, err := bind.DeployContract(opts, contractABI, bytecode, c, params...)
+	if err != nil {
+		return common.Address{}, err
+	}
+	_, err = c.commit(tx)
+	return address, err
+}
+
+// DeployRuntime deploys a contract with the given code and no constructor.
+func (c *SimulatedChain) DeployRuntime(runtime []byte) (common.Address, error) {
+	var a Assembler
+	a.Push(uint64(len(runtime))).PushLabel("runtime").Push(0).Op(vm.CODECOPY)
+	a.Push(uint64(len(runtime))).Push(0).Op(vm.RETURN)
+	a.Mark("runtime")
+	return c.Deploy(append(a.Assemble(), runtime...), abi.ABI{})
+}
+
+// Transact sends a transaction with the input to the contract from the
+// account of the chain and mines it.
+func (c *SimulatedChain) Transact(contract common.Address, input []byte) (*types.Receipt, error) {
+	opts, err := c.transactOpts()
+	if err != nil {
+		return nil, err
+	}
+	tx, err := bind.NewBoundContract(contract, abi.ABI{}, c, c, c).RawTransact(opts, input)
+	if err != nil {
+		return nil, err
+	}
+	return c.commit(tx)
+}
+
+// Stub programs the stub contract to return the answer to the call.
+// Calls which aren't programmed succeed with an empty answer.
+func (c *SimulatedChain) Stub(contract common.Address, call []byte, answer []byte) error {
+	input := append(append(append([]byte{}, stubProgramSelector...), crypto.Keccak256(call)...), answer...)
+	_, err := c.Transact(contract, input)
+	return err
+}
+
+// StubMethod programs the answer of the stub contract to a method of the ABI.
+func (c *SimulatedChain) StubMethod(contract common.Address, contractABI abi.ABI, method string, args []interface{}, outputs ...interface{}) error {
+	call, err := contractABI.Pack(method, args...)
+	if err != nil {
+		return err
+	}
+	answer, err := contractABI.Methods[method].Outputs.Pack(outputs...)
+	if err != nil {
+		return err
+	}
+	return c.Stub(contract, call, answer)
+}
+
+func (c *SimulatedChain) commit(tx *types.Transaction) (*types.Receipt, error) {
+	c.Commit()
+	receipt, err := c.TransactionReceipt(context.Background(), tx.Hash())
+	if err != nil {
+		return nil, err
+	}
+	if receipt == nil || receipt.Status != types.ReceiptStatusSuccessful {
+		return receipt, ErrTransactionFailed
+	}
+	return receipt, nil
+}
+
+// stubInit is the creation code of the stub contract, which sets the
+// deployer as the owner in slot 0.
+func stubInit() []byte {
+	runtime := stubRuntime()
+	var a Assembler
+	a.Op(vm.CALLER).Push(0).Op(vm.SSTORE)
+	a.Push(uint64(len(runtime))).PushLabel("runtime").Push(0).Op(vm.CODECOPY)
+	a.Push(uint64(len(runtime))).Push(0).Op(vm.RETURN)
+	a.Mark("runtime")
+	return append(a.Assemble(), runtime...)
+}
+
+// stubRuntime is the code of the stub contract. The answer to a call is stored
+// at the keccak256 hash h of its calldata: the length at h and the words at
+// h+1, h+2, ... The owner programs an answer with a call made of
+// stubProgramSelector, h and the answer.
+func stubRuntime() []byte {
+	var a Assembler
+
+	// Calls from the owner starting with the selector program an answer
+	a.Push(0).Op(vm.SLOAD).Op(vm.CALLER).Op(vm.EQ)
+	a.Push(0).Op(vm.CALLDATALOAD).Push(0xe0).Op(vm.SHR).Push(0xffffffff).Op(vm.EQ)
+	a.Op(vm.AND).PushLabel("program").Op(vm.JUMPI)
+
+	// Answer: stack [h, length, offset]
+	a.Op(vm.CALLDATASIZE).Push(0).Push(0).Op(vm.CALLDATACOPY)
+	a.Op(vm.CALLDATASIZE).Push(0).Op(vm.SHA3)
+	a.Op(vm.DUP1).Op(vm.SLOAD).Push(0)
+	a.Label("answer")
+	a.Op(vm.DUP2).Op(vm.DUP2).Op(vm.LT).Op(vm.ISZERO).PushLabel("return").Op(vm.JUMPI)
+	a.Op(vm.DUP1).Push(32).Op(vm.SWAP1).Op(vm.DIV).Op(vm.DUP4).Op(vm.ADD).Push(1).Op(vm.ADD).Op(vm.SLOAD)
+	a.Op(vm.DUP2).Op(vm.MSTORE)
+	a.Push(32).Op(vm.ADD).PushLabel("answer").Op(vm.JUMP)
+	a.Label("return")
+	a.Op(vm.POP).Push(0).Op(vm.RETURN)
+
+	// Program: stack [h, length, offset]
+	a.Label("program")
+	a.Push(4).Op(vm.CALLDATALOAD)
+	a.Push(36).Op(vm.CALLDATASIZE).Op(vm.SUB)
+	a.Op(vm.DUP1).Op(vm.DUP3).Op(vm.SSTORE).Push(0)
+	a.Label("store")
+	a.Op(vm.DUP2).Op(vm.DUP2).Op(vm.LT).Op(vm.ISZERO).PushLabel("stop").Op(vm.JUMPI)
+	a.Op(vm.DUP1).Push(36).Op(vm.ADD).Op(vm.CALLDATALOAD)
+	a.Op(vm.DUP2).Push(32).Op(vm.SWAP1).Op(vm.DIV).Op(vm.DUP5).Op(vm.ADD).Push(1).Op(vm.ADD)
+	a.Op(vm.SSTORE)
+	a.Push(32).Op(vm.ADD).PushLabel("store").Op(vm.JUMP)
+	a.Label("stop")
+	a.Op(vm.STOP)
+
+	return a.Assemble()
+}
+
+// Assembler builds EVM code, resolving the labels pushed as jump targets.
+// Labels are pushed with PUSH2 and are marked with a JUMPDEST.
+type Assembler struct {
+	code   []byte
+	labels map[string]int
+	refs   map[int]string
+}
+
+// Op appends an opcode.
+func (a *Assembler) Op(op vm.OpCode) *Assembler {
+	a.code = append(a.code, byte(op))
+	return a
+}
+
+// Push appends the shortest push of the value.
+func (a *Assembler) Push(value uint64) *Assembler {
+	return a.PushBytes(new(big.Int).SetUint64(value).Bytes())
+}
+
+// PushBytes appends the push of up to 32 bytes, like an address or a hash.
+func (a *Assembler) PushBytes(data []byte) *Assembler {
+	if len(data) == 0 {
+		data = []byte{0}
+	}
+	a.code = append(a.code, byte(vm.PUSH1)+byte(len(data)-1))
+	a.code = append(a.code, data...)
+	return a
+}
+
+// PushLabel appends the push of the position of a label.
+func (a *Assembler) PushLabel(name string) *Assembler {
+	if a.refs == nil {
+		a.refs = make(map[int]string)
+	}
+	a.code = append(a.code, byte(vm.PUSH2))
+	a.refs[len(a.code)] = name
+	a.code = append(a.code, 0, 0)
+	return a
+}
+
+// Mark names the current position of the code.
+func (a *Assembler) Mark(name string) *Assembler {
+	if a.labels == nil {
+		a.labels = make(map[string]int)
+	}
+	a.labels[name] = len(a.code)
+	return a
+}
+
+// Label marks a jump target.
+func (a *Assembler) Label(name string) *Assembler {
+	return a.Mark(name).Op(vm.JUMPDEST)
+}
+
+// Assemble returns the code with the positions of the labels.
+func (a *Assembler) Assemble() []byte {
+	for position, name := range a.refs {
+		target := a.labels[name]
+		a.code[position] = byte(target >> 8)
+		a.code[position+1] = byte(target)
+	}
+	return a.code
+}
+
+// callArgs are the arguments of eth_estimateGas.
+type callArgs struct {
+	From     common.Address  `json:"from"`
+	To       *common.Address `json:"to"`
+	Gas      *hexutil.Uint64 `json:"gas"`
+	GasPrice *hexutil.Big    `json:"gasPrice"`
+	Value    *hexutil.Big    `json:"value"`
+	Data     hexutil.Bytes   `json:"data"`
+}
+
+// ethAPI serves the eth methods used to send transactions from the chain.
+type ethAPI struct {
+	chain *SimulatedChain
+}
+
+func (api *ethAPI) GetTransactionCount(ctx context.Context, address common.Address, block string) (hexutil.Uint64, error) {
+	nonce, err := api.chain.PendingNonceAt(ctx, address)
+	return hexutil.Uint64(nonce), err
+}
+
+func (api *ethAPI) GasPrice(ctx context.Context) (*hexutil.Big, error) {
+	price, err := api.chain.SuggestGasPrice(ctx)
+	return (*hexutil.Big)(price), err
+}
+
+func (api *ethAPI) EstimateGas(ctx context.Context, args callArgs) (hexutil.Uint64, error) {
+	msg := ethereum.CallMsg{
+		From:     args.From,
+		To:       args.To,
+		GasPrice: (*big.Int)(args.GasPrice),
+		Value:    (*big.Int)(args.Value),
+		Data:     args.Data,
+	}
+	if args.Gas != nil {
+		msg.Gas = uint64(*args.Gas)
+	}
+	gas, err := api.chain.EstimateGas(ctx, msg)
+	return hexutil.Uint64(gas), err
+}
+
+func (api *ethAPI) SendRawTransaction(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(input); err != nil {
+		return common.Hash{}, err
+	}
+	return tx.Hash(), api.chain.SendTransaction(ctx, tx)
+}
+
+// RPCClient returns an in-process RPC client of the chain, for the code
+// sending transactions through a node. The transactions are mined on Commit.
+func (c *SimulatedChain) RPCClient() (*rpc.Client, error) {
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", &ethAPI{chain: c}); err != nil {
+		return nil, err
+	}
+	return rpc.DialInProc(server), nil
+}
@@ -0,0 +1,274 @@
+// Copyright 2019 The Waku Library Authors.
+//
+// The Waku library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Waku library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty off
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Waku library. If not, see <http://www.gnu.org/licenses/>.
+//
+// This software uses the go-ethereum library, which is licensed
+// under the GNU Lesser General Public Library, version 3 or any later.
+
+package common
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/tsenart/tb"
+)
+
+var errRateLimitExceeded = errors.New("rate limit has been exceeded")
+
+// RateLimiterHandler interface represents handler functionality for a Rate Limiter in the cases of
+// exceeding a peer limit and exceeding an IP limit
+type RateLimiterHandler interface {
+	ExceedPeerLimit(peerID string) error
+	ExceedIPLimit(ip string) error
+}
+
+// MetricsRateLimiterHandler implements RateLimiterHandler, represents a handler for reporting rate limit Exceed data
+// to the metrics collection service (currently prometheus)
+type MetricsRateLimiterHandler struct{}
+
+func (MetricsRateLimiterHandler) ExceedPeerLimit(peerID string) error {
+	RateLimitsExceeded.WithLabelValues("peer_id").Inc()
+	return nil
+}
+func (MetricsRateLimiterHandler) ExceedIPLimit(ip string) error {
+	RateLimitsExceeded.WithLabelValues("ip").Inc()
+	return nil
+}
+
+const (
+	// exceedsWindow is the time after which the exceeds of a peer or an IP are forgotten
+	exceedsWindow = time.Minute
+	// maxTrackedExceeds bounds the number of peers and IPs whose exceeds are counted
+	maxTrackedExceeds = 1024
+)
+
+// limitExceeds counts the exceeds of a peer or an IP in the current window
+type limitExceeds struct {
+	count int64
+	last  time.Time
+}
+
+// exceedsCounter counts the recent exceeds by key. It holds at most
+// maxTrackedExceeds keys, the stale ones being evicted first.
+type exceedsCounter map[string]*limitExceeds
+
+func (c exceedsCounter) inc(key string, now time.Time) int64 {
+	exceeds, ok := c[key]
+	if !ok && len(c) >= maxTrackedExceeds {
+		c.evict(now)
+	}
+	if !ok || now.Sub(exceeds.last) > exceedsWindow {
+		exceeds = &limitExceeds{}
+		c[key] = exceeds
+	}
+	exceeds.count++
+	exceeds.last = now
+	return exceeds.count
+}
+
+// evict removes the stale keys, or the least recent one if none is stale.
+func (c exceedsCounter) evict(now time.Time) {
+	var oldestKey string
+	var oldest time.Time
+	for key, exceeds := range c {
+		if now.Sub(exceeds.last) > exceedsWindow {
+			delete(c, key)
+			continue
+		}
+		if oldestKey == "" || exceeds.last.Before(oldest) {
+			oldestKey = key
+			oldest = exceeds.last
+		}
+	}
+	if len(c) >= maxTrackedExceeds {
+		delete(c, oldestKey)
+	}
+}
+
+// DropPeerRateLimiterHandler implements RateLimiterHandler, represents a handler that introduces Tolerance to the
+// number of times a peer or an IP exceeds its limits before Limit Exceeded errors are returned.
+// Unlike in Waku v1 a single rate limiter is shared by all the relay peers,
+// so the exceeds are counted per peer ID and per IP, within exceedsWindow.
+type DropPeerRateLimiterHandler struct {
+	// Tolerance is a number by which a limit must be exceeded before a peer is dropped.
+	Tolerance int64
+
+	mu               sync.Mutex
+	peerLimitExceeds exceedsCounter
+	ipLimitExceeds   exceedsCounter
+	timeSource       func() time.Time
+}
+
+func (h *DropPeerRateLimiterHandler) ExceedPeerLimit(peerID string) error {
+	return h.exceed(&h.peerLimitExceeds, peerID)
+}
+
+func (h *DropPeerRateLimiterHandler) ExceedIPLimit(ip string) error {
+	return h.exceed(&h.ipLimitExceeds, ip)
+}
+
+func (h *DropPeerRateLimiterHandler) exceed(counter *exceedsCounter, key string) error {
+	if h.Tolerance <= 0 {
+		return nil
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if *counter == nil {
+		*counter = make(exceedsCounter)
+	}
+	now := time.Now()
+	if h.timeSource != nil {
+		now = h.timeSource()
+	}
+	if counter.inc(key, now) >= h.Tolerance {
+		delete(*counter, key)
+		return errRateLimitExceeded
+	}
+	return nil
+}
+
+// PeerRateLimiterConfig represents configurations for initialising a PeerRateLimiter
+type PeerRateLimiterConfig struct {
+	PacketLimitPerSecIP     int64
+	PacketLimitPerSecPeerID int64
+	BytesLimitPerSecIP      int64
+	BytesLimitPerSecPeerID  int64
+	WhitelistedIPs          []string
+	WhitelistedPeerIDs      []string
+}
+
+var defaultPeerRateLimiterConfig = PeerRateLimiterConfig{
+	PacketLimitPerSecIP:     10,
+	PacketLimitPerSecPeerID: 5,
+	BytesLimitPerSecIP:      1048576, // 1MB
+	BytesLimitPerSecPeerID:  1048576, // 1MB
+	WhitelistedIPs:          nil,
+	WhitelistedPeerIDs:      nil,
+}
+
+// PeerRateLimiter represents a rate limiter that limits the messages relayed by libp2p peers
+type PeerRateLimiter struct {
+	packetThrottler *tb.Throttler
+	bytesThrottler  *tb.Throttler
+
+	PacketLimitPerSecIP     int64
+	PacketLimitPerSecPeerID int64
+
+	BytesLimitPerSecIP     int64
+	BytesLimitPerSecPeerID int64
+
+	whitelistedPeerIDs []string
+	whitelistedIPs     []string
+
+	handlers []RateLimiterHandler
+}
+
+func NewPeerRateLimiter(cfg *PeerRateLimiterConfig, handlers ...RateLimiterHandler) *PeerRateLimiter {
+	if cfg == nil {
+		cfgCopy := defaultPeerRateLimiterConfig
+		cfg = &cfgCopy
+	}
+
+	return &PeerRateLimiter{
+		packetThrottler:         tb.NewThrottler(time.Millisecond * 100),
+		bytesThrottler:          tb.NewThrottler(time.Millisecond * 100),
+		PacketLimitPerSecIP:     cfg.PacketLimitPerSecIP,
+		PacketLimitPerSecPeerID: cfg.PacketLimitPerSecPeerID,
+		BytesLimitPerSecIP:      cfg.BytesLimitPerSecIP,
+		BytesLimitPerSecPeerID:  cfg.BytesLimitPerSecPeerID,
+		whitelistedPeerIDs:      cfg.WhitelistedPeerIDs,
+		whitelistedIPs:          cfg.WhitelistedIPs,
+		handlers:                handlers,
+	}
+}
+
+// Process accounts for a message of the given size received from a peer.
+// It returns true if the message exceeds the limits and should be dropped,
+// and an error if the peer exceeded its limits too many times and should be disconnected.
+func (r *PeerRateLimiter) Process(peerID string, ip string, size int) (bool, error) {
+	RateLimitsProcessed.Inc()
+
+	halted := false
+
+	if r.throttleIP(ip, size) {
+		halted = true
+		for _, h := range r.handlers {
+			if err := h.ExceedIPLimit(ip); err != nil {
+				return true, err
+			}
+		}
+	}
+
+	if r.throttlePeer(peerID, size) {
+		halted = true
+		for _, h := range r.handlers {
+			if err := h.ExceedPeerLimit(peerID); err != nil {
+				return true, err
+			}
+		}
+	}
+
+	return halted, nil
+}
+
+// throttleIP throttles messages incoming from a given IP.
+func (r *PeerRateLimiter) throttleIP(ip string, size int) bool {
+	if ip == "" || stringSliceContains(r.whitelistedIPs, ip) {
+		return false
+	}
+
+	var packetLimiterResponse bool
+	var bytesLimiterResponse bool
+
+	if r.PacketLimitPerSecIP != 0 {
+		packetLimiterResponse = r.packetThrottler.Halt(ip, 1, r.PacketLimitPerSecIP)
+	}
+	if r.BytesLimitPerSecIP != 0 {
+		bytesLimiterResponse = r.bytesThrottler.Halt(ip, int64(size), r.BytesLimitPerSecIP)
+	}
+
+	return packetLimiterResponse || bytesLimiterResponse
+}
+
+// throttlePeer throttles messages incoming from a peer.
+func (r *PeerRateLimiter) throttlePeer(peerID string, size int) bool {
+	if stringSliceContains(r.whitelistedPeerIDs, peerID) {
+		return false
+	}
+
+	var packetLimiterResponse bool
+	var bytesLimiterResponse bool
+
+	if r.PacketLimitPerSecPeerID != 0 {
+		packetLimiterResponse = r.packetThrottler.Halt(peerID, 1, r.PacketLimitPerSecPeerID)
+	}
+
+	if r.BytesLimitPerSecPeerID != 0 {
+		bytesLimiterResponse = r.bytesThrottler.Halt(peerID, int64(size), r.BytesLimitPerSecPeerID)
+	}
+
+	return packetLimiterResponse || bytesLimiterResponse
+}
+
+func stringSliceContains(s []string, searched string) bool {
+	for _, item := range s {
+		if item == searched {
+			return true
+		}
+	}
+	return false
+}
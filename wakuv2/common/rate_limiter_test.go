@@ -0,0 +1,163 @@
+// Copyright 2019 The Waku Library Authors.
+//
+// The Waku library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Waku library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty off
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Waku library. If not, see <http://www.gnu.org/licenses/>.
+//
+// This software uses the go-ethereum library, which is licensed
+// under the GNU Lesser General Public Library, version 3 or any later.
+
+package common
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testPeerID = "16Uiu2HAmPLe7Mzm8TsYUubgCAW1aJoeFScxrLj8ppHFivPo97bUZ"
+	testIP     = "10.0.0.1"
+)
+
+type mockRateLimiterHandler struct {
+	exceedPeerLimit int
+	exceedIPLimit   int
+}
+
+func (m *mockRateLimiterHandler) ExceedPeerLimit(peerID string) error {
+	m.exceedPeerLimit++
+	return nil
+}
+
+func (m *mockRateLimiterHandler) ExceedIPLimit(ip string) error {
+	m.exceedIPLimit++
+	return nil
+}
+
+func TestPeerLimiterThrottlingWithZeroLimit(t *testing.T) {
+	r := NewPeerRateLimiter(&PeerRateLimiterConfig{}, &mockRateLimiterHandler{})
+	for i := 0; i < 1000; i++ {
+		halted, err := r.Process(testPeerID, testIP, 0)
+		require.NoError(t, err)
+		require.False(t, halted)
+	}
+}
+
+func TestPeerPacketLimiterHandler(t *testing.T) {
+	h := &mockRateLimiterHandler{}
+	r := NewPeerRateLimiter(nil, h)
+
+	for i := 0; i < 100; i++ {
+		_, err := r.Process(testPeerID, testIP, 0)
+		require.NoError(t, err)
+	}
+
+	require.EqualValues(t, 100-defaultPeerRateLimiterConfig.PacketLimitPerSecIP, h.exceedIPLimit)
+	require.EqualValues(t, 100-defaultPeerRateLimiterConfig.PacketLimitPerSecPeerID, h.exceedPeerLimit)
+}
+
+func TestPeerBytesLimiterHandler(t *testing.T) {
+	h := &mockRateLimiterHandler{}
+	r := NewPeerRateLimiter(&PeerRateLimiterConfig{
+		BytesLimitPerSecIP:     30,
+		BytesLimitPerSecPeerID: 30,
+	}, h)
+
+	for i := 0; i < 6; i++ {
+		_, err := r.Process(testPeerID, testIP, 10)
+		require.NoError(t, err)
+	}
+
+	require.EqualValues(t, 3, h.exceedIPLimit)
+	require.EqualValues(t, 3, h.exceedPeerLimit)
+}
+
+func TestPeerPacketLimiterHandlerWithWhitelisting(t *testing.T) {
+	h := &mockRateLimiterHandler{}
+	r := NewPeerRateLimiter(&PeerRateLimiterConfig{
+		PacketLimitPerSecIP:     1,
+		PacketLimitPerSecPeerID: 1,
+		WhitelistedIPs:          []string{testIP},
+		WhitelistedPeerIDs:      []string{testPeerID},
+	}, h)
+
+	for i := 0; i < 100; i++ {
+		halted, err := r.Process(testPeerID, testIP, 0)
+		require.NoError(t, err)
+		require.False(t, halted)
+	}
+
+	require.Equal(t, 0, h.exceedIPLimit)
+	require.Equal(t, 0, h.exceedPeerLimit)
+}
+
+func TestDropPeerRateLimiterHandler(t *testing.T) {
+	r := NewPeerRateLimiter(&PeerRateLimiterConfig{
+		PacketLimitPerSecPeerID: 1,
+	}, &DropPeerRateLimiterHandler{Tolerance: 3})
+
+	halted, err := r.Process(testPeerID, testIP, 0)
+	require.NoError(t, err)
+	require.False(t, halted)
+
+	for i := 0; i < 2; i++ {
+		halted, err = r.Process(testPeerID, testIP, 0)
+		require.NoError(t, err)
+		require.True(t, halted)
+	}
+
+	halted, err = r.Process(testPeerID, testIP, 0)
+	require.Equal(t, errRateLimitExceeded, err)
+	require.True(t, halted)
+
+	// Other peers are not affected
+	halted, err = r.Process("other-peer", testIP, 0)
+	require.NoError(t, err)
+	require.False(t, halted)
+}
+
+func TestDropPeerRateLimiterHandlerWindow(t *testing.T) {
+	now := time.Now()
+	h := &DropPeerRateLimiterHandler{Tolerance: 2, timeSource: func() time.Time { return now }}
+
+	require.NoError(t, h.ExceedPeerLimit(testPeerID))
+	// Exceeds older than the window are forgotten
+	now = now.Add(exceedsWindow + time.Second)
+	require.NoError(t, h.ExceedPeerLimit(testPeerID))
+	require.Equal(t, errRateLimitExceeded, h.ExceedPeerLimit(testPeerID))
+}
+
+func TestDropPeerRateLimiterHandlerBounded(t *testing.T) {
+	now := time.Now()
+	h := &DropPeerRateLimiterHandler{Tolerance: 2, timeSource: func() time.Time { return now }}
+
+	for i := 0; i < maxTrackedExceeds*2; i++ {
+		now = now.Add(time.Millisecond)
+		require.NoError(t, h.ExceedIPLimit(fmt.Sprintf("10.0.%d.%d", i/256, i%256)))
+	}
+	require.Len(t, h.ipLimitExceeds, maxTrackedExceeds)
+
+	// The least recent IPs are evicted first
+	require.NoError(t, h.ExceedIPLimit("10.0.0.0"))
+	lastIP := fmt.Sprintf("10.0.%d.%d", (maxTrackedExceeds*2-1)/256, (maxTrackedExceeds*2-1)%256)
+	require.Equal(t, errRateLimitExceeded, h.ExceedIPLimit(lastIP))
+
+	// Stale entries are evicted when the counter is full
+	require.NoError(t, h.ExceedIPLimit("10.0.255.255"))
+	require.Len(t, h.ipLimitExceeds, maxTrackedExceeds)
+	now = now.Add(exceedsWindow + time.Second)
+	require.NoError(t, h.ExceedIPLimit("10.1.0.0"))
+	require.Len(t, h.ipLimitExceeds, 1)
+}
@@ -30,6 +30,10 @@ type Config struct {
 	Port                   int      `toml:",omitempty"`
 	BootNodes              []string `toml:",omitempty"`
 	StoreNodes             []string `toml:",omitempty"`
+	LightpushNodes         []string `toml:",omitempty"` // Full nodes light clients send their messages through
+	LightClient            bool     `toml:",omitempty"` // Messages are sent through a full node using lightpush
+	FullNode               bool     `toml:",omitempty"` // Always act as a full node, even if LightClient is set
+	EnableConfirmations    bool     `toml:",omitempty"` // Wait for the relay to propagate a message before considering it sent
 }
 
 var DefaultConfig = Config{
@@ -0,0 +1,222 @@
+// Copyright 2019 The Waku Library Authors.
+//
+// The Waku library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Waku library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty off
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Waku library. If not, see <http://www.gnu.org/licenses/>.
+//
+// This software uses the go-ethereum library, which is licensed
+// under the GNU Lesser General Public Library, version 3 or any later.
+
+package wakuv2
+
+import (
+	"context"
+	"crypto/sha256"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	manet "github.com/multiformats/go-multiaddr/net"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	wakurelay "github.com/status-im/go-wakurelay-pubsub"
+	relaypb "github.com/status-im/go-wakurelay-pubsub/pb"
+
+	"github.com/status-im/status-go/wakuv2/common"
+)
+
+const sentQueueLimit = 1024
+
+// pendingEnvelope is an envelope published by this node
+// which hasn't been relayed to any peer yet.
+type pendingEnvelope struct {
+	topic       common.TopicType
+	publishedAt time.Time
+	relayID     string // ID of the relay message carrying the envelope
+}
+
+// sentTracer listens to the relay events and reports the IDs
+// of the messages which have been sent to a peer.
+type sentTracer struct {
+	sent chan string
+}
+
+func newSentTracer() *sentTracer {
+	return &sentTracer{
+		sent: make(chan string, sentQueueLimit),
+	}
+}
+
+// Trace is called from the relay event loop, so it must not block.
+func (t *sentTracer) Trace(evt *relaypb.TraceEvent) {
+	if evt.GetType() != relaypb.TraceEvent_SEND_RPC {
+		return
+	}
+
+	for _, msg := range evt.GetSendRPC().GetMeta().GetMessages() {
+		select {
+		case t.sent <- string(msg.GetMessageID()):
+		default:
+		}
+	}
+}
+
+// validateRelayMessage drops the messages of soft-blacklisted peers,
+// oversized messages and the messages exceeding the rate limits.
+// Peers exceeding the rate limits too many times are disconnected.
+func (w *Waku) validateRelayMessage(ctx context.Context, peerID peer.ID, msg *wakurelay.Message) wakurelay.ValidationResult {
+	// Messages published by this node are always accepted
+	if peerID == w.node.Host().ID() {
+		return wakurelay.ValidationAccept
+	}
+
+	if w.SoftBlacklisted(peerID.Pretty()) {
+		common.EnvelopesRejectedCounter.WithLabelValues("soft_blacklisted").Inc()
+		return wakurelay.ValidationIgnore
+	}
+
+	if uint32(len(msg.Data)) > w.MaxMessageSize() {
+		common.EnvelopesRejectedCounter.WithLabelValues("oversized_message").Inc()
+		return wakurelay.ValidationReject
+	}
+
+	w.settingsMu.RLock()
+	rateLimiter := w.rateLimiter
+	w.settingsMu.RUnlock()
+
+	if rateLimiter == nil {
+		return wakurelay.ValidationAccept
+	}
+
+	halted, err := rateLimiter.Process(peerID.Pretty(), w.peerIP(peerID), len(msg.Data))
+	if err != nil {
+		w.logger.Warn("dropping peer exceeding the rate limits", zap.String("peerID", peerID.Pretty()), zap.Error(err))
+		go func() {
+			if err := w.node.ClosePeerById(peerID); err != nil {
+				w.logger.Warn("failed to drop peer", zap.String("peerID", peerID.Pretty()), zap.Error(err))
+			}
+		}()
+		return wakurelay.ValidationReject
+	}
+	if halted {
+		return wakurelay.ValidationIgnore
+	}
+
+	return wakurelay.ValidationAccept
+}
+
+// peerIP returns the IP of the connection to a peer,
+// or an empty string if it can't be determined.
+func (w *Waku) peerIP(peerID peer.ID) string {
+	for _, conn := range w.node.Host().Network().ConnsToPeer(peerID) {
+		ip, err := manet.ToIP(conn.RemoteMultiaddr())
+		if err == nil {
+			return ip.String()
+		}
+	}
+	return ""
+}
+
+// relayMessageID returns the ID the relay gives to the message carrying the
+// serialized waku message, which is the SHA-256 of its content.
+func relayMessageID(data []byte) string {
+	hash := sha256.Sum256(data)
+	return string(hash[:])
+}
+
+func (w *Waku) addPending(hash gethcommon.Hash, relayID string, topic common.TopicType) {
+	w.pendingMu.Lock()
+	defer w.pendingMu.Unlock()
+	w.pending[hash] = &pendingEnvelope{
+		topic:       topic,
+		publishedAt: w.timeSource(),
+		relayID:     relayID,
+	}
+	w.relayIDs[relayID] = hash
+}
+
+func (w *Waku) removePending(hash gethcommon.Hash) *pendingEnvelope {
+	w.pendingMu.Lock()
+	defer w.pendingMu.Unlock()
+	envelope, ok := w.pending[hash]
+	if !ok {
+		return nil
+	}
+	delete(w.pending, hash)
+	delete(w.relayIDs, envelope.relayID)
+	return envelope
+}
+
+// relayedEnvelope returns the hash of the pending envelope sent in the relay message.
+func (w *Waku) relayedEnvelope(relayID string) (gethcommon.Hash, bool) {
+	w.pendingMu.Lock()
+	defer w.pendingMu.Unlock()
+	hash, ok := w.relayIDs[relayID]
+	return hash, ok
+}
+
+// processConfirmations emits an EnvelopeSent event once a pending envelope
+// is relayed to a peer, and an EnvelopeExpired event if it never is.
+func (w *Waku) processConfirmations() {
+	ticker := time.NewTicker(common.ExpirationCycle)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.quit:
+			return
+		case relayID := <-w.tracer.sent:
+			hash, ok := w.relayedEnvelope(relayID)
+			if !ok {
+				continue
+			}
+			envelope := w.removePending(hash)
+			if envelope == nil {
+				continue
+			}
+			w.logger.Debug("envelope relayed to a peer", zap.String("hash", hash.Hex()))
+			w.SendEnvelopeEvent(common.EnvelopeEvent{
+				Topic: envelope.topic,
+				Hash:  hash,
+				Event: common.EventEnvelopeSent,
+			})
+		case <-ticker.C:
+			w.expirePending()
+		}
+	}
+}
+
+func (w *Waku) expirePending() {
+	now := w.timeSource()
+	expired := make(map[gethcommon.Hash]*pendingEnvelope)
+
+	w.pendingMu.Lock()
+	for hash, envelope := range w.pending {
+		if now.Sub(envelope.publishedAt) > common.DefaultTTL*time.Second {
+			expired[hash] = envelope
+			delete(w.pending, hash)
+			delete(w.relayIDs, envelope.relayID)
+		}
+	}
+	w.pendingMu.Unlock()
+
+	for hash, envelope := range expired {
+		w.logger.Debug("envelope was not relayed to any peer", zap.String("hash", hash.Hex()))
+		w.SendEnvelopeEvent(common.EnvelopeEvent{
+			Topic: envelope.topic,
+			Hash:  hash,
+			Event: common.EventEnvelopeExpired,
+		})
+	}
+}
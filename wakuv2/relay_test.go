@@ -0,0 +1,234 @@
+// Copyright 2019 The Waku Library Authors.
+//
+// The Waku library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Waku library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty off
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Waku library. If not, see <http://www.gnu.org/licenses/>.
+//
+// This software uses the go-ethereum library, which is licensed
+// under the GNU Lesser General Public Library, version 3 or any later.
+
+package wakuv2
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/status-im/go-waku/waku/v2/protocol/pb"
+	"github.com/status-im/go-waku/waku/v2/protocol/relay"
+	wakurelay "github.com/status-im/go-wakurelay-pubsub"
+	relaypb "github.com/status-im/go-wakurelay-pubsub/pb"
+
+	"github.com/status-im/status-go/wakuv2/common"
+)
+
+const (
+	testPeerID            = "16Uiu2HAmPLe7Mzm8TsYUubgCAW1aJoeFScxrLj8ppHFivPo97bUZ"
+	testBlacklistedPeerID = "16Uiu2HAkvWiyFsgRhuJEb9JfjYxEkoHLgnUQmr1N5mKWnYjxYRVm"
+)
+
+// newTestWaku starts a node listening on a random port. The nodes aren't
+// stopped, as go-waku closes the subscriptions twice when stopping.
+func newTestWaku(t *testing.T, confirmations bool) *Waku {
+	cfg := DefaultConfig
+	cfg.Host = "127.0.0.1"
+	cfg.Port = 0
+	cfg.MaxMessageSize = 1024
+	cfg.EnableConfirmations = confirmations
+	cfg.SoftBlacklistedPeerIDs = []string{testBlacklistedPeerID}
+
+	w, err := New("", &cfg, nil)
+	require.NoError(t, err)
+	require.NoError(t, w.Start())
+	return w
+}
+
+func relayMessage(t *testing.T, msg *pb.WakuMessage) *wakurelay.Message {
+	data, err := msg.Marshal()
+	require.NoError(t, err)
+	return &wakurelay.Message{Message: &relaypb.Message{Data: data, From: []byte("author"), Seqno: []byte{1}}}
+}
+
+func testMessage(payload []byte) *pb.WakuMessage {
+	return &pb.WakuMessage{
+		Payload:      payload,
+		ContentTopic: "test",
+		Timestamp:    float64(time.Now().Unix()),
+	}
+}
+
+func waitForEnvelopeEvent(t *testing.T, events chan common.EnvelopeEvent, hash gethcommon.Hash) common.EnvelopeEvent {
+	timeout := time.After(10 * time.Second)
+	for {
+		select {
+		case event := <-events:
+			if event.Hash == hash {
+				return event
+			}
+		case <-timeout:
+			t.Fatalf("no event received for envelope %s", hash.Hex())
+		}
+	}
+}
+
+func TestValidateRelayMessage(t *testing.T) {
+	w := newTestWaku(t, false)
+
+	peerID, err := peer.Decode(testPeerID)
+	require.NoError(t, err)
+	blacklisted, err := peer.Decode(testBlacklistedPeerID)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	msg := relayMessage(t, testMessage([]byte("hello")))
+	oversized := relayMessage(t, testMessage(make([]byte, 2048)))
+
+	require.Equal(t, wakurelay.ValidationAccept, w.validateRelayMessage(ctx, w.node.Host().ID(), oversized))
+	require.Equal(t, wakurelay.ValidationIgnore, w.validateRelayMessage(ctx, blacklisted, msg))
+	require.Equal(t, wakurelay.ValidationReject, w.validateRelayMessage(ctx, peerID, oversized))
+	require.Equal(t, wakurelay.ValidationAccept, w.validateRelayMessage(ctx, peerID, msg))
+
+	// Peers exceeding the limits are ignored, then rejected and dropped
+	w.RegisterRateLimiter(common.NewPeerRateLimiter(&common.PeerRateLimiterConfig{
+		PacketLimitPerSecPeerID: 1,
+	}, &common.DropPeerRateLimiterHandler{Tolerance: 2}))
+	require.Equal(t, wakurelay.ValidationAccept, w.validateRelayMessage(ctx, peerID, msg))
+	require.Equal(t, wakurelay.ValidationIgnore, w.validateRelayMessage(ctx, peerID, msg))
+	require.Equal(t, wakurelay.ValidationReject, w.validateRelayMessage(ctx, peerID, msg))
+}
+
+func TestConfirmationWhenRelayed(t *testing.T) {
+	sender := newTestWaku(t, true)
+	receiver := newTestWaku(t, false)
+
+	events := make(chan common.EnvelopeEvent, 10)
+	sub := sender.SubscribeEnvelopeEvents(events)
+	defer sub.Unsubscribe()
+
+	require.NoError(t, sender.node.DialPeer(receiver.node.ListenAddresses()[0]))
+	require.Eventually(t, func() bool {
+		return len(sender.node.Relay().PubSub().ListPeers(string(relay.DefaultWakuTopic))) > 0
+	}, 10*time.Second, 100*time.Millisecond)
+
+	hash, err := sender.Send(testMessage([]byte("hello")))
+	require.NoError(t, err)
+
+	event := waitForEnvelopeEvent(t, events, gethcommon.BytesToHash(hash))
+	require.Equal(t, common.EventEnvelopeSent, event.Event)
+	require.Equal(t, common.StringToTopic("test"), event.Topic)
+
+	// The relay state of the envelope is released
+	sender.pendingMu.Lock()
+	defer sender.pendingMu.Unlock()
+	require.Empty(t, sender.pending)
+	require.Empty(t, sender.relayIDs)
+}
+
+func TestConfirmationExpires(t *testing.T) {
+	w := newTestWaku(t, true)
+
+	events := make(chan common.EnvelopeEvent, 10)
+	sub := w.SubscribeEnvelopeEvents(events)
+	defer sub.Unsubscribe()
+
+	// Without peers the envelope is never relayed
+	hash, err := w.Send(testMessage([]byte("hello")))
+	require.NoError(t, err)
+	envelopeHash := gethcommon.BytesToHash(hash)
+
+	w.pendingMu.Lock()
+	require.Equal(t, envelopeHash, w.relayIDs[w.pending[envelopeHash].relayID])
+	w.pendingMu.Unlock()
+
+	w.timeSource = func() time.Time {
+		return time.Now().Add(2 * common.DefaultTTL * time.Second)
+	}
+	w.expirePending()
+
+	event := waitForEnvelopeEvent(t, events, envelopeHash)
+	require.Equal(t, common.EventEnvelopeExpired, event.Event)
+	w.pendingMu.Lock()
+	defer w.pendingMu.Unlock()
+	require.Empty(t, w.pending)
+	require.Empty(t, w.relayIDs)
+}
+
+func newTestLightClient(t *testing.T, lightpushNodes []string) *Waku {
+	cfg := DefaultConfig
+	cfg.Host = "127.0.0.1"
+	cfg.Port = 0
+	cfg.LightClient = true
+	cfg.LightpushNodes = lightpushNodes
+
+	w, err := New("", &cfg, nil)
+	require.NoError(t, err)
+	require.NoError(t, w.Start())
+	return w
+}
+
+func TestLightClientPushesThroughFullNode(t *testing.T) {
+	fullNode := newTestWaku(t, false)
+	lightClient := newTestLightClient(t, fullNode.node.ListenAddresses()[:1])
+
+	events := make(chan common.EnvelopeEvent, 10)
+	sub := lightClient.SubscribeEnvelopeEvents(events)
+	defer sub.Unsubscribe()
+
+	hash, err := lightClient.Send(testMessage([]byte("hello")))
+	require.NoError(t, err)
+	envelopeHash := gethcommon.BytesToHash(hash)
+
+	event := waitForEnvelopeEvent(t, events, envelopeHash)
+	require.Equal(t, common.EventEnvelopeSent, event.Event)
+	require.Equal(t, common.StringToTopic("test"), event.Topic)
+
+	// The full node relays the message, which it then holds
+	require.Eventually(t, func() bool {
+		return len(fullNode.Envelopes()) == 1
+	}, 10*time.Second, 100*time.Millisecond)
+}
+
+func TestLightClientWithoutFullNodeExpires(t *testing.T) {
+	lightClient := newTestLightClient(t, nil)
+
+	events := make(chan common.EnvelopeEvent, 10)
+	sub := lightClient.SubscribeEnvelopeEvents(events)
+	defer sub.Unsubscribe()
+
+	hash, err := lightClient.Send(testMessage([]byte("hello")))
+	require.NoError(t, err)
+
+	event := waitForEnvelopeEvent(t, events, gethcommon.BytesToHash(hash))
+	require.Equal(t, common.EventEnvelopeExpired, event.Event)
+}
+
+func TestLightClientIsRefusedByLightClient(t *testing.T) {
+	other := newTestLightClient(t, nil)
+	lightClient := newTestLightClient(t, other.node.ListenAddresses()[:1])
+
+	events := make(chan common.EnvelopeEvent, 10)
+	sub := lightClient.SubscribeEnvelopeEvents(events)
+	defer sub.Unsubscribe()
+
+	// Light clients don't relay the messages pushed to them
+	hash, err := lightClient.Send(testMessage([]byte("hello")))
+	require.NoError(t, err)
+
+	event := waitForEnvelopeEvent(t, events, gethcommon.BytesToHash(hash))
+	require.Equal(t, common.EventEnvelopeExpired, event.Event)
+}
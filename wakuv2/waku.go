@@ -50,6 +50,7 @@ import (
 	"github.com/status-im/status-go/wakuv2/common"
 
 	node "github.com/status-im/go-waku/waku/v2/node"
+	"github.com/status-im/go-waku/waku/v2/protocol/lightpush"
 	"github.com/status-im/go-waku/waku/v2/protocol/pb"
 	"github.com/status-im/go-waku/waku/v2/protocol/store"
 	wakurelay "github.com/status-im/go-wakurelay-pubsub"
@@ -57,16 +58,26 @@ import (
 
 const messageQueueLimit = 1024
 
+// lightPushTimeout is how long a full node has to acknowledge a message pushed by a light client
+const lightPushTimeout = 10 * time.Second
+
+// Bridge moves messages between wakuv2 and another network.
+type Bridge interface {
+	Pipe() (<-chan *pb.WakuMessage, chan<- *pb.WakuMessage)
+}
+
 type settings struct {
 	MaxMsgSize             uint32          // Maximal message length allowed by the waku node
 	EnableConfirmations    bool            // Enable sending message confirmations
+	LightClient            bool            // Indicates if the node is sending messages through lightpush
 	SoftBlacklistedPeerIDs map[string]bool // SoftBlacklistedPeerIDs is a list of peer ids that we want to keep connected but silently drop any envelope from
 }
 
 // Waku represents a dark communication interface through the Ethereum
 // network, using its very own P2P communication layer.
 type Waku struct {
-	node *node.WakuNode // reference to a libp2p waku node
+	node      *node.WakuNode           // reference to a libp2p waku node
+	lightPush *lightpush.WakuLightPush // Client pushing the messages of a light client to full nodes
 
 	filters *common.Filters // Message filters installed with Subscribe function
 
@@ -88,6 +99,17 @@ type Waku struct {
 
 	timeSource func() time.Time // source of time for waku
 
+	bridge       Bridge
+	bridgeWg     sync.WaitGroup
+	cancelBridge chan struct{}
+
+	rateLimiter *common.PeerRateLimiter
+
+	pending   map[gethcommon.Hash]*pendingEnvelope // Envelopes published by this node waiting to be relayed to a peer
+	relayIDs  map[string]gethcommon.Hash           // Hashes of the pending envelopes by relay message ID
+	pendingMu sync.Mutex                           // Mutex to sync the pending envelopes
+	tracer    *sentTracer                          // Reports the envelopes relayed to the peers
+
 	logger *zap.Logger
 }
 
@@ -111,14 +133,23 @@ func New(nodeKey string, cfg *Config, logger *zap.Logger) (*Waku, error) {
 		msgQueue:    make(chan *common.ReceivedMessage, messageQueueLimit),
 		quit:        make(chan struct{}),
 		timeSource:  time.Now,
+		pending:     make(map[gethcommon.Hash]*pendingEnvelope),
+		relayIDs:    make(map[string]gethcommon.Hash),
+		tracer:      newSentTracer(),
 		logger:      logger,
 	}
 
 	waku.settings = settings{
 		MaxMsgSize:             cfg.MaxMessageSize,
+		EnableConfirmations:    cfg.EnableConfirmations,
+		LightClient:            cfg.LightClient && !cfg.FullNode,
 		SoftBlacklistedPeerIDs: make(map[string]bool),
 	}
 
+	for _, peerID := range cfg.SoftBlacklistedPeerIDs {
+		waku.settings.SoftBlacklistedPeerIDs[peerID] = true
+	}
+
 	waku.filters = common.NewFilters()
 
 	var privateKey *ecdsa.PrivateKey
@@ -139,18 +170,44 @@ func New(nodeKey string, cfg *Config, logger *zap.Logger) (*Waku, error) {
 		return nil, fmt.Errorf("failed to setup the network interface: %v", err)
 	}
 
-	waku.node, err = node.New(context.Background(),
+	opts := []node.WakuNodeOption{
 		node.WithPrivateKey(privateKey),
 		node.WithHostAddress([]net.Addr{hostAddr}),
-		node.WithWakuRelay(wakurelay.WithMaxMessageSize(int(waku.settings.MaxMsgSize))),
+		node.WithWakuRelay(
+			wakurelay.WithMaxMessageSize(int(waku.settings.MaxMsgSize)),
+			wakurelay.WithEventTracer(waku.tracer),
+		),
 		node.WithWakuStore(false), // Mounts the store protocol (without storing the messages)
-	)
+	}
+
+	if waku.settings.LightClient {
+		// go-waku only accepts lightpush peers once the filter protocol is mounted
+		opts = append(opts, node.WithWakuFilter())
+	}
+
+	waku.node, err = node.New(context.Background(), opts...)
 
 	if err != nil {
 		fmt.Println(err)
 		return nil, fmt.Errorf("failed to start the go-waku node: %v", err)
 	}
 
+	// The lightpush protocol isn't mounted with the node, as the node would
+	// then publish its own messages through lightpush as well
+	if waku.settings.LightClient {
+		// Messages are pushed to full nodes instead of being relayed,
+		// and the messages pushed by other light clients are refused
+		waku.lightPush = lightpush.NewWakuLightPush(context.Background(), waku.node.Host(), nil)
+	} else {
+		// Full nodes relay the messages pushed by light clients
+		lightpush.NewWakuLightPush(context.Background(), waku.node.Host(), waku.node.Relay())
+	}
+
+	err = waku.node.Relay().PubSub().RegisterTopicValidator(string(relay.DefaultWakuTopic), waku.validateRelayMessage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to register the relay validator: %v", err)
+	}
+
 	for _, bootnode := range cfg.BootNodes {
 		err := waku.node.DialPeer(bootnode)
 		if err != nil {
@@ -169,6 +226,17 @@ func New(nodeKey string, cfg *Config, logger *zap.Logger) (*Waku, error) {
 		}
 	}
 
+	if waku.settings.LightClient {
+		for _, lightpushNode := range cfg.LightpushNodes {
+			peerID, err := waku.node.AddLightPushPeer(lightpushNode)
+			if err != nil {
+				log.Warn("Could not add lightpush peer", err)
+			} else {
+				log.Info("Lightpush peer added successfully", "peerId", peerID.Pretty())
+			}
+		}
+	}
+
 	go waku.runMsgLoop()
 
 	log.Info("setup the go-waku node successfully")
@@ -206,6 +274,28 @@ func (w *Waku) ConfirmationsEnabled() bool {
 	return w.settings.EnableConfirmations
 }
 
+// LightClient returns true if messages are sent through a full node.
+func (w *Waku) LightClient() bool {
+	w.settingsMu.RLock()
+	defer w.settingsMu.RUnlock()
+	return w.settings.LightClient
+}
+
+// SoftBlacklisted returns true if the messages of a peer are silently dropped.
+func (w *Waku) SoftBlacklisted(peerID string) bool {
+	w.settingsMu.RLock()
+	defer w.settingsMu.RUnlock()
+	return w.settings.SoftBlacklistedPeerIDs[peerID]
+}
+
+// RegisterRateLimiter registers a rate limiter that is applied to the
+// messages relayed by the peers.
+func (w *Waku) RegisterRateLimiter(r *common.PeerRateLimiter) {
+	w.settingsMu.Lock()
+	defer w.settingsMu.Unlock()
+	w.rateLimiter = r
+}
+
 // CurrentTime returns current time.
 func (w *Waku) CurrentTime() time.Time {
 	return w.timeSource()
@@ -522,8 +612,94 @@ func (w *Waku) UnsubscribeMany(ids []string) error {
 
 // Send injects a message into the waku send queue, to be distributed in the
 // network in the coming cycles.
+// An EnvelopeSent event is emitted once the message has been relayed to a peer.
+// If confirmations are disabled the event is emitted as soon as the message is
+// published. Light clients push the message to a full node instead, and the
+// event is emitted once the full node acknowledges it.
 func (w *Waku) Send(msg *pb.WakuMessage) ([]byte, error) {
-	return w.node.Publish(context.Background(), msg, nil)
+	data, err := msg.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	hash := pb.Hash(data)
+
+	envelopeHash := gethcommon.BytesToHash(hash)
+	topic := common.StringToTopic(msg.ContentTopic)
+
+	if w.LightClient() {
+		go w.pushMessage(msg, envelopeHash, topic)
+		return hash, nil
+	}
+
+	// The message might be relayed before Publish returns,
+	// so it must be tracked beforehand
+	waitForRelay := w.ConfirmationsEnabled()
+	if waitForRelay {
+		w.addPending(envelopeHash, relayMessageID(data), topic)
+	}
+
+	_, err = w.node.Publish(context.Background(), msg, nil)
+	if err != nil {
+		if waitForRelay {
+			w.removePending(envelopeHash)
+		}
+		return nil, err
+	}
+
+	if !waitForRelay {
+		w.SendEnvelopeEvent(common.EnvelopeEvent{
+			Topic: topic,
+			Hash:  envelopeHash,
+			Event: common.EventEnvelopeSent,
+		})
+	}
+
+	return hash, nil
+}
+
+// pushMessage sends the message of a light client through a full node. An
+// EnvelopeSent event is emitted once the full node reports it relayed the
+// message, and an EnvelopeExpired event if it didn't or never answered.
+func (w *Waku) pushMessage(msg *pb.WakuMessage, hash gethcommon.Hash, topic common.TopicType) {
+	ctx, cancel := context.WithTimeout(context.Background(), lightPushTimeout)
+	defer cancel()
+
+	type pushResult struct {
+		response *pb.PushResponse
+		err      error
+	}
+
+	// The context is only used to open the stream,
+	// so the response is awaited separately
+	result := make(chan pushResult, 1)
+	go func() {
+		response, err := w.lightPush.Request(ctx, &pb.PushRequest{
+			Message:     msg,
+			PubsubTopic: string(relay.DefaultWakuTopic),
+		})
+		result <- pushResult{response, err}
+	}()
+
+	event := common.EventEnvelopeExpired
+	select {
+	case <-ctx.Done():
+		w.logger.Warn("lightpush node didn't answer", zap.String("hash", hash.Hex()))
+	case r := <-result:
+		switch {
+		case r.err != nil:
+			w.logger.Warn("failed to push the message", zap.String("hash", hash.Hex()), zap.Error(r.err))
+		case r.response == nil || !r.response.IsSuccess:
+			w.logger.Warn("lightpush node didn't relay the message", zap.String("hash", hash.Hex()), zap.String("info", r.response.GetInfo()))
+		default:
+			event = common.EventEnvelopeSent
+		}
+	}
+
+	w.SendEnvelopeEvent(common.EnvelopeEvent{
+		Topic: topic,
+		Hash:  hash,
+		Event: event,
+	})
 }
 
 func (w *Waku) Query(topics []types.TopicType, from uint64, to uint64, opts []store.HistoryRequestOption) (cursor *pb.Index, err error) {
@@ -549,6 +725,44 @@ func (w *Waku) Query(topics []types.TopicType, from uint64, to uint64, opts []st
 	return
 }
 
+// RegisterBridge registers a new Bridge that moves messages
+// between different subprotocols.
+func (w *Waku) RegisterBridge(b Bridge) {
+	if w.cancelBridge != nil {
+		close(w.cancelBridge)
+	}
+	w.bridge = b
+	w.cancelBridge = make(chan struct{})
+	w.bridgeWg.Add(1)
+	go w.readBridgeLoop()
+}
+
+func (w *Waku) readBridgeLoop() {
+	defer w.bridgeWg.Done()
+	out, _ := w.bridge.Pipe()
+	for {
+		select {
+		case <-w.cancelBridge:
+			return
+		case msg := <-out:
+			envelope := wakuprotocol.NewEnvelope(msg, string(relay.DefaultWakuTopic))
+			// Cache the message first so that it's not bridged back
+			// when the relay delivers it to our own subscription
+			_, err := w.addAndBridge(common.NewReceivedMessage(envelope), true)
+			if err == nil {
+				_, err = w.node.Publish(context.Background(), msg, nil)
+			}
+			if err != nil {
+				common.BridgeReceivedFailed.Inc()
+				w.logger.Warn("failed to publish a bridged message", zap.Binary("ID", envelope.Hash()), zap.Error(err))
+			} else {
+				common.BridgeReceivedSucceed.Inc()
+				w.logger.Debug("bridged message successfully", zap.Binary("ID", envelope.Hash()))
+			}
+		}
+	}
+}
+
 // Start implements node.Service, starting the background data propagation thread
 // of the Waku protocol.
 func (w *Waku) Start() error {
@@ -557,12 +771,19 @@ func (w *Waku) Start() error {
 		go w.processQueue()
 	}
 
+	go w.processConfirmations()
+
 	return nil
 }
 
 // Stop implements node.Service, stopping the background data propagation thread
 // of the Waku protocol.
 func (w *Waku) Stop() error {
+	if w.cancelBridge != nil {
+		close(w.cancelBridge)
+		w.cancelBridge = nil
+		w.bridgeWg.Wait()
+	}
 	w.node.Stop()
 	close(w.quit)
 	return nil
@@ -600,6 +821,12 @@ func (w *Waku) addEnvelope(envelope *common.ReceivedMessage) {
 }
 
 func (w *Waku) add(recvMessage *common.ReceivedMessage) (bool, error) {
+	return w.addAndBridge(recvMessage, false)
+}
+
+// addAndBridge caches a new message and passes it to the bridge,
+// unless the message was received from the bridge.
+func (w *Waku) addAndBridge(recvMessage *common.ReceivedMessage, bridged bool) (bool, error) {
 	common.EnvelopesReceivedCounter.Inc()
 
 	hash := recvMessage.Hash()
@@ -619,6 +846,12 @@ func (w *Waku) add(recvMessage *common.ReceivedMessage) (bool, error) {
 		common.EnvelopesCachedCounter.WithLabelValues("miss").Inc()
 		common.EnvelopesSizeMeter.Observe(float64(recvMessage.Envelope.Size()))
 		w.postEvent(recvMessage) // notify the local node about the new message
+		if !bridged && w.bridge != nil {
+			log.Debug("bridging message from WakuV2", "hash", recvMessage.Hash().Hex())
+			_, in := w.bridge.Pipe()
+			in <- recvMessage.Envelope.Message()
+			common.BridgeSent.Inc()
+		}
 	}
 	return true, nil
 }